@@ -14,13 +14,14 @@ import (
 )
 
 type handler struct {
-	store *storage.Storage
-	pool  *worker.Pool
+	store  *storage.Storage
+	pool   *worker.Pool
+	router *mux.Router
 }
 
 // Serve declares API routes for the application.
 func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
-	handler := &handler{store, pool}
+	handler := &handler{store, pool, router}
 
 	sr := router.PathPrefix("/v1").Subrouter()
 	middleware := newMiddleware(store)
@@ -36,6 +37,7 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	sr.HandleFunc("/users/{userID:[0-9]+}/mark-all-as-read", handler.markUserAsRead).Methods(http.MethodPut)
 	sr.HandleFunc("/users/{username}", handler.userByUsername).Methods(http.MethodGet)
 	sr.HandleFunc("/me", handler.currentUser).Methods(http.MethodGet)
+	sr.HandleFunc("/audit", handler.auditEvents).Methods(http.MethodGet)
 	sr.HandleFunc("/categories", handler.createCategory).Methods(http.MethodPost)
 	sr.HandleFunc("/categories", handler.getCategories).Methods(http.MethodGet)
 	sr.HandleFunc("/categories/{categoryID}", handler.updateCategory).Methods(http.MethodPut)
@@ -54,12 +56,22 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	sr.HandleFunc("/feeds/{feedID}", handler.removeFeed).Methods(http.MethodDelete)
 	sr.HandleFunc("/feeds/{feedID}/icon", handler.feedIcon).Methods(http.MethodGet)
 	sr.HandleFunc("/feeds/{feedID}/mark-all-as-read", handler.markFeedAsRead).Methods(http.MethodPut)
+	sr.HandleFunc("/newsletters", handler.createNewsletter).Methods(http.MethodPost)
+	sr.HandleFunc("/scraped-feeds", handler.createScrapedFeed).Methods(http.MethodPost)
+	sr.HandleFunc("/scraped-feeds/preview", handler.previewScrapedFeed).Methods(http.MethodPost)
+	sr.HandleFunc("/watch-pages", handler.createWatchPage).Methods(http.MethodPost)
 	sr.HandleFunc("/export", handler.exportFeeds).Methods(http.MethodGet)
 	sr.HandleFunc("/import", handler.importFeeds).Methods(http.MethodPost)
+	sr.HandleFunc("/import/{jobID}", handler.importJob).Methods(http.MethodGet)
+	sr.HandleFunc("/export/epub", handler.exportEntries).Methods(http.MethodGet)
 	sr.HandleFunc("/feeds/{feedID}/entries", handler.getFeedEntries).Methods(http.MethodGet)
 	sr.HandleFunc("/feeds/{feedID}/entries/{entryID}", handler.getFeedEntry).Methods(http.MethodGet)
 	sr.HandleFunc("/entries", handler.getEntries).Methods(http.MethodGet)
 	sr.HandleFunc("/entries", handler.setEntryStatus).Methods(http.MethodPut)
 	sr.HandleFunc("/entries/{entryID}", handler.getEntry).Methods(http.MethodGet)
 	sr.HandleFunc("/entries/{entryID}/bookmark", handler.toggleBookmark).Methods(http.MethodPut)
+	sr.HandleFunc("/entries/{entryID}/archive", handler.getEntryArchive).Methods(http.MethodGet)
+	sr.HandleFunc("/entries/{entryID}/archive", handler.createEntryArchive).Methods(http.MethodPost)
+	sr.HandleFunc("/enclosures/{enclosureID}", handler.getEnclosure).Methods(http.MethodGet)
+	sr.HandleFunc("/enclosures/{enclosureID}", handler.updateEnclosure).Methods(http.MethodPut)
 }
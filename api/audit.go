@@ -0,0 +1,56 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+	"time"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/validator"
+)
+
+func (h *handler) auditEvents(w http.ResponseWriter, r *http.Request) {
+	if !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	limit := request.QueryIntParam(r, "limit", 100)
+	offset := request.QueryIntParam(r, "offset", 0)
+	if err := validator.ValidateRange(offset, limit); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	builder := h.store.NewAuditEventQueryBuilder()
+	builder.WithUserID(request.QueryInt64Param(r, "user_id", 0))
+	builder.WithAction(request.QueryStringParam(r, "action", ""))
+	builder.WithClientIP(request.QueryStringParam(r, "client_ip", ""))
+
+	if beforeTimestamp := request.QueryInt64Param(r, "before", 0); beforeTimestamp > 0 {
+		builder.BeforeDate(time.Unix(beforeTimestamp, 0))
+	}
+
+	if afterTimestamp := request.QueryInt64Param(r, "after", 0); afterTimestamp > 0 {
+		builder.AfterDate(time.Unix(afterTimestamp, 0))
+	}
+
+	count, err := builder.CountEvents()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	events, err := builder.WithOffset(offset).WithLimit(limit).GetEvents()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	events.UseTimezone(request.UserTimezone(r))
+	json.OK(w, r, &auditEventsResponse{Total: count, Events: events})
+}
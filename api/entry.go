@@ -10,14 +10,18 @@ import (
 	"net/http"
 	"time"
 
+	"miniflux.app/config"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
 	"miniflux.app/model"
+	"miniflux.app/proxy"
+	"miniflux.app/reader/archiver"
+	"miniflux.app/reader/podcast"
 	"miniflux.app/storage"
 	"miniflux.app/validator"
 )
 
-func getEntryFromBuilder(w http.ResponseWriter, r *http.Request, b *storage.EntryQueryBuilder) {
+func (h *handler) getEntryFromBuilder(w http.ResponseWriter, r *http.Request, b *storage.EntryQueryBuilder) {
 	entry, err := b.GetEntry()
 	if err != nil {
 		json.ServerError(w, r, err)
@@ -29,9 +33,23 @@ func getEntryFromBuilder(w http.ResponseWriter, r *http.Request, b *storage.Entr
 		return
 	}
 
+	podcast.QueueEpisode(h.store, entry)
+	h.proxifyEntries(model.Entries{entry})
+
 	json.OK(w, r, entry)
 }
 
+// proxifyEntries rewrites the media URLs of the content when API clients can fetch them through the proxy.
+func (h *handler) proxifyEntries(entries model.Entries) {
+	if !config.Opts.IsProxyPublic() {
+		return
+	}
+
+	for _, entry := range entries {
+		entry.Content = proxy.AbsoluteImageProxyRewriter(h.router, entry.Content)
+	}
+}
+
 func (h *handler) getFeedEntry(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 	entryID := request.RouteInt64Param(r, "entryID")
@@ -40,7 +58,7 @@ func (h *handler) getFeedEntry(w http.ResponseWriter, r *http.Request) {
 	builder.WithFeedID(feedID)
 	builder.WithEntryID(entryID)
 
-	getEntryFromBuilder(w, r, builder)
+	h.getEntryFromBuilder(w, r, builder)
 }
 
 func (h *handler) getCategoryEntry(w http.ResponseWriter, r *http.Request) {
@@ -51,7 +69,7 @@ func (h *handler) getCategoryEntry(w http.ResponseWriter, r *http.Request) {
 	builder.WithCategoryID(categoryID)
 	builder.WithEntryID(entryID)
 
-	getEntryFromBuilder(w, r, builder)
+	h.getEntryFromBuilder(w, r, builder)
 }
 
 func (h *handler) getEntry(w http.ResponseWriter, r *http.Request) {
@@ -59,7 +77,7 @@ func (h *handler) getEntry(w http.ResponseWriter, r *http.Request) {
 	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
 	builder.WithEntryID(entryID)
 
-	getEntryFromBuilder(w, r, builder)
+	h.getEntryFromBuilder(w, r, builder)
 }
 
 func (h *handler) getFeedEntries(w http.ResponseWriter, r *http.Request) {
@@ -139,6 +157,7 @@ func (h *handler) findEntries(w http.ResponseWriter, r *http.Request, feedID int
 		return
 	}
 
+	h.proxifyEntries(entries)
 	json.OK(w, r, &entriesResponse{Total: count, Entries: entries})
 }
 
@@ -169,6 +188,8 @@ func (h *handler) toggleBookmark(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	archiver.ArchiveStarredEntry(h.store, request.UserID(r), entryID)
+
 	json.NoContent(w, r)
 }
 
@@ -206,4 +227,9 @@ func configureFilters(builder *storage.EntryQueryBuilder, r *http.Request) {
 	if searchQuery != "" {
 		builder.WithSearchQuery(searchQuery)
 	}
+
+	categoryLabel := request.QueryStringParam(r, "category_label", "")
+	if categoryLabel != "" {
+		builder.WithTag(categoryLabel)
+	}
 }
@@ -0,0 +1,44 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/reader/archiver"
+)
+
+func (h *handler) getEntryArchive(w http.ResponseWriter, r *http.Request) {
+	entryID := request.RouteInt64Param(r, "entryID")
+	archive, err := h.store.EntryArchive(request.UserID(r), entryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if archive == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.OK(w, r, archive)
+}
+
+func (h *handler) createEntryArchive(w http.ResponseWriter, r *http.Request) {
+	archive, err := archiver.ArchiveEntry(h.store, request.UserID(r), request.RouteInt64Param(r, "entryID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if archive == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.Created(w, r, archive)
+}
@@ -0,0 +1,80 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/epub"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+	"miniflux.app/validator"
+)
+
+func (h *handler) exportEntries(w http.ResponseWriter, r *http.Request) {
+	statuses := request.QueryStringParamList(r, "status")
+	for _, status := range statuses {
+		if err := validator.ValidateEntryStatus(status); err != nil {
+			json.BadRequest(w, r, err)
+			return
+		}
+	}
+
+	userID := request.UserID(r)
+	categoryID := request.QueryInt64Param(r, "category_id", 0)
+	if categoryID > 0 && !h.store.CategoryIDExists(userID, categoryID) {
+		json.BadRequest(w, r, errors.New("Invalid category ID"))
+		return
+	}
+
+	feedID := request.QueryInt64Param(r, "feed_id", 0)
+	if feedID > 0 && !h.store.FeedExists(userID, feedID) {
+		json.BadRequest(w, r, errors.New("Invalid feed ID"))
+		return
+	}
+
+	user, err := h.store.UserByID(userID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if user == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(userID)
+	builder.WithFeedID(feedID)
+	builder.WithCategoryID(categoryID)
+	builder.WithStatuses(statuses)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+	builder.WithOrder(model.DefaultSortingOrder)
+	builder.WithDirection(user.EntryDirection)
+	builder.WithLimit(epub.MaxEntries)
+	configureFilters(builder, r)
+
+	entries, err := builder.GetEntries()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	book, err := epub.Export(request.QueryStringParam(r, "title", "Miniflux"), user.Language, entries)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	response.New(w, r).
+		WithHeader("Content-Type", "application/epub+zip").
+		WithAttachment("entries.epub").
+		WithBody(book).
+		WithoutCompression().
+		Write()
+}
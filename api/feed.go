@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"time"
 
+	"miniflux.app/audit"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
 	"miniflux.app/model"
@@ -93,6 +94,12 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The address of a newsletter feed is generated and cannot be changed.
+	if originalFeed.IsNewsletter() {
+		feedModificationRequest.FeedURL = nil
+		feedModificationRequest.SiteURL = nil
+	}
+
 	if validationErr := validator.ValidateFeedModification(h.store, userID, &feedModificationRequest); validationErr != nil {
 		json.BadRequest(w, r, validationErr.Error())
 		return
@@ -190,7 +197,13 @@ func (h *handler) removeFeed(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 	userID := request.UserID(r)
 
-	if !h.store.FeedExists(userID, feedID) {
+	feed, err := h.store.FeedByID(userID, feedID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
 		json.NotFound(w, r)
 		return
 	}
@@ -200,5 +213,7 @@ func (h *handler) removeFeed(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	audit.Log(h.store, r, &model.AuditEvent{UserID: userID, Action: model.AuditActionFeedRemoved, Target: feed.FeedURL})
+
 	json.NoContent(w, r)
 }
@@ -8,8 +8,12 @@ import (
 	"context"
 	"net/http"
 
+	"miniflux.app/audit"
+	"miniflux.app/config"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/ldap"
+	"miniflux.app/lockout"
 	"miniflux.app/logger"
 	"miniflux.app/storage"
 )
@@ -95,12 +99,33 @@ func (m *middleware) basicAuth(next http.Handler) http.Handler {
 			return
 		}
 
-		if err := m.store.CheckPassword(username, password); err != nil {
-			logger.Error("[API][BasicAuth] [ClientIP=%s] Invalid username or password: %s", clientIP, username)
-			json.Unauthorized(w, r)
+		lockoutIP := lockout.ClientIP(r)
+		guard := lockout.NewGuard(m.store)
+		if retryAfter := guard.Check("api", username, lockoutIP); retryAfter > 0 {
+			json.TooManyRequests(w, r, retryAfter)
 			return
 		}
 
+		if err := m.store.CheckPassword(username, password); err != nil {
+			if !config.Opts.HasLDAP() {
+				logger.Error("[API][BasicAuth] [ClientIP=%s] Invalid username or password: %s", clientIP, username)
+				guard.Fail("api", username, lockoutIP)
+				audit.LogFailedLogin(m.store, r, username, "api")
+				json.Unauthorized(w, r)
+				return
+			}
+
+			if _, err := ldap.Login(m.store, r, username, password); err != nil {
+				logger.Error("[API][BasicAuth] [ClientIP=%s] Invalid username or password: %s (%v)", clientIP, username, err)
+				guard.Fail("api", username, lockoutIP)
+				audit.LogFailedLogin(m.store, r, username, "api")
+				json.Unauthorized(w, r)
+				return
+			}
+		}
+
+		guard.Succeed(username)
+
 		user, err := m.store.UserByUsername(username)
 		if err != nil {
 			logger.Error("[API][BasicAuth] %v", err)
@@ -0,0 +1,39 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	json_parser "encoding/json"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+	feedHandler "miniflux.app/reader/handler"
+	"miniflux.app/validator"
+)
+
+func (h *handler) createNewsletter(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	var newsletterCreationRequest model.NewsletterCreationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&newsletterCreationRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if validationErr := validator.ValidateNewsletterCreation(h.store, userID, &newsletterCreationRequest); validationErr != nil {
+		json.BadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	feed, err := feedHandler.CreateNewsletterFeed(h.store, userID, &newsletterCreationRequest)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, &feedCreationResponse{FeedID: feed.ID})
+}
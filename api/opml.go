@@ -26,12 +26,36 @@ func (h *handler) exportFeeds(w http.ResponseWriter, r *http.Request) {
 
 func (h *handler) importFeeds(w http.ResponseWriter, r *http.Request) {
 	opmlHandler := opml.NewHandler(h.store)
-	err := opmlHandler.Import(request.UserID(r), r.Body)
+	if request.HasQueryParam(r, "apply_settings") {
+		opmlHandler.WithFeedSettings()
+	}
+
+	if request.HasQueryParam(r, "validate") {
+		opmlHandler.WithValidation()
+	}
+
+	job, err := opmlHandler.Import(request.UserID(r), r.Body)
 	defer r.Body.Close()
 	if err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
 
-	json.Created(w, r, map[string]string{"message": "Feeds imported successfully"})
+	// The feeds are created in the background, the progress is available with the job ID.
+	json.Created(w, r, map[string]interface{}{"message": "Feeds import started", "job_id": job.ID})
+}
+
+func (h *handler) importJob(w http.ResponseWriter, r *http.Request) {
+	job, err := h.store.ImportJob(request.UserID(r), request.RouteInt64Param(r, "jobID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if job == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.OK(w, r, job)
 }
@@ -19,6 +19,11 @@ type entriesResponse struct {
 	Entries model.Entries `json:"entries"`
 }
 
+type auditEventsResponse struct {
+	Total  int               `json:"total"`
+	Events model.AuditEvents `json:"events"`
+}
+
 type feedCreationResponse struct {
 	FeedID int64 `json:"feed_id"`
 }
@@ -0,0 +1,60 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package api // import "miniflux.app/api"
+
+import (
+	json_parser "encoding/json"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+	feedHandler "miniflux.app/reader/handler"
+	"miniflux.app/validator"
+)
+
+func (h *handler) createScrapedFeed(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	var scrapedFeedCreationRequest model.ScrapedFeedCreationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&scrapedFeedCreationRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if validationErr := validator.ValidateScrapedFeedCreation(h.store, userID, &scrapedFeedCreationRequest); validationErr != nil {
+		json.BadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	feed, err := feedHandler.CreateScrapedFeed(h.store, userID, &scrapedFeedCreationRequest)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, &feedCreationResponse{FeedID: feed.ID})
+}
+
+func (h *handler) previewScrapedFeed(w http.ResponseWriter, r *http.Request) {
+	var scrapedFeedCreationRequest model.ScrapedFeedCreationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&scrapedFeedCreationRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if validationErr := validator.ValidateScrapedFeedPreview(&scrapedFeedCreationRequest); validationErr != nil {
+		json.BadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	feed, err := feedHandler.PreviewScrapedFeed(&scrapedFeedCreationRequest)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	json.OK(w, r, &entriesResponse{Total: len(feed.Entries), Entries: feed.Entries})
+}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"net/http"
 
+	"miniflux.app/audit"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
 	"miniflux.app/model"
@@ -48,6 +49,7 @@ func (h *handler) createUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	audit.Log(h.store, r, &model.AuditEvent{UserID: user.ID, Action: model.AuditActionUserCreated, Target: user.Username})
 	json.Created(w, r, user)
 }
 
@@ -88,12 +90,15 @@ func (h *handler) updateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	wasAdmin := originalUser.IsAdmin
 	userModificationRequest.Patch(originalUser)
 	if err = h.store.UpdateUser(originalUser); err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
 
+	audit.LogUserModification(h.store, r, originalUser, wasAdmin, userModificationRequest.Password != nil)
+
 	json.Created(w, r, originalUser)
 }
 
@@ -200,5 +205,6 @@ func (h *handler) removeUser(w http.ResponseWriter, r *http.Request) {
 	}
 
 	h.store.RemoveUserAsync(user.ID)
+	audit.Log(h.store, r, &model.AuditEvent{Action: model.AuditActionUserRemoved, Target: user.Username})
 	json.NoContent(w, r)
 }
@@ -0,0 +1,108 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package audit // import "miniflux.app/audit"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/storage"
+)
+
+// ActorCLI is the actor of the events recorded by the command line.
+const ActorCLI = "cli"
+
+// ActorLDAP is the actor of the accounts created and updated from the directory.
+const ActorLDAP = "ldap"
+
+// ActorOAuth2 is the prefix of the actor of the accounts created and updated by an OAuth2 provider.
+const ActorOAuth2 = "oauth2"
+
+// Log records an event, the actor and the client are taken from the request unless already set.
+// Errors are logged but never returned: the audited action must not fail because of the audit log.
+func Log(store *storage.Storage, r *http.Request, event *model.AuditEvent) {
+	if r != nil {
+		if event.ActorID == 0 {
+			event.ActorID = request.UserID(r)
+		}
+
+		if event.ClientIP == "" {
+			event.ClientIP = request.ClientIP(r)
+		}
+
+		if event.UserAgent == "" {
+			event.UserAgent = r.UserAgent()
+		}
+	}
+
+	if err := store.CreateAuditEvent(event); err != nil {
+		logger.Error("[Audit] %v", err)
+	}
+}
+
+// LogLogin records a successful login, the method is for example "password" or "webauthn".
+func LogLogin(store *storage.Storage, r *http.Request, user *model.User, method string) {
+	Log(store, r, &model.AuditEvent{
+		ActorID: user.ID,
+		Actor:   user.Username,
+		UserID:  user.ID,
+		Action:  model.AuditActionLogin,
+		Target:  method,
+	})
+}
+
+// LogFailedLogin records a failed login, the event is attached to the account when the username exists.
+func LogFailedLogin(store *storage.Storage, r *http.Request, username, source string) {
+	event := &model.AuditEvent{Actor: username, Action: model.AuditActionLoginFailed, Target: source}
+	if user, err := store.UserByUsername(username); err == nil && user != nil {
+		event.UserID = user.ID
+	}
+
+	Log(store, r, event)
+}
+
+// LogUserModification records password and permission changes made to an account.
+func LogUserModification(store *storage.Storage, r *http.Request, user *model.User, wasAdmin, passwordChanged bool) {
+	if passwordChanged {
+		Log(store, r, &model.AuditEvent{UserID: user.ID, Action: model.AuditActionPasswordChanged, Target: user.Username})
+	}
+
+	if user.IsAdmin != wasAdmin {
+		action := model.AuditActionUserDemoted
+		if user.IsAdmin {
+			action = model.AuditActionUserPromoted
+		}
+
+		Log(store, r, &model.AuditEvent{UserID: user.ID, Action: action, Target: user.Username})
+	}
+}
+
+// LogProvisioning records the accounts created and the permissions changed by an identity provider during the login.
+// The actor is the identity provider, wasAdmin is ignored when the account has been created.
+func LogProvisioning(store *storage.Storage, r *http.Request, actor string, user *model.User, created, wasAdmin bool) {
+	if created {
+		Log(store, r, &model.AuditEvent{Actor: actor, UserID: user.ID, Action: model.AuditActionUserCreated, Target: user.Username})
+		if !user.IsAdmin {
+			return
+		}
+		wasAdmin = false
+	}
+
+	if user.IsAdmin != wasAdmin {
+		action := model.AuditActionUserDemoted
+		if user.IsAdmin {
+			action = model.AuditActionUserPromoted
+		}
+
+		Log(store, r, &model.AuditEvent{Actor: actor, UserID: user.ID, Action: action, Target: user.Username})
+	}
+}
+
+// LogCLI records an action performed on an account from the command line.
+func LogCLI(store *storage.Storage, action string, user *model.User) {
+	Log(store, nil, &model.AuditEvent{Actor: ActorCLI, UserID: user.ID, Action: action, Target: user.Username})
+}
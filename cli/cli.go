@@ -22,7 +22,12 @@ const (
 	flagFlushSessionsHelp   = "Flush all sessions (disconnect users)"
 	flagCreateAdminHelp     = "Create admin user"
 	flagResetPasswordHelp   = "Reset user password"
+	flagResetTOTPHelp       = "Disable two-factor authentication for a user"
 	flagResetFeedErrorsHelp = "Clear all feed errors for all users"
+	flagEncryptSecretsHelp  = "Encrypt the credentials and secrets stored in plaintext"
+	flagRotateKeyHelp       = "Re-encrypt all secrets with a new data key wrapped by ENCRYPTION_KEY (the application must be stopped)"
+	flagImportHelp          = "Import an OPML file or an export of another feed reader (Google Reader JSON, Tiny Tiny RSS, FreshRSS)"
+	flagImportUserHelp      = "Username of the user receiving the imported feeds and entries"
 	flagDebugModeHelp       = "Show debug logs"
 	flagConfigFileHelp      = "Load configuration file"
 	flagConfigDumpHelp      = "Print parsed configuration values"
@@ -38,7 +43,12 @@ func Parse() {
 		flagFlushSessions   bool
 		flagCreateAdmin     bool
 		flagResetPassword   bool
+		flagResetTOTP       bool
 		flagResetFeedErrors bool
+		flagEncryptSecrets  bool
+		flagRotateKey       bool
+		flagImport          string
+		flagImportUser      string
 		flagDebugMode       bool
 		flagConfigFile      string
 		flagConfigDump      bool
@@ -52,7 +62,12 @@ func Parse() {
 	flag.BoolVar(&flagFlushSessions, "flush-sessions", false, flagFlushSessionsHelp)
 	flag.BoolVar(&flagCreateAdmin, "create-admin", false, flagCreateAdminHelp)
 	flag.BoolVar(&flagResetPassword, "reset-password", false, flagResetPasswordHelp)
+	flag.BoolVar(&flagResetTOTP, "reset-totp", false, flagResetTOTPHelp)
 	flag.BoolVar(&flagResetFeedErrors, "reset-feed-errors", false, flagResetFeedErrorsHelp)
+	flag.BoolVar(&flagEncryptSecrets, "encrypt-secrets", false, flagEncryptSecretsHelp)
+	flag.BoolVar(&flagRotateKey, "rotate-encryption-key", false, flagRotateKeyHelp)
+	flag.StringVar(&flagImport, "import", "", flagImportHelp)
+	flag.StringVar(&flagImportUser, "import-user", "", flagImportUserHelp)
 	flag.BoolVar(&flagDebugMode, "debug", false, flagDebugModeHelp)
 	flag.StringVar(&flagConfigFile, "config-file", "", flagConfigFileHelp)
 	flag.StringVar(&flagConfigFile, "c", "", flagConfigFileHelp)
@@ -137,6 +152,26 @@ func Parse() {
 		return
 	}
 
+	if flagResetTOTP {
+		resetTOTP(store)
+		return
+	}
+
+	if flagEncryptSecrets {
+		encryptSecrets(store)
+		return
+	}
+
+	if flagRotateKey {
+		rotateEncryptionKey(store)
+		return
+	}
+
+	if flagImport != "" {
+		importFile(store, flagImportUser, flagImport)
+		return
+	}
+
 	// Run migrations and start the deamon.
 	if config.Opts.RunMigrations() {
 		if err := database.Migrate(db); err != nil {
@@ -148,6 +183,24 @@ func Parse() {
 		logger.Fatal(`You must run the SQL migrations, %v`, err)
 	}
 
+	if config.Opts.HasEncryption() {
+		if err := enableEncryption(store); err != nil {
+			logger.Fatal(`Unable to enable encryption: %v`, err)
+		}
+
+		if err := store.LockEncryptionKeys(); err != nil {
+			logger.Fatal(`Unable to enable encryption: %v`, err)
+		}
+	}
+
+	if !config.Opts.HasProxyPrivateKey() {
+		key, err := store.AppSecret("proxy_private_key")
+		if err != nil {
+			logger.Fatal(`Unable to load the proxy private key: %v`, err)
+		}
+		config.Opts.SetProxyPrivateKey(key)
+	}
+
 	// Create admin user and start the deamon.
 	if config.Opts.CreateAdmin() {
 		createAdmin(store)
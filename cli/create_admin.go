@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 
+	"miniflux.app/audit"
 	"miniflux.app/config"
 	"miniflux.app/logger"
 	"miniflux.app/model"
@@ -36,8 +37,11 @@ func createAdmin(store *storage.Storage) {
 		os.Exit(1)
 	}
 
-	if _, err := store.CreateUser(userCreationRequest); err != nil {
+	user, err := store.CreateUser(userCreationRequest)
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
+
+	audit.LogCLI(store, model.AuditActionUserCreated, user)
 }
@@ -28,6 +28,11 @@ func startDaemon(store *storage.Storage) {
 	signal.Notify(stop, os.Interrupt)
 	signal.Notify(stop, syscall.SIGTERM)
 
+	// Import jobs run inside the process, those still running were interrupted by the last shutdown.
+	if err := store.InterruptImportJobs(); err != nil {
+		logger.Error("[Daemon] %v", err)
+	}
+
 	pool := worker.NewPool(store, config.Opts.WorkerPoolSize())
 
 	if config.Opts.HasSchedulerService() && !config.Opts.HasMaintenanceMode() {
@@ -0,0 +1,67 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cli // import "miniflux.app/cli"
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"miniflux.app/config"
+	"miniflux.app/crypto"
+	"miniflux.app/storage"
+)
+
+func enableEncryption(store *storage.Storage) error {
+	if !config.Opts.HasEncryption() {
+		return errors.New("ENCRYPTION_KEY is not defined")
+	}
+
+	masterKey, err := crypto.ParseEncryptionKey(config.Opts.EncryptionKey())
+	if err != nil {
+		return fmt.Errorf("invalid ENCRYPTION_KEY: %v", err)
+	}
+
+	var previousMasterKeys [][]byte
+	for _, value := range config.Opts.EncryptionPreviousKeys() {
+		key, err := crypto.ParseEncryptionKey(value)
+		if err != nil {
+			return fmt.Errorf("invalid ENCRYPTION_PREVIOUS_KEYS: %v", err)
+		}
+		previousMasterKeys = append(previousMasterKeys, key)
+	}
+
+	return store.EnableEncryption(masterKey, previousMasterKeys)
+}
+
+func encryptSecrets(store *storage.Storage) {
+	if err := enableEncryption(store); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	count, err := store.EncryptSecrets()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("%d values encrypted\n", count)
+}
+
+func rotateEncryptionKey(store *storage.Storage) {
+	if err := enableEncryption(store); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	count, err := store.RotateEncryptionKey()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("New data key created, %d values re-encrypted\n", count)
+}
@@ -0,0 +1,53 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cli // import "miniflux.app/cli"
+
+import (
+	"fmt"
+	"os"
+
+	"miniflux.app/reader/importer"
+	"miniflux.app/storage"
+)
+
+func importFile(store *storage.Storage, username, filename string) {
+	if username == "" {
+		fmt.Fprintf(os.Stderr, "The username is required, use the -import-user flag\n")
+		os.Exit(1)
+	}
+
+	user, err := store.UserByUsername(username)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	if user == nil {
+		fmt.Fprintf(os.Stderr, "User not found!\n")
+		os.Exit(1)
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	report, err := importer.Import(store, user.ID, file)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Format: %s\n", report.Format)
+	fmt.Printf("Feeds created: %d\n", report.FeedsCreated)
+	fmt.Printf("Categories created: %d\n", report.CategoriesCreated)
+	fmt.Printf("Entries created: %d (%d starred)\n", report.EntriesCreated, report.EntriesStarred)
+	fmt.Printf("Entries skipped: %d\n", report.EntriesSkipped)
+	for _, message := range report.Errors {
+		fmt.Fprintf(os.Stderr, "%s\n", message)
+	}
+}
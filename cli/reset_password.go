@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 
+	"miniflux.app/audit"
 	"miniflux.app/model"
 	"miniflux.app/storage"
 	"miniflux.app/validator"
@@ -34,10 +35,13 @@ func resetPassword(store *storage.Storage) {
 		os.Exit(1)
 	}
 
+	userModificationRequest.Patch(user)
 	if err := store.UpdateUser(user); err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
 	}
 
+	audit.LogCLI(store, model.AuditActionPasswordChanged, user)
+
 	fmt.Println("Password changed!")
 }
@@ -0,0 +1,49 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package cli // import "miniflux.app/cli"
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"miniflux.app/audit"
+	"miniflux.app/model"
+	"miniflux.app/storage"
+)
+
+func resetTOTP(store *storage.Storage) {
+	fmt.Print("Enter Username: ")
+
+	reader := bufio.NewReader(os.Stdin)
+	username, _ := reader.ReadString('\n')
+	username = strings.TrimSpace(username)
+
+	user, err := store.UserByUsername(username)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	if user == nil {
+		fmt.Fprintf(os.Stderr, "User not found!\n")
+		os.Exit(1)
+	}
+
+	if !store.HasTOTP(user.ID) {
+		fmt.Fprintf(os.Stderr, "Two-factor authentication is not enabled for this user!\n")
+		os.Exit(1)
+	}
+
+	if err := store.DisableTOTP(user.ID); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+
+	audit.LogCLI(store, model.AuditActionTOTPDisabled, user)
+
+	fmt.Println("Two-factor authentication disabled!")
+}
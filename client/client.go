@@ -11,8 +11,12 @@ import (
 	"io/ioutil"
 	"net/url"
 	"strconv"
+	"time"
 )
 
+// importPollInterval is the delay between two checks of a running import job.
+const importPollInterval = time.Second
+
 // Client holds API procedure calls.
 type Client struct {
 	request *request
@@ -278,10 +282,66 @@ func (c *Client) Export() ([]byte, error) {
 	return opml, nil
 }
 
-// Import imports an OPML file.
+// Import imports an OPML file and waits until the import job is finished.
+// Use StartImport to run the import in the background.
 func (c *Client) Import(f io.ReadCloser) error {
-	_, err := c.request.PostFile("/v1/import", f)
-	return err
+	jobID, err := c.StartImport(f)
+	if err != nil {
+		return err
+	}
+
+	for {
+		job, err := c.ImportJob(jobID)
+		if err != nil {
+			return err
+		}
+
+		switch job.Status {
+		case ImportJobStatusFinished:
+			return nil
+		case ImportJobStatusFailed:
+			return fmt.Errorf("miniflux: import failed (%s)", job.ErrorMsg)
+		}
+
+		time.Sleep(importPollInterval)
+	}
+}
+
+// StartImport imports an OPML file in the background and returns the ID of the import job.
+func (c *Client) StartImport(f io.ReadCloser) (int64, error) {
+	body, err := c.request.PostFile("/v1/import", f)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	var result struct {
+		JobID int64 `json:"job_id"`
+	}
+
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return 0, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return result.JobID, nil
+}
+
+// ImportJob gets the status and the results of an import job.
+func (c *Client) ImportJob(jobID int64) (*ImportJob, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/import/%d", jobID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var job *ImportJob
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&job); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return job, nil
 }
 
 // Feed gets a feed.
@@ -322,6 +382,86 @@ func (c *Client) CreateFeed(feedCreationRequest *FeedCreationRequest) (int64, er
 	return r.FeedID, nil
 }
 
+// CreateNewsletterFeed creates a new feed that receives its entries by email.
+func (c *Client) CreateNewsletterFeed(newsletterCreationRequest *NewsletterCreationRequest) (int64, error) {
+	body, err := c.request.Post("/v1/newsletters", newsletterCreationRequest)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	type result struct {
+		FeedID int64 `json:"feed_id"`
+	}
+
+	var r result
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&r); err != nil {
+		return 0, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return r.FeedID, nil
+}
+
+// CreateScrapedFeed creates a new feed whose entries are extracted from a web page with CSS selectors.
+func (c *Client) CreateScrapedFeed(scrapedFeedCreationRequest *ScrapedFeedCreationRequest) (int64, error) {
+	body, err := c.request.Post("/v1/scraped-feeds", scrapedFeedCreationRequest)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	type result struct {
+		FeedID int64 `json:"feed_id"`
+	}
+
+	var r result
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&r); err != nil {
+		return 0, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return r.FeedID, nil
+}
+
+// CreateWatchPageFeed creates a new feed reporting the changes of a web page.
+func (c *Client) CreateWatchPageFeed(watchPageCreationRequest *WatchPageCreationRequest) (int64, error) {
+	body, err := c.request.Post("/v1/watch-pages", watchPageCreationRequest)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+
+	type result struct {
+		FeedID int64 `json:"feed_id"`
+	}
+
+	var r result
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&r); err != nil {
+		return 0, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return r.FeedID, nil
+}
+
+// PreviewScrapedFeed returns the entries extracted from a web page without creating the feed.
+func (c *Client) PreviewScrapedFeed(scrapedFeedCreationRequest *ScrapedFeedCreationRequest) (*EntryResultSet, error) {
+	body, err := c.request.Post("/v1/scraped-feeds/preview", scrapedFeedCreationRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result EntryResultSet
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
 // UpdateFeed updates a feed.
 func (c *Client) UpdateFeed(feedID int64, feedChanges *FeedModificationRequest) (*Feed, error) {
 	body, err := c.request.Put(fmt.Sprintf("/v1/feeds/%d", feedID), feedChanges)
@@ -448,6 +588,74 @@ func (c *Client) Entries(filter *Filter) (*EntryResultSet, error) {
 	return &result, nil
 }
 
+// ExportEntries generates an EPUB book from the entries matching the filter.
+func (c *Client) ExportEntries(filter *Filter) ([]byte, error) {
+	body, err := c.request.Get(buildFilterQueryString("/v1/export/epub", filter))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	book, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return book, nil
+}
+
+// AuditEvents fetches the security audit log (admin only).
+func (c *Client) AuditEvents(filter *AuditFilter) (*AuditEventResultSet, error) {
+	path := "/v1/audit"
+	if filter != nil {
+		values := url.Values{}
+
+		if filter.UserID > 0 {
+			values.Set("user_id", strconv.FormatInt(filter.UserID, 10))
+		}
+
+		if filter.Action != "" {
+			values.Set("action", filter.Action)
+		}
+
+		if filter.ClientIP != "" {
+			values.Set("client_ip", filter.ClientIP)
+		}
+
+		if filter.Before > 0 {
+			values.Set("before", strconv.FormatInt(filter.Before, 10))
+		}
+
+		if filter.After > 0 {
+			values.Set("after", strconv.FormatInt(filter.After, 10))
+		}
+
+		if filter.Limit > 0 {
+			values.Set("limit", strconv.Itoa(filter.Limit))
+		}
+
+		if filter.Offset > 0 {
+			values.Set("offset", strconv.Itoa(filter.Offset))
+		}
+
+		path = fmt.Sprintf("%s?%s", path, values.Encode())
+	}
+
+	body, err := c.request.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result AuditEventResultSet
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return &result, nil
+}
+
 // FeedEntries fetch feed entries.
 func (c *Client) FeedEntries(feedID int64, filter *Filter) (*EntryResultSet, error) {
 	path := buildFilterQueryString(fmt.Sprintf("/v1/feeds/%d/entries", feedID), filter)
@@ -503,6 +711,63 @@ func (c *Client) ToggleBookmark(entryID int64) error {
 	return err
 }
 
+// Enclosure fetches a single attachment.
+func (c *Client) Enclosure(enclosureID int64) (*Enclosure, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/enclosures/%d", enclosureID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var enclosure *Enclosure
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&enclosure); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return enclosure, nil
+}
+
+// UpdateEnclosure saves the playback position of an attachment.
+func (c *Client) UpdateEnclosure(enclosureID int64, enclosureChanges *EnclosureUpdateRequest) error {
+	_, err := c.request.Put(fmt.Sprintf("/v1/enclosures/%d", enclosureID), enclosureChanges)
+	return err
+}
+
+// EntryArchive fetches the snapshot of an entry.
+func (c *Client) EntryArchive(entryID int64) (*EntryArchive, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/entries/%d/archive", entryID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var archive *EntryArchive
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&archive); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return archive, nil
+}
+
+// ArchiveEntry downloads the web page of an entry and stores a snapshot.
+func (c *Client) ArchiveEntry(entryID int64) (*EntryArchive, error) {
+	body, err := c.request.Post(fmt.Sprintf("/v1/entries/%d/archive", entryID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var archive *EntryArchive
+	decoder := json.NewDecoder(body)
+	if err := decoder.Decode(&archive); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return archive, nil
+}
+
 func buildFilterQueryString(path string, filter *Filter) string {
 	if filter != nil {
 		values := url.Values{}
@@ -555,6 +820,10 @@ func buildFilterQueryString(path string, filter *Filter) string {
 			values.Set("category_id", strconv.FormatInt(filter.CategoryID, 10))
 		}
 
+		if filter.CategoryLabel != "" {
+			values.Set("category_label", filter.CategoryLabel)
+		}
+
 		if filter.FeedID > 0 {
 			values.Set("feed_id", strconv.FormatInt(filter.FeedID, 10))
 		}
@@ -16,6 +16,13 @@ const (
 	EntryStatusRemoved = "removed"
 )
 
+// Import job statuses.
+const (
+	ImportJobStatusRunning  = "running"
+	ImportJobStatusFinished = "finished"
+	ImportJobStatusFailed   = "failed"
+)
+
 // User represents a user in the system.
 type User struct {
 	ID                int64      `json:"id"`
@@ -29,10 +36,14 @@ type User struct {
 	Stylesheet        string     `json:"stylesheet"`
 	GoogleID          string     `json:"google_id"`
 	OpenIDConnectID   string     `json:"openid_connect_id"`
+	GitHubID          string     `json:"github_id"`
+	GitLabID          string     `json:"gitlab_id"`
+	LDAPDN            string     `json:"ldap_dn"`
 	EntriesPerPage    int        `json:"entries_per_page"`
 	KeyboardShortcuts bool       `json:"keyboard_shortcuts"`
 	ShowReadingTime   bool       `json:"show_reading_time"`
 	EntrySwipe        bool       `json:"entry_swipe"`
+	ArchiveStarred    bool       `json:"archive_starred"`
 	LastLoginAt       *time.Time `json:"last_login_at"`
 }
 
@@ -47,6 +58,8 @@ type UserCreationRequest struct {
 	IsAdmin         bool   `json:"is_admin"`
 	GoogleID        string `json:"google_id"`
 	OpenIDConnectID string `json:"openid_connect_id"`
+	GitHubID        string `json:"github_id"`
+	GitLabID        string `json:"gitlab_id"`
 }
 
 // UserModificationRequest represents the request to update a user.
@@ -61,10 +74,13 @@ type UserModificationRequest struct {
 	Stylesheet        *string `json:"stylesheet"`
 	GoogleID          *string `json:"google_id"`
 	OpenIDConnectID   *string `json:"openid_connect_id"`
+	GitHubID          *string `json:"github_id"`
+	GitLabID          *string `json:"gitlab_id"`
 	EntriesPerPage    *int    `json:"entries_per_page"`
 	KeyboardShortcuts *bool   `json:"keyboard_shortcuts"`
 	ShowReadingTime   *bool   `json:"show_reading_time"`
 	EntrySwipe        *bool   `json:"entry_swipe"`
+	ArchiveStarred    *bool   `json:"archive_starred"`
 }
 
 // Users represents a list of users.
@@ -100,28 +116,70 @@ type Subscriptions []*Subscription
 
 // Feed represents a Miniflux feed.
 type Feed struct {
-	ID                 int64     `json:"id"`
-	UserID             int64     `json:"user_id"`
-	FeedURL            string    `json:"feed_url"`
-	SiteURL            string    `json:"site_url"`
-	Title              string    `json:"title"`
-	CheckedAt          time.Time `json:"checked_at,omitempty"`
-	EtagHeader         string    `json:"etag_header,omitempty"`
-	LastModifiedHeader string    `json:"last_modified_header,omitempty"`
-	ParsingErrorMsg    string    `json:"parsing_error_message,omitempty"`
-	ParsingErrorCount  int       `json:"parsing_error_count,omitempty"`
-	Disabled           bool      `json:"disabled"`
-	IgnoreHTTPCache    bool      `json:"ignore_http_cache"`
-	FetchViaProxy      bool      `json:"fetch_via_proxy"`
-	ScraperRules       string    `json:"scraper_rules"`
-	RewriteRules       string    `json:"rewrite_rules"`
-	BlocklistRules     string    `json:"blocklist_rules"`
-	KeeplistRules      string    `json:"keeplist_rules"`
-	Crawler            bool      `json:"crawler"`
-	UserAgent          string    `json:"user_agent"`
-	Username           string    `json:"username"`
-	Password           string    `json:"password"`
-	Category           *Category `json:"category,omitempty"`
+	ID                 int64          `json:"id"`
+	UserID             int64          `json:"user_id"`
+	FeedURL            string         `json:"feed_url"`
+	SiteURL            string         `json:"site_url"`
+	Title              string         `json:"title"`
+	Description        string         `json:"description"`
+	Language           string         `json:"language"`
+	ImageURL           string         `json:"image_url"`
+	CheckedAt          time.Time      `json:"checked_at,omitempty"`
+	EtagHeader         string         `json:"etag_header,omitempty"`
+	LastModifiedHeader string         `json:"last_modified_header,omitempty"`
+	ParsingErrorMsg    string         `json:"parsing_error_message,omitempty"`
+	ParsingErrorCount  int            `json:"parsing_error_count,omitempty"`
+	Disabled           bool           `json:"disabled"`
+	IgnoreHTTPCache    bool           `json:"ignore_http_cache"`
+	FetchViaProxy      bool           `json:"fetch_via_proxy"`
+	Kind               string         `json:"kind"`
+	ScraperRules       string         `json:"scraper_rules"`
+	RewriteRules       string         `json:"rewrite_rules"`
+	BlocklistRules     string         `json:"blocklist_rules"`
+	KeeplistRules      string         `json:"keeplist_rules"`
+	Crawler            bool           `json:"crawler"`
+	UserAgent          string         `json:"user_agent"`
+	Username           string         `json:"username"`
+	Password           string         `json:"password"`
+	Category           *Category      `json:"category,omitempty"`
+	Selectors          *FeedSelectors `json:"selectors,omitempty"`
+	WatchSelector      string         `json:"watch_selector,omitempty"`
+}
+
+// FeedSelectors represents the CSS selectors used to extract the entries of a scraped feed.
+type FeedSelectors struct {
+	Item    string `json:"item"`
+	Title   string `json:"title"`
+	Link    string `json:"link"`
+	Date    string `json:"date"`
+	Content string `json:"content"`
+}
+
+// ScrapedFeedCreationRequest represents the request to create a feed scraped from a web page.
+type ScrapedFeedCreationRequest struct {
+	PageURL       string        `json:"page_url"`
+	CategoryID    int64         `json:"category_id"`
+	Selectors     FeedSelectors `json:"selectors"`
+	Title         string        `json:"title"`
+	UserAgent     string        `json:"user_agent"`
+	Crawler       bool          `json:"crawler"`
+	FetchViaProxy bool          `json:"fetch_via_proxy"`
+}
+
+// WatchPageCreationRequest represents the request to create a feed reporting the changes of a web page.
+type WatchPageCreationRequest struct {
+	PageURL       string `json:"page_url"`
+	CategoryID    int64  `json:"category_id"`
+	Selector      string `json:"selector"`
+	Title         string `json:"title"`
+	UserAgent     string `json:"user_agent"`
+	FetchViaProxy bool   `json:"fetch_via_proxy"`
+}
+
+// NewsletterCreationRequest represents the request to create a newsletter feed.
+type NewsletterCreationRequest struct {
+	Title      string `json:"title"`
+	CategoryID int64  `json:"category_id"`
 }
 
 // FeedCreationRequest represents the request to create a feed.
@@ -143,21 +201,23 @@ type FeedCreationRequest struct {
 
 // FeedModificationRequest represents the request to update a feed.
 type FeedModificationRequest struct {
-	FeedURL         *string `json:"feed_url"`
-	SiteURL         *string `json:"site_url"`
-	Title           *string `json:"title"`
-	ScraperRules    *string `json:"scraper_rules"`
-	RewriteRules    *string `json:"rewrite_rules"`
-	BlocklistRules  *string `json:"blocklist_rules"`
-	KeeplistRules   *string `json:"keeplist_rules"`
-	Crawler         *bool   `json:"crawler"`
-	UserAgent       *string `json:"user_agent"`
-	Username        *string `json:"username"`
-	Password        *string `json:"password"`
-	CategoryID      *int64  `json:"category_id"`
-	Disabled        *bool   `json:"disabled"`
-	IgnoreHTTPCache *bool   `json:"ignore_http_cache"`
-	FetchViaProxy   *bool   `json:"fetch_via_proxy"`
+	FeedURL         *string        `json:"feed_url"`
+	SiteURL         *string        `json:"site_url"`
+	Title           *string        `json:"title"`
+	ScraperRules    *string        `json:"scraper_rules"`
+	RewriteRules    *string        `json:"rewrite_rules"`
+	BlocklistRules  *string        `json:"blocklist_rules"`
+	KeeplistRules   *string        `json:"keeplist_rules"`
+	Crawler         *bool          `json:"crawler"`
+	UserAgent       *string        `json:"user_agent"`
+	Username        *string        `json:"username"`
+	Password        *string        `json:"password"`
+	CategoryID      *int64         `json:"category_id"`
+	Disabled        *bool          `json:"disabled"`
+	IgnoreHTTPCache *bool          `json:"ignore_http_cache"`
+	FetchViaProxy   *bool          `json:"fetch_via_proxy"`
+	Selectors       *FeedSelectors `json:"selectors,omitempty"`
+	WatchSelector   *string        `json:"watch_selector,omitempty"`
 }
 
 // FeedIcon represents the feed icon.
@@ -172,22 +232,26 @@ type Feeds []*Feed
 
 // Entry represents a subscription item in the system.
 type Entry struct {
-	ID          int64      `json:"id"`
-	UserID      int64      `json:"user_id"`
-	FeedID      int64      `json:"feed_id"`
-	Status      string     `json:"status"`
-	Hash        string     `json:"hash"`
-	Title       string     `json:"title"`
-	URL         string     `json:"url"`
-	Date        time.Time  `json:"published_at"`
-	CreatedAt   time.Time  `json:"created_at"`
-	Content     string     `json:"content"`
-	Author      string     `json:"author"`
-	ShareCode   string     `json:"share_code"`
-	Starred     bool       `json:"starred"`
-	ReadingTime int        `json:"reading_time"`
-	Enclosures  Enclosures `json:"enclosures,omitempty"`
-	Feed        *Feed      `json:"feed,omitempty"`
+	ID          int64           `json:"id"`
+	UserID      int64           `json:"user_id"`
+	FeedID      int64           `json:"feed_id"`
+	Status      string          `json:"status"`
+	Hash        string          `json:"hash"`
+	Title       string          `json:"title"`
+	URL         string          `json:"url"`
+	Date        time.Time       `json:"published_at"`
+	CreatedAt   time.Time       `json:"created_at"`
+	Content     string          `json:"content"`
+	Summary     string          `json:"summary"`
+	ImageURL    string          `json:"image_url"`
+	Author      string          `json:"author"`
+	ShareCode   string          `json:"share_code"`
+	Starred     bool            `json:"starred"`
+	ReadingTime int             `json:"reading_time"`
+	Tags        []string        `json:"tags"`
+	Podcast     *PodcastEpisode `json:"podcast,omitempty"`
+	Enclosures  Enclosures      `json:"enclosures,omitempty"`
+	Feed        *Feed           `json:"feed,omitempty"`
 }
 
 // Entries represents a list of entries.
@@ -195,17 +259,77 @@ type Entries []*Entry
 
 // Enclosure represents an attachment.
 type Enclosure struct {
-	ID       int64  `json:"id"`
-	UserID   int64  `json:"user_id"`
-	EntryID  int64  `json:"entry_id"`
-	URL      string `json:"url"`
-	MimeType string `json:"mime_type"`
-	Size     int    `json:"size"`
+	ID               int64  `json:"id"`
+	UserID           int64  `json:"user_id"`
+	EntryID          int64  `json:"entry_id"`
+	URL              string `json:"url"`
+	MimeType         string `json:"mime_type"`
+	Size             int    `json:"size"`
+	Duration         int    `json:"duration"`
+	MediaProgression int    `json:"media_progression"`
 }
 
 // Enclosures represents a list of attachments.
 type Enclosures []*Enclosure
 
+// EnclosureUpdateRequest represents a request to save the playback position of an attachment.
+type EnclosureUpdateRequest struct {
+	MediaProgression int `json:"media_progression"`
+	Duration         int `json:"duration,omitempty"`
+}
+
+// PodcastEpisode represents the podcast metadata of an entry.
+type PodcastEpisode struct {
+	Season      int                  `json:"season,omitempty"`
+	SeasonName  string               `json:"season_name,omitempty"`
+	Episode     string               `json:"episode,omitempty"`
+	EpisodeType string               `json:"episode_type,omitempty"`
+	ChaptersURL string               `json:"chapters_url,omitempty"`
+	Chapters    []*PodcastChapter    `json:"chapters,omitempty"`
+	Transcripts []*PodcastTranscript `json:"transcripts,omitempty"`
+	Transcript  []*PodcastCue        `json:"transcript,omitempty"`
+	Persons     []*PodcastPerson     `json:"persons,omitempty"`
+	Funding     []*PodcastFunding    `json:"funding,omitempty"`
+}
+
+// PodcastChapter represents a chapter of an episode, the start time is in seconds.
+type PodcastChapter struct {
+	StartTime float64 `json:"start_time"`
+	Title     string  `json:"title"`
+	URL       string  `json:"url,omitempty"`
+	ImageURL  string  `json:"image_url,omitempty"`
+}
+
+// PodcastTranscript represents a link to the transcript of an episode.
+type PodcastTranscript struct {
+	URL      string `json:"url"`
+	MimeType string `json:"mime_type"`
+	Language string `json:"language,omitempty"`
+	Rel      string `json:"rel,omitempty"`
+}
+
+// PodcastCue represents a part of the transcript of an episode, the start time is in seconds.
+type PodcastCue struct {
+	StartTime float64 `json:"start_time"`
+	Speaker   string  `json:"speaker,omitempty"`
+	Text      string  `json:"text"`
+}
+
+// PodcastPerson represents a person involved in an episode.
+type PodcastPerson struct {
+	Name     string `json:"name"`
+	Role     string `json:"role,omitempty"`
+	Group    string `json:"group,omitempty"`
+	URL      string `json:"url,omitempty"`
+	ImageURL string `json:"image_url,omitempty"`
+}
+
+// PodcastFunding represents a donation link of the podcast.
+type PodcastFunding struct {
+	URL   string `json:"url"`
+	Title string `json:"title"`
+}
+
 // Filter is used to filter entries.
 type Filter struct {
 	Status        string
@@ -222,6 +346,7 @@ type Filter struct {
 	CategoryID    int64
 	FeedID        int64
 	Statuses      []string
+	CategoryLabel string
 }
 
 // EntryResultSet represents the response when fetching entries.
@@ -229,3 +354,72 @@ type EntryResultSet struct {
 	Total   int     `json:"total"`
 	Entries Entries `json:"entries"`
 }
+
+// EntryArchive represents a self-contained snapshot of the web page of an entry.
+type EntryArchive struct {
+	EntryID   int64     `json:"entry_id"`
+	UserID    int64     `json:"user_id"`
+	URL       string    `json:"url"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ImportJob represents an OPML import running in the background.
+type ImportJob struct {
+	ID         int64              `json:"id"`
+	UserID     int64              `json:"user_id"`
+	Status     string             `json:"status"`
+	Validate   bool               `json:"validate"`
+	Total      int                `json:"total"`
+	Processed  int                `json:"processed"`
+	ErrorMsg   string             `json:"error_message"`
+	CreatedAt  time.Time          `json:"created_at"`
+	FinishedAt *time.Time         `json:"finished_at"`
+	Results    []*ImportJobResult `json:"results"`
+}
+
+// ImportJobResult represents the result of the import of one subscription.
+type ImportJobResult struct {
+	ID           int64  `json:"id"`
+	JobID        int64  `json:"job_id"`
+	Title        string `json:"title"`
+	FeedURL      string `json:"feed_url"`
+	CategoryName string `json:"category"`
+	Status       string `json:"status"`
+	ErrorMsg     string `json:"error_message"`
+	FeedID       int64  `json:"feed_id"`
+}
+
+// AuditEvent represents a security-related action.
+type AuditEvent struct {
+	ID        int64     `json:"id"`
+	ActorID   int64     `json:"actor_id"`
+	Actor     string    `json:"actor"`
+	UserID    int64     `json:"user_id"`
+	ClientIP  string    `json:"client_ip"`
+	UserAgent string    `json:"user_agent"`
+	Action    string    `json:"action"`
+	Target    string    `json:"target"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AuditEvents represents a list of audit events.
+type AuditEvents []*AuditEvent
+
+// AuditFilter is used to filter audit events.
+type AuditFilter struct {
+	UserID   int64
+	Action   string
+	ClientIP string
+	Before   int64
+	After    int64
+	Offset   int
+	Limit    int
+}
+
+// AuditEventResultSet represents the response when fetching audit events.
+type AuditEventResultSet struct {
+	Total  int         `json:"total"`
+	Events AuditEvents `json:"events"`
+}
@@ -7,6 +7,8 @@ package config // import "miniflux.app/config"
 import (
 	"io/ioutil"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -551,6 +553,41 @@ func TestCleanupRemoveSessionsDays(t *testing.T) {
 	}
 }
 
+func TestDefaultCleanupRemoveAuditEventsDaysValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 365
+	result := opts.CleanupRemoveAuditEventsDays()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CLEANUP_REMOVE_AUDIT_EVENTS_DAYS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestCleanupRemoveAuditEventsDays(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("CLEANUP_REMOVE_AUDIT_EVENTS_DAYS", "-1")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := -1
+	result := opts.CleanupRemoveAuditEventsDays()
+
+	if result != expected {
+		t.Fatalf(`Unexpected CLEANUP_REMOVE_AUDIT_EVENTS_DAYS value, got %v instead of %v`, result, expected)
+	}
+}
+
 func TestDefaultWorkerPoolSizeValue(t *testing.T) {
 	os.Clearenv()
 
@@ -621,6 +658,41 @@ func TestPollingFrequency(t *testing.T) {
 	}
 }
 
+func TestDefaultOPMLSourceFrequencyValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultOPMLSourceFrequency
+	result := opts.OPMLSourceFrequency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected OPML_SOURCE_FREQUENCY value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestOPMLSourceFrequency(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("OPML_SOURCE_FREQUENCY", "15")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 15
+	result := opts.OPMLSourceFrequency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected OPML_SOURCE_FREQUENCY value, got %v instead of %v`, result, expected)
+	}
+}
+
 func TestDefaultBatchSizeValue(t *testing.T) {
 	os.Clearenv()
 
@@ -1431,3 +1503,674 @@ func TestAuthProxyUserCreationAdmin(t *testing.T) {
 		t.Fatalf(`Unexpected AUTH_PROXY_USER_CREATION value, got %v instead of %v`, result, expected)
 	}
 }
+
+func TestNewsletterWebhookDisabledByDefault(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.HasNewsletterWebhook() {
+		t.Fatal(`The newsletter webhook should be disabled by default`)
+	}
+}
+
+func TestNewsletterWebhookSecret(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("NEWSLETTER_WEBHOOK_SECRET", "s3cr3t")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.HasNewsletterWebhook() {
+		t.Fatal(`The newsletter webhook should be enabled`)
+	}
+
+	expected := "s3cr3t"
+	result := opts.NewsletterWebhookSecret()
+
+	if result != expected {
+		t.Fatalf(`Unexpected NEWSLETTER_WEBHOOK_SECRET value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDefaultNewsletterDomainValue(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("BASE_URL", "https://reader.example.org/miniflux/")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "reader.example.org"
+	result := opts.NewsletterDomain()
+
+	if result != expected {
+		t.Fatalf(`Unexpected NEWSLETTER_DOMAIN value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestNewsletterDomain(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("NEWSLETTER_DOMAIN", "newsletters.example.org")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := "newsletters.example.org"
+	result := opts.NewsletterDomain()
+
+	if result != expected {
+		t.Fatalf(`Unexpected NEWSLETTER_DOMAIN value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestLDAPDisabledByDefault(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.HasLDAP() {
+		t.Fatal(`LDAP authentication should be disabled by default`)
+	}
+
+	expected := "(uid=%s)"
+	result := opts.LDAPUserFilter()
+
+	if result != expected {
+		t.Fatalf(`Unexpected LDAP_USER_FILTER value, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestLDAPOptions(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("LDAP_URL", "ldap://ldap.example.org")
+	os.Setenv("LDAP_START_TLS", "1")
+	os.Setenv("LDAP_BASE_DN", "ou=people,dc=example,dc=org")
+	os.Setenv("LDAP_USER_FILTER", "(&(objectClass=person)(mail=%s))")
+	os.Setenv("LDAP_ADMIN_GROUP_DN", "cn=admins,dc=example,dc=org")
+	os.Setenv("LDAP_USER_CREATION", "1")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.HasLDAP() || opts.LDAPURL() != "ldap://ldap.example.org" {
+		t.Fatalf(`Unexpected LDAP_URL value, got %q`, opts.LDAPURL())
+	}
+
+	if !opts.HasLDAPStartTLS() {
+		t.Fatal(`LDAP_START_TLS should be enabled`)
+	}
+
+	if opts.LDAPBaseDN() != "ou=people,dc=example,dc=org" {
+		t.Fatalf(`Unexpected LDAP_BASE_DN value, got %q`, opts.LDAPBaseDN())
+	}
+
+	if opts.LDAPUserFilter() != "(&(objectClass=person)(mail=%s))" {
+		t.Fatalf(`Unexpected LDAP_USER_FILTER value, got %q`, opts.LDAPUserFilter())
+	}
+
+	if opts.LDAPAdminGroupDN() != "cn=admins,dc=example,dc=org" {
+		t.Fatalf(`Unexpected LDAP_ADMIN_GROUP_DN value, got %q`, opts.LDAPAdminGroupDN())
+	}
+
+	if !opts.IsLDAPUserCreationAllowed() {
+		t.Fatal(`LDAP_USER_CREATION should be enabled`)
+	}
+}
+
+func TestLDAPBindPasswordFromFile(t *testing.T) {
+	os.Clearenv()
+
+	tmpfile, err := ioutil.TempFile("", "miniflux")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpfile.Name())
+
+	if _, err := tmpfile.Write([]byte("secret\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	os.Setenv("LDAP_BIND_DN", "cn=miniflux,dc=example,dc=org")
+	os.Setenv("LDAP_BIND_PASSWORD_FILE", tmpfile.Name())
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.LDAPBindDN() != "cn=miniflux,dc=example,dc=org" {
+		t.Fatalf(`Unexpected LDAP_BIND_DN value, got %q`, opts.LDAPBindDN())
+	}
+
+	if opts.LDAPBindPassword() != "secret" {
+		t.Fatalf(`Unexpected LDAP_BIND_PASSWORD_FILE value, got %q`, opts.LDAPBindPassword())
+	}
+}
+
+func TestLDAPBindPasswordIsRedacted(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("LDAP_BIND_PASSWORD", "ldap-secret")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	for _, option := range opts.SortedOptions() {
+		if option.Key == "LDAP_BIND_PASSWORD" && option.Value != "<redacted>" {
+			t.Errorf(`LDAP_BIND_PASSWORD should be redacted, got %v`, option.Value)
+		}
+	}
+
+	if dump := opts.String(); strings.Contains(dump, "ldap-secret") {
+		t.Errorf(`The configuration dump should not contain the LDAP password: %s`, dump)
+	}
+}
+
+func TestNewsletterWebhookSecretIsRedacted(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("NEWSLETTER_WEBHOOK_SECRET", "webhook-secret")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	for _, option := range opts.SortedOptions() {
+		if option.Key == "NEWSLETTER_WEBHOOK_SECRET" && option.Value != "<redacted>" {
+			t.Errorf(`NEWSLETTER_WEBHOOK_SECRET should be redacted, got %v`, option.Value)
+		}
+	}
+
+	if dump := opts.String(); strings.Contains(dump, "webhook-secret") {
+		t.Errorf(`The configuration dump should not contain the webhook secret: %s`, dump)
+	}
+}
+
+func TestDefaultOAuth2ProviderSettings(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.OAuth2GitLabURL() != defaultOAuth2GitLabURL {
+		t.Fatalf(`Unexpected OAUTH2_GITLAB_URL value, got %q`, opts.OAuth2GitLabURL())
+	}
+
+	if opts.OAuth2OidcUsernameClaim() != "email" {
+		t.Fatalf(`Unexpected OAUTH2_OIDC_USERNAME_CLAIM value, got %q`, opts.OAuth2OidcUsernameClaim())
+	}
+
+	if opts.OAuth2OidcGroupsClaim() != "groups" {
+		t.Fatalf(`Unexpected OAUTH2_OIDC_GROUPS_CLAIM value, got %q`, opts.OAuth2OidcGroupsClaim())
+	}
+
+	if len(opts.OAuth2AllowedGroups()) != 0 || len(opts.OAuth2AdminGroups()) != 0 {
+		t.Fatalf(`OAuth2 groups should be empty by default`)
+	}
+}
+
+func TestOAuth2ProviderSettings(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("OAUTH2_GITLAB_URL", "https://gitlab.example.org")
+	os.Setenv("OAUTH2_OIDC_USERNAME_CLAIM", "preferred_username")
+	os.Setenv("OAUTH2_OIDC_GROUPS_CLAIM", "roles")
+	os.Setenv("OAUTH2_ALLOWED_GROUPS", "users, admins")
+	os.Setenv("OAUTH2_ADMIN_GROUPS", "admins")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.OAuth2GitLabURL() != "https://gitlab.example.org" {
+		t.Fatalf(`Unexpected OAUTH2_GITLAB_URL value, got %q`, opts.OAuth2GitLabURL())
+	}
+
+	if opts.OAuth2OidcUsernameClaim() != "preferred_username" {
+		t.Fatalf(`Unexpected OAUTH2_OIDC_USERNAME_CLAIM value, got %q`, opts.OAuth2OidcUsernameClaim())
+	}
+
+	if opts.OAuth2OidcGroupsClaim() != "roles" {
+		t.Fatalf(`Unexpected OAUTH2_OIDC_GROUPS_CLAIM value, got %q`, opts.OAuth2OidcGroupsClaim())
+	}
+
+	if !reflect.DeepEqual(opts.OAuth2AllowedGroups(), []string{"users", "admins"}) {
+		t.Fatalf(`Unexpected OAUTH2_ALLOWED_GROUPS value, got %v`, opts.OAuth2AllowedGroups())
+	}
+
+	if !reflect.DeepEqual(opts.OAuth2AdminGroups(), []string{"admins"}) {
+		t.Fatalf(`Unexpected OAUTH2_ADMIN_GROUPS value, got %v`, opts.OAuth2AdminGroups())
+	}
+}
+
+func TestDefaultLoginLockoutValues(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.LoginMaxFailures() != defaultLoginMaxFailures {
+		t.Fatalf(`Unexpected LOGIN_MAX_FAILURES value, got %d`, opts.LoginMaxFailures())
+	}
+
+	if opts.LoginLockoutDuration() != defaultLoginLockoutDuration {
+		t.Fatalf(`Unexpected LOGIN_LOCKOUT_DURATION value, got %d`, opts.LoginLockoutDuration())
+	}
+
+	if len(opts.TrustedProxies()) != 0 {
+		t.Fatalf(`Unexpected TRUSTED_PROXIES value, got %v`, opts.TrustedProxies())
+	}
+}
+
+func TestLoginLockout(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("LOGIN_MAX_FAILURES", "3")
+	os.Setenv("LOGIN_LOCKOUT_DURATION", "60")
+	os.Setenv("TRUSTED_PROXIES", "10.0.0.0/8, 192.168.1.1")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.LoginMaxFailures() != 3 {
+		t.Fatalf(`Unexpected LOGIN_MAX_FAILURES value, got %d`, opts.LoginMaxFailures())
+	}
+
+	if opts.LoginLockoutDuration() != 60 {
+		t.Fatalf(`Unexpected LOGIN_LOCKOUT_DURATION value, got %d`, opts.LoginLockoutDuration())
+	}
+
+	if !reflect.DeepEqual(opts.TrustedProxies(), []string{"10.0.0.0/8", "192.168.1.1"}) {
+		t.Fatalf(`Unexpected TRUSTED_PROXIES value, got %v`, opts.TrustedProxies())
+	}
+}
+
+func TestEncryptionDisabledByDefault(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.HasEncryption() {
+		t.Fatal(`Encryption should be disabled by default`)
+	}
+
+	if len(opts.EncryptionPreviousKeys()) != 0 {
+		t.Fatalf(`Unexpected ENCRYPTION_PREVIOUS_KEYS value, got %v`, opts.EncryptionPreviousKeys())
+	}
+}
+
+func TestEncryptionKeys(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ENCRYPTION_KEY", "current")
+	os.Setenv("ENCRYPTION_PREVIOUS_KEYS", "old1, old2")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.HasEncryption() {
+		t.Fatal(`Encryption should be enabled`)
+	}
+
+	if opts.EncryptionKey() != "current" {
+		t.Fatalf(`Unexpected ENCRYPTION_KEY value, got %q`, opts.EncryptionKey())
+	}
+
+	if !reflect.DeepEqual(opts.EncryptionPreviousKeys(), []string{"old1", "old2"}) {
+		t.Fatalf(`Unexpected ENCRYPTION_PREVIOUS_KEYS value, got %v`, opts.EncryptionPreviousKeys())
+	}
+}
+
+func TestEncryptionKeysAreRedacted(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("ENCRYPTION_KEY", "current-master-key")
+	os.Setenv("ENCRYPTION_PREVIOUS_KEYS", "old-master-key1, old-master-key2")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	options := make(map[string]interface{})
+	for _, option := range opts.SortedOptions() {
+		options[option.Key] = option.Value
+	}
+
+	if options["ENCRYPTION_KEY"] != "<redacted>" {
+		t.Errorf(`ENCRYPTION_KEY should be redacted, got %v`, options["ENCRYPTION_KEY"])
+	}
+
+	if !reflect.DeepEqual(options["ENCRYPTION_PREVIOUS_KEYS"], []string{"<redacted>", "<redacted>"}) {
+		t.Errorf(`ENCRYPTION_PREVIOUS_KEYS should be redacted, got %v`, options["ENCRYPTION_PREVIOUS_KEYS"])
+	}
+
+	if dump := opts.String(); strings.Contains(dump, "master-key") {
+		t.Errorf(`The configuration dump should not contain the encryption keys: %s`, dump)
+	}
+
+	os.Clearenv()
+	opts, err = NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	for _, option := range opts.SortedOptions() {
+		if option.Key == "ENCRYPTION_KEY" && option.Value != "" {
+			t.Errorf(`An empty ENCRYPTION_KEY should not be redacted, got %v`, option.Value)
+		}
+	}
+}
+
+func TestEncryptionKeyFromFile(t *testing.T) {
+	os.Clearenv()
+
+	tmpfile, err := ioutil.TempFile("", "miniflux")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tmpfile.Name())
+
+	if _, err := tmpfile.Write([]byte("secret\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	os.Setenv("ENCRYPTION_KEY_FILE", tmpfile.Name())
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.EncryptionKey() != "secret" {
+		t.Fatalf(`Unexpected ENCRYPTION_KEY_FILE value, got %q`, opts.EncryptionKey())
+	}
+}
+
+func TestDefaultHTTPClientNetworks(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if len(opts.HTTPClientAllowedNetworks()) != 0 {
+		t.Fatalf(`Unexpected HTTP_CLIENT_ALLOWED_NETWORKS value, got %v`, opts.HTTPClientAllowedNetworks())
+	}
+
+	if len(opts.HTTPClientDeniedNetworks()) != 0 {
+		t.Fatalf(`Unexpected HTTP_CLIENT_DENIED_NETWORKS value, got %v`, opts.HTTPClientDeniedNetworks())
+	}
+}
+
+func TestHTTPClientNetworks(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_ALLOWED_NETWORKS", "192.168.1.0/24, 10.0.0.5, fd00::1")
+	os.Setenv("HTTP_CLIENT_DENIED_NETWORKS", "203.0.113.0/24")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	var allowed []string
+	for _, network := range opts.HTTPClientAllowedNetworks() {
+		allowed = append(allowed, network.String())
+	}
+
+	expected := []string{"192.168.1.0/24", "10.0.0.5/32", "fd00::1/128"}
+	if !reflect.DeepEqual(allowed, expected) {
+		t.Fatalf(`Unexpected HTTP_CLIENT_ALLOWED_NETWORKS value, got %v instead of %v`, allowed, expected)
+	}
+
+	denied := opts.HTTPClientDeniedNetworks()
+	if len(denied) != 1 || denied[0].String() != "203.0.113.0/24" {
+		t.Fatalf(`Unexpected HTTP_CLIENT_DENIED_NETWORKS value, got %v`, denied)
+	}
+}
+
+func TestInvalidHTTPClientNetworks(t *testing.T) {
+	for _, value := range []string{"not-an-ip", "10.0.0.0/33"} {
+		os.Clearenv()
+		os.Setenv("HTTP_CLIENT_ALLOWED_NETWORKS", value)
+
+		parser := NewParser()
+		if _, err := parser.ParseEnvironmentVariables(); err == nil {
+			t.Fatalf(`Parsing %q should fail`, value)
+		}
+	}
+}
+
+func TestDefaultProxyPrivateKey(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.HasProxyPrivateKey() {
+		t.Fatalf(`Unexpected PROXY_PRIVATE_KEY value, got %q`, opts.ProxyPrivateKey())
+	}
+
+	opts.SetProxyPrivateKey("stored")
+	if string(opts.ProxyPrivateKey()) != "stored" {
+		t.Fatalf(`Unexpected proxy private key, got %q`, opts.ProxyPrivateKey())
+	}
+
+	if len(opts.ProxyPreviousPrivateKeys()) != 0 {
+		t.Fatalf(`Unexpected PROXY_PREVIOUS_PRIVATE_KEYS value, got %v`, opts.ProxyPreviousPrivateKeys())
+	}
+
+	if opts.IsProxyPublic() {
+		t.Fatal(`The proxy should not be public by default`)
+	}
+}
+
+func TestProxyPrivateKeys(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_PRIVATE_KEY", "current")
+	os.Setenv("PROXY_PREVIOUS_PRIVATE_KEYS", "old1, old2")
+	os.Setenv("PROXY_PUBLIC", "1")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if string(opts.ProxyPrivateKey()) != "current" {
+		t.Fatalf(`Unexpected PROXY_PRIVATE_KEY value, got %q`, opts.ProxyPrivateKey())
+	}
+
+	if !reflect.DeepEqual(opts.ProxyPreviousPrivateKeys(), [][]byte{[]byte("old1"), []byte("old2")}) {
+		t.Fatalf(`Unexpected PROXY_PREVIOUS_PRIVATE_KEYS value, got %q`, opts.ProxyPreviousPrivateKeys())
+	}
+
+	if !opts.IsProxyPublic() {
+		t.Fatal(`Unexpected PROXY_PUBLIC value`)
+	}
+}
+
+func TestProxyPrivateKeysAreRedacted(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_PRIVATE_KEY", "current-proxy-key")
+	os.Setenv("PROXY_PREVIOUS_PRIVATE_KEYS", "old-proxy-key1, old-proxy-key2")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	options := make(map[string]interface{})
+	for _, option := range opts.SortedOptions() {
+		options[option.Key] = option.Value
+	}
+
+	if options["PROXY_PRIVATE_KEY"] != "<redacted>" {
+		t.Errorf(`PROXY_PRIVATE_KEY should be redacted, got %v`, options["PROXY_PRIVATE_KEY"])
+	}
+
+	if !reflect.DeepEqual(options["PROXY_PREVIOUS_PRIVATE_KEYS"], []string{"<redacted>", "<redacted>"}) {
+		t.Errorf(`PROXY_PREVIOUS_PRIVATE_KEYS should be redacted, got %v`, options["PROXY_PREVIOUS_PRIVATE_KEYS"])
+	}
+
+	if dump := opts.String(); strings.Contains(dump, "proxy-key") {
+		t.Errorf(`The configuration dump should not contain the proxy keys: %s`, dump)
+	}
+}
+
+func TestDefaultProxyCacheSettings(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.HasProxyCache() {
+		t.Fatal(`The proxy cache should be disabled by default`)
+	}
+
+	if opts.ProxyCacheMaxSize() != defaultProxyCacheMaxSize*1024*1024 {
+		t.Fatalf(`Unexpected PROXY_CACHE_MAX_SIZE value, got %d`, opts.ProxyCacheMaxSize())
+	}
+
+	if opts.ProxyCacheMaxAge() != defaultProxyCacheMaxAge {
+		t.Fatalf(`Unexpected PROXY_CACHE_MAX_AGE value, got %d`, opts.ProxyCacheMaxAge())
+	}
+
+	if !reflect.DeepEqual(opts.ProxyImageWidths(), defaultProxyImageWidths) {
+		t.Fatalf(`Unexpected PROXY_IMAGE_WIDTHS value, got %v`, opts.ProxyImageWidths())
+	}
+}
+
+func TestProxyCacheSettings(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_CACHE_DIR", "/var/cache/miniflux")
+	os.Setenv("PROXY_CACHE_MAX_SIZE", "100")
+	os.Setenv("PROXY_CACHE_MAX_AGE", "24")
+	os.Setenv("PROXY_IMAGE_WIDTHS", "320, 640, invalid, -1")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.HasProxyCache() || opts.ProxyCacheDir() != "/var/cache/miniflux" {
+		t.Fatalf(`Unexpected PROXY_CACHE_DIR value, got %q`, opts.ProxyCacheDir())
+	}
+
+	if opts.ProxyCacheMaxSize() != 100*1024*1024 {
+		t.Fatalf(`Unexpected PROXY_CACHE_MAX_SIZE value, got %d`, opts.ProxyCacheMaxSize())
+	}
+
+	if opts.ProxyCacheMaxAge() != 24 {
+		t.Fatalf(`Unexpected PROXY_CACHE_MAX_AGE value, got %d`, opts.ProxyCacheMaxAge())
+	}
+
+	if !reflect.DeepEqual(opts.ProxyImageWidths(), []int{320, 640}) {
+		t.Fatalf(`Unexpected PROXY_IMAGE_WIDTHS value, got %v`, opts.ProxyImageWidths())
+	}
+}
+
+func TestProxyImageResizingDisabled(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGE_WIDTHS", "0")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if len(opts.ProxyImageWidths()) != 0 {
+		t.Fatalf(`Unexpected PROXY_IMAGE_WIDTHS value, got %v`, opts.ProxyImageWidths())
+	}
+}
+
+func TestDefaultProxyMediaTypes(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !reflect.DeepEqual(opts.ProxyMediaTypes(), []string{"image"}) {
+		t.Fatalf(`Unexpected PROXY_MEDIA_TYPES value, got %v`, opts.ProxyMediaTypes())
+	}
+}
+
+func TestProxyMediaTypes(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_MEDIA_TYPES", "image, Audio,video")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !reflect.DeepEqual(opts.ProxyMediaTypes(), []string{"image", "audio", "video"}) {
+		t.Fatalf(`Unexpected PROXY_MEDIA_TYPES value, got %v`, opts.ProxyMediaTypes())
+	}
+}
+
+func TestInvalidProxyMediaTypes(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_MEDIA_TYPES", "image,document")
+
+	parser := NewParser()
+	if _, err := parser.ParseEnvironmentVariables(); err == nil {
+		t.Fatal(`Invalid media types should be rejected`)
+	}
+}
@@ -6,12 +6,17 @@ package config // import "miniflux.app/config"
 
 import (
 	"fmt"
+	"net"
+	url_parser "net/url"
 	"sort"
 	"strings"
 
 	"miniflux.app/version"
 )
 
+// redactedValue replaces the secrets in the list of options.
+const redactedValue = "<redacted>"
+
 const (
 	defaultHTTPS                              = false
 	defaultLogDateTime                        = false
@@ -26,6 +31,7 @@ const (
 	defaultWorkerPoolSize                     = 5
 	defaultPollingFrequency                   = 60
 	defaultBatchSize                          = 10
+	defaultOPMLSourceFrequency                = 60
 	defaultPollingScheduler                   = "round_robin"
 	defaultSchedulerEntryFrequencyMinInterval = 5
 	defaultSchedulerEntryFrequencyMaxInterval = 24 * 60
@@ -42,7 +48,12 @@ const (
 	defaultCleanupArchiveReadDays             = 60
 	defaultCleanupArchiveUnreadDays           = 180
 	defaultCleanupRemoveSessionsDays          = 30
+	defaultCleanupRemoveAuditEventsDays       = 365
 	defaultProxyImages                        = "http-only"
+	defaultProxyPublic                        = false
+	defaultProxyCacheDir                      = ""
+	defaultProxyCacheMaxSize                  = 512
+	defaultProxyCacheMaxAge                   = 168
 	defaultCreateAdmin                        = false
 	defaultAdminUsername                      = ""
 	defaultAdminPassword                      = ""
@@ -52,6 +63,9 @@ const (
 	defaultOAuth2RedirectURL                  = ""
 	defaultOAuth2OidcDiscoveryEndpoint        = ""
 	defaultOAuth2Provider                     = ""
+	defaultOAuth2GitLabURL                    = "https://gitlab.com"
+	defaultOAuth2OidcUsernameClaim            = "email"
+	defaultOAuth2OidcGroupsClaim              = "groups"
 	defaultPocketConsumerKey                  = ""
 	defaultHTTPClientTimeout                  = 20
 	defaultHTTPClientMaxBodySize              = 15
@@ -63,10 +77,27 @@ const (
 	defaultMetricsCollector                   = false
 	defaultMetricsRefreshInterval             = 60
 	defaultMetricsAllowedNetworks             = "127.0.0.1/8"
+	defaultNewsletterWebhookSecret            = ""
+	defaultNewsletterDomain                   = ""
+	defaultLDAPURL                            = ""
+	defaultLDAPStartTLS                       = false
+	defaultLDAPBindDN                         = ""
+	defaultLDAPBindPassword                   = ""
+	defaultLDAPBaseDN                         = ""
+	defaultLDAPUserFilter                     = "(uid=%s)"
+	defaultLDAPAdminGroupDN                   = ""
+	defaultLDAPUserCreation                   = false
+	defaultLoginMaxFailures                   = 10
+	defaultLoginLockoutDuration               = 15
+	defaultEncryptionKey                      = ""
 )
 
 var defaultHTTPClientUserAgent = "Mozilla/5.0 (compatible; Miniflux/" + version.Version + "; +https://miniflux.app)"
 
+var defaultProxyImageWidths []int
+
+var defaultProxyMediaTypes = []string{"image"}
+
 // Option contains a key to value map of a single option. It may be used to output debug strings.
 type Option struct {
 	Key   string
@@ -98,8 +129,10 @@ type Options struct {
 	cleanupArchiveReadDays             int
 	cleanupArchiveUnreadDays           int
 	cleanupRemoveSessionsDays          int
+	cleanupRemoveAuditEventsDays       int
 	pollingFrequency                   int
 	batchSize                          int
+	opmlSourceFrequency                int
 	pollingScheduler                   string
 	schedulerEntryFrequencyMinInterval int
 	schedulerEntryFrequencyMaxInterval int
@@ -108,17 +141,32 @@ type Options struct {
 	adminUsername                      string
 	adminPassword                      string
 	proxyImages                        string
+	proxyPrivateKey                    string
+	proxyPreviousPrivateKeys           []string
+	proxyPublic                        bool
+	proxyCacheDir                      string
+	proxyCacheMaxSize                  int
+	proxyCacheMaxAge                   int
+	proxyImageWidths                   []int
+	proxyMediaTypes                    []string
 	oauth2UserCreationAllowed          bool
 	oauth2ClientID                     string
 	oauth2ClientSecret                 string
 	oauth2RedirectURL                  string
 	oauth2OidcDiscoveryEndpoint        string
 	oauth2Provider                     string
+	oauth2GitLabURL                    string
+	oauth2OidcUsernameClaim            string
+	oauth2OidcGroupsClaim              string
+	oauth2AllowedGroups                []string
+	oauth2AdminGroups                  []string
 	pocketConsumerKey                  string
 	httpClientTimeout                  int
 	httpClientMaxBodySize              int64
 	httpClientProxy                    string
 	httpClientUserAgent                string
+	httpClientAllowedNetworks          []*net.IPNet
+	httpClientDeniedNetworks           []*net.IPNet
 	authProxyHeader                    string
 	authProxyUserCreation              bool
 	maintenanceMode                    bool
@@ -126,6 +174,21 @@ type Options struct {
 	metricsCollector                   bool
 	metricsRefreshInterval             int
 	metricsAllowedNetworks             []string
+	newsletterWebhookSecret            string
+	newsletterDomain                   string
+	ldapURL                            string
+	ldapStartTLS                       bool
+	ldapBindDN                         string
+	ldapBindPassword                   string
+	ldapBaseDN                         string
+	ldapUserFilter                     string
+	ldapAdminGroupDN                   string
+	ldapUserCreationAllowed            bool
+	trustedProxies                     []string
+	loginMaxFailures                   int
+	loginLockoutDuration               int
+	encryptionKey                      string
+	encryptionPreviousKeys             []string
 }
 
 // NewOptions returns Options with default values.
@@ -154,20 +217,33 @@ func NewOptions() *Options {
 		cleanupArchiveReadDays:             defaultCleanupArchiveReadDays,
 		cleanupArchiveUnreadDays:           defaultCleanupArchiveUnreadDays,
 		cleanupRemoveSessionsDays:          defaultCleanupRemoveSessionsDays,
+		cleanupRemoveAuditEventsDays:       defaultCleanupRemoveAuditEventsDays,
 		pollingFrequency:                   defaultPollingFrequency,
 		batchSize:                          defaultBatchSize,
+		opmlSourceFrequency:                defaultOPMLSourceFrequency,
 		pollingScheduler:                   defaultPollingScheduler,
 		schedulerEntryFrequencyMinInterval: defaultSchedulerEntryFrequencyMinInterval,
 		schedulerEntryFrequencyMaxInterval: defaultSchedulerEntryFrequencyMaxInterval,
 		workerPoolSize:                     defaultWorkerPoolSize,
 		createAdmin:                        defaultCreateAdmin,
 		proxyImages:                        defaultProxyImages,
+		proxyPrivateKey:                    "",
+		proxyPreviousPrivateKeys:           nil,
+		proxyPublic:                        defaultProxyPublic,
+		proxyCacheDir:                      defaultProxyCacheDir,
+		proxyCacheMaxSize:                  defaultProxyCacheMaxSize,
+		proxyCacheMaxAge:                   defaultProxyCacheMaxAge,
+		proxyImageWidths:                   defaultProxyImageWidths,
+		proxyMediaTypes:                    defaultProxyMediaTypes,
 		oauth2UserCreationAllowed:          defaultOAuth2UserCreation,
 		oauth2ClientID:                     defaultOAuth2ClientID,
 		oauth2ClientSecret:                 defaultOAuth2ClientSecret,
 		oauth2RedirectURL:                  defaultOAuth2RedirectURL,
 		oauth2OidcDiscoveryEndpoint:        defaultOAuth2OidcDiscoveryEndpoint,
 		oauth2Provider:                     defaultOAuth2Provider,
+		oauth2GitLabURL:                    defaultOAuth2GitLabURL,
+		oauth2OidcUsernameClaim:            defaultOAuth2OidcUsernameClaim,
+		oauth2OidcGroupsClaim:              defaultOAuth2OidcGroupsClaim,
 		pocketConsumerKey:                  defaultPocketConsumerKey,
 		httpClientTimeout:                  defaultHTTPClientTimeout,
 		httpClientMaxBodySize:              defaultHTTPClientMaxBodySize * 1024 * 1024,
@@ -180,6 +256,20 @@ func NewOptions() *Options {
 		metricsCollector:                   defaultMetricsCollector,
 		metricsRefreshInterval:             defaultMetricsRefreshInterval,
 		metricsAllowedNetworks:             []string{defaultMetricsAllowedNetworks},
+		newsletterWebhookSecret:            defaultNewsletterWebhookSecret,
+		newsletterDomain:                   defaultNewsletterDomain,
+		ldapURL:                            defaultLDAPURL,
+		ldapStartTLS:                       defaultLDAPStartTLS,
+		ldapBindDN:                         defaultLDAPBindDN,
+		ldapBindPassword:                   defaultLDAPBindPassword,
+		ldapBaseDN:                         defaultLDAPBaseDN,
+		ldapUserFilter:                     defaultLDAPUserFilter,
+		ldapAdminGroupDN:                   defaultLDAPAdminGroupDN,
+		ldapUserCreationAllowed:            defaultLDAPUserCreation,
+		loginMaxFailures:                   defaultLoginMaxFailures,
+		loginLockoutDuration:               defaultLoginLockoutDuration,
+		encryptionKey:                      defaultEncryptionKey,
+		encryptionPreviousKeys:             nil,
 	}
 }
 
@@ -288,6 +378,11 @@ func (o *Options) CleanupRemoveSessionsDays() int {
 	return o.cleanupRemoveSessionsDays
 }
 
+// CleanupRemoveAuditEventsDays returns the number of days after which to remove audit events.
+func (o *Options) CleanupRemoveAuditEventsDays() int {
+	return o.cleanupRemoveAuditEventsDays
+}
+
 // WorkerPoolSize returns the number of background worker.
 func (o *Options) WorkerPoolSize() int {
 	return o.workerPoolSize
@@ -303,6 +398,11 @@ func (o *Options) BatchSize() int {
 	return o.batchSize
 }
 
+// OPMLSourceFrequency returns the interval to synchronize the remote OPML lists in the background.
+func (o *Options) OPMLSourceFrequency() int {
+	return o.opmlSourceFrequency
+}
+
 // PollingScheduler returns the scheduler used for polling feeds.
 func (o *Options) PollingScheduler() string {
 	return o.pollingScheduler
@@ -348,6 +448,31 @@ func (o *Options) OAuth2Provider() string {
 	return o.oauth2Provider
 }
 
+// OAuth2GitLabURL returns the URL of the GitLab instance used by the gitlab provider.
+func (o *Options) OAuth2GitLabURL() string {
+	return o.oauth2GitLabURL
+}
+
+// OAuth2OidcUsernameClaim returns the OIDC claim used as username.
+func (o *Options) OAuth2OidcUsernameClaim() string {
+	return o.oauth2OidcUsernameClaim
+}
+
+// OAuth2OidcGroupsClaim returns the OIDC claim that contains the groups of the user.
+func (o *Options) OAuth2OidcGroupsClaim() string {
+	return o.oauth2OidcGroupsClaim
+}
+
+// OAuth2AllowedGroups returns the groups allowed to sign in with OAuth2, everybody is allowed when empty.
+func (o *Options) OAuth2AllowedGroups() []string {
+	return o.oauth2AllowedGroups
+}
+
+// OAuth2AdminGroups returns the groups whose members are administrators.
+func (o *Options) OAuth2AdminGroups() []string {
+	return o.oauth2AdminGroups
+}
+
 // HasHSTS returns true if HTTP Strict Transport Security is enabled.
 func (o *Options) HasHSTS() bool {
 	return o.hsts
@@ -378,6 +503,65 @@ func (o *Options) ProxyImages() string {
 	return o.proxyImages
 }
 
+// ProxyPrivateKey returns the key used to sign the proxified URLs.
+func (o *Options) ProxyPrivateKey() []byte {
+	return []byte(o.proxyPrivateKey)
+}
+
+// ProxyPreviousPrivateKeys returns the keys still accepted to verify the proxified URLs.
+func (o *Options) ProxyPreviousPrivateKeys() [][]byte {
+	keys := make([][]byte, 0, len(o.proxyPreviousPrivateKeys))
+	for _, key := range o.proxyPreviousPrivateKeys {
+		keys = append(keys, []byte(key))
+	}
+	return keys
+}
+
+// IsProxyPublic returns true if the proxified URLs can be fetched without a user session.
+func (o *Options) IsProxyPublic() bool {
+	return o.proxyPublic
+}
+
+// HasProxyPrivateKey returns true if the key used to sign the proxified URLs is configured.
+func (o *Options) HasProxyPrivateKey() bool {
+	return o.proxyPrivateKey != ""
+}
+
+// SetProxyPrivateKey defines the key used to sign the proxified URLs when PROXY_PRIVATE_KEY is not configured.
+func (o *Options) SetProxyPrivateKey(key string) {
+	o.proxyPrivateKey = key
+}
+
+// HasProxyCache returns true if the proxified media are cached on disk.
+func (o *Options) HasProxyCache() bool {
+	return o.proxyCacheDir != ""
+}
+
+// ProxyCacheDir returns the directory where the proxified media are cached.
+func (o *Options) ProxyCacheDir() string {
+	return o.proxyCacheDir
+}
+
+// ProxyCacheMaxSize returns the maximum size of the proxy cache in bytes.
+func (o *Options) ProxyCacheMaxSize() int64 {
+	return int64(o.proxyCacheMaxSize) * 1024 * 1024
+}
+
+// ProxyCacheMaxAge returns the number of hours the proxified media are kept in cache.
+func (o *Options) ProxyCacheMaxAge() int {
+	return o.proxyCacheMaxAge
+}
+
+// ProxyImageWidths returns the widths of the resized variants of proxified images, an empty list disables resizing.
+func (o *Options) ProxyImageWidths() []int {
+	return o.proxyImageWidths
+}
+
+// ProxyMediaTypes returns the kinds of media proxified: "image", "audio" or "video".
+func (o *Options) ProxyMediaTypes() []string {
+	return o.proxyMediaTypes
+}
+
 // HasHTTPService returns true if the HTTP service is enabled.
 func (o *Options) HasHTTPService() bool {
 	return o.httpService
@@ -416,6 +600,16 @@ func (o *Options) HasHTTPClientProxyConfigured() bool {
 	return o.httpClientProxy != ""
 }
 
+// HTTPClientAllowedNetworks returns the networks reachable by the HTTP client even if they are internal.
+func (o *Options) HTTPClientAllowedNetworks() []*net.IPNet {
+	return o.httpClientAllowedNetworks
+}
+
+// HTTPClientDeniedNetworks returns the networks blocked in addition to the internal ones.
+func (o *Options) HTTPClientDeniedNetworks() []*net.IPNet {
+	return o.httpClientDeniedNetworks
+}
+
 // AuthProxyHeader returns an HTTP header name that contains username for
 // authentication using auth proxy.
 func (o *Options) AuthProxyHeader() string {
@@ -448,6 +642,104 @@ func (o *Options) HTTPClientUserAgent() string {
 	return o.httpClientUserAgent
 }
 
+// HasNewsletterWebhook returns true if the inbound newsletter webhook is enabled.
+func (o *Options) HasNewsletterWebhook() bool {
+	return o.newsletterWebhookSecret != ""
+}
+
+// NewsletterWebhookSecret returns the shared secret used to authenticate the inbound newsletter webhook.
+func (o *Options) NewsletterWebhookSecret() string {
+	return o.newsletterWebhookSecret
+}
+
+// NewsletterDomain returns the domain used to build newsletter email addresses.
+func (o *Options) NewsletterDomain() string {
+	if o.newsletterDomain != "" {
+		return o.newsletterDomain
+	}
+
+	if u, err := url_parser.Parse(o.rootURL); err == nil && u.Hostname() != "" {
+		return u.Hostname()
+	}
+
+	return "localhost"
+}
+
+// HasLDAP returns true if LDAP authentication is enabled.
+func (o *Options) HasLDAP() bool {
+	return o.ldapURL != ""
+}
+
+// LDAPURL returns the address of the LDAP server, for example ldaps://ldap.example.org.
+func (o *Options) LDAPURL() string {
+	return o.ldapURL
+}
+
+// HasLDAPStartTLS returns true if the LDAP connection must be upgraded with StartTLS.
+func (o *Options) HasLDAPStartTLS() bool {
+	return o.ldapStartTLS
+}
+
+// LDAPBindDN returns the DN used to search users, anonymous searches are used when empty.
+func (o *Options) LDAPBindDN() string {
+	return o.ldapBindDN
+}
+
+// LDAPBindPassword returns the password of the search DN.
+func (o *Options) LDAPBindPassword() string {
+	return o.ldapBindPassword
+}
+
+// LDAPBaseDN returns the DN where users are searched.
+func (o *Options) LDAPBaseDN() string {
+	return o.ldapBaseDN
+}
+
+// LDAPUserFilter returns the filter used to find users, %s is replaced by the escaped username.
+func (o *Options) LDAPUserFilter() string {
+	return o.ldapUserFilter
+}
+
+// LDAPAdminGroupDN returns the DN of the group whose members are administrators.
+func (o *Options) LDAPAdminGroupDN() string {
+	return o.ldapAdminGroupDN
+}
+
+// IsLDAPUserCreationAllowed returns true if user creation is allowed for LDAP users.
+func (o *Options) IsLDAPUserCreationAllowed() bool {
+	return o.ldapUserCreationAllowed
+}
+
+// TrustedProxies returns the list of networks allowed to set the client IP with the X-Forwarded-For or X-Real-Ip headers.
+func (o *Options) TrustedProxies() []string {
+	return o.trustedProxies
+}
+
+// LoginMaxFailures returns the number of failed logins before locking a username or a client IP, 0 disables the protection.
+func (o *Options) LoginMaxFailures() int {
+	return o.loginMaxFailures
+}
+
+// LoginLockoutDuration returns the lockout duration in minutes.
+func (o *Options) LoginLockoutDuration() int {
+	return o.loginLockoutDuration
+}
+
+// HasEncryption returns true if sensitive values must be encrypted in the database.
+func (o *Options) HasEncryption() bool {
+	return o.encryptionKey != ""
+}
+
+// EncryptionKey returns the master key used to encrypt sensitive values in the database.
+func (o *Options) EncryptionKey() string {
+	return o.encryptionKey
+}
+
+// EncryptionPreviousKeys returns the master keys that were used before the current one.
+func (o *Options) EncryptionPreviousKeys() []string {
+	return o.encryptionPreviousKeys
+}
+
 // SortedOptions returns options as a list of key value pairs, sorted by keys.
 func (o *Options) SortedOptions() []*Option {
 	var keyValues = map[string]interface{}{
@@ -464,43 +756,75 @@ func (o *Options) SortedOptions() []*Option {
 		"CLEANUP_ARCHIVE_READ_DAYS":              o.cleanupArchiveReadDays,
 		"CLEANUP_ARCHIVE_UNREAD_DAYS":            o.cleanupArchiveUnreadDays,
 		"CLEANUP_FREQUENCY_HOURS":                o.cleanupFrequencyHours,
+		"CLEANUP_REMOVE_AUDIT_EVENTS_DAYS":       o.cleanupRemoveAuditEventsDays,
 		"CLEANUP_REMOVE_SESSIONS_DAYS":           o.cleanupRemoveSessionsDays,
 		"CREATE_ADMIN":                           o.createAdmin,
 		"DATABASE_MAX_CONNS":                     o.databaseMaxConns,
 		"DATABASE_MIN_CONNS":                     o.databaseMinConns,
 		"DATABASE_URL":                           o.databaseURL,
 		"DEBUG":                                  o.debug,
+		"ENCRYPTION_KEY":                         redactSecretValue(o.encryptionKey),
+		"ENCRYPTION_PREVIOUS_KEYS":               redactSecretValues(o.encryptionPreviousKeys),
 		"HSTS":                                   o.hsts,
 		"HTTPS":                                  o.HTTPS,
+		"HTTP_CLIENT_ALLOWED_NETWORKS":           o.httpClientAllowedNetworks,
+		"HTTP_CLIENT_DENIED_NETWORKS":            o.httpClientDeniedNetworks,
 		"HTTP_CLIENT_MAX_BODY_SIZE":              o.httpClientMaxBodySize,
 		"HTTP_CLIENT_PROXY":                      o.httpClientProxy,
 		"HTTP_CLIENT_TIMEOUT":                    o.httpClientTimeout,
 		"HTTP_CLIENT_USER_AGENT":                 o.httpClientUserAgent,
 		"HTTP_SERVICE":                           o.httpService,
 		"KEY_FILE":                               o.certKeyFile,
+		"LDAP_ADMIN_GROUP_DN":                    o.ldapAdminGroupDN,
+		"LDAP_BASE_DN":                           o.ldapBaseDN,
+		"LDAP_BIND_DN":                           o.ldapBindDN,
+		"LDAP_BIND_PASSWORD":                     redactSecretValue(o.ldapBindPassword),
+		"LDAP_START_TLS":                         o.ldapStartTLS,
+		"LDAP_URL":                               o.ldapURL,
+		"LDAP_USER_CREATION":                     o.ldapUserCreationAllowed,
+		"LDAP_USER_FILTER":                       o.ldapUserFilter,
 		"LISTEN_ADDR":                            o.listenAddr,
+		"LOGIN_LOCKOUT_DURATION":                 o.loginLockoutDuration,
+		"LOGIN_MAX_FAILURES":                     o.loginMaxFailures,
 		"LOG_DATE_TIME":                          o.logDateTime,
 		"MAINTENANCE_MESSAGE":                    o.maintenanceMessage,
 		"MAINTENANCE_MODE":                       o.maintenanceMode,
 		"METRICS_ALLOWED_NETWORKS":               o.metricsAllowedNetworks,
 		"METRICS_COLLECTOR":                      o.metricsCollector,
 		"METRICS_REFRESH_INTERVAL":               o.metricsRefreshInterval,
+		"NEWSLETTER_DOMAIN":                      o.newsletterDomain,
+		"NEWSLETTER_WEBHOOK_SECRET":              redactSecretValue(o.newsletterWebhookSecret),
+		"OAUTH2_ADMIN_GROUPS":                    o.oauth2AdminGroups,
+		"OAUTH2_ALLOWED_GROUPS":                  o.oauth2AllowedGroups,
 		"OAUTH2_CLIENT_ID":                       o.oauth2ClientID,
 		"OAUTH2_CLIENT_SECRET":                   o.oauth2ClientSecret,
+		"OAUTH2_GITLAB_URL":                      o.oauth2GitLabURL,
 		"OAUTH2_OIDC_DISCOVERY_ENDPOINT":         o.oauth2OidcDiscoveryEndpoint,
+		"OAUTH2_OIDC_GROUPS_CLAIM":               o.oauth2OidcGroupsClaim,
+		"OAUTH2_OIDC_USERNAME_CLAIM":             o.oauth2OidcUsernameClaim,
 		"OAUTH2_PROVIDER":                        o.oauth2Provider,
 		"OAUTH2_REDIRECT_URL":                    o.oauth2RedirectURL,
 		"OAUTH2_USER_CREATION":                   o.oauth2UserCreationAllowed,
+		"OPML_SOURCE_FREQUENCY":                  o.opmlSourceFrequency,
 		"POCKET_CONSUMER_KEY":                    o.pocketConsumerKey,
 		"POLLING_FREQUENCY":                      o.pollingFrequency,
 		"POLLING_SCHEDULER":                      o.pollingScheduler,
+		"PROXY_CACHE_DIR":                        o.proxyCacheDir,
+		"PROXY_CACHE_MAX_AGE":                    o.proxyCacheMaxAge,
+		"PROXY_CACHE_MAX_SIZE":                   o.proxyCacheMaxSize,
 		"PROXY_IMAGES":                           o.proxyImages,
+		"PROXY_IMAGE_WIDTHS":                     o.proxyImageWidths,
+		"PROXY_MEDIA_TYPES":                      o.proxyMediaTypes,
+		"PROXY_PREVIOUS_PRIVATE_KEYS":            redactSecretValues(o.proxyPreviousPrivateKeys),
+		"PROXY_PRIVATE_KEY":                      redactSecretValue(o.proxyPrivateKey),
+		"PROXY_PUBLIC":                           o.proxyPublic,
 		"ROOT_URL":                               o.rootURL,
 		"RUN_MIGRATIONS":                         o.runMigrations,
 		"SCHEDULER_ENTRY_FREQUENCY_MAX_INTERVAL": o.schedulerEntryFrequencyMaxInterval,
 		"SCHEDULER_ENTRY_FREQUENCY_MIN_INTERVAL": o.schedulerEntryFrequencyMinInterval,
 		"SCHEDULER_SERVICE":                      o.schedulerService,
 		"SERVER_TIMING_HEADER":                   o.serverTimingHeader,
+		"TRUSTED_PROXIES":                        o.trustedProxies,
 		"WORKER_POOL_SIZE":                       o.workerPoolSize,
 	}
 
@@ -526,3 +850,22 @@ func (o *Options) String() string {
 
 	return builder.String()
 }
+
+// redactSecretValue hides a secret from the about page and the configuration dump,
+// an empty value is kept to show that the option is not set.
+func redactSecretValue(value string) string {
+	if value == "" {
+		return ""
+	}
+
+	return redactedValue
+}
+
+func redactSecretValues(values []string) []string {
+	redactedValues := make([]string, 0, len(values))
+	for _, value := range values {
+		redactedValues = append(redactedValues, redactSecretValue(value))
+	}
+
+	return redactedValues
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	url_parser "net/url"
 	"os"
 	"strconv"
@@ -122,12 +123,16 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.cleanupArchiveUnreadDays = parseInt(value, defaultCleanupArchiveUnreadDays)
 		case "CLEANUP_REMOVE_SESSIONS_DAYS":
 			p.opts.cleanupRemoveSessionsDays = parseInt(value, defaultCleanupRemoveSessionsDays)
+		case "CLEANUP_REMOVE_AUDIT_EVENTS_DAYS":
+			p.opts.cleanupRemoveAuditEventsDays = parseInt(value, defaultCleanupRemoveAuditEventsDays)
 		case "WORKER_POOL_SIZE":
 			p.opts.workerPoolSize = parseInt(value, defaultWorkerPoolSize)
 		case "POLLING_FREQUENCY":
 			p.opts.pollingFrequency = parseInt(value, defaultPollingFrequency)
 		case "BATCH_SIZE":
 			p.opts.batchSize = parseInt(value, defaultBatchSize)
+		case "OPML_SOURCE_FREQUENCY":
+			p.opts.opmlSourceFrequency = parseInt(value, defaultOPMLSourceFrequency)
 		case "POLLING_SCHEDULER":
 			p.opts.pollingScheduler = strings.ToLower(parseString(value, defaultPollingScheduler))
 		case "SCHEDULER_ENTRY_FREQUENCY_MAX_INTERVAL":
@@ -136,6 +141,27 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.schedulerEntryFrequencyMinInterval = parseInt(value, defaultSchedulerEntryFrequencyMinInterval)
 		case "PROXY_IMAGES":
 			p.opts.proxyImages = parseString(value, defaultProxyImages)
+		case "PROXY_PRIVATE_KEY":
+			p.opts.proxyPrivateKey = parseString(value, p.opts.proxyPrivateKey)
+		case "PROXY_PRIVATE_KEY_FILE":
+			p.opts.proxyPrivateKey = readSecretFile(value, p.opts.proxyPrivateKey)
+		case "PROXY_PREVIOUS_PRIVATE_KEYS":
+			p.opts.proxyPreviousPrivateKeys = parseStringList(value, nil)
+		case "PROXY_PUBLIC":
+			p.opts.proxyPublic = parseBool(value, defaultProxyPublic)
+		case "PROXY_CACHE_DIR":
+			p.opts.proxyCacheDir = parseString(value, defaultProxyCacheDir)
+		case "PROXY_CACHE_MAX_SIZE":
+			p.opts.proxyCacheMaxSize = parseInt(value, defaultProxyCacheMaxSize)
+		case "PROXY_CACHE_MAX_AGE":
+			p.opts.proxyCacheMaxAge = parseInt(value, defaultProxyCacheMaxAge)
+		case "PROXY_IMAGE_WIDTHS":
+			p.opts.proxyImageWidths = parseIntList(value, defaultProxyImageWidths)
+		case "PROXY_MEDIA_TYPES":
+			p.opts.proxyMediaTypes, err = parseMediaTypes(key, value)
+			if err != nil {
+				return err
+			}
 		case "CREATE_ADMIN":
 			p.opts.createAdmin = parseBool(value, defaultCreateAdmin)
 		case "ADMIN_USERNAME":
@@ -164,6 +190,16 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.oauth2RedirectURL = parseString(value, defaultOAuth2RedirectURL)
 		case "OAUTH2_OIDC_DISCOVERY_ENDPOINT":
 			p.opts.oauth2OidcDiscoveryEndpoint = parseString(value, defaultOAuth2OidcDiscoveryEndpoint)
+		case "OAUTH2_GITLAB_URL":
+			p.opts.oauth2GitLabURL = parseString(value, defaultOAuth2GitLabURL)
+		case "OAUTH2_OIDC_USERNAME_CLAIM":
+			p.opts.oauth2OidcUsernameClaim = parseString(value, defaultOAuth2OidcUsernameClaim)
+		case "OAUTH2_OIDC_GROUPS_CLAIM":
+			p.opts.oauth2OidcGroupsClaim = parseString(value, defaultOAuth2OidcGroupsClaim)
+		case "OAUTH2_ALLOWED_GROUPS":
+			p.opts.oauth2AllowedGroups = parseStringList(value, nil)
+		case "OAUTH2_ADMIN_GROUPS":
+			p.opts.oauth2AdminGroups = parseStringList(value, nil)
 		case "OAUTH2_PROVIDER":
 			p.opts.oauth2Provider = parseString(value, defaultOAuth2Provider)
 		case "HTTP_CLIENT_TIMEOUT":
@@ -172,6 +208,16 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.httpClientMaxBodySize = int64(parseInt(value, defaultHTTPClientMaxBodySize) * 1024 * 1024)
 		case "HTTP_CLIENT_PROXY":
 			p.opts.httpClientProxy = parseString(value, defaultHTTPClientProxy)
+		case "HTTP_CLIENT_ALLOWED_NETWORKS":
+			p.opts.httpClientAllowedNetworks, err = parseNetworkList(key, value)
+			if err != nil {
+				return err
+			}
+		case "HTTP_CLIENT_DENIED_NETWORKS":
+			p.opts.httpClientDeniedNetworks, err = parseNetworkList(key, value)
+			if err != nil {
+				return err
+			}
 		case "HTTP_CLIENT_USER_AGENT":
 			p.opts.httpClientUserAgent = parseString(value, defaultHTTPClientUserAgent)
 		case "AUTH_PROXY_HEADER":
@@ -188,6 +234,42 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.metricsRefreshInterval = parseInt(value, defaultMetricsRefreshInterval)
 		case "METRICS_ALLOWED_NETWORKS":
 			p.opts.metricsAllowedNetworks = parseStringList(value, []string{defaultMetricsAllowedNetworks})
+		case "NEWSLETTER_WEBHOOK_SECRET":
+			p.opts.newsletterWebhookSecret = parseString(value, defaultNewsletterWebhookSecret)
+		case "NEWSLETTER_WEBHOOK_SECRET_FILE":
+			p.opts.newsletterWebhookSecret = readSecretFile(value, defaultNewsletterWebhookSecret)
+		case "NEWSLETTER_DOMAIN":
+			p.opts.newsletterDomain = parseString(value, defaultNewsletterDomain)
+		case "LDAP_URL":
+			p.opts.ldapURL = parseString(value, defaultLDAPURL)
+		case "LDAP_START_TLS":
+			p.opts.ldapStartTLS = parseBool(value, defaultLDAPStartTLS)
+		case "LDAP_BIND_DN":
+			p.opts.ldapBindDN = parseString(value, defaultLDAPBindDN)
+		case "LDAP_BIND_PASSWORD":
+			p.opts.ldapBindPassword = parseString(value, defaultLDAPBindPassword)
+		case "LDAP_BIND_PASSWORD_FILE":
+			p.opts.ldapBindPassword = readSecretFile(value, defaultLDAPBindPassword)
+		case "LDAP_BASE_DN":
+			p.opts.ldapBaseDN = parseString(value, defaultLDAPBaseDN)
+		case "LDAP_USER_FILTER":
+			p.opts.ldapUserFilter = parseString(value, defaultLDAPUserFilter)
+		case "LDAP_ADMIN_GROUP_DN":
+			p.opts.ldapAdminGroupDN = parseString(value, defaultLDAPAdminGroupDN)
+		case "LDAP_USER_CREATION":
+			p.opts.ldapUserCreationAllowed = parseBool(value, defaultLDAPUserCreation)
+		case "TRUSTED_PROXIES":
+			p.opts.trustedProxies = parseStringList(value, nil)
+		case "LOGIN_MAX_FAILURES":
+			p.opts.loginMaxFailures = parseInt(value, defaultLoginMaxFailures)
+		case "LOGIN_LOCKOUT_DURATION":
+			p.opts.loginLockoutDuration = parseInt(value, defaultLoginLockoutDuration)
+		case "ENCRYPTION_KEY":
+			p.opts.encryptionKey = parseString(value, defaultEncryptionKey)
+		case "ENCRYPTION_KEY_FILE":
+			p.opts.encryptionKey = readSecretFile(value, defaultEncryptionKey)
+		case "ENCRYPTION_PREVIOUS_KEYS":
+			p.opts.encryptionPreviousKeys = parseStringList(value, nil)
 		}
 	}
 
@@ -268,6 +350,75 @@ func parseStringList(value string, fallback []string) []string {
 	return strList
 }
 
+// parseIntList parses a comma-separated list of positive integers, invalid and non-positive values are ignored.
+func parseIntList(value string, fallback []int) []int {
+	if value == "" {
+		return fallback
+	}
+
+	intList := []int{}
+	for _, item := range parseStringList(value, nil) {
+		if v, err := strconv.Atoi(item); err == nil && v > 0 {
+			intList = append(intList, v)
+		}
+	}
+
+	return intList
+}
+
+// parseNetworkList parses a comma-separated list of CIDR blocks or IP addresses.
+func parseNetworkList(key, value string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, item := range parseStringList(value, nil) {
+		if item == "" {
+			continue
+		}
+
+		if !strings.Contains(item, "/") {
+			ip := net.ParseIP(item)
+			if ip == nil {
+				return nil, fmt.Errorf("Invalid %s: %q is not an IP address", key, item)
+			}
+
+			bits := 128
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 32
+			}
+
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(item)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid %s: %v", key, err)
+		}
+
+		networks = append(networks, network)
+	}
+
+	return networks, nil
+}
+
+// parseMediaTypes parses a comma-separated list of media types, an empty value disables the proxy for all media.
+func parseMediaTypes(key, value string) ([]string, error) {
+	mediaTypes := []string{}
+	for _, item := range parseStringList(value, nil) {
+		item = strings.ToLower(item)
+		switch item {
+		case "":
+			continue
+		case "image", "audio", "video":
+			mediaTypes = append(mediaTypes, item)
+		default:
+			return nil, fmt.Errorf("Invalid %s: %q is not a media type", key, item)
+		}
+	}
+
+	return mediaTypes, nil
+}
+
 func readSecretFile(filename, fallback string) string {
 	data, err := ioutil.ReadFile(filename)
 	if err != nil {
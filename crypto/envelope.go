@@ -0,0 +1,93 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package crypto // import "miniflux.app/crypto"
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// EncryptionKeySize is the size in bytes of the keys used by Encrypt and Decrypt.
+const EncryptionKeySize = 32
+
+// ParseEncryptionKey decodes a base64 or hexadecimal encoded 256-bit key.
+func ParseEncryptionKey(value string) ([]byte, error) {
+	value = strings.TrimSpace(value)
+
+	for _, decode := range []func(string) ([]byte, error){
+		hex.DecodeString,
+		base64.StdEncoding.DecodeString,
+		base64.URLEncoding.DecodeString,
+		base64.RawStdEncoding.DecodeString,
+		base64.RawURLEncoding.DecodeString,
+	} {
+		if key, err := decode(value); err == nil && len(key) == EncryptionKeySize {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("crypto: the encryption key must be a base64 or hexadecimal encoded value of %d bytes", EncryptionKeySize)
+}
+
+// GenerateEncryptionKey returns a new random 256-bit key.
+func GenerateEncryptionKey() []byte {
+	return GenerateRandomBytes(EncryptionKeySize)
+}
+
+// KeyFingerprint returns a short identifier of the key that does not reveal the key itself.
+func KeyFingerprint(key []byte) string {
+	sum := sha256.Sum256(append([]byte("miniflux-key-fingerprint:"), key...))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Encrypt seals the plaintext with AES-256-GCM, the random nonce is prepended to the result.
+func Encrypt(key, plaintext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := GenerateRandomBytes(aead.NonceSize())
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Decrypt opens a value sealed by Encrypt.
+func Decrypt(key, ciphertext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("crypto: the ciphertext is too short")
+	}
+
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, fmt.Errorf("crypto: unable to decrypt the value: %v", err)
+	}
+
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != EncryptionKeySize {
+		return nil, fmt.Errorf("crypto: invalid key size %d", len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,120 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package crypto // import "miniflux.app/crypto"
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	key := GenerateEncryptionKey()
+	plaintext := []byte("my secret password")
+
+	ciphertext, err := Encrypt(key, plaintext)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.Contains(ciphertext, plaintext) {
+		t.Fatal(`The ciphertext should not contain the plaintext`)
+	}
+
+	result, err := Decrypt(key, ciphertext)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(result, plaintext) {
+		t.Fatalf(`Unexpected result, got %q instead of %q`, result, plaintext)
+	}
+}
+
+func TestEncryptUsesRandomNonce(t *testing.T) {
+	key := GenerateEncryptionKey()
+
+	a, _ := Encrypt(key, []byte("value"))
+	b, _ := Encrypt(key, []byte("value"))
+	if bytes.Equal(a, b) {
+		t.Fatal(`Encrypting the same value twice should give different results`)
+	}
+}
+
+func TestDecryptWithWrongKey(t *testing.T) {
+	ciphertext, err := Encrypt(GenerateEncryptionKey(), []byte("value"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Decrypt(GenerateEncryptionKey(), ciphertext); err == nil {
+		t.Fatal(`Decrypting with another key should fail`)
+	}
+}
+
+func TestDecryptTamperedValue(t *testing.T) {
+	key := GenerateEncryptionKey()
+	ciphertext, _ := Encrypt(key, []byte("value"))
+	ciphertext[len(ciphertext)-1] ^= 0xff
+
+	if _, err := Decrypt(key, ciphertext); err == nil {
+		t.Fatal(`Decrypting a tampered value should fail`)
+	}
+
+	if _, err := Decrypt(key, []byte("short")); err == nil {
+		t.Fatal(`Decrypting a truncated value should fail`)
+	}
+}
+
+func TestEncryptWithInvalidKey(t *testing.T) {
+	if _, err := Encrypt([]byte("short"), []byte("value")); err == nil {
+		t.Fatal(`Encrypting with an invalid key should fail`)
+	}
+}
+
+func TestParseEncryptionKey(t *testing.T) {
+	key := GenerateEncryptionKey()
+
+	for _, value := range []string{
+		hex.EncodeToString(key),
+		base64.StdEncoding.EncodeToString(key),
+		base64.URLEncoding.EncodeToString(key),
+		base64.RawStdEncoding.EncodeToString(key) + "\n",
+	} {
+		result, err := ParseEncryptionKey(value)
+		if err != nil {
+			t.Fatalf(`Unable to parse %q: %v`, value, err)
+		}
+
+		if !bytes.Equal(result, key) {
+			t.Fatalf(`Unexpected key for %q`, value)
+		}
+	}
+}
+
+func TestParseInvalidEncryptionKey(t *testing.T) {
+	for _, value := range []string{"", "not a key", hex.EncodeToString([]byte("too short"))} {
+		if _, err := ParseEncryptionKey(value); err == nil {
+			t.Fatalf(`Parsing %q should fail`, value)
+		}
+	}
+}
+
+func TestKeyFingerprint(t *testing.T) {
+	key := GenerateEncryptionKey()
+
+	if KeyFingerprint(key) != KeyFingerprint(key) {
+		t.Fatal(`The fingerprint should be stable`)
+	}
+
+	if KeyFingerprint(key) == KeyFingerprint(GenerateEncryptionKey()) {
+		t.Fatal(`Two keys should have different fingerprints`)
+	}
+
+	if len(KeyFingerprint(key)) != 16 {
+		t.Fatalf(`Unexpected fingerprint length: %d`, len(KeyFingerprint(key)))
+	}
+}
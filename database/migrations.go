@@ -496,4 +496,298 @@ var migrations = []func(tx *sql.Tx) error{
 		`)
 		return err
 	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			ALTER TABLE feeds
+				ADD COLUMN kind text not null default 'default',
+				ADD COLUMN newsletter_token text not null default '';
+			CREATE UNIQUE INDEX feeds_newsletter_token_idx ON feeds(newsletter_token) WHERE newsletter_token <> '';
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE users
+				ADD COLUMN totp_secret text not null default '',
+				ADD COLUMN totp_last_counter bigint not null default 0;
+
+			CREATE TABLE totp_recovery_codes (
+				id serial not null,
+				user_id int not null references users(id) on delete cascade,
+				code_hash text not null,
+				created_at timestamp with time zone default now(),
+				primary key(id),
+				unique (user_id, code_hash)
+			);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			CREATE TABLE webauthn_credentials (
+				id serial not null,
+				user_id int not null references users(id) on delete cascade,
+				credential_id text not null,
+				public_key bytea not null,
+				sign_count bigint not null default 0,
+				name text not null default '',
+				last_used_at timestamp with time zone null,
+				created_at timestamp with time zone default now(),
+				primary key(id),
+				unique (credential_id)
+			);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			ALTER TABLE users
+				ADD COLUMN github_id text not null default '',
+				ADD COLUMN gitlab_id text not null default '';
+			CREATE UNIQUE INDEX users_github_id_idx ON users(github_id) WHERE github_id <> '';
+			CREATE UNIQUE INDEX users_gitlab_id_idx ON users(gitlab_id) WHERE gitlab_id <> '';
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			CREATE TABLE login_failures (
+				kind text not null,
+				value text not null,
+				failures int not null default 0,
+				last_failure_at timestamp with time zone not null default now(),
+				last_attempt_at timestamp with time zone not null default now(),
+				locked_until timestamp with time zone null,
+				primary key(kind, value)
+			);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			CREATE TABLE audit_events (
+				id bigserial not null,
+				actor_id int null references users(id) on delete set null,
+				actor text not null default '',
+				user_id int null references users(id) on delete set null,
+				client_ip text not null default '',
+				user_agent text not null default '',
+				action text not null,
+				target text not null default '',
+				created_at timestamp with time zone not null default now(),
+				primary key(id)
+			);
+			CREATE INDEX audit_events_created_at_idx ON audit_events(created_at);
+			CREATE INDEX audit_events_actor_id_idx ON audit_events(actor_id);
+			CREATE INDEX audit_events_user_id_idx ON audit_events(user_id);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			CREATE TABLE encryption_keys (
+				id text not null,
+				master_key_id text not null,
+				wrapped_key bytea not null,
+				created_at timestamp with time zone not null default now(),
+				primary key(id)
+			);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE users ADD COLUMN archive_starred bool default 'f';
+
+			CREATE TABLE entry_archives (
+				entry_id bigint not null references entries(id) on delete cascade,
+				user_id int not null references users(id) on delete cascade,
+				url text not null,
+				title text not null default '',
+				content text not null,
+				created_at timestamp with time zone not null default now(),
+				primary key(entry_id)
+			);
+			CREATE INDEX entry_archives_user_id_idx ON entry_archives(user_id);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			CREATE TABLE import_jobs (
+				id bigserial not null,
+				user_id int not null references users(id) on delete cascade,
+				status text not null,
+				validate bool not null default 'f',
+				total int not null default 0,
+				error_msg text not null default '',
+				created_at timestamp with time zone not null default now(),
+				finished_at timestamp with time zone,
+				primary key(id)
+			);
+			CREATE INDEX import_jobs_user_id_idx ON import_jobs(user_id);
+
+			CREATE TABLE import_job_results (
+				id bigserial not null,
+				job_id bigint not null references import_jobs(id) on delete cascade,
+				title text not null default '',
+				feed_url text not null default '',
+				category text not null default '',
+				status text not null,
+				error_msg text not null default '',
+				feed_id bigint references feeds(id) on delete set null,
+				primary key(id)
+			);
+			CREATE INDEX import_job_results_job_id_idx ON import_job_results(job_id);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			CREATE TABLE opml_sources (
+				id bigserial not null,
+				user_id int not null references users(id) on delete cascade,
+				category_id int not null references categories(id) on delete cascade,
+				url text not null,
+				disable_removed bool not null default 'f',
+				etag_header text not null default '',
+				last_modified_header text not null default '',
+				checked_at timestamp with time zone,
+				error_msg text not null default '',
+				created_at timestamp with time zone not null default now(),
+				primary key(id),
+				unique(user_id, url)
+			);
+
+			CREATE TABLE opml_source_syncs (
+				id bigserial not null,
+				source_id bigint not null references opml_sources(id) on delete cascade,
+				error_msg text not null default '',
+				created_at timestamp with time zone not null default now(),
+				primary key(id)
+			);
+			CREATE INDEX opml_source_syncs_source_id_idx ON opml_source_syncs(source_id);
+
+			CREATE TABLE opml_source_changes (
+				id bigserial not null,
+				sync_id bigint not null references opml_source_syncs(id) on delete cascade,
+				action text not null,
+				title text not null default '',
+				feed_url text not null,
+				feed_id bigint references feeds(id) on delete set null,
+				primary key(id)
+			);
+			CREATE INDEX opml_source_changes_sync_id_idx ON opml_source_changes(sync_id);
+
+			ALTER TABLE feeds ADD COLUMN opml_source_id bigint references opml_sources(id) on delete set null;
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`ALTER TABLE feeds ADD COLUMN selectors jsonb`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE feeds ADD COLUMN watch_selector text not null default '';
+			ALTER TABLE feeds ADD COLUMN watch_snapshot text not null default '';
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE feeds ADD COLUMN description text not null default '';
+			ALTER TABLE feeds ADD COLUMN language text not null default '';
+			ALTER TABLE feeds ADD COLUMN image_url text not null default '';
+			ALTER TABLE entries ADD COLUMN summary text not null default '';
+			ALTER TABLE entries ADD COLUMN image_url text not null default '';
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE entries ADD COLUMN tags text[] not null default '{}';
+			CREATE INDEX entries_tags_idx ON entries USING gin(tags);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE entries ADD COLUMN podcast jsonb;
+			ALTER TABLE entries ADD COLUMN podcast_chapters jsonb;
+			ALTER TABLE enclosures ADD COLUMN duration int not null default 0;
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		// The same attachment could be stored several times for an entry before the index was unique.
+		// The duplicates are merged into the row with the highest playback position.
+		sql := `
+			ALTER TABLE enclosures ADD COLUMN media_progression int not null default 0;
+
+			DELETE FROM enclosures WHERE id IN (
+				SELECT id FROM (
+					SELECT
+						id,
+						row_number() OVER (
+							PARTITION BY user_id, entry_id, md5(url)
+							ORDER BY media_progression DESC, id ASC
+						) AS position
+					FROM enclosures
+				) AS ranked
+				WHERE position > 1
+			);
+
+			DROP INDEX IF EXISTS enclosures_user_entry_url_idx;
+			CREATE UNIQUE INDEX enclosures_user_entry_url_idx ON enclosures(user_id, entry_id, md5(url));
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`ALTER TABLE entries ADD COLUMN podcast_transcript jsonb`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			ALTER TABLE users ADD COLUMN ldap_dn text not null default '';
+			CREATE UNIQUE INDEX users_ldap_dn_idx ON users(ldap_dn) WHERE ldap_dn <> '';
+		`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			CREATE TABLE app_secrets (
+				name text not null,
+				value text not null,
+				created_at timestamp with time zone not null default now(),
+				primary key(name)
+			);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`ALTER TABLE entries ADD COLUMN podcast_retry_at timestamp with time zone`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`
+			ALTER TABLE import_jobs ADD COLUMN processed int not null default 0;
+			ALTER TABLE import_jobs ADD COLUMN report jsonb;
+		`)
+		return err
+	},
 }
@@ -0,0 +1,10 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package epub generates EPUB 3 books from feed entries.
+
+*/
+package epub // import "miniflux.app/epub"
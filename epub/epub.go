@@ -0,0 +1,460 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package epub // import "miniflux.app/epub"
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/proxy"
+	"miniflux.app/url"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	// MaxEntries is the maximum number of entries in a book.
+	MaxEntries = 100
+
+	// maxImagesSize is the total size of the images embedded in a book, the next images are removed.
+	maxImagesSize = 50 * 1024 * 1024
+
+	// imagesTimeout is the time allowed to download the images of a book, the images not downloaded in time are removed.
+	imagesTimeout = time.Minute
+
+	// nbImageDownloaders is the number of images downloaded at the same time.
+	nbImageDownloaders = 4
+)
+
+// Only the image formats supported by all reading systems are embedded, other images are removed.
+var imageExtensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+}
+
+// Elements that cannot be displayed by e-readers.
+var removedElements = map[string]bool{
+	"audio":  true,
+	"iframe": true,
+	"object": true,
+	"script": true,
+	"source": true,
+	"video":  true,
+}
+
+// FetchImageFunc downloads an image.
+type FetchImageFunc func(link string) (*proxy.CachedMedia, error)
+
+// Book is an EPUB 3 publication, each entry is a chapter.
+type Book struct {
+	Title      string
+	Language   string
+	Identifier string
+	Modified   time.Time
+
+	chapters      []*chapter
+	images        []*image
+	fetchImage    FetchImageFunc
+	imagesSize    int
+	imagesTimeout time.Duration
+}
+
+type chapter struct {
+	ID      string
+	Title   string
+	Author  string
+	Feed    string
+	URL     string
+	Date    time.Time
+	Content string
+
+	body      *html.Node
+	imageTags []*imageTag
+}
+
+// imageTag is an image of a chapter, it is embedded or removed when the book is written.
+type imageTag struct {
+	node *html.Node
+	link string
+}
+
+type templateData struct {
+	*Book
+	Chapters []*chapter
+	Images   []*image
+	Cover    *image
+	Chapter  *chapter
+}
+
+type image struct {
+	ID          string
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// NewBook returns an empty book, the images are downloaded through the media proxy.
+func NewBook(title, language string) *Book {
+	return &Book{
+		Title:         title,
+		Language:      strings.Replace(language, "_", "-", -1),
+		Identifier:    "urn:uuid:" + newUUID(),
+		Modified:      time.Now().UTC(),
+		fetchImage:    func(link string) (*proxy.CachedMedia, error) { return proxy.FetchImage(link, 0) },
+		imagesSize:    maxImagesSize,
+		imagesTimeout: imagesTimeout,
+	}
+}
+
+// WithImageFetcher replaces the function used to download the images.
+func (b *Book) WithImageFetcher(fetchImage FetchImageFunc) *Book {
+	b.fetchImage = fetchImage
+	return b
+}
+
+// AddEntry adds a chapter to the book, the images are downloaded when the book is written.
+func (b *Book) AddEntry(entry *model.Entry) error {
+	c := &chapter{
+		ID:     fmt.Sprintf("chapter-%d", len(b.chapters)+1),
+		Title:  entry.Title,
+		Author: entry.Author,
+		URL:    entry.URL,
+		Date:   entry.Date,
+	}
+
+	if err := c.parseContent(entry.Content); err != nil {
+		return fmt.Errorf("epub: unable to convert entry #%d: %v", entry.ID, err)
+	}
+
+	if entry.Feed != nil {
+		c.Feed = entry.Feed.Title
+	}
+
+	b.chapters = append(b.chapters, c)
+	return nil
+}
+
+// Export generates a book that contains the given entries.
+func Export(title, language string, entries model.Entries) ([]byte, error) {
+	book := NewBook(title, language)
+	for _, entry := range entries {
+		if err := book.AddEntry(entry); err != nil {
+			return nil, err
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err := book.Write(&buffer); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// Write generates the EPUB archive.
+func (b *Book) Write(w io.Writer) error {
+	b.embedImages()
+	for _, c := range b.chapters {
+		if err := c.renderContent(); err != nil {
+			return fmt.Errorf("epub: unable to convert chapter %q: %v", c.Title, err)
+		}
+	}
+
+	archive := zip.NewWriter(w)
+
+	// The mimetype file must be the first file of the archive and must not be compressed.
+	mimetype, err := archive.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+	if err != nil {
+		return fmt.Errorf("epub: unable to create archive: %v", err)
+	}
+	io.WriteString(mimetype, "application/epub+zip")
+
+	data := &templateData{Book: b, Chapters: b.chapters, Images: b.images}
+	if len(b.images) > 0 {
+		// The first image of the book is used as cover by the reading systems.
+		data.Cover = b.images[0]
+	}
+
+	files := []struct {
+		name     string
+		template *template.Template
+	}{
+		{"META-INF/container.xml", containerTemplate},
+		{"OEBPS/content.opf", packageTemplate},
+		{"OEBPS/nav.xhtml", navTemplate},
+		{"OEBPS/toc.ncx", ncxTemplate},
+		{"OEBPS/cover.xhtml", coverTemplate},
+		{"OEBPS/style.css", stylesheetTemplate},
+	}
+
+	for _, file := range files {
+		if err := writeTemplate(archive, file.name, file.template, data); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range b.chapters {
+		data.Chapter = c
+		if err := writeTemplate(archive, "OEBPS/"+c.ID+".xhtml", chapterTemplate, data); err != nil {
+			return err
+		}
+	}
+
+	for _, img := range b.images {
+		f, err := archive.Create("OEBPS/" + img.Filename)
+		if err != nil {
+			return fmt.Errorf("epub: unable to create %s: %v", img.Filename, err)
+		}
+
+		if _, err := f.Write(img.Data); err != nil {
+			return fmt.Errorf("epub: unable to write %s: %v", img.Filename, err)
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("epub: unable to close archive: %v", err)
+	}
+
+	return nil
+}
+
+// parseContent keeps the entry content as a tree, the images are resolved against the entry URL.
+func (c *chapter) parseContent(content string) error {
+	c.body = &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(content), c.body)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		c.body.AppendChild(node)
+	}
+
+	c.processNode(c.body)
+	return nil
+}
+
+func (c *chapter) processNode(node *html.Node) {
+	for child := node.FirstChild; child != nil; {
+		next := child.NextSibling
+		switch {
+		case child.Type != html.ElementNode:
+		case removedElements[child.Data]:
+			node.RemoveChild(child)
+		case child.Data == "img":
+			c.imageTags = append(c.imageTags, &imageTag{node: child, link: c.imageURL(child)})
+		default:
+			c.processNode(child)
+		}
+		child = next
+	}
+}
+
+// imageURL returns the absolute URL of the image, an empty string is returned when it cannot be downloaded.
+func (c *chapter) imageURL(node *html.Node) string {
+	var src string
+	for _, attribute := range node.Attr {
+		if attribute.Key == "src" {
+			src = strings.TrimSpace(attribute.Val)
+		}
+	}
+
+	if src == "" || strings.HasPrefix(src, "data:") {
+		return ""
+	}
+
+	link, err := url.AbsoluteURL(c.URL, src)
+	if err != nil || (!strings.HasPrefix(link, "http://") && !strings.HasPrefix(link, "https://")) {
+		return ""
+	}
+
+	return link
+}
+
+// renderContent returns the chapter content as XHTML.
+func (c *chapter) renderContent() error {
+	var buffer bytes.Buffer
+	for node := c.body.FirstChild; node != nil; node = node.NextSibling {
+		if err := html.Render(&buffer, node); err != nil {
+			return err
+		}
+	}
+
+	c.Content = buffer.String()
+	return nil
+}
+
+// embedImages downloads the images of all chapters and replaces their URLs.
+// The images that cannot be downloaded, or that do not fit in the size and time budgets of the book, are removed.
+func (b *Book) embedImages() {
+	var links []string
+	seen := make(map[string]bool)
+	for _, c := range b.chapters {
+		for _, tag := range c.imageTags {
+			if tag.link != "" && !seen[tag.link] {
+				seen[tag.link] = true
+				links = append(links, tag.link)
+			}
+		}
+	}
+
+	downloads := b.downloadImages(links)
+
+	b.images = nil
+	imagesByURL := make(map[string]*image)
+	for _, link := range links {
+		media := downloads[link]
+		if media == nil {
+			continue
+		}
+
+		contentType := strings.ToLower(strings.TrimSpace(strings.Split(media.ContentType, ";")[0]))
+		extension, found := imageExtensions[contentType]
+		if !found {
+			logger.Debug("[EPUB] Unsupported image type %q for %q", media.ContentType, link)
+			continue
+		}
+
+		id := fmt.Sprintf("image-%d", len(b.images)+1)
+		img := &image{
+			ID:          id,
+			Filename:    "images/" + id + "." + extension,
+			ContentType: contentType,
+			Data:        media.Body,
+		}
+		b.images = append(b.images, img)
+		imagesByURL[link] = img
+	}
+
+	for _, c := range b.chapters {
+		for _, tag := range c.imageTags {
+			if img := imagesByURL[tag.link]; img != nil {
+				replaceImageSource(tag.node, img.Filename)
+			} else if tag.node.Parent != nil {
+				tag.node.Parent.RemoveChild(tag.node)
+			}
+		}
+		c.imageTags = nil
+	}
+}
+
+// downloadImages fetches the images in parallel until the size or the time budget of the book is used.
+// The downloads still running at the end of the time budget are ignored.
+func (b *Book) downloadImages(links []string) map[string]*proxy.CachedMedia {
+	var mutex sync.Mutex
+	downloads := make(map[string]*proxy.CachedMedia)
+	remainingSize := b.imagesSize
+	finished := false
+
+	queue := make(chan string)
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+
+	for i := 0; i < nbImageDownloaders; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for link := range queue {
+				media, err := b.fetchImage(link)
+				if err != nil {
+					logger.Debug("[EPUB] %v", err)
+					continue
+				}
+
+				mutex.Lock()
+				if !finished && len(media.Body) <= remainingSize {
+					remainingSize -= len(media.Body)
+					downloads[link] = media
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	go func() {
+		defer close(queue)
+		for _, link := range links {
+			mutex.Lock()
+			budgetUsed := finished || remainingSize <= 0
+			mutex.Unlock()
+
+			if budgetUsed {
+				return
+			}
+
+			select {
+			case queue <- link:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	timer := time.NewTimer(b.imagesTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+	case <-timer.C:
+		logger.Debug("[EPUB] The images are not all downloaded after %s", b.imagesTimeout)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	finished = true
+	return downloads
+}
+
+// replaceImageSource points the image to the embedded file, the responsive attributes are removed.
+func replaceImageSource(node *html.Node, filename string) {
+	var attributes []html.Attribute
+	for _, attribute := range node.Attr {
+		switch attribute.Key {
+		case "src", "srcset", "sizes", "loading":
+		default:
+			attributes = append(attributes, attribute)
+		}
+	}
+
+	node.Attr = append(attributes, html.Attribute{Key: "src", Val: filename})
+}
+
+func writeTemplate(archive *zip.Writer, name string, tpl *template.Template, data *templateData) error {
+	f, err := archive.Create(name)
+	if err != nil {
+		return fmt.Errorf("epub: unable to create %s: %v", name, err)
+	}
+
+	if err := tpl.Execute(f, data); err != nil {
+		return fmt.Errorf("epub: unable to generate %s: %v", name, err)
+	}
+
+	return nil
+}
+
+// newUUID returns a random (version 4) UUID.
+func newUUID() string {
+	u := crypto.GenerateRandomBytes(16)
+	u[6] = (u[6] & 0x0f) | 0x40
+	u[8] = (u[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:])
+}
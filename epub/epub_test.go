@@ -0,0 +1,296 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package epub // import "miniflux.app/epub"
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+	"miniflux.app/proxy"
+)
+
+func fakeImageFetcher(link string) (*proxy.CachedMedia, error) {
+	switch {
+	case strings.HasSuffix(link, ".png"):
+		return &proxy.CachedMedia{ContentType: "image/png", Body: []byte("png")}, nil
+	case strings.HasSuffix(link, ".svg"):
+		return &proxy.CachedMedia{ContentType: "image/svg+xml", Body: []byte("<svg/>")}, nil
+	default:
+		return nil, errors.New("not found")
+	}
+}
+
+func generateBook(t *testing.T, entries ...*model.Entry) (*zip.Reader, map[string]string) {
+	book := NewBook("Starred", "fr_FR").WithImageFetcher(fakeImageFetcher)
+	for _, entry := range entries {
+		if err := book.AddEntry(entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err := book.Write(&buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	if err != nil {
+		t.Fatalf(`Unable to open the archive: %v`, err)
+	}
+
+	files := make(map[string]string)
+	for _, file := range archive.File {
+		f, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadAll(f)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[file.Name] = string(data)
+	}
+
+	return archive, files
+}
+
+func isWellFormed(data string) error {
+	decoder := xml.NewDecoder(strings.NewReader(data))
+	for {
+		_, err := decoder.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func TestArchiveStructure(t *testing.T) {
+	entries := []*model.Entry{
+		{ID: 1, Title: "First <entry>", Author: "Jane", URL: "https://example.org/1", Date: time.Now(), Content: "<p>Hello&nbsp;World<br></p>", Feed: &model.Feed{Title: "Feed & Co"}},
+		{ID: 2, Title: "Second entry", Content: "<p>Test</p>"},
+	}
+	archive, files := generateBook(t, entries...)
+
+	first := archive.File[0]
+	if first.Name != "mimetype" || first.Method != zip.Store {
+		t.Fatalf(`The first file should be an uncompressed mimetype file, got %q (method=%d)`, first.Name, first.Method)
+	}
+
+	if files["mimetype"] != "application/epub+zip" {
+		t.Errorf(`Invalid mimetype, got %q`, files["mimetype"])
+	}
+
+	if !strings.Contains(files["META-INF/container.xml"], `full-path="OEBPS/content.opf"`) {
+		t.Errorf(`The container does not reference the package document`)
+	}
+
+	expectedFiles := []string{
+		"OEBPS/content.opf",
+		"OEBPS/nav.xhtml",
+		"OEBPS/toc.ncx",
+		"OEBPS/cover.xhtml",
+		"OEBPS/style.css",
+		"OEBPS/chapter-1.xhtml",
+		"OEBPS/chapter-2.xhtml",
+	}
+	for _, name := range expectedFiles {
+		if _, found := files[name]; !found {
+			t.Errorf(`The file %q is missing`, name)
+		}
+	}
+
+	for name, data := range files {
+		if strings.HasSuffix(name, ".xml") || strings.HasSuffix(name, ".opf") || strings.HasSuffix(name, ".ncx") || strings.HasSuffix(name, ".xhtml") {
+			if err := isWellFormed(data); err != nil {
+				t.Errorf(`The file %q is not well-formed: %v`, name, err)
+			}
+		}
+	}
+
+	opf := files["OEBPS/content.opf"]
+	for _, expected := range []string{
+		`<dc:language>fr-FR</dc:language>`,
+		`<dc:title>Starred</dc:title>`,
+		`<item id="chapter-1" href="chapter-1.xhtml" media-type="application/xhtml+xml"/>`,
+		`<itemref idref="chapter-2"/>`,
+		`properties="nav"`,
+	} {
+		if !strings.Contains(opf, expected) {
+			t.Errorf(`The package document does not contain %q`, expected)
+		}
+	}
+
+	if !strings.Contains(files["OEBPS/nav.xhtml"], `<a href="chapter-1.xhtml">First &lt;entry&gt;</a>`) {
+		t.Errorf(`The table of contents does not contain the first chapter`)
+	}
+
+	if !strings.Contains(files["OEBPS/chapter-1.xhtml"], `Feed &amp; Co – Jane`) {
+		t.Errorf(`The chapter does not contain the entry metadata`)
+	}
+}
+
+func TestEmbeddedImages(t *testing.T) {
+	entry := &model.Entry{
+		ID:      1,
+		Title:   "Images",
+		Content: `<p><img src="https://example.org/a.png" srcset="https://example.org/a2.png 2x" alt="A"><img src="https://example.org/missing.jpg"><img src="https://example.org/b.svg"><img src="https://example.org/a.png"></p>`,
+	}
+	_, files := generateBook(t, entry)
+
+	if files["OEBPS/images/image-1.png"] != "png" {
+		t.Errorf(`The image has not been embedded`)
+	}
+
+	if len(files) != 9 {
+		t.Errorf(`Only one image should be embedded, got %d files`, len(files))
+	}
+
+	expected := `<p><img alt="A" src="images/image-1.png"/><img src="images/image-1.png"/></p>`
+	if !strings.Contains(files["OEBPS/chapter-1.xhtml"], expected) {
+		t.Errorf(`Wrong chapter content, expected %q, got %q`, expected, files["OEBPS/chapter-1.xhtml"])
+	}
+
+	if strings.Contains(files["OEBPS/chapter-1.xhtml"], "example.org") {
+		t.Errorf(`The remote images should be removed`)
+	}
+
+	opf := files["OEBPS/content.opf"]
+	if !strings.Contains(opf, `<item id="image-1" href="images/image-1.png" media-type="image/png" properties="cover-image"/>`) {
+		t.Errorf(`The cover image is not declared in the manifest`)
+	}
+
+	if !strings.Contains(opf, `<meta name="cover" content="image-1"/>`) {
+		t.Errorf(`The cover metadata is missing`)
+	}
+}
+
+func TestRemovedElements(t *testing.T) {
+	entry := &model.Entry{
+		ID:      1,
+		Title:   "Media",
+		Content: `<p>Text</p><iframe src="https://example.org/"></iframe><video src="https://example.org/v.mp4"><source src="https://example.org/v.webm"></video><audio src="a.mp3"></audio>`,
+	}
+	_, files := generateBook(t, entry)
+
+	chapter := files["OEBPS/chapter-1.xhtml"]
+	for _, element := range []string{"<iframe", "<video", "<source", "<audio"} {
+		if strings.Contains(chapter, element) {
+			t.Errorf(`The element %q should be removed`, element)
+		}
+	}
+
+	if !strings.Contains(chapter, "<p>Text</p>") {
+		t.Errorf(`The text should be kept`)
+	}
+
+	if strings.Contains(files["OEBPS/content.opf"], `name="cover"`) {
+		t.Errorf(`A book without images should not have a cover image`)
+	}
+}
+
+func TestRelativeImageURLs(t *testing.T) {
+	var mutex sync.Mutex
+	var fetched []string
+	book := NewBook("Relative", "en_US").WithImageFetcher(func(link string) (*proxy.CachedMedia, error) {
+		mutex.Lock()
+		fetched = append(fetched, link)
+		mutex.Unlock()
+		return fakeImageFetcher(link)
+	})
+
+	entry := &model.Entry{
+		ID:      1,
+		Title:   "Relative images",
+		URL:     "https://example.org/blog/post.html",
+		Content: `<p><img src="images/a.png"><img src="/b.png"></p>`,
+	}
+	if err := book.AddEntry(entry); err != nil {
+		t.Fatal(err)
+	}
+
+	var buffer bytes.Buffer
+	if err := book.Write(&buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(book.images) != 2 {
+		t.Fatalf(`The relative images should be embedded, got %d images`, len(book.images))
+	}
+
+	for _, expected := range []string{"https://example.org/blog/images/a.png", "https://example.org/b.png"} {
+		found := false
+		for _, link := range fetched {
+			found = found || link == expected
+		}
+		if !found {
+			t.Errorf(`The image %q has not been downloaded, got %v`, expected, fetched)
+		}
+	}
+}
+
+func TestImagesSizeBudget(t *testing.T) {
+	book := NewBook("Budget", "en_US").WithImageFetcher(fakeImageFetcher)
+	book.imagesSize = 5
+
+	entry := &model.Entry{
+		ID:      1,
+		Title:   "Images",
+		Content: `<p><img src="https://example.org/a.png"><img src="https://example.org/b.png"><img src="https://example.org/c.png"></p>`,
+	}
+	if err := book.AddEntry(entry); err != nil {
+		t.Fatal(err)
+	}
+
+	var buffer bytes.Buffer
+	if err := book.Write(&buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(book.images) != 1 {
+		t.Fatalf(`Only one image fits in the budget, got %d images`, len(book.images))
+	}
+
+	if strings.Count(book.chapters[0].Content, "<img") != 1 {
+		t.Errorf(`The images over the budget should be removed, got %q`, book.chapters[0].Content)
+	}
+}
+
+func TestImagesTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	book := NewBook("Timeout", "en_US").WithImageFetcher(func(link string) (*proxy.CachedMedia, error) {
+		<-release
+		return fakeImageFetcher(link)
+	})
+	book.imagesTimeout = 10 * time.Millisecond
+
+	entry := &model.Entry{ID: 1, Title: "Slow", Content: `<p>Text<img src="https://example.org/a.png"></p>`}
+	if err := book.AddEntry(entry); err != nil {
+		t.Fatal(err)
+	}
+
+	var buffer bytes.Buffer
+	if err := book.Write(&buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(book.images) != 0 || strings.Contains(book.chapters[0].Content, "<img") {
+		t.Errorf(`The images not downloaded in time should be removed, got %q`, book.chapters[0].Content)
+	}
+}
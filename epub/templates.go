@@ -0,0 +1,166 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package epub // import "miniflux.app/epub"
+
+import (
+	"bytes"
+	"encoding/xml"
+	"text/template"
+	"time"
+)
+
+var funcMap = template.FuncMap{
+	"escape": func(value string) string {
+		var buffer bytes.Buffer
+		xml.EscapeText(&buffer, []byte(value))
+		return buffer.String()
+	},
+	"isodate": func(t time.Time) string {
+		return t.UTC().Format("2006-01-02T15:04:05Z")
+	},
+	"date": func(t time.Time) string {
+		return t.Format("2006-01-02")
+	},
+}
+
+func newTemplate(name, text string) *template.Template {
+	return template.Must(template.New(name).Funcs(funcMap).Parse(text))
+}
+
+var containerTemplate = newTemplate("container", `<?xml version="1.0" encoding="UTF-8"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles>
+    <rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/>
+  </rootfiles>
+</container>
+`)
+
+var packageTemplate = newTemplate("package", `<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="book-id" xml:lang="{{ escape .Language }}">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+    <dc:identifier id="book-id">{{ escape .Identifier }}</dc:identifier>
+    <dc:title>{{ escape .Title }}</dc:title>
+    <dc:language>{{ escape .Language }}</dc:language>
+    <dc:creator>Miniflux</dc:creator>
+    <dc:date>{{ isodate .Modified }}</dc:date>
+    <meta property="dcterms:modified">{{ isodate .Modified }}</meta>
+    {{- if .Cover }}
+    <meta name="cover" content="{{ .Cover.ID }}"/>
+    {{- end }}
+  </metadata>
+  <manifest>
+    <item id="nav" href="nav.xhtml" media-type="application/xhtml+xml" properties="nav"/>
+    <item id="ncx" href="toc.ncx" media-type="application/x-dtbncx+xml"/>
+    <item id="cover" href="cover.xhtml" media-type="application/xhtml+xml"/>
+    <item id="style" href="style.css" media-type="text/css"/>
+    {{- range .Chapters }}
+    <item id="{{ .ID }}" href="{{ .ID }}.xhtml" media-type="application/xhtml+xml"/>
+    {{- end }}
+    {{- range $index, $image := .Images }}
+    <item id="{{ $image.ID }}" href="{{ $image.Filename }}" media-type="{{ $image.ContentType }}"{{ if eq $index 0 }} properties="cover-image"{{ end }}/>
+    {{- end }}
+  </manifest>
+  <spine toc="ncx">
+    <itemref idref="cover"/>
+    <itemref idref="nav"/>
+    {{- range .Chapters }}
+    <itemref idref="{{ .ID }}"/>
+    {{- end }}
+  </spine>
+</package>
+`)
+
+var navTemplate = newTemplate("nav", `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" xml:lang="{{ escape .Language }}" lang="{{ escape .Language }}">
+<head>
+  <meta charset="UTF-8"/>
+  <title>{{ escape .Title }}</title>
+  <link rel="stylesheet" type="text/css" href="style.css"/>
+</head>
+<body>
+  <nav epub:type="toc" id="toc">
+    <h1>{{ escape .Title }}</h1>
+    <ol>
+      {{- range .Chapters }}
+      <li><a href="{{ .ID }}.xhtml">{{ escape .Title }}</a></li>
+      {{- end }}
+    </ol>
+  </nav>
+</body>
+</html>
+`)
+
+var ncxTemplate = newTemplate("ncx", `<?xml version="1.0" encoding="UTF-8"?>
+<ncx xmlns="http://www.daisy.org/z3986/2005/ncx/" version="2005-1">
+  <head>
+    <meta name="dtb:uid" content="{{ escape .Identifier }}"/>
+  </head>
+  <docTitle>
+    <text>{{ escape .Title }}</text>
+  </docTitle>
+  <navMap>
+    {{- range $index, $chapter := .Chapters }}
+    <navPoint id="nav-{{ $chapter.ID }}">
+      <navLabel><text>{{ escape $chapter.Title }}</text></navLabel>
+      <content src="{{ $chapter.ID }}.xhtml"/>
+    </navPoint>
+    {{- end }}
+  </navMap>
+</ncx>
+`)
+
+var coverTemplate = newTemplate("cover", `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xml:lang="{{ escape .Language }}" lang="{{ escape .Language }}">
+<head>
+  <meta charset="UTF-8"/>
+  <title>{{ escape .Title }}</title>
+  <link rel="stylesheet" type="text/css" href="style.css"/>
+</head>
+<body>
+  <section class="cover">
+    <h1>{{ escape .Title }}</h1>
+    <p>{{ date .Modified }}</p>
+    {{- if .Cover }}
+    <p><img src="{{ .Cover.Filename }}" alt=""/></p>
+    {{- end }}
+  </section>
+</body>
+</html>
+`)
+
+var chapterTemplate = newTemplate("chapter", `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xml:lang="{{ escape .Language }}" lang="{{ escape .Language }}">
+<head>
+  <meta charset="UTF-8"/>
+  <title>{{ escape .Chapter.Title }}</title>
+  <link rel="stylesheet" type="text/css" href="style.css"/>
+</head>
+<body>
+  <h1>{{ escape .Chapter.Title }}</h1>
+  <p class="meta">
+    {{- if .Chapter.Feed }}{{ escape .Chapter.Feed }}{{ end }}
+    {{- if .Chapter.Author }} – {{ escape .Chapter.Author }}{{ end }}
+    {{- if not .Chapter.Date.IsZero }} – {{ date .Chapter.Date }}{{ end -}}
+  </p>
+  {{- if .Chapter.URL }}
+  <p class="meta"><a href="{{ escape .Chapter.URL }}">{{ escape .Chapter.URL }}</a></p>
+  {{- end }}
+  <div class="content">
+{{ .Chapter.Content }}
+  </div>
+</body>
+</html>
+`)
+
+var stylesheetTemplate = newTemplate("stylesheet", `body { font-family: serif; line-height: 1.4; }
+h1 { font-size: 1.5em; }
+.meta { font-size: 0.8em; color: #555; }
+.cover { text-align: center; margin-top: 20%; }
+img { max-width: 100%; height: auto; }
+pre { white-space: pre-wrap; }
+`)
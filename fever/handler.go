@@ -15,6 +15,7 @@ import (
 	"miniflux.app/integration"
 	"miniflux.app/logger"
 	"miniflux.app/model"
+	"miniflux.app/reader/archiver"
 	"miniflux.app/storage"
 
 	"github.com/gorilla/mux"
@@ -427,6 +428,8 @@ func (h *handler) handleWriteItems(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		archiver.ArchiveStarredEntry(h.store, userID, entryID)
+
 		settings, err := h.store.Integration(userID)
 		if err != nil {
 			json.ServerError(w, r, err)
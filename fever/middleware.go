@@ -10,6 +10,7 @@ import (
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/lockout"
 	"miniflux.app/logger"
 	"miniflux.app/storage"
 )
@@ -32,6 +33,13 @@ func (m *middleware) serve(next http.Handler) http.Handler {
 			return
 		}
 
+		lockoutIP := lockout.ClientIP(r)
+		guard := lockout.NewGuard(m.store)
+		if guard.Check("fever", "", lockoutIP) > 0 {
+			json.OK(w, r, newAuthFailureResponse())
+			return
+		}
+
 		user, err := m.store.UserByFeverToken(apiKey)
 		if err != nil {
 			logger.Error("[Fever] %v", err)
@@ -41,6 +49,7 @@ func (m *middleware) serve(next http.Handler) http.Handler {
 
 		if user == nil {
 			logger.Info("[Fever] [ClientIP=%s] No user found with this API key", clientIP)
+			guard.Fail("fever", "", lockoutIP)
 			json.OK(w, r, newAuthFailureResponse())
 			return
 		}
@@ -192,16 +192,22 @@ func main() {
 			"ui/static/js/request_builder.js",
 			"ui/static/js/modal_handler.js",
 			"ui/static/js/app.js",
+			"ui/static/js/media_player.js",
 			"ui/static/js/bootstrap.js",
 		},
 		"service-worker": []string{
 			"ui/static/js/service_worker.js",
 		},
+		"webauthn": []string{
+			"ui/static/js/webauthn.js",
+		},
 	}, map[string]string{
-		"app": "(function(){'use strict';",
-		"sw":  "'use strict';",
+		"app":      "(function(){'use strict';",
+		"sw":       "'use strict';",
+		"webauthn": "(function(){'use strict';",
 	}, map[string]string{
-		"app": "})();",
+		"app":      "})();",
+		"webauthn": "})();",
 	})
 
 	generateCSSBundle("ui/static/css.go", map[string][]string{
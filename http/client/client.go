@@ -34,6 +34,7 @@ var (
 	errTemporaryNetworkOperation = "This website is temporarily unreachable (original error: %q)"
 	errPermanentNetworkOperation = "This website is permanently unreachable (original error: %q)"
 	errRequestTimeout            = "Website unreachable, the request timed out after %d seconds"
+	errForbiddenAddress          = "This website is hosted on a private or forbidden network address (%s)"
 )
 
 // Client builds and executes HTTP requests.
@@ -196,8 +197,12 @@ func (c *Client) executeRequest(request *http.Request) (*Response, error) {
 			switch uerr.Err.(type) {
 			case x509.CertificateInvalidError, x509.HostnameError:
 				err = errors.NewLocalizedError(errInvalidCertificate, uerr.Err)
+			case *ForbiddenAddressError:
+				err = errors.NewLocalizedError(errForbiddenAddress, uerr.Err.(*ForbiddenAddressError).IP)
 			case *net.OpError:
-				if uerr.Err.(*net.OpError).Temporary() {
+				if ferr, ok := uerr.Err.(*net.OpError).Err.(*ForbiddenAddressError); ok {
+					err = errors.NewLocalizedError(errForbiddenAddress, ferr.IP)
+				} else if uerr.Err.(*net.OpError).Temporary() {
 					err = errors.NewLocalizedError(errTemporaryNetworkOperation, uerr.Err)
 				} else {
 					err = errors.NewLocalizedError(errPermanentNetworkOperation, uerr.Err)
@@ -273,15 +278,6 @@ func (c *Client) buildClient() http.Client {
 	}
 
 	transport := &http.Transport{
-		Proxy: http.ProxyFromEnvironment,
-		DialContext: (&net.Dialer{
-			// Default is 30s.
-			Timeout: 10 * time.Second,
-
-			// Default is 30s.
-			KeepAlive: 15 * time.Second,
-		}).DialContext,
-
 		// Default is 100.
 		MaxIdleConns: 50,
 
@@ -295,17 +291,18 @@ func (c *Client) buildClient() http.Client {
 		}
 	}
 
+	proxy := http.ProxyFromEnvironment
 	if c.useProxy && c.ClientProxyURL != "" {
 		proxyURL, err := url.Parse(c.ClientProxyURL)
 		if err != nil {
 			logger.Error("[HttpClient] Proxy URL error: %v", err)
 		} else {
 			logger.Debug("[HttpClient] Use proxy: %s", proxyURL)
-			transport.Proxy = http.ProxyURL(proxyURL)
+			proxy = http.ProxyURL(proxyURL)
 		}
 	}
 
-	client.Transport = transport
+	client.Transport = NewGuard(config.Opts).Transport(transport, proxy)
 
 	return client
 }
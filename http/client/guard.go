@@ -0,0 +1,367 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package client // import "miniflux.app/http/client"
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"miniflux.app/config"
+
+	"golang.org/x/net/proxy"
+)
+
+// internalNetworks are not reachable unless they are allowed in the configuration.
+var internalNetworks = parseNetworks(
+	// Loopback.
+	"127.0.0.0/8",
+	"::1/128",
+	// Unspecified and "this network".
+	"0.0.0.0/8",
+	"::/128",
+	// Private networks.
+	"10.0.0.0/8",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"fc00::/7",
+	// IPv6 prefixes embedding an IPv4 address: NAT64 and 6to4.
+	"64:ff9b::/96",
+	"2002::/16",
+	// Carrier-grade NAT.
+	"100.64.0.0/10",
+	// Link-local, including cloud metadata services.
+	"169.254.0.0/16",
+	"fe80::/10",
+	// Benchmarking.
+	"198.18.0.0/15",
+	// Multicast.
+	"224.0.0.0/4",
+	"ff00::/8",
+	// Reserved, including the broadcast address.
+	"240.0.0.0/4",
+)
+
+// ForbiddenAddressError is returned when a connection to a forbidden IP address is attempted.
+type ForbiddenAddressError struct {
+	IP net.IP
+}
+
+func (f *ForbiddenAddressError) Error() string {
+	return fmt.Sprintf("client: connections to %s are not allowed", f.IP)
+}
+
+// Guard rejects the connections to internal and denied networks.
+// The IP address is checked after DNS resolution, just before connecting,
+// so a hostname resolving to an internal address cannot bypass it (DNS rebinding).
+type Guard struct {
+	allowedNetworks []*net.IPNet
+	deniedNetworks  []*net.IPNet
+}
+
+// NewGuard returns a Guard configured with the application options.
+func NewGuard(opts *config.Options) *Guard {
+	g := &Guard{deniedNetworks: internalNetworks}
+
+	if opts != nil {
+		g.allowedNetworks = opts.HTTPClientAllowedNetworks()
+		g.deniedNetworks = append(append([]*net.IPNet{}, internalNetworks...), opts.HTTPClientDeniedNetworks()...)
+	}
+
+	return g
+}
+
+// IsAllowed returns true if connections to the given IP address are allowed.
+func (g *Guard) IsAllowed(ip net.IP) bool {
+	for _, network := range g.allowedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	for _, network := range g.deniedNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Transport returns a round tripper sending the requests with the given transport, directly or through the proxy.
+//
+// The proxies are configured by the administrator, connecting to them is always allowed.
+// The proxy would resolve the hostname a second time, so the HTTPS requests and the requests sent through
+// SOCKS proxies are tunneled to the IP address validated here instead. Plain HTTP requests are forwarded
+// to HTTP proxies as usual once the hostname has been validated, because most proxies refuse CONNECT to
+// other ports than 443: the proxy resolves the hostname again and should deny the internal networks itself.
+// A hostname that cannot be resolved locally is rejected, except the onion services reached through Tor,
+// which the guard doesn't apply to.
+func (g *Guard) Transport(transport *http.Transport, proxy func(*http.Request) (*url.URL, error)) http.RoundTripper {
+	transport.Proxy = forwardProxy
+	transport.DialContext = g.DialContext
+	return &guardedTransport{guard: g, transport: transport, proxy: proxy}
+}
+
+// DialContext connects to the given address once the resolved IP address has been validated.
+func (g *Guard) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	if t, ok := ctx.Value(tunnelContextKey{}).(*tunnel); ok {
+		return t.dial(ctx, network, address)
+	}
+
+	// The address is the one of the proxy, the IP address of the origin has been validated by the round tripper.
+	if _, ok := ctx.Value(forwardContextKey{}).(*url.URL); ok {
+		return newDialer().DialContext(ctx, network, address)
+	}
+
+	dialer := newDialer()
+	dialer.Control = g.control
+	return dialer.DialContext(ctx, network, address)
+}
+
+// resolve returns the first IP address of the host, or nil for the onion services that only Tor can resolve.
+// An error is returned if the host cannot be resolved or if any of the addresses is forbidden.
+func (g *Guard) resolve(ctx context.Context, host string) (net.IP, error) {
+	var ips []net.IP
+	if ip := net.ParseIP(host); ip != nil {
+		ips = append(ips, ip)
+	} else if strings.HasSuffix(strings.ToLower(host), ".onion") {
+		return nil, nil
+	} else {
+		addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			return nil, fmt.Errorf("client: unable to resolve %q: %v", host, err)
+		}
+
+		for _, addr := range addrs {
+			ips = append(ips, addr.IP)
+		}
+	}
+
+	for _, ip := range ips {
+		if !g.IsAllowed(ip) {
+			return nil, &ForbiddenAddressError{IP: ip}
+		}
+	}
+
+	if len(ips) == 0 {
+		return nil, nil
+	}
+
+	return ips[0], nil
+}
+
+// control is called for each IP address the hostname resolves to, before connecting.
+func (g *Guard) control(network, address string, conn syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("client: invalid IP address %q", host)
+	}
+
+	if !g.IsAllowed(ip) {
+		return &ForbiddenAddressError{IP: ip}
+	}
+
+	return nil
+}
+
+type guardedTransport struct {
+	guard     *Guard
+	transport *http.Transport
+	proxy     func(*http.Request) (*url.URL, error)
+}
+
+func (t *guardedTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if t.proxy == nil {
+		return t.transport.RoundTrip(r)
+	}
+
+	proxyURL, err := t.proxy(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if proxyURL == nil {
+		return t.transport.RoundTrip(r)
+	}
+
+	ip, err := t.guard.resolve(r.Context(), r.URL.Hostname())
+	if err != nil {
+		return nil, err
+	}
+
+	// Most HTTP proxies only allow CONNECT to the HTTPS port.
+	if r.URL.Scheme == "http" && (proxyURL.Scheme == "http" || proxyURL.Scheme == "https") {
+		return t.forward(r, proxyURL)
+	}
+
+	ctx := context.WithValue(r.Context(), tunnelContextKey{}, &tunnel{proxyURL: proxyURL, ip: ip})
+	return t.transport.RoundTrip(r.WithContext(ctx))
+}
+
+// forward sends the request to the proxy as an absolute URI once the IP addresses of the host have been validated.
+func (t *guardedTransport) forward(r *http.Request, proxyURL *url.URL) (*http.Response, error) {
+	ctx := context.WithValue(r.Context(), forwardContextKey{}, proxyURL)
+	return t.transport.RoundTrip(r.WithContext(ctx))
+}
+
+// CloseIdleConnections closes the idle connections of the transport.
+func (t *guardedTransport) CloseIdleConnections() {
+	t.transport.CloseIdleConnections()
+}
+
+type forwardContextKey struct{}
+
+// forwardProxy returns the proxy of the requests forwarded by the round tripper.
+func forwardProxy(r *http.Request) (*url.URL, error) {
+	proxyURL, _ := r.Context().Value(forwardContextKey{}).(*url.URL)
+	return proxyURL, nil
+}
+
+type tunnelContextKey struct{}
+
+// tunnel connects to the validated IP address through a proxy.
+type tunnel struct {
+	proxyURL *url.URL
+	ip       net.IP
+}
+
+func (t *tunnel) dial(ctx context.Context, network, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.ip != nil {
+		host = t.ip.String()
+	}
+	target := net.JoinHostPort(host, port)
+
+	switch t.proxyURL.Scheme {
+	case "socks5", "socks5h":
+		var auth *proxy.Auth
+		if t.proxyURL.User != nil {
+			password, _ := t.proxyURL.User.Password()
+			auth = &proxy.Auth{User: t.proxyURL.User.Username(), Password: password}
+		}
+
+		dialer, err := proxy.SOCKS5("tcp", proxyAddress(t.proxyURL), auth, newDialer())
+		if err != nil {
+			return nil, err
+		}
+
+		return dialer.(proxy.ContextDialer).DialContext(ctx, network, target)
+	case "http", "https":
+		return t.connect(ctx, target)
+	default:
+		return nil, fmt.Errorf("client: unsupported proxy scheme %q", t.proxyURL.Scheme)
+	}
+}
+
+// connect opens a tunnel to the target with the CONNECT method of HTTP proxies.
+func (t *tunnel) connect(ctx context.Context, target string) (net.Conn, error) {
+	conn, err := newDialer().DialContext(ctx, "tcp", proxyAddress(t.proxyURL))
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+
+	if t.proxyURL.Scheme == "https" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: t.proxyURL.Hostname()})
+		if err := tlsConn.Handshake(); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+
+	request := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: target},
+		Host:   target,
+		Header: make(http.Header),
+	}
+
+	if t.proxyURL.User != nil {
+		password, _ := t.proxyURL.User.Password()
+		credentials := t.proxyURL.User.Username() + ":" + password
+		request.Header.Set("Proxy-Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(credentials)))
+	}
+
+	if err := request.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	response, err := http.ReadResponse(bufio.NewReader(conn), request)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("client: the proxy refused the connection to %s: %s", target, response.Status)
+	}
+
+	return conn, nil
+}
+
+func newDialer() *net.Dialer {
+	return &net.Dialer{
+		// Default is 30s.
+		Timeout: 10 * time.Second,
+
+		// Default is 30s.
+		KeepAlive: 15 * time.Second,
+	}
+}
+
+// proxyAddress returns the host:port used to connect to the given proxy.
+func proxyAddress(u *url.URL) string {
+	port := u.Port()
+	if port == "" {
+		switch u.Scheme {
+		case "https":
+			port = "443"
+		case "socks5", "socks5h":
+			port = "1080"
+		default:
+			port = "80"
+		}
+	}
+
+	return net.JoinHostPort(u.Hostname(), port)
+}
+
+func parseNetworks(values ...string) []*net.IPNet {
+	networks := make([]*net.IPNet, 0, len(values))
+	for _, value := range values {
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
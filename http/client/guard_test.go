@@ -0,0 +1,341 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package client // import "miniflux.app/http/client"
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"miniflux.app/config"
+	"miniflux.app/errors"
+)
+
+func TestGuardWithDefaultNetworks(t *testing.T) {
+	scenarios := map[string]bool{
+		"127.0.0.1":        false,
+		"127.1.2.3":        false,
+		"::1":              false,
+		"::ffff:127.0.0.1": false,
+		"0.0.0.0":          false,
+		"10.1.2.3":         false,
+		"172.16.5.4":       false,
+		"192.168.1.1":      false,
+		"100.64.0.1":       false,
+		"169.254.169.254":  false,
+		"fe80::1":          false,
+		"fd00::1":          false,
+		"224.0.0.1":        false,
+		"198.18.0.1":       false,
+		"198.19.255.254":   false,
+		"240.0.0.1":        false,
+		"255.255.255.255":  false,
+		"64:ff9b::a00:1":   false,
+		"2002:7f00:1::1":   false,
+		"93.184.216.34":    true,
+		"172.32.0.1":       true,
+		"2606:2800:220::1": true,
+	}
+
+	guard := NewGuard(nil)
+	for address, expected := range scenarios {
+		if result := guard.IsAllowed(net.ParseIP(address)); result != expected {
+			t.Errorf(`Unexpected result for %s, got %v instead of %v`, address, result, expected)
+		}
+	}
+}
+
+func TestGuardWithConfiguredNetworks(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_ALLOWED_NETWORKS", "192.168.1.0/24")
+	os.Setenv("HTTP_CLIENT_DENIED_NETWORKS", "203.0.113.0/24")
+
+	opts, err := config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scenarios := map[string]bool{
+		"192.168.1.20":  true,
+		"192.168.2.20":  false,
+		"203.0.113.5":   false,
+		"93.184.216.34": true,
+	}
+
+	guard := NewGuard(opts)
+	for address, expected := range scenarios {
+		if result := guard.IsAllowed(net.ParseIP(address)); result != expected {
+			t.Errorf(`Unexpected result for %s, got %v instead of %v`, address, result, expected)
+		}
+	}
+}
+
+func TestClientRejectsInternalAddresses(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("internal"))
+	}))
+	defer ts.Close()
+
+	// The hostname resolves to the loopback address after the URL has been validated.
+	localhostURL := strings.Replace(ts.URL, "127.0.0.1", "localhost", 1)
+
+	for _, url := range []string{ts.URL, localhostURL} {
+		_, err := New(url).Get()
+		if err == nil {
+			t.Fatalf(`The request to %s should be rejected`, url)
+		}
+
+		if _, ok := err.(*errors.LocalizedError); !ok {
+			t.Fatalf(`Unexpected error type for %s: %T (%v)`, url, err, err)
+		}
+
+		if !strings.Contains(err.Error(), "forbidden network address") {
+			t.Fatalf(`Unexpected error for %s: %v`, url, err)
+		}
+	}
+}
+
+func TestClientWithAllowedInternalNetwork(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("internal"))
+	}))
+	defer ts.Close()
+
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_ALLOWED_NETWORKS", "127.0.0.1")
+
+	opts, err := config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config.Opts = opts
+	defer func() { config.Opts = nil }()
+
+	response, err := New(ts.URL).Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf(`Unexpected status code: %d`, response.StatusCode)
+	}
+}
+
+func TestGuardTransportWithProxy(t *testing.T) {
+	proxyURL, _ := url.Parse("http://proxy.example.org:3128")
+	transport := NewGuard(nil).Transport(&http.Transport{}, http.ProxyURL(proxyURL))
+
+	for _, link := range []string{
+		"http://127.0.0.1/feed.xml",
+		"http://[::1]:8080/feed.xml",
+		"http://169.254.169.254/latest/",
+		"http://localhost/feed.xml",
+		"http://198.18.0.1/feed.xml",
+	} {
+		request, _ := http.NewRequest("GET", link, nil)
+		if _, err := transport.RoundTrip(request); err == nil {
+			t.Errorf(`The request to %s should be rejected`, link)
+		} else if _, ok := err.(*ForbiddenAddressError); !ok {
+			t.Errorf(`The request to %s should be rejected before using the proxy, got %v`, link, err)
+		}
+	}
+}
+
+// newProxy returns a proxy server recording the target of the forwarded requests and of the CONNECT requests.
+func newProxy(targets chan<- string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			targets <- r.URL.Host
+
+			request, _ := http.NewRequest(r.Method, r.URL.String(), r.Body)
+			request.Host = r.Host
+			response, err := http.DefaultTransport.RoundTrip(request)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadGateway)
+				return
+			}
+			defer response.Body.Close()
+
+			w.WriteHeader(response.StatusCode)
+			io.Copy(w, response.Body)
+			return
+		}
+		targets <- r.Host
+
+		upstream, err := net.Dial("tcp", r.Host)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		conn, buffer, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			upstream.Close()
+			return
+		}
+		conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+
+		go func() {
+			io.Copy(upstream, buffer)
+			upstream.Close()
+		}()
+		io.Copy(conn, upstream)
+		conn.Close()
+	}))
+}
+
+func TestClientForwardsProxiedRequests(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Host))
+	}))
+	defer ts.Close()
+
+	targets := make(chan string, 1)
+	proxy := newProxy(targets)
+	defer proxy.Close()
+
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_PROXY", proxy.URL)
+	os.Setenv("HTTP_CLIENT_ALLOWED_NETWORKS", "127.0.0.1/32")
+
+	opts, err := config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config.Opts = opts
+	defer func() { config.Opts = nil }()
+
+	// Plain HTTP requests are sent to the proxy as absolute URIs, not through CONNECT.
+	localhostURL := strings.Replace(ts.URL, "127.0.0.1", "localhost", 1)
+	response, err := NewClientWithConfig(localhostURL, opts).WithProxy().Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, port, _ := net.SplitHostPort(strings.TrimPrefix(ts.URL, "http://"))
+	select {
+	case target := <-targets:
+		if target != "localhost:"+port {
+			t.Errorf(`The proxy should receive the absolute URI, got %q`, target)
+		}
+	default:
+		t.Error(`The request should be sent through the proxy`)
+	}
+
+	if host := response.BodyAsString(); host != "localhost:"+port {
+		t.Errorf(`The origin should receive the original Host header, got %q`, host)
+	}
+}
+
+func TestTunnelConnectsToValidatedAddress(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	targets := make(chan string, 1)
+	proxy := newProxy(targets)
+	defer proxy.Close()
+
+	proxyURL, _ := url.Parse(proxy.URL)
+	_, port, _ := net.SplitHostPort(strings.TrimPrefix(ts.URL, "http://"))
+
+	tun := &tunnel{proxyURL: proxyURL, ip: net.ParseIP("127.0.0.1")}
+	conn, err := tun.dial(context.Background(), "tcp", net.JoinHostPort("localhost", port))
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn.Close()
+
+	if target := <-targets; target != "127.0.0.1:"+port {
+		t.Errorf(`The proxy should connect to the validated IP address, got %q`, target)
+	}
+}
+
+func TestGuardTransportRejectsUnresolvableHosts(t *testing.T) {
+	proxyURL, _ := url.Parse("http://proxy.example.org:3128")
+	transport := NewGuard(nil).Transport(&http.Transport{}, http.ProxyURL(proxyURL))
+
+	request, _ := http.NewRequest("GET", "http://unresolvable.invalid/feed.xml", nil)
+	if _, err := transport.RoundTrip(request); err == nil || !strings.Contains(err.Error(), "unable to resolve") {
+		t.Errorf(`The request to a host that cannot be resolved should be rejected, got %v`, err)
+	}
+}
+
+func TestClientRejectsProxyAddressAsOrigin(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("proxy"))
+	}))
+	defer ts.Close()
+
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_PROXY", ts.URL)
+
+	opts, err := config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config.Opts = opts
+	defer func() { config.Opts = nil }()
+
+	// Only the connections to the proxy are allowed, not the requests sent directly to its address.
+	_, err = New(ts.URL).Get()
+	if err == nil || !strings.Contains(err.Error(), "forbidden network address") {
+		t.Fatalf(`The request should be rejected, got: %v`, err)
+	}
+}
+
+func TestClientRejectsInternalAddressesThroughProxy(t *testing.T) {
+	proxied := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = true
+		w.Write([]byte("internal"))
+	}))
+	defer ts.Close()
+
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_PROXY", ts.URL)
+
+	opts, err := config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config.Opts = opts
+	defer func() { config.Opts = nil }()
+
+	_, err = NewClientWithConfig("http://169.254.169.254/latest/meta-data/", opts).WithProxy().Get()
+	if err == nil || !strings.Contains(err.Error(), "forbidden network address") {
+		t.Fatalf(`The request should be rejected before using the proxy, got: %v`, err)
+	}
+
+	if proxied {
+		t.Error(`The request should not be sent to the proxy`)
+	}
+}
+
+func TestProxyAddress(t *testing.T) {
+	scenarios := map[string]string{
+		"http://proxy.example.org":    "proxy.example.org:80",
+		"https://proxy.example.org":   "proxy.example.org:443",
+		"socks5://10.0.0.1":           "10.0.0.1:1080",
+		"http://10.0.0.1:3128":        "10.0.0.1:3128",
+		"http://user:pass@[::1]:8080": "[::1]:8080",
+	}
+
+	for input, expected := range scenarios {
+		u, _ := url.Parse(input)
+		if result := proxyAddress(u); result != expected {
+			t.Errorf(`Unexpected result for %q, got %q instead of %q`, input, result, expected)
+		}
+	}
+}
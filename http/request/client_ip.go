@@ -26,6 +26,66 @@ func FindClientIP(r *http.Request) string {
 		}
 	}
 
+	return findRemoteIP(r)
+}
+
+// FindTrustedClientIP returns the client IP address that can be used for security decisions.
+// Unlike FindClientIPWithTrustedProxies, proxy headers are ignored when no trusted proxy is configured
+// because any client can send them.
+func FindTrustedClientIP(r *http.Request, trustedNetworks []string) string {
+	if len(trustedNetworks) == 0 {
+		return findRemoteIP(r)
+	}
+
+	return FindClientIPWithTrustedProxies(r, trustedNetworks)
+}
+
+// FindClientIPWithTrustedProxies returns client real IP address.
+// Proxy headers are used only when the request comes from one of the trusted networks,
+// the right-most address of X-Forwarded-For that is not a trusted proxy is the client.
+// All proxies are trusted when the list is empty.
+func FindClientIPWithTrustedProxies(r *http.Request, trustedNetworks []string) string {
+	if len(trustedNetworks) == 0 {
+		return FindClientIP(r)
+	}
+
+	remoteIP := findRemoteIP(r)
+	if !isTrustedIP(remoteIP, trustedNetworks) {
+		return remoteIP
+	}
+
+	if value := r.Header.Get("X-Forwarded-For"); value != "" {
+		addresses := strings.Split(value, ",")
+		for i := len(addresses) - 1; i >= 0; i-- {
+			address := strings.TrimSpace(addresses[i])
+			if net.ParseIP(address) == nil {
+				break
+			}
+
+			if i == 0 || !isTrustedIP(address, trustedNetworks) {
+				return address
+			}
+		}
+	}
+
+	if address := strings.TrimSpace(r.Header.Get("X-Real-Ip")); net.ParseIP(address) != nil {
+		return address
+	}
+
+	return remoteIP
+}
+
+// IsFromUntrustedProxy returns true if the request has proxy headers but does not come from a trusted proxy.
+// Behind a reverse proxy missing from the trusted networks, all the clients share the address of the proxy.
+func IsFromUntrustedProxy(r *http.Request, trustedNetworks []string) bool {
+	if r.Header.Get("X-Forwarded-For") == "" && r.Header.Get("X-Real-Ip") == "" {
+		return false
+	}
+
+	return !isTrustedIP(findRemoteIP(r), trustedNetworks)
+}
+
+func findRemoteIP(r *http.Request) string {
 	// Fallback to TCP/IP source IP address.
 	var remoteIP string
 	if strings.ContainsRune(r.RemoteAddr, ':') {
@@ -41,3 +101,24 @@ func FindClientIP(r *http.Request) string {
 
 	return remoteIP
 }
+
+// isTrustedIP returns true if the address belongs to one of the networks,
+// a network can be written in CIDR notation or as a single IP address.
+func isTrustedIP(address string, networks []string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+
+	for _, network := range networks {
+		if _, ipNet, err := net.ParseCIDR(network); err == nil {
+			if ipNet.Contains(ip) {
+				return true
+			}
+		} else if trustedIP := net.ParseIP(network); trustedIP != nil && trustedIP.Equal(ip) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -100,3 +100,95 @@ func TestClientIPWithoutRemoteAddrAndBothHeaders(t *testing.T) {
 		t.Fatalf(`Unexpected result, got: %q`, ip)
 	}
 }
+
+func TestFindClientIPWithTrustedProxies(t *testing.T) {
+	trustedNetworks := []string{"10.0.0.0/8", "192.168.0.1"}
+
+	headers := http.Header{}
+	headers.Set("X-Forwarded-For", "203.0.113.195, 70.41.3.18, 10.0.0.2")
+	r := &http.Request{RemoteAddr: "192.168.0.1:4242", Header: headers}
+
+	if ip := FindClientIPWithTrustedProxies(r, trustedNetworks); ip != "70.41.3.18" {
+		t.Fatalf(`Unexpected result, got: %q`, ip)
+	}
+
+	// Only trusted proxies in the chain.
+	headers = http.Header{}
+	headers.Set("X-Forwarded-For", "10.0.0.3, 10.0.0.2")
+	r = &http.Request{RemoteAddr: "10.0.0.1:4242", Header: headers}
+
+	if ip := FindClientIPWithTrustedProxies(r, trustedNetworks); ip != "10.0.0.3" {
+		t.Fatalf(`Unexpected result, got: %q`, ip)
+	}
+
+	headers = http.Header{}
+	headers.Set("X-Real-Ip", "203.0.113.195")
+	r = &http.Request{RemoteAddr: "10.0.0.1:4242", Header: headers}
+
+	if ip := FindClientIPWithTrustedProxies(r, trustedNetworks); ip != "203.0.113.195" {
+		t.Fatalf(`Unexpected result, got: %q`, ip)
+	}
+
+	// Invalid address in the chain.
+	headers = http.Header{}
+	headers.Set("X-Forwarded-For", "203.0.113.195, fake IP")
+	r = &http.Request{RemoteAddr: "10.0.0.1:4242", Header: headers}
+
+	if ip := FindClientIPWithTrustedProxies(r, trustedNetworks); ip != "10.0.0.1" {
+		t.Fatalf(`Unexpected result, got: %q`, ip)
+	}
+}
+
+func TestFindClientIPWithUntrustedProxy(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("X-Forwarded-For", "203.0.113.195")
+	headers.Set("X-Real-Ip", "203.0.113.195")
+	r := &http.Request{RemoteAddr: "198.51.100.4:4242", Header: headers}
+
+	if ip := FindClientIPWithTrustedProxies(r, []string{"10.0.0.0/8"}); ip != "198.51.100.4" {
+		t.Fatalf(`Unexpected result, got: %q`, ip)
+	}
+
+	if ip := FindClientIPWithTrustedProxies(r, nil); ip != "203.0.113.195" {
+		t.Fatalf(`Headers should be used when no trusted proxy is configured, got: %q`, ip)
+	}
+}
+
+func TestFindTrustedClientIP(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("X-Forwarded-For", "203.0.113.195")
+	headers.Set("X-Real-Ip", "203.0.113.196")
+	r := &http.Request{RemoteAddr: "198.51.100.4:4242", Header: headers}
+
+	if ip := FindTrustedClientIP(r, nil); ip != "198.51.100.4" {
+		t.Fatalf(`Headers should be ignored when no trusted proxy is configured, got: %q`, ip)
+	}
+
+	if ip := FindTrustedClientIP(r, []string{"10.0.0.0/8"}); ip != "198.51.100.4" {
+		t.Fatalf(`Headers from an untrusted proxy should be ignored, got: %q`, ip)
+	}
+
+	if ip := FindTrustedClientIP(r, []string{"198.51.100.4"}); ip != "203.0.113.195" {
+		t.Fatalf(`Headers from a trusted proxy should be used, got: %q`, ip)
+	}
+}
+
+func TestIsFromUntrustedProxy(t *testing.T) {
+	r := &http.Request{RemoteAddr: "198.51.100.4:4242", Header: http.Header{}}
+	if IsFromUntrustedProxy(r, nil) {
+		t.Fatal(`Requests without proxy headers should not come from a proxy`)
+	}
+
+	r.Header.Set("X-Forwarded-For", "203.0.113.195")
+	if !IsFromUntrustedProxy(r, nil) {
+		t.Fatal(`Requests with proxy headers should come from an untrusted proxy when no trusted proxy is configured`)
+	}
+
+	if !IsFromUntrustedProxy(r, []string{"10.0.0.0/8"}) {
+		t.Fatal(`Requests with proxy headers should come from an untrusted proxy`)
+	}
+
+	if IsFromUntrustedProxy(r, []string{"198.51.100.4"}) {
+		t.Fatal(`Requests should come from a trusted proxy`)
+	}
+}
@@ -25,6 +25,7 @@ const (
 	FlashErrorMessageContextKey
 	PocketRequestTokenContextKey
 	ClientIPContextKey
+	TrustedClientIPContextKey
 )
 
 // IsAdminUser checks if the logged user is administrator.
@@ -109,6 +110,11 @@ func ClientIP(r *http.Request) string {
 	return getContextStringValue(r, ClientIPContextKey)
 }
 
+// TrustedClientIP returns the client IP address stored in the context that cannot be spoofed with proxy headers.
+func TrustedClientIP(r *http.Request) string {
+	return getContextStringValue(r, TrustedClientIPContextKey)
+}
+
 func getContextStringValue(r *http.Request, key ContextKey) string {
 	if v := r.Context().Value(key); v != nil {
 		value, valid := v.(string)
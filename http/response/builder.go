@@ -16,6 +16,8 @@ import (
 
 const compressionThreshold = 1024
 
+const defaultContentSecurityPolicy = "default-src 'self'; img-src *; media-src *; frame-src *"
+
 // Builder generates HTTP responses.
 type Builder struct {
 	w                 http.ResponseWriter
@@ -50,6 +52,12 @@ func (b *Builder) WithAttachment(filename string) *Builder {
 	return b
 }
 
+// WithContentSecurityPolicy replaces the default Content-Security-Policy header.
+func (b *Builder) WithContentSecurityPolicy(policy string) *Builder {
+	b.headers["Content-Security-Policy"] = policy
+	return b
+}
+
 // WithoutCompression disables HTTP compression.
 func (b *Builder) WithoutCompression() *Builder {
 	b.enableCompression = false
@@ -96,7 +104,9 @@ func (b *Builder) writeHeaders() {
 	b.headers["X-XSS-Protection"] = "1; mode=block"
 	b.headers["X-Content-Type-Options"] = "nosniff"
 	b.headers["X-Frame-Options"] = "DENY"
-	b.headers["Content-Security-Policy"] = "default-src 'self'; img-src *; media-src *; frame-src *"
+	if _, found := b.headers["Content-Security-Policy"]; !found {
+		b.headers["Content-Security-Policy"] = defaultContentSecurityPolicy
+	}
 
 	for key, value := range b.headers {
 		b.w.Header().Set(key, value)
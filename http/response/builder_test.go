@@ -349,3 +349,25 @@ func TestBuildResponseWithoutCompressionHeader(t *testing.T) {
 		t.Fatalf(`Unexpected header value, got %q instead of %q`, actual, expected)
 	}
 }
+
+func TestBuildResponseWithContentSecurityPolicy(t *testing.T) {
+	r, err := http.NewRequest("GET", "/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		New(w, r).WithContentSecurityPolicy("default-src 'self'; img-src * data:").Write()
+	})
+
+	handler.ServeHTTP(w, r)
+	resp := w.Result()
+
+	expected := "default-src 'self'; img-src * data:"
+	actual := resp.Header.Get("Content-Security-Policy")
+	if actual != expected {
+		t.Fatalf(`Unexpected header value, got %q instead of %q`, actual, expected)
+	}
+}
@@ -7,7 +7,10 @@ package json // import "miniflux.app/http/response/json"
 import (
 	"encoding/json"
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 
 	"miniflux.app/http/response"
 	"miniflux.app/logger"
@@ -84,6 +87,18 @@ func Forbidden(w http.ResponseWriter, r *http.Request) {
 	builder.Write()
 }
 
+// TooManyRequests sends a too many requests error to the client.
+func TooManyRequests(w http.ResponseWriter, r *http.Request, retryAfter time.Duration) {
+	logger.Error("[HTTP:Too Many Requests] %s", r.URL)
+
+	builder := response.New(w, r)
+	builder.WithStatus(http.StatusTooManyRequests)
+	builder.WithHeader("Content-Type", contentTypeHeader)
+	builder.WithHeader("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	builder.WithBody(toJSONError(errors.New("Too Many Requests")))
+	builder.Write()
+}
+
 // NotFound sends a page not found error to the client.
 func NotFound(w http.ResponseWriter, r *http.Request) {
 	logger.Error("[HTTP:Not Found] %s", r.URL)
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestOKResponse(t *testing.T) {
@@ -213,6 +214,39 @@ func TestUnauthorizedResponse(t *testing.T) {
 	}
 }
 
+func TestTooManyRequestsResponse(t *testing.T) {
+	r, err := http.NewRequest("GET", "/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		TooManyRequests(w, r, 1500*time.Millisecond)
+	})
+
+	handler.ServeHTTP(w, r)
+	resp := w.Result()
+
+	expectedStatusCode := http.StatusTooManyRequests
+	if resp.StatusCode != expectedStatusCode {
+		t.Fatalf(`Unexpected status code, got %d instead of %d`, resp.StatusCode, expectedStatusCode)
+	}
+
+	expectedBody := `{"error_message":"Too Many Requests"}`
+	actualBody := w.Body.String()
+	if actualBody != expectedBody {
+		t.Fatalf(`Unexpected body, got %s instead of %s`, actualBody, expectedBody)
+	}
+
+	expectedRetryAfter := "2"
+	actualRetryAfter := resp.Header.Get("Retry-After")
+	if actualRetryAfter != expectedRetryAfter {
+		t.Fatalf(`Unexpected Retry-After header, got %q instead of %q`, actualRetryAfter, expectedRetryAfter)
+	}
+}
+
 func TestForbiddenResponse(t *testing.T) {
 	r, err := http.NewRequest("GET", "/", nil)
 	if err != nil {
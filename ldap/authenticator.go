@@ -0,0 +1,191 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ldap // import "miniflux.app/ldap"
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"miniflux.app/config"
+
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// ErrInvalidCredentials is returned when the user is unknown or the password is wrong.
+var ErrInvalidCredentials = errors.New("ldap: invalid credentials")
+
+// Profile is the directory entry of an authenticated user.
+type Profile struct {
+	Username string
+	DN       string
+	IsAdmin  bool
+}
+
+func (p Profile) String() string {
+	return fmt.Sprintf(`Username=%q, DN=%q, IsAdmin=%v`, p.Username, p.DN, p.IsAdmin)
+}
+
+// Authenticator checks credentials against an LDAP directory.
+type Authenticator struct {
+	URL          string
+	StartTLS     bool
+	TLSConfig    *tls.Config
+	BindDN       string
+	BindPassword string
+	BaseDN       string
+	UserFilter   string
+	AdminGroupDN string
+	Timeout      time.Duration
+}
+
+// NewAuthenticator returns an authenticator configured from the application options.
+func NewAuthenticator() *Authenticator {
+	return &Authenticator{
+		URL:          config.Opts.LDAPURL(),
+		StartTLS:     config.Opts.HasLDAPStartTLS(),
+		BindDN:       config.Opts.LDAPBindDN(),
+		BindPassword: config.Opts.LDAPBindPassword(),
+		BaseDN:       config.Opts.LDAPBaseDN(),
+		UserFilter:   config.Opts.LDAPUserFilter(),
+		AdminGroupDN: config.Opts.LDAPAdminGroupDN(),
+		Timeout:      defaultTimeout,
+	}
+}
+
+// Authenticate finds the user entry with the search filter and binds with its DN and the given password.
+func (a *Authenticator) Authenticate(username, password string) (*Profile, error) {
+	// An empty password would be an unauthenticated bind, which always succeeds.
+	if username == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := a.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := a.bindSearchUser(conn); err != nil {
+		return nil, err
+	}
+
+	filter := strings.Replace(a.UserFilter, "%s", goldap.EscapeFilter(username), -1)
+	result, err := conn.Search(goldap.NewSearchRequest(
+		a.BaseDN,
+		goldap.ScopeWholeSubtree,
+		goldap.NeverDerefAliases,
+		2,
+		int(a.Timeout.Seconds()),
+		false,
+		filter,
+		[]string{"dn"},
+		nil,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("ldap: unable to search user %q: %v", username, err)
+	}
+
+	if len(result.Entries) != 1 {
+		return nil, ErrInvalidCredentials
+	}
+
+	profile := &Profile{Username: username, DN: result.Entries[0].DN}
+
+	if a.AdminGroupDN != "" {
+		if profile.IsAdmin, err = a.isGroupMember(conn, profile.DN); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := conn.Bind(profile.DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("ldap: unable to bind as %q: %v", profile.DN, err)
+	}
+
+	return profile, nil
+}
+
+func (a *Authenticator) dial() (*goldap.Conn, error) {
+	u, err := url.Parse(a.URL)
+	if err != nil {
+		return nil, fmt.Errorf("ldap: invalid URL: %v", err)
+	}
+
+	tlsConfig := a.TLSConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	}
+
+	if tlsConfig.ServerName == "" {
+		tlsConfig = tlsConfig.Clone()
+		tlsConfig.ServerName = u.Hostname()
+	}
+
+	conn, err := goldap.DialURL(
+		a.URL,
+		goldap.DialWithDialer(&net.Dialer{Timeout: a.Timeout}),
+		goldap.DialWithTLSConfig(tlsConfig),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ldap: unable to connect to %q: %v", a.URL, err)
+	}
+
+	conn.SetTimeout(a.Timeout)
+
+	if a.StartTLS && u.Scheme != "ldaps" {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("ldap: unable to start TLS: %v", err)
+		}
+	}
+
+	return conn, nil
+}
+
+func (a *Authenticator) bindSearchUser(conn *goldap.Conn) error {
+	if a.BindDN == "" {
+		return nil
+	}
+
+	if err := conn.Bind(a.BindDN, a.BindPassword); err != nil {
+		return fmt.Errorf("ldap: unable to bind as %q: %v", a.BindDN, err)
+	}
+
+	return nil
+}
+
+func (a *Authenticator) isGroupMember(conn *goldap.Conn, userDN string) (bool, error) {
+	escapedDN := goldap.EscapeFilter(userDN)
+	result, err := conn.Search(goldap.NewSearchRequest(
+		a.AdminGroupDN,
+		goldap.ScopeBaseObject,
+		goldap.NeverDerefAliases,
+		1,
+		int(a.Timeout.Seconds()),
+		false,
+		fmt.Sprintf("(|(member=%s)(uniqueMember=%s))", escapedDN, escapedDN),
+		[]string{"dn"},
+		nil,
+	))
+
+	if goldap.IsErrorWithCode(err, goldap.LDAPResultNoSuchObject) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("ldap: unable to check membership of group %q: %v", a.AdminGroupDN, err)
+	}
+
+	return len(result.Entries) == 1, nil
+}
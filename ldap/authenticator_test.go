@@ -0,0 +1,373 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ldap // import "miniflux.app/ldap"
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+const (
+	testBaseDN       = "ou=people,dc=example,dc=org"
+	testBindDN       = "cn=miniflux,dc=example,dc=org"
+	testBindPassword = "service-secret"
+	testAdminGroupDN = "cn=admins,ou=groups,dc=example,dc=org"
+	testAliceDN      = "uid=alice,ou=people,dc=example,dc=org"
+	testBobDN        = "uid=bob,ou=people,dc=example,dc=org"
+)
+
+// testDirectory is a minimal in-process LDAP server that understands
+// simple binds, searches and the StartTLS extended operation.
+type testDirectory struct {
+	listener        net.Listener
+	tlsConfig       *tls.Config
+	requireStartTLS bool
+	passwords       map[string]string
+	users           map[string]string
+	groups          map[string][]string
+}
+
+func newTestDirectory(t *testing.T) *testDirectory {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := &testDirectory{
+		listener: listener,
+		passwords: map[string]string{
+			testBindDN:  testBindPassword,
+			testAliceDN: "alice-password",
+			testBobDN:   "bob-password",
+		},
+		users: map[string]string{
+			"(uid=alice)": testAliceDN,
+			"(uid=bob)":   testBobDN,
+		},
+		groups: map[string][]string{
+			testAdminGroupDN: {testAliceDN},
+		},
+	}
+
+	t.Cleanup(func() { listener.Close() })
+	return d
+}
+
+func (d *testDirectory) serve() {
+	for {
+		conn, err := d.listener.Accept()
+		if err != nil {
+			return
+		}
+		go d.handle(conn)
+	}
+}
+
+func (d *testDirectory) handle(conn net.Conn) {
+	defer func() { conn.Close() }()
+
+	_, isTLS := conn.(*tls.Conn)
+	boundDN := ""
+
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+
+		messageID := packet.Children[0].Value.(int64)
+		operation := packet.Children[1]
+
+		switch operation.Tag {
+		case goldap.ApplicationBindRequest:
+			dn := operation.Children[1].Value.(string)
+			password := operation.Children[2].Data.String()
+
+			switch expected, found := d.passwords[dn]; {
+			case d.requireStartTLS && !isTLS:
+				d.reply(conn, messageID, goldap.ApplicationBindResponse, goldap.LDAPResultConfidentialityRequired)
+			case found && password != "" && password == expected:
+				boundDN = dn
+				d.reply(conn, messageID, goldap.ApplicationBindResponse, goldap.LDAPResultSuccess)
+			default:
+				d.reply(conn, messageID, goldap.ApplicationBindResponse, goldap.LDAPResultInvalidCredentials)
+			}
+		case goldap.ApplicationSearchRequest:
+			if boundDN != testBindDN {
+				d.reply(conn, messageID, goldap.ApplicationSearchResultDone, goldap.LDAPResultInsufficientAccessRights)
+				continue
+			}
+
+			base := operation.Children[0].Value.(string)
+			filter, _ := goldap.DecompileFilter(operation.Children[6])
+			dns, code := d.search(base, filter)
+			for _, dn := range dns {
+				d.writeEntry(conn, messageID, dn)
+			}
+			d.reply(conn, messageID, goldap.ApplicationSearchResultDone, code)
+		case goldap.ApplicationExtendedRequest:
+			d.reply(conn, messageID, goldap.ApplicationExtendedResponse, goldap.LDAPResultSuccess)
+			tlsConn := tls.Server(conn, d.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn, isTLS = tlsConn, true
+		case goldap.ApplicationUnbindRequest:
+			return
+		}
+	}
+}
+
+func (d *testDirectory) search(base, filter string) ([]string, uint16) {
+	if base == testBaseDN {
+		if dn, found := d.users[filter]; found {
+			return []string{dn}, goldap.LDAPResultSuccess
+		}
+		return nil, goldap.LDAPResultSuccess
+	}
+
+	members, found := d.groups[base]
+	if !found {
+		return nil, goldap.LDAPResultNoSuchObject
+	}
+
+	for _, member := range members {
+		if filter == fmt.Sprintf("(|(member=%s)(uniqueMember=%s))", member, member) {
+			return []string{base}, goldap.LDAPResultSuccess
+		}
+	}
+
+	return nil, goldap.LDAPResultSuccess
+}
+
+func (d *testDirectory) reply(conn net.Conn, messageID int64, tag ber.Tag, code uint16) {
+	response := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Response")
+	response.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "Result Code"))
+	response.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	response.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	d.write(conn, messageID, response)
+}
+
+func (d *testDirectory) writeEntry(conn net.Conn, messageID int64, dn string) {
+	entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, goldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, dn, "Object Name"))
+	entry.AppendChild(ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes"))
+	d.write(conn, messageID, entry)
+}
+
+func (d *testDirectory) write(conn net.Conn, messageID int64, operation *ber.Packet) {
+	envelope := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	envelope.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "Message ID"))
+	envelope.AppendChild(operation)
+	conn.Write(envelope.Bytes())
+}
+
+// newTestCertificate returns a self-signed certificate for 127.0.0.1 and a pool that trusts it.
+func newTestCertificate(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(certificate)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, pool
+}
+
+func newTestAuthenticator(d *testDirectory, scheme string) *Authenticator {
+	return &Authenticator{
+		URL:          scheme + "://" + d.listener.Addr().String(),
+		BindDN:       testBindDN,
+		BindPassword: testBindPassword,
+		BaseDN:       testBaseDN,
+		UserFilter:   "(uid=%s)",
+		AdminGroupDN: testAdminGroupDN,
+		Timeout:      5 * time.Second,
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	d := newTestDirectory(t)
+	go d.serve()
+
+	profile, err := newTestAuthenticator(d, "ldap").Authenticate("alice", "alice-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if profile.Username != "alice" || profile.DN != testAliceDN {
+		t.Errorf(`Unexpected profile: %s`, profile)
+	}
+
+	if !profile.IsAdmin {
+		t.Error(`Members of the admin group should be administrators`)
+	}
+
+	profile, err = newTestAuthenticator(d, "ldap").Authenticate("bob", "bob-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if profile.IsAdmin {
+		t.Error(`Users outside of the admin group should not be administrators`)
+	}
+}
+
+func TestAuthenticateWithoutAdminGroup(t *testing.T) {
+	d := newTestDirectory(t)
+	go d.serve()
+
+	authenticator := newTestAuthenticator(d, "ldap")
+	authenticator.AdminGroupDN = ""
+
+	profile, err := authenticator.Authenticate("alice", "alice-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if profile.IsAdmin {
+		t.Error(`Users should not be administrators when no admin group is configured`)
+	}
+}
+
+func TestAuthenticateWithMissingAdminGroup(t *testing.T) {
+	d := newTestDirectory(t)
+	go d.serve()
+
+	authenticator := newTestAuthenticator(d, "ldap")
+	authenticator.AdminGroupDN = "cn=unknown,ou=groups,dc=example,dc=org"
+
+	profile, err := authenticator.Authenticate("alice", "alice-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if profile.IsAdmin {
+		t.Error(`Users should not be administrators when the admin group does not exist`)
+	}
+}
+
+func TestAuthenticateWithInvalidCredentials(t *testing.T) {
+	d := newTestDirectory(t)
+	go d.serve()
+
+	scenarios := map[string]string{
+		"alice":   "wrong-password",
+		"bob":     "alice-password",
+		"charlie": "alice-password",
+		"*":       "alice-password",
+		"":        "alice-password",
+	}
+
+	for username, password := range scenarios {
+		if _, err := newTestAuthenticator(d, "ldap").Authenticate(username, password); err != ErrInvalidCredentials {
+			t.Errorf(`Unexpected result for %q: %v`, username, err)
+		}
+	}
+}
+
+func TestAuthenticateWithEmptyPassword(t *testing.T) {
+	d := newTestDirectory(t)
+	d.passwords[testAliceDN] = ""
+	go d.serve()
+
+	if _, err := newTestAuthenticator(d, "ldap").Authenticate("alice", ""); err != ErrInvalidCredentials {
+		t.Errorf(`An empty password should never be accepted, got %v`, err)
+	}
+}
+
+func TestAuthenticateWithInvalidServiceAccount(t *testing.T) {
+	d := newTestDirectory(t)
+	go d.serve()
+
+	authenticator := newTestAuthenticator(d, "ldap")
+	authenticator.BindPassword = "wrong"
+
+	if _, err := authenticator.Authenticate("alice", "alice-password"); err == nil || err == ErrInvalidCredentials {
+		t.Errorf(`A misconfigured service account should be reported, got %v`, err)
+	}
+}
+
+func TestAuthenticateWithStartTLS(t *testing.T) {
+	certificate, pool := newTestCertificate(t)
+
+	d := newTestDirectory(t)
+	d.requireStartTLS = true
+	d.tlsConfig = &tls.Config{Certificates: []tls.Certificate{certificate}}
+	go d.serve()
+
+	authenticator := newTestAuthenticator(d, "ldap")
+	if _, err := authenticator.Authenticate("alice", "alice-password"); err == nil {
+		t.Fatal(`The server requires StartTLS, the bind should fail without it`)
+	}
+
+	authenticator.StartTLS = true
+	authenticator.TLSConfig = &tls.Config{RootCAs: pool}
+	if _, err := authenticator.Authenticate("alice", "alice-password"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAuthenticateWithUntrustedStartTLSCertificate(t *testing.T) {
+	certificate, _ := newTestCertificate(t)
+
+	d := newTestDirectory(t)
+	d.tlsConfig = &tls.Config{Certificates: []tls.Certificate{certificate}}
+	go d.serve()
+
+	authenticator := newTestAuthenticator(d, "ldap")
+	authenticator.StartTLS = true
+	if _, err := authenticator.Authenticate("alice", "alice-password"); err == nil {
+		t.Fatal(`A certificate signed by an unknown authority should be rejected`)
+	}
+}
+
+func TestAuthenticateWithLDAPS(t *testing.T) {
+	certificate, pool := newTestCertificate(t)
+
+	d := newTestDirectory(t)
+	d.listener = tls.NewListener(d.listener, &tls.Config{Certificates: []tls.Certificate{certificate}})
+	go d.serve()
+
+	authenticator := newTestAuthenticator(d, "ldaps")
+	authenticator.TLSConfig = &tls.Config{RootCAs: pool}
+
+	if _, err := authenticator.Authenticate("alice", "alice-password"); err != nil {
+		t.Fatal(err)
+	}
+}
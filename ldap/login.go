@@ -0,0 +1,97 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ldap // import "miniflux.app/ldap"
+
+import (
+	"errors"
+	"net/http"
+
+	"miniflux.app/audit"
+	"miniflux.app/config"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/storage"
+)
+
+// ErrLocalAccount is returned when the username belongs to an account that was not created by the directory.
+var ErrLocalAccount = errors.New("ldap: the account is not linked to the directory")
+
+// Login authenticates the user with the directory and returns the matching local user.
+// Unknown users are created when LDAP_USER_CREATION is enabled, and the administrator
+// flag follows the membership of LDAP_ADMIN_GROUP_DN when it is configured.
+// Only the accounts created by the directory can be used, a local account with the same username is never taken over.
+// The created accounts and the administrator flag changes are recorded in the audit log.
+func Login(store *storage.Storage, r *http.Request, username, password string) (*model.User, error) {
+	profile, err := NewAuthenticator().Authenticate(username, password)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := store.UserByUsername(profile.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		if !config.Opts.IsLDAPUserCreationAllowed() {
+			logger.Error("[LDAP] User creation is not allowed: %s", profile)
+			return nil, ErrInvalidCredentials
+		}
+
+		logger.Info("[LDAP] Creating user: %s", profile)
+		user, err = store.CreateUser(&model.UserCreationRequest{Username: profile.Username, IsAdmin: profile.IsAdmin, LDAPDN: profile.DN})
+		if err != nil {
+			return nil, err
+		}
+
+		audit.LogProvisioning(store, r, audit.ActorLDAP, user, true, false)
+		return user, nil
+	}
+
+	wasAdmin := user.IsAdmin
+	dnChanged, adminChanged, err := syncUser(user, profile, config.Opts.LDAPAdminGroupDN() != "")
+	if err != nil {
+		logger.Error("[LDAP] %v: %s", err, profile)
+		return nil, err
+	}
+
+	if dnChanged {
+		logger.Info("[LDAP] Updating directory entry: %s", profile)
+		if err := store.SetUserLDAPDN(user.ID, user.LDAPDN); err != nil {
+			return nil, err
+		}
+	}
+
+	if adminChanged {
+		logger.Info("[LDAP] Updating administrator flag: %s", profile)
+		if err := store.UpdateUser(user); err != nil {
+			return nil, err
+		}
+
+		audit.LogProvisioning(store, r, audit.ActorLDAP, user, false, wasAdmin)
+	}
+
+	return user, nil
+}
+
+// syncUser copies the directory entry to an account created by the directory.
+// The administrator flag is only synchronized when an administrator group is configured.
+func syncUser(user *model.User, profile *Profile, syncAdmin bool) (dnChanged, adminChanged bool, err error) {
+	if user.LDAPDN == "" {
+		return false, false, ErrLocalAccount
+	}
+
+	if user.LDAPDN != profile.DN {
+		user.LDAPDN = profile.DN
+		dnChanged = true
+	}
+
+	if syncAdmin && user.IsAdmin != profile.IsAdmin {
+		user.IsAdmin = profile.IsAdmin
+		adminChanged = true
+	}
+
+	return dnChanged, adminChanged, nil
+}
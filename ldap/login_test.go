@@ -0,0 +1,66 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ldap // import "miniflux.app/ldap"
+
+import (
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestSyncUserRefusesLocalAccount(t *testing.T) {
+	user := &model.User{Username: "alice", IsAdmin: true}
+	profile := &Profile{Username: "alice", DN: testAliceDN, IsAdmin: false}
+
+	if _, _, err := syncUser(user, profile, true); err != ErrLocalAccount {
+		t.Fatalf(`A local account must not be used by the directory, got: %v`, err)
+	}
+
+	if !user.IsAdmin || user.LDAPDN != "" {
+		t.Errorf(`A local account must not be modified, got: %+v`, user)
+	}
+}
+
+func TestSyncUserWithAdminGroup(t *testing.T) {
+	user := &model.User{Username: "alice", LDAPDN: testAliceDN}
+	profile := &Profile{Username: "alice", DN: testAliceDN, IsAdmin: true}
+
+	dnChanged, adminChanged, err := syncUser(user, profile, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if dnChanged || !adminChanged || !user.IsAdmin {
+		t.Errorf(`The administrator flag should be updated, got: dnChanged=%v adminChanged=%v user=%+v`, dnChanged, adminChanged, user)
+	}
+}
+
+func TestSyncUserWithoutAdminGroup(t *testing.T) {
+	user := &model.User{Username: "alice", LDAPDN: testAliceDN, IsAdmin: true}
+	profile := &Profile{Username: "alice", DN: testAliceDN, IsAdmin: false}
+
+	_, adminChanged, err := syncUser(user, profile, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if adminChanged || !user.IsAdmin {
+		t.Errorf(`The administrator flag must be kept without group, got: %+v`, user)
+	}
+}
+
+func TestSyncUserWithNewDN(t *testing.T) {
+	user := &model.User{Username: "alice", LDAPDN: "uid=alice,ou=former,dc=example,dc=org"}
+	profile := &Profile{Username: "alice", DN: testAliceDN}
+
+	dnChanged, _, err := syncUser(user, profile, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !dnChanged || user.LDAPDN != testAliceDN {
+		t.Errorf(`The directory entry should be updated, got: %+v`, user)
+	}
+}
@@ -10,15 +10,22 @@ var translations = map[string]string{
     "confirm.loading": "In Arbeit...",
     "action.subscribe": "Abonnieren",
     "action.save": "Speichern",
+    "action.preview": "Vorschau",
     "action.or": "oder",
     "action.cancel": "abbrechen",
     "action.remove": "Entfernen",
+    "action.filter": "Filtern",
+    "action.unlock": "Entsperren",
     "action.remove_feed": "Dieses Abonnement entfernen",
     "action.update": "Aktualisieren",
+    "action.refresh_archive": "Schnappschuss aktualisieren",
     "action.edit": "Bearbeiten",
     "action.download": "Herunterladen",
     "action.import": "Importieren",
     "action.login": "Anmelden",
+    "action.enable_totp": "Zwei-Faktor-Authentifizierung aktivieren",
+    "action.disable_totp": "Zwei-Faktor-Authentifizierung deaktivieren",
+    "action.register_webauthn": "Sicherheitsschlüssel oder Passkey registrieren",
     "action.home_screen": "Zum Startbildschirm hinzufügen",
     "tooltip.keyboard_shortcuts": "Tastenkürzel: %s",
     "tooltip.logged_user": "Angemeldet als %s",
@@ -32,12 +39,18 @@ var translations = map[string]string{
     "menu.preferences": "Einstellungen",
     "menu.integrations": "Dienste",
     "menu.sessions": "Sitzungen",
+    "menu.totp": "Zwei-Faktor-Authentifizierung",
+    "menu.webauthn": "Passkeys",
+    "menu.security_activity": "Sicherheitsaktivität",
+    "menu.audit": "Audit-Protokoll",
     "menu.users": "Benutzer",
     "menu.about": "Über",
     "menu.export": "Exportieren",
     "menu.import": "Importieren",
+    "menu.opml_sources": "Entfernte Listen",
     "menu.create_category": "Kategorie anlegen",
     "menu.mark_page_as_read": "Diese Seite als gelesen markieren",
+    "menu.export_epub": "Als EPUB exportieren",
     "menu.mark_all_as_read": "Alle als gelesen markieren",
     "menu.show_all_entries": "Zeige alle Artikel",
     "menu.show_only_unread_entries": "Nur ungelesene Artikel anzeigen",
@@ -73,6 +86,8 @@ var translations = map[string]string{
     "entry.save.toast.completed": "Artikel gespeichert",
     "entry.scraper.label": "Herunterladen",
     "entry.scraper.title": "Inhalt herunterladen",
+    "entry.archive.label": "Schnappschuss",
+    "entry.archive.title": "Eine lokale Kopie des Artikels behalten",
     "entry.scraper.completed": "Erledigt!",
     "entry.external_link.label": "Externer Link",
     "entry.comments.label": "Kommentare",
@@ -86,6 +101,7 @@ var translations = map[string]string{
         "%d Minute zu lesen",
         "%d Minuten zu lesen"
     ],
+    "entry.watch_page.title": "%s wurde geändert",
     "page.shared_entries.title": "Geteilte Artikel",
     "page.unread.title": "Ungelesen",
     "page.starred.title": "Lesezeichen",
@@ -98,6 +114,17 @@ var translations = map[string]string{
         "Es gibt %d Abonnements."
     ],
     "page.new_category.title": "Neue Kategorie",
+    "page.new_newsletter.title": "Neuer Newsletter",
+    "page.new_newsletter.help": "Es wird eine eindeutige E-Mail-Adresse erzeugt. Verwenden Sie sie, um einen Newsletter zu abonnieren, und jede empfangene E-Mail erscheint als neuer Artikel.",
+    "page.new_scraped_feed.title": "Neues Abonnement aus einer Webseite",
+    "page.new_scraped_feed.help": "Für Webseiten ohne Feed: Jedes vom Element-Selektor gefundene Element wird zu einem Artikel. Die anderen Selektoren werden innerhalb jedes Elements angewendet.",
+    "page.new_scraped_feed.preview": [
+        "%d Artikel gefunden",
+        "%d Artikel gefunden"
+    ],
+    "page.new_scraped_feed.content": "Inhalt",
+    "page.new_watch_page.title": "Webseite beobachten",
+    "page.new_watch_page.help": "Die Seite wird wie die anderen Abonnements geprüft und bei jeder Änderung ihres Textes wird ein neuer Artikel mit den Unterschieden erstellt.",
     "page.new_user.title": "Neuer Benutzer",
     "page.edit_category.title": "Kategorie bearbeiten: %s",
     "page.edit_user.title": "Benutzer bearbeiten: %s",
@@ -111,7 +138,40 @@ var translations = map[string]string{
     ],
     "page.history.title": "Verlauf",
     "page.import.title": "Importieren",
+    "page.import.report": "Import abgeschlossen: %d Abonnements, %d Kategorien und %d Artikel (davon %d mit Lesezeichen) erstellt, %d Artikel übersprungen.",
+    "page.import_job.title": "Importergebnisse",
+    "page.import_job.running": "Der Import läuft: %d von %d Abonnements verarbeitet.",
+    "page.import_job.running_items": "Der Import läuft: %d von %d Elementen verarbeitet.",
+    "page.import_job.refresh": "Aktualisieren",
+    "page.import_job.finished": "Import abgeschlossen: %d Abonnements erstellt, %d Duplikate, %d nicht erreichbar, %d Analysefehler, %d andere Fehler.",
+    "page.import_job.failed": "Der Import ist fehlgeschlagen: %s",
+    "page.import_job.table.feed": "Abonnement",
+    "page.import_job.table.category": "Kategorie",
+    "page.import_job.table.result": "Ergebnis",
+    "page.import_job.status.created": "Erstellt",
+    "page.import_job.status.duplicate": "Bereits abonniert",
+    "page.import_job.status.unreachable": "Nicht erreichbar",
+    "page.import_job.status.parse_error": "Ungültiges Format",
+    "page.import_job.status.error": "Fehler",
+    "page.opml_sources.title": "Entfernte OPML-Listen",
+    "page.opml_sources.help": "Die entfernte Liste wird regelmäßig abgerufen und ihre neuen Abonnements werden der gewählten Kategorie hinzugefügt.",
+    "page.opml_sources.no_source": "Sie haben keine entfernte Liste abonniert.",
+    "page.opml_sources.new": "Entfernte Liste abonnieren",
+    "page.opml_sources.table.url": "Liste",
+    "page.opml_sources.table.category": "Kategorie",
+    "page.opml_sources.table.feeds": "Abonnements",
+    "page.opml_sources.table.checked_at": "Letzte Prüfung",
+    "page.opml_source.title": "Entfernte OPML-Liste",
+    "page.opml_source.never_checked": "Nie",
+    "page.opml_source.error": "Letzter Fehler",
+    "page.opml_source.disable_removed": "Die aus der Liste entfernten Abonnements werden deaktiviert.",
+    "page.opml_source.history": "Verlauf",
+    "page.opml_source.no_history": "Die Synchronisierungen haben noch kein Abonnement geändert.",
+    "page.opml_source.change.added": "Hinzugefügt",
+    "page.opml_source.change.disabled": "Deaktiviert",
+    "page.opml_source.change.enabled": "Wieder aktiviert",
     "page.search.title": "Suchergebnisse",
+    "page.tag_entries.title": "Schlagwort: %s",
     "page.about.title": "Über",
     "page.about.credits": "Urheberrechte",
     "page.about.version": "Version:",
@@ -125,6 +185,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "Abonnement suchen",
     "page.add_feed.legend.advanced_options": "Erweiterte Optionen",
     "page.add_feed.choose_feed": "Abonnement auswählen",
+    "page.add_feed.newsletter": "Stattdessen einen Newsletter per E-Mail empfangen",
+    "page.add_feed.scraped_feed": "Kein Feed auf dieser Webseite? Stattdessen die Artikel einer Webseite extrahieren",
+    "page.add_feed.watch_page": "Stattdessen die Änderungen einer Webseite beobachten",
     "page.edit_feed.title": "Abonnement bearbeiten: %s",
     "page.edit_feed.last_check": "Letzte Aktualisierung:",
     "page.edit_feed.last_modified_header": "Zuletzt geändert:",
@@ -132,6 +195,14 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "Nicht verfügbar",
     "page.edit_feed.last_parsing_error": "Letzter Analysefehler",
     "page.entry.attachments": "Anlagen",
+    "page.entry.podcast.season": "Staffel %d",
+    "page.entry.podcast.episode": "Folge %s",
+    "page.entry.podcast.chapters": "Kapitel",
+    "page.entry.podcast.transcript": "Transkript",
+    "page.entry.podcast.transcripts": "Transkripte",
+    "page.entry.podcast.captions": "Untertitel",
+    "page.entry.podcast.persons": "Personen",
+    "page.entry.podcast.funding": "Diesen Podcast unterstützen:",
     "page.keyboard_shortcuts.title": "Tastenkürzel",
     "page.keyboard_shortcuts.subtitle.sections": "Navigation zwischen den Menüpunkten",
     "page.keyboard_shortcuts.subtitle.items": "Navigation zwischen den Artikeln",
@@ -172,14 +243,24 @@ var translations = map[string]string{
     "page.users.actions": "Aktionen",
     "page.users.last_login": "Letzte Anmeldung",
     "page.users.is_admin": "Administrator",
+    "page.users.locked": "Nach zu vielen fehlgeschlagenen Anmeldungen gesperrt",
     "page.settings.title": "Einstellungen",
     "page.settings.link_google_account": "Google Konto verknüpfen",
     "page.settings.unlink_google_account": "Google Konto Verknüpfung entfernen",
     "page.settings.link_oidc_account": "OpenID Connect Konto verknüpfen",
     "page.settings.unlink_oidc_account": "OpenID Connect Konto Verknüpfung entfernen",
+    "page.settings.link_github_account": "GitHub Konto verknüpfen",
+    "page.settings.unlink_github_account": "GitHub Konto Verknüpfung entfernen",
+    "page.settings.link_gitlab_account": "GitLab Konto verknüpfen",
+    "page.settings.unlink_gitlab_account": "GitLab Konto Verknüpfung entfernen",
     "page.login.title": "Anmeldung",
     "page.login.google_signin": "Anmeldung mit Google",
     "page.login.oidc_signin": "Anmeldung mit OpenID Connect",
+    "page.login.github_signin": "Anmeldung mit GitHub",
+    "page.login.gitlab_signin": "Anmeldung mit GitLab",
+    "page.login.webauthn_signin": "Mit einem Passkey anmelden",
+    "page.login_totp.title": "Zwei-Faktor-Authentifizierung",
+    "page.login_totp.help": "Geben Sie den von Ihrer Authenticator-App angezeigten Code oder einen Ihrer Wiederherstellungscodes ein.",
     "page.integrations.title": "Dienste",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "API Endpunkt",
@@ -191,11 +272,58 @@ var translations = map[string]string{
     "page.integration.bookmarklet.instructions": "Ziehen Sie diesen Link in Ihre Lesezeichen.",
     "page.integration.bookmarklet.help": "Dieser spezielle Link ermöglicht es, eine Webseite direkt über ein Lesezeichen im Browser zu abonnieren.",
     "page.sessions.title": "Sitzungen",
+    "page.totp.title": "Zwei-Faktor-Authentifizierung",
+    "page.totp.enroll_help": "Scannen Sie diesen QR-Code mit einer Authenticator-App und geben Sie zur Bestätigung den angezeigten Code ein.",
+    "page.totp.qr_code": "QR-Code",
+    "page.totp.secret": "Geheimer Schlüssel:",
+    "page.totp.recovery_codes_help": "Bewahren Sie diese Wiederherstellungscodes an einem sicheren Ort auf. Jeder Code kann einmal zur Anmeldung verwendet werden, falls Sie keinen Zugriff mehr auf Ihre Authenticator-App haben. Sie werden nicht erneut angezeigt.",
+    "page.totp.enabled": [
+        "Die Zwei-Faktor-Authentifizierung ist aktiviert. %d Wiederherstellungscode übrig.",
+        "Die Zwei-Faktor-Authentifizierung ist aktiviert. %d Wiederherstellungscodes übrig."
+    ],
+    "page.webauthn.title": "Sicherheitsschlüssel und Passkeys",
+    "page.webauthn.help": "Mit Sicherheitsschlüsseln und Passkeys können Sie sich ohne Passwort anmelden.",
+    "page.webauthn.unsupported": "Ihr Browser unterstützt keine Sicherheitsschlüssel und Passkeys.",
+    "page.webauthn.never_used": "Nie benutzt",
+    "page.webauthn.table.name": "Name",
+    "page.webauthn.table.last_used_at": "Zuletzt verwendet",
+    "page.webauthn.table.created_at": "Erstellungsdatum",
+    "page.webauthn.table.actions": "Aktionen",
     "page.sessions.table.date": "Datum",
     "page.sessions.table.ip": "IP Addresse",
     "page.sessions.table.user_agent": "Benutzeragent",
     "page.sessions.table.actions": "Aktionen",
     "page.sessions.table.current_session": "Aktuelle Sitzung",
+    "page.security_activity.title": "Letzte Sicherheitsaktivität",
+    "page.entry_archive.created_at": "Schnappschuss erstellt",
+    "page.entry_archive.pending": "Ein neuer Schnappschuss wird erstellt, laden Sie die Seite gleich neu.",
+    "page.entry_archive.unavailable": "Zu viele Schnappschüsse werden gerade erstellt, versuchen Sie es später erneut.",
+    "page.audit.title": "Audit-Protokoll",
+    "page.audit.date": "Datum",
+    "page.audit.action": "Aktion",
+    "page.audit.actor": "Ausgeführt von",
+    "page.audit.target": "Details",
+    "page.audit.client_ip": "IP-Adresse",
+    "page.audit.user": "Benutzer",
+    "page.audit.all_users": "Alle Benutzer",
+    "page.audit.all_actions": "Alle Aktionen",
+    "audit.action.login": "Anmeldung",
+    "audit.action.login_failed": "Fehlgeschlagene Anmeldung",
+    "audit.action.logout": "Abmeldung",
+    "audit.action.password_changed": "Passwort geändert",
+    "audit.action.api_key_created": "API-Schlüssel erstellt",
+    "audit.action.api_key_removed": "API-Schlüssel entfernt",
+    "audit.action.user_created": "Benutzer erstellt",
+    "audit.action.user_removed": "Benutzer entfernt",
+    "audit.action.user_promoted": "Administratorrechte erteilt",
+    "audit.action.user_demoted": "Administratorrechte entzogen",
+    "audit.action.user_unlocked": "Benutzer entsperrt",
+    "audit.action.feed_removed": "Abonnement entfernt",
+    "audit.action.totp_enabled": "Zwei-Faktor-Authentifizierung aktiviert",
+    "audit.action.totp_disabled": "Zwei-Faktor-Authentifizierung deaktiviert",
+    "audit.action.webauthn_registered": "Passkey registriert",
+    "audit.action.webauthn_removed": "Passkey entfernt",
+    "audit.action.session_removed": "Sitzung entfernt",
     "page.api_keys.title": "API-Schlüssel",
     "page.api_keys.table.description": "Beschreibung",
     "page.api_keys.table.token": "Zeichen",
@@ -214,12 +342,15 @@ var translations = map[string]string{
     "alert.no_history": "Es existiert zur Zeit kein Verlauf.",
     "alert.feed_error": "Es gibt ein Problem mit diesem Abonnement",
     "alert.no_search_result": "Es gibt kein Ergebnis für diese Suche.",
+    "alert.no_tag_entry": "Es gibt keine Artikel mit diesem Schlagwort.",
     "alert.no_unread_entry": "Es existiert kein ungelesener Artikel.",
     "alert.no_user": "Sie sind der einzige Benutzer.",
+    "alert.no_audit_event": "Es gibt kein Ereignis.",
     "alert.account_unlinked": "Ihr externer Account ist jetzt getrennt!",
     "alert.account_linked": "Ihr externes Konto wurde verknüpft!",
     "alert.pocket_linked": "Ihr Pocket Konto ist jetzt verknüpft!",
     "alert.prefs_saved": "Einstellungen gespeichert!",
+    "alert.totp_disabled": "Die Zwei-Faktor-Authentifizierung ist jetzt deaktiviert.",
     "error.unlink_account_without_password": "Sie müssen ein Passwort festlegen, sonst können Sie sich nicht erneut anmelden.",
     "error.duplicate_linked_account": "Es ist bereits jemand mit diesem Anbieter assoziiert!",
     "error.duplicate_fever_username": "Es existiert bereits jemand mit diesem Fever Benutzernamen!",
@@ -234,7 +365,15 @@ var translations = map[string]string{
     "error.unable_to_update_feed": "Dieses Abonnement konnte nicht aktualisiert werden.",
     "error.subscription_not_found": "Es wurden keine Abonnements gefunden.",
     "error.empty_file": "Diese Datei ist leer.",
+    "error.file_too_large": "Diese Datei ist zu groß (maximal 50 MB).",
+    "error.unsupported_import_format": "Dieses Dateiformat wird nicht unterstützt.",
+    "error.invalid_opml_source_url": "Ungültige Listen-URL.",
+    "error.opml_source_already_exists": "Sie haben diese Liste bereits abonniert.",
     "error.bad_credentials": "Benutzername oder Passwort ungültig.",
+    "error.too_many_login_attempts": "Zu viele fehlgeschlagene Anmeldeversuche, bitte versuchen Sie es später erneut.",
+    "error.invalid_totp_code": "Ungültiger Code für die Zwei-Faktor-Authentifizierung.",
+    "error.totp_code_required": "Der Code ist ein Pflichtfeld.",
+    "error.webauthn_failed": "Der Vorgang mit Ihrem Sicherheitsschlüssel oder Passkey ist fehlgeschlagen oder wurde abgebrochen.",
     "error.fields_mandatory": "Alle Felder sind obligatorisch.",
     "error.title_required": "Der Titel ist obligatorisch.",
     "error.different_passwords": "Passwörter stimmen nicht überein.",
@@ -242,6 +381,10 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "Die Felder für Benutzername, Thema, Sprache und Zeitzone sind obligatorisch.",
     "error.entries_per_page_invalid": "Die Anzahl der Einträge pro Seite ist ungültig.",
     "error.feed_mandatory_fields": "Die URL und die Kategorie sind obligatorisch.",
+    "error.newsletter_mandatory_fields": "Der Titel und die Kategorie sind Pflichtfelder.",
+    "error.scraped_feed_mandatory_fields": "Die Seiten-URL, die Kategorie und der Element-Selektor sind obligatorisch.",
+    "error.scraped_feed_item_selector_not_empty": "Der Element-Selektor darf nicht leer sein.",
+    "error.watch_page_mandatory_fields": "Die Seiten-URL und die Kategorie sind obligatorisch.",
     "error.feed_already_exists": "Dieser Feed existiert bereits.",
     "error.invalid_feed_url": "Ungültige Feed-URL.",
     "error.invalid_site_url": "Ungültige Site-URL.",
@@ -268,14 +411,28 @@ var translations = map[string]string{
     "form.feed.label.rewrite_rules": "Umschreiberegeln",
     "form.feed.label.blocklist_rules": "Regeln blockieren",
     "form.feed.label.keeplist_rules": "Regeln einhalten",
+    "form.feed.label.watch_selector": "Beobachteter Teil der Seite (CSS-Selektor)",
+    "form.feed.help.watch_selector": "Leer lassen, um die ganze Seite zu beobachten. Damit lassen sich Teile ignorieren, die sich bei jedem Besuch ändern.",
     "form.feed.label.ignore_http_cache": "Ignoriere HTTP-cache",
     "form.feed.label.fetch_via_proxy": "Über Proxy abrufen",
     "form.feed.label.disabled": "Dieses Abonnement nicht aktualisieren",
+    "form.newsletter.label.address": "E-Mail-Adresse des Newsletters",
+    "form.scraped_feed.label.page_url": "URL der Webseite",
+    "form.scraped_feed.legend.selectors": "CSS-Selektoren",
+    "form.scraped_feed.label.item": "Element",
+    "form.scraped_feed.label.title": "Titel",
+    "form.scraped_feed.label.link": "Link",
+    "form.scraped_feed.label.date": "Datum",
+    "form.scraped_feed.label.content": "Inhalt",
+    "form.scraped_feed.help": "Ohne Link-Selektor wird der erste Link des Elements verwendet. Ohne Titel-Selektor wird der Text des Links verwendet. Ohne Inhalts-Selektor wird das ganze Element verwendet.",
     "form.category.label.title": "Titel",
     "form.user.label.username": "Benutzername",
     "form.user.label.password": "Passwort",
     "form.user.label.confirmation": "Passwort Bestätigung",
     "form.user.label.admin": "Administrator",
+    "form.totp.label.code": "Authentifizierungscode",
+    "form.totp.label.code_or_recovery_code": "Authentifizierungscode oder Wiederherstellungscode",
+    "form.webauthn.label.name": "Name",
     "form.prefs.label.language": "Sprache",
     "form.prefs.label.timezone": "Zeitzone",
     "form.prefs.label.theme": "Thema",
@@ -285,10 +442,16 @@ var translations = map[string]string{
     "form.prefs.select.recent_first": "Neueste Artikel zuerst",
     "form.prefs.label.keyboard_shortcuts": "Tastaturkürzel aktivieren",
     "form.prefs.label.entry_swipe": "Wischgeste für Einträge auf dem Handy aktivieren",
+    "form.prefs.label.archive_starred": "Eine lokale Kopie der Lesezeichen behalten",
     "form.prefs.label.show_reading_time": "Geschätzte Lesezeit für Artikel anzeigen",
     "form.prefs.label.custom_css": "Benutzerdefiniertes CSS",
     "form.import.label.file": "OPML Datei",
     "form.import.label.url": "URL",
+    "form.import.label.apply_settings": "Miniflux-Einstellungen der Abonnements übernehmen (Regeln, User-Agent, Crawler, Proxy)",
+    "form.import.label.validate": "Jedes Abonnement vor dem Abonnieren prüfen (langsamer)",
+    "form.import.help.file": "OPML-Dateien, JSON-Exporte im Google-Reader-Format (Feedly, Inoreader), XML-Exporte von Tiny Tiny RSS und ZIP-Archive von FreshRSS werden unterstützt.",
+    "form.opml_source.label.url": "URL der OPML-Liste",
+    "form.opml_source.label.disable_removed": "Aus der Liste entfernte Abonnements deaktivieren",
     "form.integration.fever_activate": "Fever API aktivieren",
     "form.integration.fever_username": "Fever Benutzername",
     "form.integration.fever_password": "Fever Passwort",
@@ -360,6 +523,7 @@ var translations = map[string]string{
     "This website is temporarily unreachable (original error: %q)": "Diese Webseite ist vorübergehend nicht erreichbar (ursprünglicher Fehler: %q)",
     "This website is permanently unreachable (original error: %q)": "Diese Webseite ist dauerhaft nicht erreichbar (ursprünglicher Fehler: %q)",
     "Website unreachable, the request timed out after %d seconds": "Webseite nicht erreichbar, die Anfrage endete nach %d Sekunden",
+    "This website is hosted on a private or forbidden network address (%s)": "Diese Webseite befindet sich unter einer privaten oder gesperrten Netzwerkadresse (%s)",
     "You are not authorized to access this resource (invalid username/password)": "Sie sind nicht berechtigt, auf diese Ressource zuzugreifen (Benutzername/Passwort ungültig)",
     "Unable to fetch this resource (Status Code = %d)": "Ressource konnte nicht abgerufen werden (code=%d)",
     "Resource not found (404), this feed doesn't exists anymore, check the feed URL": "Ressource nicht gefunden (404), dieses Abonnement existiert nicht mehr, überprüfen Sie die Abonnement-URL"
@@ -372,15 +536,22 @@ var translations = map[string]string{
     "confirm.loading": "In progress...",
     "action.subscribe": "Subscribe",
     "action.save": "Save",
+    "action.preview": "Preview",
     "action.or": "or",
     "action.cancel": "cancel",
     "action.remove": "Remove",
+    "action.filter": "Filter",
+    "action.unlock": "Unlock",
     "action.remove_feed": "Remove this feed",
     "action.update": "Update",
+    "action.refresh_archive": "Refresh the snapshot",
     "action.edit": "Edit",
     "action.download": "Download",
     "action.import": "Import",
     "action.login": "Login",
+    "action.enable_totp": "Enable two-factor authentication",
+    "action.disable_totp": "Disable two-factor authentication",
+    "action.register_webauthn": "Register a security key or passkey",
     "action.home_screen": "Add to home screen",
     "tooltip.keyboard_shortcuts": "Keyboard Shortcut: %s",
     "tooltip.logged_user": "Logged as %s",
@@ -394,12 +565,18 @@ var translations = map[string]string{
     "menu.preferences": "Preferences",
     "menu.integrations": "Integrations",
     "menu.sessions": "Sessions",
+    "menu.totp": "Two-Factor Authentication",
+    "menu.webauthn": "Passkeys",
+    "menu.security_activity": "Security Activity",
+    "menu.audit": "Audit Log",
     "menu.users": "Users",
     "menu.about": "About",
     "menu.export": "Export",
     "menu.import": "Import",
+    "menu.opml_sources": "Remote lists",
     "menu.create_category": "Create a category",
     "menu.mark_page_as_read": "Mark this page as read",
+    "menu.export_epub": "Export to EPUB",
     "menu.mark_all_as_read": "Mark all as read",
     "menu.show_all_entries": "Show all entries",
     "menu.show_only_unread_entries": "Show only unread entries",
@@ -435,6 +612,8 @@ var translations = map[string]string{
     "entry.save.toast.completed": "Article saved",
     "entry.scraper.label": "Download",
     "entry.scraper.title": "Fetch original content",
+    "entry.archive.label": "Snapshot",
+    "entry.archive.title": "Keep a local copy of the article",
     "entry.scraper.completed": "Done!",
     "entry.external_link.label": "External link",
     "entry.comments.label": "Comments",
@@ -448,6 +627,7 @@ var translations = map[string]string{
         "%d minute read",
         "%d minutes read"
     ],
+    "entry.watch_page.title": "%s has changed",
     "page.shared_entries.title": "Shared Entries",
     "page.unread.title": "Unread",
     "page.starred.title": "Starred",
@@ -460,6 +640,17 @@ var translations = map[string]string{
         "There are %d feeds."
     ],
     "page.new_category.title": "New Category",
+    "page.new_newsletter.title": "New Newsletter",
+    "page.new_newsletter.help": "A unique email address will be generated. Use it to subscribe to a newsletter and every email received will appear as a new entry.",
+    "page.new_scraped_feed.title": "New Feed from a Web Page",
+    "page.new_scraped_feed.help": "For websites without feed: each element matched by the item selector becomes an entry. The other selectors are applied inside each item.",
+    "page.new_scraped_feed.preview": [
+        "%d entry found",
+        "%d entries found"
+    ],
+    "page.new_scraped_feed.content": "Content",
+    "page.new_watch_page.title": "Watch a Web Page",
+    "page.new_watch_page.help": "The page is checked like the other feeds and a new entry showing the differences is created every time its text changes.",
     "page.new_user.title": "New User",
     "page.edit_category.title": "Edit Category: %s",
     "page.edit_user.title": "Edit User: %s",
@@ -473,7 +664,40 @@ var translations = map[string]string{
     ],
     "page.history.title": "History",
     "page.import.title": "Import",
+    "page.import.report": "Import completed: %d feeds, %d categories and %d entries (%d starred) created, %d entries skipped.",
+    "page.import_job.title": "Import Results",
+    "page.import_job.running": "The import is in progress: %d of %d subscriptions processed.",
+    "page.import_job.running_items": "The import is in progress: %d of %d items processed.",
+    "page.import_job.refresh": "Refresh",
+    "page.import_job.finished": "The import is finished: %d feeds created, %d duplicates, %d unreachable, %d parsing errors, %d other errors.",
+    "page.import_job.failed": "The import has failed: %s",
+    "page.import_job.table.feed": "Feed",
+    "page.import_job.table.category": "Category",
+    "page.import_job.table.result": "Result",
+    "page.import_job.status.created": "Created",
+    "page.import_job.status.duplicate": "Already subscribed",
+    "page.import_job.status.unreachable": "Unreachable",
+    "page.import_job.status.parse_error": "Invalid feed",
+    "page.import_job.status.error": "Error",
+    "page.opml_sources.title": "Remote OPML Lists",
+    "page.opml_sources.help": "The remote list is fetched periodically and its new feeds are added to the chosen category.",
+    "page.opml_sources.no_source": "You are not subscribed to any remote list.",
+    "page.opml_sources.new": "Subscribe to a remote list",
+    "page.opml_sources.table.url": "List",
+    "page.opml_sources.table.category": "Category",
+    "page.opml_sources.table.feeds": "Feeds",
+    "page.opml_sources.table.checked_at": "Last check",
+    "page.opml_source.title": "Remote OPML List",
+    "page.opml_source.never_checked": "Never",
+    "page.opml_source.error": "Last error",
+    "page.opml_source.disable_removed": "The feeds removed from the list are disabled.",
+    "page.opml_source.history": "History",
+    "page.opml_source.no_history": "The synchronizations have not changed any subscription yet.",
+    "page.opml_source.change.added": "Added",
+    "page.opml_source.change.disabled": "Disabled",
+    "page.opml_source.change.enabled": "Enabled again",
     "page.search.title": "Search Results",
+    "page.tag_entries.title": "Tag: %s",
     "page.about.title": "About",
     "page.about.credits": "Credits",
     "page.about.version": "Version:",
@@ -487,6 +711,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "Find a subscription",
     "page.add_feed.legend.advanced_options": "Advanced Options",
     "page.add_feed.choose_feed": "Choose a Subscription",
+    "page.add_feed.newsletter": "Receive a newsletter by email instead",
+    "page.add_feed.scraped_feed": "No feed on this website? Extract the entries of a web page instead",
+    "page.add_feed.watch_page": "Watch the changes of a web page instead",
     "page.edit_feed.title": "Edit Feed: %s",
     "page.edit_feed.last_check": "Last check:",
     "page.edit_feed.last_modified_header": "LastModified header:",
@@ -494,6 +721,14 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "None",
     "page.edit_feed.last_parsing_error": "Last Parsing Error",
     "page.entry.attachments": "Attachments",
+    "page.entry.podcast.season": "Season %d",
+    "page.entry.podcast.episode": "Episode %s",
+    "page.entry.podcast.chapters": "Chapters",
+    "page.entry.podcast.transcript": "Transcript",
+    "page.entry.podcast.transcripts": "Transcripts",
+    "page.entry.podcast.captions": "captions",
+    "page.entry.podcast.persons": "People",
+    "page.entry.podcast.funding": "Support this podcast:",
     "page.keyboard_shortcuts.title": "Keyboard Shortcuts",
     "page.keyboard_shortcuts.subtitle.sections": "Sections Navigation",
     "page.keyboard_shortcuts.subtitle.items": "Items Navigation",
@@ -534,14 +769,24 @@ var translations = map[string]string{
     "page.users.actions": "Actions",
     "page.users.last_login": "Last Login",
     "page.users.is_admin": "Administrator",
+    "page.users.locked": "Locked after too many failed logins",
     "page.settings.title": "Settings",
     "page.settings.link_google_account": "Link my Google account",
     "page.settings.unlink_google_account": "Unlink my Google account",
     "page.settings.link_oidc_account": "Link my OpenID Connect account",
     "page.settings.unlink_oidc_account": "Unlink my OpenID Connect account",
+    "page.settings.link_github_account": "Link my GitHub account",
+    "page.settings.unlink_github_account": "Unlink my GitHub account",
+    "page.settings.link_gitlab_account": "Link my GitLab account",
+    "page.settings.unlink_gitlab_account": "Unlink my GitLab account",
     "page.login.title": "Sign In",
     "page.login.google_signin": "Sign in with Google",
     "page.login.oidc_signin": "Sign in with OpenID Connect",
+    "page.login.github_signin": "Sign in with GitHub",
+    "page.login.gitlab_signin": "Sign in with GitLab",
+    "page.login.webauthn_signin": "Sign in with a passkey",
+    "page.login_totp.title": "Two-Factor Authentication",
+    "page.login_totp.help": "Enter the code displayed by your authenticator app, or one of your recovery codes.",
     "page.integrations.title": "Integrations",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "API Endpoint",
@@ -553,11 +798,58 @@ var translations = map[string]string{
     "page.integration.bookmarklet.instructions": "Drag and drop this link to your bookmarks.",
     "page.integration.bookmarklet.help": "This special link allows you to subscribe to a website directly by using a bookmark in your web browser.",
     "page.sessions.title": "Sessions",
+    "page.totp.title": "Two-Factor Authentication",
+    "page.totp.enroll_help": "Scan this QR code with an authenticator app, then enter the code it displays to confirm.",
+    "page.totp.qr_code": "QR code",
+    "page.totp.secret": "Secret key:",
+    "page.totp.recovery_codes_help": "Save these recovery codes in a safe place. Each code can be used once to sign in if you lose access to your authenticator app. They will not be displayed again.",
+    "page.totp.enabled": [
+        "Two-factor authentication is enabled. %d recovery code left.",
+        "Two-factor authentication is enabled. %d recovery codes left."
+    ],
+    "page.webauthn.title": "Security Keys and Passkeys",
+    "page.webauthn.help": "Security keys and passkeys let you sign in without typing your password.",
+    "page.webauthn.unsupported": "Your browser does not support security keys and passkeys.",
+    "page.webauthn.never_used": "Never Used",
+    "page.webauthn.table.name": "Name",
+    "page.webauthn.table.last_used_at": "Last Used",
+    "page.webauthn.table.created_at": "Creation Date",
+    "page.webauthn.table.actions": "Actions",
     "page.sessions.table.date": "Date",
     "page.sessions.table.ip": "IP Address",
     "page.sessions.table.user_agent": "User Agent",
     "page.sessions.table.actions": "Actions",
     "page.sessions.table.current_session": "Current Session",
+    "page.security_activity.title": "Recent Security Activity",
+    "page.entry_archive.created_at": "Snapshot taken",
+    "page.entry_archive.pending": "A new snapshot is being taken, reload the page in a moment.",
+    "page.entry_archive.unavailable": "Too many snapshots are being taken, try again later.",
+    "page.audit.title": "Audit Log",
+    "page.audit.date": "Date",
+    "page.audit.action": "Action",
+    "page.audit.actor": "Performed by",
+    "page.audit.target": "Details",
+    "page.audit.client_ip": "IP Address",
+    "page.audit.user": "User",
+    "page.audit.all_users": "All users",
+    "page.audit.all_actions": "All actions",
+    "audit.action.login": "Login",
+    "audit.action.login_failed": "Failed login",
+    "audit.action.logout": "Logout",
+    "audit.action.password_changed": "Password changed",
+    "audit.action.api_key_created": "API key created",
+    "audit.action.api_key_removed": "API key removed",
+    "audit.action.user_created": "User created",
+    "audit.action.user_removed": "User removed",
+    "audit.action.user_promoted": "Administrator rights granted",
+    "audit.action.user_demoted": "Administrator rights revoked",
+    "audit.action.user_unlocked": "User unlocked",
+    "audit.action.feed_removed": "Feed removed",
+    "audit.action.totp_enabled": "Two-factor authentication enabled",
+    "audit.action.totp_disabled": "Two-factor authentication disabled",
+    "audit.action.webauthn_registered": "Passkey registered",
+    "audit.action.webauthn_removed": "Passkey removed",
+    "audit.action.session_removed": "Session removed",
     "page.api_keys.title": "API Keys",
     "page.api_keys.table.description": "Description",
     "page.api_keys.table.token": "Token",
@@ -576,12 +868,15 @@ var translations = map[string]string{
     "alert.no_history": "There is no history at the moment.",
     "alert.feed_error": "There is a problem with this feed",
     "alert.no_search_result": "There are no results for this search.",
+    "alert.no_tag_entry": "There are no entries with this tag.",
     "alert.no_unread_entry": "There are no unread articles.",
     "alert.no_user": "You are the only user.",
+    "alert.no_audit_event": "There is no event.",
     "alert.account_unlinked": "Your external account is now dissociated!",
     "alert.account_linked": "Your external account is now linked!",
     "alert.pocket_linked": "Your Pocket account is now linked!",
     "alert.prefs_saved": "Preferences saved!",
+    "alert.totp_disabled": "Two-factor authentication is now disabled.",
     "error.unlink_account_without_password": "You must define a password otherwise you won't be able to login again.",
     "error.duplicate_linked_account": "There is already someone associated with this provider!",
     "error.duplicate_fever_username": "There is already someone else with the same Fever username!",
@@ -600,7 +895,15 @@ var translations = map[string]string{
     "error.invalid_timezone": "Invalid timezone.",
     "error.invalid_entry_direction": "Invalid entry direction.",
     "error.empty_file": "This file is empty.",
+    "error.file_too_large": "This file is too large (50 MB maximum).",
+    "error.unsupported_import_format": "This file format is not supported.",
+    "error.invalid_opml_source_url": "Invalid list URL.",
+    "error.opml_source_already_exists": "You are already subscribed to this list.",
     "error.bad_credentials": "Invalid username or password.",
+    "error.too_many_login_attempts": "Too many failed login attempts, please try again later.",
+    "error.invalid_totp_code": "Invalid two-factor authentication code.",
+    "error.totp_code_required": "The code is mandatory.",
+    "error.webauthn_failed": "The operation with your security key or passkey failed or was cancelled.",
     "error.fields_mandatory": "All fields are mandatory.",
     "error.title_required": "The title is mandatory.",
     "error.different_passwords": "Passwords are not the same.",
@@ -608,6 +911,10 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "The username, theme, language and timezone fields are mandatory.",
     "error.entries_per_page_invalid": "The number of entries per page is not valid.",
     "error.feed_mandatory_fields": "The URL and the category are mandatory.",
+    "error.newsletter_mandatory_fields": "The title and the category are mandatory.",
+    "error.scraped_feed_mandatory_fields": "The page URL, the category and the item selector are mandatory.",
+    "error.scraped_feed_item_selector_not_empty": "The item selector cannot be empty.",
+    "error.watch_page_mandatory_fields": "The page URL and the category are mandatory.",
     "error.feed_already_exists": "This feed already exists.",
     "error.invalid_feed_url": "Invalid feed URL.",
     "error.invalid_site_url": "Invalid site URL.",
@@ -630,14 +937,28 @@ var translations = map[string]string{
     "form.feed.label.rewrite_rules": "Rewrite Rules",
     "form.feed.label.blocklist_rules": "Block Rules",
     "form.feed.label.keeplist_rules": "Keep Rules",
+    "form.feed.label.watch_selector": "Watched part of the page (CSS selector)",
+    "form.feed.help.watch_selector": "Leave empty to watch the whole page. Use it to ignore the parts that change at every visit.",
     "form.feed.label.ignore_http_cache": "Ignore HTTP cache",
     "form.feed.label.fetch_via_proxy": "Fetch via proxy",
     "form.feed.label.disabled": "Do not refresh this feed",
+    "form.newsletter.label.address": "Newsletter email address",
+    "form.scraped_feed.label.page_url": "Web page URL",
+    "form.scraped_feed.legend.selectors": "CSS selectors",
+    "form.scraped_feed.label.item": "Item",
+    "form.scraped_feed.label.title": "Title",
+    "form.scraped_feed.label.link": "Link",
+    "form.scraped_feed.label.date": "Date",
+    "form.scraped_feed.label.content": "Content",
+    "form.scraped_feed.help": "Without link selector, the first link of the item is used. Without title selector, the text of the link is used. Without content selector, the whole item is used.",
     "form.category.label.title": "Title",
     "form.user.label.username": "Username",
     "form.user.label.password": "Password",
     "form.user.label.confirmation": "Password Confirmation",
     "form.user.label.admin": "Administrator",
+    "form.totp.label.code": "Authentication code",
+    "form.totp.label.code_or_recovery_code": "Authentication code or recovery code",
+    "form.webauthn.label.name": "Name",
     "form.prefs.label.language": "Language",
     "form.prefs.label.timezone": "Timezone",
     "form.prefs.label.theme": "Theme",
@@ -647,10 +968,16 @@ var translations = map[string]string{
     "form.prefs.select.recent_first": "Recent entries first",
     "form.prefs.label.keyboard_shortcuts": "Enable keyboard shortcuts",
     "form.prefs.label.entry_swipe": "Enable swipe gesture on entries on mobile",
+    "form.prefs.label.archive_starred": "Keep a local copy of starred articles",
     "form.prefs.label.show_reading_time": "Show estimated reading time for articles",
     "form.prefs.label.custom_css": "Custom CSS",
     "form.import.label.file": "OPML file",
     "form.import.label.url": "URL",
+    "form.import.label.apply_settings": "Apply the Miniflux feed settings (rules, user agent, crawler, proxy)",
+    "form.import.label.validate": "Check each feed before subscribing (slower)",
+    "form.import.help.file": "OPML files, Google Reader JSON exports (Feedly, Inoreader), Tiny Tiny RSS XML exports and FreshRSS ZIP archives are supported.",
+    "form.opml_source.label.url": "OPML list URL",
+    "form.opml_source.label.disable_removed": "Disable the feeds removed from the list",
     "form.integration.fever_activate": "Activate Fever API",
     "form.integration.fever_username": "Fever Username",
     "form.integration.fever_password": "Fever Password",
@@ -714,15 +1041,22 @@ var translations = map[string]string{
     "confirm.loading": "En progreso...",
     "action.subscribe": "Suscribir",
     "action.save": "Guardar",
+    "action.preview": "Vista previa",
     "action.or": "o",
     "action.cancel": "Cancelar",
     "action.remove": "Quitar",
+    "action.filter": "Filtrar",
+    "action.unlock": "Desbloquear",
     "action.remove_feed": "Quitar esta fuente",
     "action.update": "Actualizar",
+    "action.refresh_archive": "Actualizar la instantánea",
     "action.edit": "Editar",
     "action.download": "Descargar",
     "action.import": "Importar",
     "action.login": "Iniciar sesión",
+    "action.enable_totp": "Activar la autenticación de dos factores",
+    "action.disable_totp": "Desactivar la autenticación de dos factores",
+    "action.register_webauthn": "Registrar una llave de seguridad o passkey",
     "action.home_screen": "Añadir a la pantalla principal",
     "tooltip.keyboard_shortcuts": "Atajo de teclado: %s",
     "tooltip.logged_user": "Registrado como %s",
@@ -736,12 +1070,18 @@ var translations = map[string]string{
     "menu.preferences": "Preferencias",
     "menu.integrations": "Integraciones",
     "menu.sessions": "Sesiones",
+    "menu.totp": "Autenticación de dos factores",
+    "menu.webauthn": "Passkeys",
+    "menu.security_activity": "Actividad de seguridad",
+    "menu.audit": "Registro de auditoría",
     "menu.users": "Usuarios",
     "menu.about": "Acerca de",
     "menu.export": "Exportar",
     "menu.import": "Importar",
+    "menu.opml_sources": "Listas remotas",
     "menu.create_category": "Crear una categoría",
     "menu.mark_page_as_read": "Marcar esta pagína como leída",
+    "menu.export_epub": "Exportar a EPUB",
     "menu.mark_all_as_read": "Marcar todos como leídos",
     "menu.show_all_entries": "Mostrar todas las entradas",
     "menu.show_only_unread_entries": "Mostrar solo las entradas no leídas",
@@ -777,6 +1117,8 @@ var translations = map[string]string{
     "entry.save.toast.completed": "Artículo guardado",
     "entry.scraper.label": "Descargar",
     "entry.scraper.title": "Obtener contenido original",
+    "entry.archive.label": "Instantánea",
+    "entry.archive.title": "Guardar una copia local del artículo",
     "entry.scraper.completed": "¡Hecho!",
     "entry.external_link.label": "Enlace externo",
     "entry.comments.label": "Comentarios",
@@ -790,6 +1132,7 @@ var translations = map[string]string{
         "%d minuto de lectura",
         "%d minutos de lectura"
     ],
+    "entry.watch_page.title": "%s ha cambiado",
     "page.shared_entries.title": "Entradas compartidas",
     "page.unread.title": "No leídos",
     "page.starred.title": "Marcadores",
@@ -802,6 +1145,17 @@ var translations = map[string]string{
         "Hay %d fuentes."
     ],
     "page.new_category.title": "Nueva categoría",
+    "page.new_newsletter.title": "Nuevo boletín",
+    "page.new_newsletter.help": "Se generará una dirección de correo electrónico única. Úsela para suscribirse a un boletín y cada correo recibido aparecerá como un nuevo artículo.",
+    "page.new_scraped_feed.title": "Nueva fuente a partir de una página web",
+    "page.new_scraped_feed.help": "Para sitios web sin fuente: cada elemento encontrado por el selector de elementos se convierte en un artículo. Los demás selectores se aplican dentro de cada elemento.",
+    "page.new_scraped_feed.preview": [
+        "%d artículo encontrado",
+        "%d artículos encontrados"
+    ],
+    "page.new_scraped_feed.content": "Contenido",
+    "page.new_watch_page.title": "Vigilar una página web",
+    "page.new_watch_page.help": "La página se comprueba como las demás fuentes y se crea un nuevo artículo con las diferencias cada vez que cambia su texto.",
     "page.new_user.title": "Nuevo usario",
     "page.edit_category.title": "Editar categoría: %s",
     "page.edit_user.title": "Editar usuario: %s",
@@ -815,7 +1169,40 @@ var translations = map[string]string{
     ],
     "page.history.title": "Historial",
     "page.import.title": "Importar",
+    "page.import.report": "Importación completada: %d fuentes, %d categorías y %d artículos (%d marcados) creados, %d artículos omitidos.",
+    "page.import_job.title": "Resultados de la importación",
+    "page.import_job.running": "La importación está en curso: %d de %d suscripciones procesadas.",
+    "page.import_job.running_items": "La importación está en curso: %d de %d elementos procesados.",
+    "page.import_job.refresh": "Actualizar",
+    "page.import_job.finished": "La importación ha terminado: %d fuentes creadas, %d duplicadas, %d inaccesibles, %d errores de análisis, %d otros errores.",
+    "page.import_job.failed": "La importación ha fallado: %s",
+    "page.import_job.table.feed": "Fuente",
+    "page.import_job.table.category": "Categoría",
+    "page.import_job.table.result": "Resultado",
+    "page.import_job.status.created": "Creada",
+    "page.import_job.status.duplicate": "Ya suscrito",
+    "page.import_job.status.unreachable": "Inaccesible",
+    "page.import_job.status.parse_error": "Fuente no válida",
+    "page.import_job.status.error": "Error",
+    "page.opml_sources.title": "Listas OPML remotas",
+    "page.opml_sources.help": "La lista remota se descarga periódicamente y sus nuevas fuentes se añaden a la categoría elegida.",
+    "page.opml_sources.no_source": "No está suscrito a ninguna lista remota.",
+    "page.opml_sources.new": "Suscribirse a una lista remota",
+    "page.opml_sources.table.url": "Lista",
+    "page.opml_sources.table.category": "Categoría",
+    "page.opml_sources.table.feeds": "Fuentes",
+    "page.opml_sources.table.checked_at": "Última comprobación",
+    "page.opml_source.title": "Lista OPML remota",
+    "page.opml_source.never_checked": "Nunca",
+    "page.opml_source.error": "Último error",
+    "page.opml_source.disable_removed": "Las fuentes eliminadas de la lista se desactivan.",
+    "page.opml_source.history": "Historial",
+    "page.opml_source.no_history": "Las sincronizaciones aún no han cambiado ninguna suscripción.",
+    "page.opml_source.change.added": "Añadida",
+    "page.opml_source.change.disabled": "Desactivada",
+    "page.opml_source.change.enabled": "Reactivada",
     "page.search.title": "Resultados de la búsqueda",
+    "page.tag_entries.title": "Etiqueta: %s",
     "page.about.title": "Acerca de",
     "page.about.credits": "Creditos",
     "page.about.version": "Versión:",
@@ -829,6 +1216,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "Encontrar una suscripción",
     "page.add_feed.legend.advanced_options": "Opciones avanzadas",
     "page.add_feed.choose_feed": "Elegir una suscripción",
+    "page.add_feed.newsletter": "Recibir un boletín por correo electrónico",
+    "page.add_feed.scraped_feed": "¿No hay fuente en este sitio? Extraer los artículos de una página web",
+    "page.add_feed.watch_page": "Vigilar los cambios de una página web",
     "page.edit_feed.title": "Editar fuente: %s",
     "page.edit_feed.last_check": "Última verificación:",
     "page.edit_feed.last_modified_header": "Cabecera de LastModified:",
@@ -836,6 +1226,14 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "Sin cabecera",
     "page.edit_feed.last_parsing_error": "Último error de análisis",
     "page.entry.attachments": "Archivos adjuntos",
+    "page.entry.podcast.season": "Temporada %d",
+    "page.entry.podcast.episode": "Episodio %s",
+    "page.entry.podcast.chapters": "Capítulos",
+    "page.entry.podcast.transcript": "Transcripción",
+    "page.entry.podcast.transcripts": "Transcripciones",
+    "page.entry.podcast.captions": "subtítulos",
+    "page.entry.podcast.persons": "Personas",
+    "page.entry.podcast.funding": "Apoya este podcast:",
     "page.keyboard_shortcuts.title": "Atajos de teclado",
     "page.keyboard_shortcuts.subtitle.sections": "Navegación de secciones",
     "page.keyboard_shortcuts.subtitle.items": "Navegación de artículos",
@@ -876,14 +1274,24 @@ var translations = map[string]string{
     "page.users.actions": "Acciones",
     "page.users.last_login": "Último ingreso",
     "page.users.is_admin": "Administrador",
+    "page.users.locked": "Bloqueado tras demasiados inicios de sesión fallidos",
     "page.settings.title": "Ajustes",
     "page.settings.link_google_account": "Vincular mi cuenta de Google",
     "page.settings.unlink_google_account": "Desvincular mi cuenta de Google",
     "page.settings.link_oidc_account": "Vincular mi cuenta de OpenID Connect",
     "page.settings.unlink_oidc_account": "Desvincular mi cuenta de OpenID Connect",
+    "page.settings.link_github_account": "Vincular mi cuenta de GitHub",
+    "page.settings.unlink_github_account": "Desvincular mi cuenta de GitHub",
+    "page.settings.link_gitlab_account": "Vincular mi cuenta de GitLab",
+    "page.settings.unlink_gitlab_account": "Desvincular mi cuenta de GitLab",
     "page.login.title": "Iniciar sesión",
     "page.login.google_signin": "Iniciar sesión con tu cuenta de Google",
     "page.login.oidc_signin": "Iniciar sesión con tu cuenta de OpenID Connect",
+    "page.login.github_signin": "Iniciar sesión con tu cuenta de GitHub",
+    "page.login.gitlab_signin": "Iniciar sesión con tu cuenta de GitLab",
+    "page.login.webauthn_signin": "Iniciar sesión con una passkey",
+    "page.login_totp.title": "Autenticación de dos factores",
+    "page.login_totp.help": "Introduzca el código mostrado por su aplicación de autenticación o uno de sus códigos de recuperación.",
     "page.integrations.title": "Integraciones",
     "page.integration.miniflux_api": "API de Miniflux",
     "page.integration.miniflux_api_endpoint": "Extremo de API",
@@ -895,11 +1303,58 @@ var translations = map[string]string{
     "page.integration.bookmarklet.instructions": "Arrastrar y soltar este enlace a tus marcadores del navegador.",
     "page.integration.bookmarklet.help": "Este enlace especial te permite suscribirte a un sitio de web directamente usando un marcador del navegador.",
     "page.sessions.title": "Sesiones",
+    "page.totp.title": "Autenticación de dos factores",
+    "page.totp.enroll_help": "Escanee este código QR con una aplicación de autenticación y luego introduzca el código que muestra para confirmar.",
+    "page.totp.qr_code": "Código QR",
+    "page.totp.secret": "Clave secreta:",
+    "page.totp.recovery_codes_help": "Guarde estos códigos de recuperación en un lugar seguro. Cada código puede usarse una vez para iniciar sesión si pierde el acceso a su aplicación de autenticación. No se volverán a mostrar.",
+    "page.totp.enabled": [
+        "La autenticación de dos factores está activada. Queda %d código de recuperación.",
+        "La autenticación de dos factores está activada. Quedan %d códigos de recuperación."
+    ],
+    "page.webauthn.title": "Llaves de seguridad y passkeys",
+    "page.webauthn.help": "Las llaves de seguridad y las passkeys le permiten iniciar sesión sin escribir su contraseña.",
+    "page.webauthn.unsupported": "Su navegador no admite llaves de seguridad ni passkeys.",
+    "page.webauthn.never_used": "Nunca usado",
+    "page.webauthn.table.name": "Nombre",
+    "page.webauthn.table.last_used_at": "Último uso",
+    "page.webauthn.table.created_at": "Fecha de creación",
+    "page.webauthn.table.actions": "Acciones",
     "page.sessions.table.date": "Fecha",
     "page.sessions.table.ip": "Dirección de IP",
     "page.sessions.table.user_agent": "Agente de usuario",
     "page.sessions.table.actions": "Acciones",
     "page.sessions.table.current_session": "Sesión actual",
+    "page.security_activity.title": "Actividad de seguridad reciente",
+    "page.entry_archive.created_at": "Instantánea tomada",
+    "page.entry_archive.pending": "Se está tomando una nueva instantánea, vuelva a cargar la página en un momento.",
+    "page.entry_archive.unavailable": "Se están tomando demasiadas instantáneas, inténtelo de nuevo más tarde.",
+    "page.audit.title": "Registro de auditoría",
+    "page.audit.date": "Fecha",
+    "page.audit.action": "Acción",
+    "page.audit.actor": "Realizado por",
+    "page.audit.target": "Detalles",
+    "page.audit.client_ip": "Dirección IP",
+    "page.audit.user": "Usuario",
+    "page.audit.all_users": "Todos los usuarios",
+    "page.audit.all_actions": "Todas las acciones",
+    "audit.action.login": "Inicio de sesión",
+    "audit.action.login_failed": "Inicio de sesión fallido",
+    "audit.action.logout": "Cierre de sesión",
+    "audit.action.password_changed": "Contraseña cambiada",
+    "audit.action.api_key_created": "Clave de API creada",
+    "audit.action.api_key_removed": "Clave de API eliminada",
+    "audit.action.user_created": "Usuario creado",
+    "audit.action.user_removed": "Usuario eliminado",
+    "audit.action.user_promoted": "Derechos de administrador concedidos",
+    "audit.action.user_demoted": "Derechos de administrador revocados",
+    "audit.action.user_unlocked": "Usuario desbloqueado",
+    "audit.action.feed_removed": "Fuente eliminada",
+    "audit.action.totp_enabled": "Autenticación de dos factores activada",
+    "audit.action.totp_disabled": "Autenticación de dos factores desactivada",
+    "audit.action.webauthn_registered": "Llave de acceso registrada",
+    "audit.action.webauthn_removed": "Llave de acceso eliminada",
+    "audit.action.session_removed": "Sesión eliminada",
     "page.api_keys.title": "Claves API",
     "page.api_keys.table.description": "Descripción",
     "page.api_keys.table.token": "simbólico",
@@ -918,12 +1373,15 @@ var translations = map[string]string{
     "alert.no_history": "No hay historial en este momento.",
     "alert.feed_error": "Hay un problema con esta fuente.",
     "alert.no_search_result": "No hay resultados para esta búsqueda.",
+    "alert.no_tag_entry": "No hay artículos con esta etiqueta.",
     "alert.no_unread_entry": "No hay artículos sin leer.",
     "alert.no_user": "Eres el unico usuario.",
+    "alert.no_audit_event": "No hay ningún evento.",
     "alert.account_unlinked": "¡Tu cuenta externa ya está desvinculada!",
     "alert.account_linked": "¡Tu cuenta externa ya está vinculada!",
     "alert.pocket_linked": "¡Tu cuenta de Pocket ya está vinculada!",
     "alert.prefs_saved": "¡Las preferencias se han guardado!",
+    "alert.totp_disabled": "La autenticación de dos factores está ahora desactivada.",
     "error.unlink_account_without_password": "Debe definir una contraseña, de lo contrario no podrá volver a iniciar sesión.",
     "error.duplicate_linked_account": "¡Ya hay alguien asociado a este servicio!",
     "error.duplicate_fever_username": "¡Ya hay alguien con el mismo nombre de usuario de Fever!",
@@ -938,7 +1396,15 @@ var translations = map[string]string{
     "error.unable_to_update_feed": "Incapaz de actualizar esta fuente.",
     "error.subscription_not_found": "Incapaz de encontrar ninguna suscripción.",
     "error.empty_file": "Este archivo está vacío.",
+    "error.file_too_large": "Este archivo es demasiado grande (50 MB como máximo).",
+    "error.unsupported_import_format": "Este formato de archivo no es compatible.",
+    "error.invalid_opml_source_url": "URL de la lista no válida.",
+    "error.opml_source_already_exists": "Ya está suscrito a esta lista.",
     "error.bad_credentials": "Usuario o contraseña no válido.",
+    "error.too_many_login_attempts": "Demasiados intentos de inicio de sesión fallidos, inténtelo de nuevo más tarde.",
+    "error.invalid_totp_code": "Código de autenticación de dos factores no válido.",
+    "error.totp_code_required": "El código es obligatorio.",
+    "error.webauthn_failed": "La operación con su llave de seguridad o passkey falló o fue cancelada.",
     "error.fields_mandatory": "Todos los campos son obligatorios.",
     "error.title_required": "El título es obligatorio.",
     "error.different_passwords": "Las contraseñas no son las mismas.",
@@ -946,6 +1412,10 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "Los campos de nombre de usuario, tema, idioma y zona horaria son obligatorios.",
     "error.entries_per_page_invalid": "El número de entradas por página no es válido.",
     "error.feed_mandatory_fields": "Los campos de URL y categoría son obligatorios.",
+    "error.newsletter_mandatory_fields": "El título y la categoría son obligatorios.",
+    "error.scraped_feed_mandatory_fields": "La URL de la página, la categoría y el selector de elementos son obligatorios.",
+    "error.scraped_feed_item_selector_not_empty": "El selector de elementos no puede estar vacío.",
+    "error.watch_page_mandatory_fields": "La URL de la página y la categoría son obligatorias.",
     "error.feed_already_exists": "Este feed ya existe.",
     "error.invalid_feed_url": "URL de feed no válida.",
     "error.invalid_site_url": "URL del sitio no válida.",
@@ -972,14 +1442,28 @@ var translations = map[string]string{
     "form.feed.label.rewrite_rules": "Reglas de reescribir",
     "form.feed.label.blocklist_rules": "Reglas de Filtrado(Bloquear)",
     "form.feed.label.keeplist_rules": "Reglas de Filtrado(Permitir)",
+    "form.feed.label.watch_selector": "Parte vigilada de la página (selector CSS)",
+    "form.feed.help.watch_selector": "Déjelo vacío para vigilar toda la página. Úselo para ignorar las partes que cambian en cada visita.",
     "form.feed.label.ignore_http_cache": "Ignorar caché HTTP",
     "form.feed.label.fetch_via_proxy": "Buscar a través de proxy",
     "form.feed.label.disabled": "No actualice este feed",
+    "form.newsletter.label.address": "Dirección de correo del boletín",
+    "form.scraped_feed.label.page_url": "URL de la página web",
+    "form.scraped_feed.legend.selectors": "Selectores CSS",
+    "form.scraped_feed.label.item": "Elemento",
+    "form.scraped_feed.label.title": "Título",
+    "form.scraped_feed.label.link": "Enlace",
+    "form.scraped_feed.label.date": "Fecha",
+    "form.scraped_feed.label.content": "Contenido",
+    "form.scraped_feed.help": "Sin selector de enlace, se usa el primer enlace del elemento. Sin selector de título, se usa el texto del enlace. Sin selector de contenido, se usa todo el elemento.",
     "form.category.label.title": "Título",
     "form.user.label.username": "Nombre de usuario",
     "form.user.label.password": "Contraseña",
     "form.user.label.confirmation": "Confirmación de contraseña",
     "form.user.label.admin": "Administrador",
+    "form.totp.label.code": "Código de autenticación",
+    "form.totp.label.code_or_recovery_code": "Código de autenticación o de recuperación",
+    "form.webauthn.label.name": "Nombre",
     "form.prefs.label.language": "Idioma",
     "form.prefs.label.timezone": "Zona horaria",
     "form.prefs.label.theme": "Tema",
@@ -989,10 +1473,16 @@ var translations = map[string]string{
     "form.prefs.select.recent_first": "Entradas recientes primero",
     "form.prefs.label.keyboard_shortcuts": "Habilitar atajos de teclado",
     "form.prefs.label.entry_swipe": "Habilitar el gesto de deslizar el dedo en las entradas en el móvil",
+    "form.prefs.label.archive_starred": "Guardar una copia local de los artículos marcados",
     "form.prefs.label.show_reading_time": "Mostrar el tiempo estimado de lectura de los artículos",
     "form.prefs.label.custom_css": "CSS personalizado",
     "form.import.label.file": "Archivo OPML",
     "form.import.label.url": "URL",
+    "form.import.label.apply_settings": "Aplicar la configuración de Miniflux de las fuentes (reglas, agente de usuario, rastreador, proxy)",
+    "form.import.label.validate": "Comprobar cada fuente antes de suscribirse (más lento)",
+    "form.import.help.file": "Se admiten archivos OPML, exportaciones JSON de Google Reader (Feedly, Inoreader), exportaciones XML de Tiny Tiny RSS y archivos ZIP de FreshRSS.",
+    "form.opml_source.label.url": "URL de la lista OPML",
+    "form.opml_source.label.disable_removed": "Desactivar las fuentes eliminadas de la lista",
     "form.integration.fever_activate": "Activar API de Fever",
     "form.integration.fever_username": "Nombre de usuario de Fever",
     "form.integration.fever_password": "Contraseña de Fever",
@@ -1056,15 +1546,22 @@ var translations = map[string]string{
     "confirm.loading": "En cours...",
     "action.subscribe": "S'abonner",
     "action.save": "Sauvegarder",
+    "action.preview": "Aperçu",
     "action.or": "ou",
     "action.cancel": "annuler",
     "action.remove": "Supprimer",
+    "action.filter": "Filtrer",
+    "action.unlock": "Déverrouiller",
     "action.remove_feed": "Supprimer ce flux",
     "action.update": "Mettre à jour",
+    "action.refresh_archive": "Actualiser l'archive",
     "action.edit": "Modifier",
     "action.download": "Télécharger",
     "action.import": "Importer",
     "action.login": "Se connecter",
+    "action.enable_totp": "Activer l'authentification à deux facteurs",
+    "action.disable_totp": "Désactiver l'authentification à deux facteurs",
+    "action.register_webauthn": "Enregistrer une clé de sécurité ou une clé d'accès",
     "action.home_screen": "Ajouter à l'écran d'accueil",
     "tooltip.keyboard_shortcuts": "Raccourci clavier : %s",
     "tooltip.logged_user": "Connecté en tant que %s",
@@ -1078,12 +1575,18 @@ var translations = map[string]string{
     "menu.preferences": "Préférences",
     "menu.integrations": "Intégrations",
     "menu.sessions": "Sessions",
+    "menu.totp": "Authentification à deux facteurs",
+    "menu.webauthn": "Clés d'accès",
+    "menu.security_activity": "Activité de sécurité",
+    "menu.audit": "Journal d'audit",
     "menu.users": "Utilisateurs",
     "menu.about": "A propos",
     "menu.export": "Export",
     "menu.import": "Import",
+    "menu.opml_sources": "Listes distantes",
     "menu.create_category": "Créer une catégorie",
     "menu.mark_page_as_read": "Marquer cette page comme lu",
+    "menu.export_epub": "Exporter en EPUB",
     "menu.mark_all_as_read": "Tout marquer comme lu",
     "menu.show_all_entries": "Afficher tous les articles",
     "menu.show_only_unread_entries": "Afficher uniquement les articles non lus",
@@ -1119,6 +1622,8 @@ var translations = map[string]string{
     "entry.save.toast.completed": "Article sauvegardé",
     "entry.scraper.label": "Télécharger",
     "entry.scraper.title": "Récupérer le contenu original",
+    "entry.archive.label": "Archive",
+    "entry.archive.title": "Conserver une copie locale de l'article",
     "entry.scraper.completed": "Terminé !",
     "entry.external_link.label": "Lien externe",
     "entry.comments.label": "Commentaires",
@@ -1132,6 +1637,7 @@ var translations = map[string]string{
         "%d minute de lecture",
         "%d minutes de lecture"
     ],
+    "entry.watch_page.title": "%s a été modifiée",
     "page.shared_entries.title": "Articles partagés",
     "page.unread.title": "Non lus",
     "page.starred.title": "Favoris",
@@ -1144,6 +1650,17 @@ var translations = map[string]string{
         "Il y a %d abonnements."
     ],
     "page.new_category.title": "Nouvelle catégorie",
+    "page.new_newsletter.title": "Nouvelle newsletter",
+    "page.new_newsletter.help": "Une adresse email unique va être générée. Utilisez-la pour vous abonner à une newsletter et chaque email reçu apparaîtra comme un nouvel article.",
+    "page.new_scraped_feed.title": "Nouvel abonnement à partir d'une page web",
+    "page.new_scraped_feed.help": "Pour les sites web sans flux : chaque élément trouvé par le sélecteur d'élément devient un article. Les autres sélecteurs sont appliqués à l'intérieur de chaque élément.",
+    "page.new_scraped_feed.preview": [
+        "%d article trouvé",
+        "%d articles trouvés"
+    ],
+    "page.new_scraped_feed.content": "Contenu",
+    "page.new_watch_page.title": "Surveiller une page web",
+    "page.new_watch_page.help": "La page est vérifiée comme les autres abonnements et un nouvel article montrant les différences est créé à chaque fois que son texte change.",
     "page.new_user.title": "Nouvel Utilisateur",
     "page.edit_category.title": "Modification de la catégorie : %s",
     "page.edit_user.title": "Modification de l'utilisateur : %s",
@@ -1157,7 +1674,40 @@ var translations = map[string]string{
     ],
     "page.history.title": "Historique",
     "page.import.title": "Importation",
+    "page.import.report": "Importation terminée : %d abonnements, %d catégories et %d articles (dont %d favoris) créés, %d articles ignorés.",
+    "page.import_job.title": "Résultats de l'importation",
+    "page.import_job.running": "L'importation est en cours : %d abonnements traités sur %d.",
+    "page.import_job.running_items": "L'importation est en cours : %d éléments traités sur %d.",
+    "page.import_job.refresh": "Actualiser",
+    "page.import_job.finished": "L'importation est terminée : %d abonnements créés, %d doublons, %d inaccessibles, %d erreurs d'analyse, %d autres erreurs.",
+    "page.import_job.failed": "L'importation a échoué : %s",
+    "page.import_job.table.feed": "Abonnement",
+    "page.import_job.table.category": "Catégorie",
+    "page.import_job.table.result": "Résultat",
+    "page.import_job.status.created": "Créé",
+    "page.import_job.status.duplicate": "Déjà abonné",
+    "page.import_job.status.unreachable": "Inaccessible",
+    "page.import_job.status.parse_error": "Flux invalide",
+    "page.import_job.status.error": "Erreur",
+    "page.opml_sources.title": "Listes OPML distantes",
+    "page.opml_sources.help": "La liste distante est récupérée périodiquement et ses nouveaux abonnements sont ajoutés à la catégorie choisie.",
+    "page.opml_sources.no_source": "Vous n'êtes abonné à aucune liste distante.",
+    "page.opml_sources.new": "S'abonner à une liste distante",
+    "page.opml_sources.table.url": "Liste",
+    "page.opml_sources.table.category": "Catégorie",
+    "page.opml_sources.table.feeds": "Abonnements",
+    "page.opml_sources.table.checked_at": "Dernière vérification",
+    "page.opml_source.title": "Liste OPML distante",
+    "page.opml_source.never_checked": "Jamais",
+    "page.opml_source.error": "Dernière erreur",
+    "page.opml_source.disable_removed": "Les abonnements retirés de la liste sont désactivés.",
+    "page.opml_source.history": "Historique",
+    "page.opml_source.no_history": "Les synchronisations n'ont encore modifié aucun abonnement.",
+    "page.opml_source.change.added": "Ajouté",
+    "page.opml_source.change.disabled": "Désactivé",
+    "page.opml_source.change.enabled": "Réactivé",
     "page.search.title": "Résultats de la recherche",
+    "page.tag_entries.title": "Étiquette : %s",
     "page.about.title": "A propos",
     "page.about.credits": "Crédits",
     "page.about.version": "Version :",
@@ -1171,6 +1721,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "Trouver un abonnement",
     "page.add_feed.legend.advanced_options": "Options avancées",
     "page.add_feed.choose_feed": "Choisissez un abonnement",
+    "page.add_feed.newsletter": "Recevoir plutôt une newsletter par email",
+    "page.add_feed.scraped_feed": "Pas de flux sur ce site ? Extraire plutôt les articles d'une page web",
+    "page.add_feed.watch_page": "Surveiller plutôt les modifications d'une page web",
     "page.edit_feed.title": "Modification de l'abonnement : %s",
     "page.edit_feed.last_check": "Dernière vérification :",
     "page.edit_feed.last_modified_header": "En-tête LastModified :",
@@ -1178,6 +1731,14 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "Aucune",
     "page.edit_feed.last_parsing_error": "Dernière erreur d'analyse",
     "page.entry.attachments": "Pièces Jointes",
+    "page.entry.podcast.season": "Saison %d",
+    "page.entry.podcast.episode": "Épisode %s",
+    "page.entry.podcast.chapters": "Chapitres",
+    "page.entry.podcast.transcript": "Transcription",
+    "page.entry.podcast.transcripts": "Transcriptions",
+    "page.entry.podcast.captions": "sous-titres",
+    "page.entry.podcast.persons": "Personnes",
+    "page.entry.podcast.funding": "Soutenir ce podcast :",
     "page.keyboard_shortcuts.title": "Raccourcis clavier",
     "page.keyboard_shortcuts.subtitle.sections": "Naviguation entre les sections",
     "page.keyboard_shortcuts.subtitle.items": "Naviguation entre les éléments",
@@ -1218,14 +1779,24 @@ var translations = map[string]string{
     "page.users.actions": "Actions",
     "page.users.last_login": "Dernière connexion",
     "page.users.is_admin": "Administrateur",
+    "page.users.locked": "Verrouillé après trop d'échecs de connexion",
     "page.settings.title": "Réglages",
     "page.settings.link_google_account": "Associer mon compte Google",
     "page.settings.unlink_google_account": "Dissocier mon compte Google",
     "page.settings.link_oidc_account": "Associer mon compte OpenID Connect",
     "page.settings.unlink_oidc_account": "Dissocier mon compte OpenID Connect",
+    "page.settings.link_github_account": "Associer mon compte GitHub",
+    "page.settings.unlink_github_account": "Dissocier mon compte GitHub",
+    "page.settings.link_gitlab_account": "Associer mon compte GitLab",
+    "page.settings.unlink_gitlab_account": "Dissocier mon compte GitLab",
     "page.login.title": "Connexion",
     "page.login.google_signin": "Se connecter avec Google",
     "page.login.oidc_signin": "Se connecter avec OpenID Connect",
+    "page.login.github_signin": "Se connecter avec GitHub",
+    "page.login.gitlab_signin": "Se connecter avec GitLab",
+    "page.login.webauthn_signin": "Se connecter avec une clé d'accès",
+    "page.login_totp.title": "Authentification à deux facteurs",
+    "page.login_totp.help": "Saisissez le code affiché par votre application d'authentification ou l'un de vos codes de récupération.",
     "page.integrations.title": "Intégrations",
     "page.integration.miniflux_api": "API de Miniflux",
     "page.integration.miniflux_api_endpoint": "Point de terminaison de l'API",
@@ -1237,11 +1808,58 @@ var translations = map[string]string{
     "page.integration.bookmarklet.instructions": "Glisser-déposer ce lien dans vos favoris.",
     "page.integration.bookmarklet.help": "Ce lien spécial vous permet de vous abonner à un site web directement en utilisant un marque page dans votre navigateur web.",
     "page.sessions.title": "Sessions",
+    "page.totp.title": "Authentification à deux facteurs",
+    "page.totp.enroll_help": "Scannez ce QR code avec une application d'authentification, puis saisissez le code affiché pour confirmer.",
+    "page.totp.qr_code": "QR code",
+    "page.totp.secret": "Clé secrète :",
+    "page.totp.recovery_codes_help": "Conservez ces codes de récupération en lieu sûr. Chaque code peut être utilisé une fois pour vous connecter si vous perdez l'accès à votre application d'authentification. Ils ne seront plus affichés.",
+    "page.totp.enabled": [
+        "L'authentification à deux facteurs est activée. Il reste %d code de récupération.",
+        "L'authentification à deux facteurs est activée. Il reste %d codes de récupération."
+    ],
+    "page.webauthn.title": "Clés de sécurité et clés d'accès",
+    "page.webauthn.help": "Les clés de sécurité et les clés d'accès permettent de se connecter sans saisir son mot de passe.",
+    "page.webauthn.unsupported": "Votre navigateur ne prend pas en charge les clés de sécurité et les clés d'accès.",
+    "page.webauthn.never_used": "Jamais utilisée",
+    "page.webauthn.table.name": "Nom",
+    "page.webauthn.table.last_used_at": "Dernière utilisation",
+    "page.webauthn.table.created_at": "Date de création",
+    "page.webauthn.table.actions": "Actions",
     "page.sessions.table.date": "Date",
     "page.sessions.table.ip": "Adresse IP",
     "page.sessions.table.user_agent": "Navigateur Web",
     "page.sessions.table.actions": "Actions",
     "page.sessions.table.current_session": "Session actuelle",
+    "page.security_activity.title": "Activité de sécurité récente",
+    "page.entry_archive.created_at": "Archive créée",
+    "page.entry_archive.pending": "Une nouvelle archive est en cours de création, rechargez la page dans un instant.",
+    "page.entry_archive.unavailable": "Trop d'archives sont en cours de création, réessayez plus tard.",
+    "page.audit.title": "Journal d'audit",
+    "page.audit.date": "Date",
+    "page.audit.action": "Action",
+    "page.audit.actor": "Effectué par",
+    "page.audit.target": "Détails",
+    "page.audit.client_ip": "Adresse IP",
+    "page.audit.user": "Utilisateur",
+    "page.audit.all_users": "Tous les utilisateurs",
+    "page.audit.all_actions": "Toutes les actions",
+    "audit.action.login": "Connexion",
+    "audit.action.login_failed": "Échec de connexion",
+    "audit.action.logout": "Déconnexion",
+    "audit.action.password_changed": "Mot de passe modifié",
+    "audit.action.api_key_created": "Clé d'API créée",
+    "audit.action.api_key_removed": "Clé d'API supprimée",
+    "audit.action.user_created": "Utilisateur créé",
+    "audit.action.user_removed": "Utilisateur supprimé",
+    "audit.action.user_promoted": "Droits d'administrateur accordés",
+    "audit.action.user_demoted": "Droits d'administrateur retirés",
+    "audit.action.user_unlocked": "Utilisateur déverrouillé",
+    "audit.action.feed_removed": "Abonnement supprimé",
+    "audit.action.totp_enabled": "Authentification à deux facteurs activée",
+    "audit.action.totp_disabled": "Authentification à deux facteurs désactivée",
+    "audit.action.webauthn_registered": "Clé d'accès enregistrée",
+    "audit.action.webauthn_removed": "Clé d'accès supprimée",
+    "audit.action.session_removed": "Session supprimée",
     "page.api_keys.title": "Clés d'API",
     "page.api_keys.table.description": "Description",
     "page.api_keys.table.token": "Jeton",
@@ -1260,12 +1878,15 @@ var translations = map[string]string{
     "alert.no_history": "Il n'y a aucun historique pour le moment.",
     "alert.feed_error": "Il y a un problème avec cet abonnement",
     "alert.no_search_result": "Il n'y a aucun résultat pour cette recherche.",
+    "alert.no_tag_entry": "Il n'y a aucun article avec cette étiquette.",
     "alert.no_unread_entry": "Il n'y a rien de nouveau à lire.",
     "alert.no_user": "Vous êtes le seul utilisateur.",
+    "alert.no_audit_event": "Il n'y a aucun événement.",
     "alert.account_unlinked": "Votre compte externe est maintenant dissocié !",
     "alert.account_linked": "Votre compte externe est maintenant associé !",
     "alert.pocket_linked": "Votre compte Pocket est maintenant connecté !",
     "alert.prefs_saved": "Préférences sauvegardées !",
+    "alert.totp_disabled": "L'authentification à deux facteurs est maintenant désactivée.",
     "error.unlink_account_without_password": "Vous devez définir un mot de passe sinon vous ne pourrez plus vous connecter par la suite.",
     "error.duplicate_linked_account": "Il y a déjà quelqu'un d'associé avec ce provider !",
     "error.duplicate_fever_username": "Il y a déjà quelqu'un d'autre avec le même nom d'utilisateur Fever !",
@@ -1280,7 +1901,15 @@ var translations = map[string]string{
     "error.unable_to_update_feed": "Impossible de mettre à jour cet abonnement.",
     "error.subscription_not_found": "Impossible de trouver un abonnement.",
     "error.empty_file": "Ce fichier est vide.",
+    "error.file_too_large": "Ce fichier est trop volumineux (50 Mo maximum).",
+    "error.unsupported_import_format": "Ce format de fichier n'est pas supporté.",
+    "error.invalid_opml_source_url": "L'URL de la liste n'est pas valide.",
+    "error.opml_source_already_exists": "Vous êtes déjà abonné à cette liste.",
     "error.bad_credentials": "Mauvais identifiant ou mot de passe.",
+    "error.too_many_login_attempts": "Trop de tentatives de connexion échouées, veuillez réessayer plus tard.",
+    "error.invalid_totp_code": "Code d'authentification à deux facteurs invalide.",
+    "error.totp_code_required": "Le code est obligatoire.",
+    "error.webauthn_failed": "L'opération avec votre clé de sécurité ou clé d'accès a échoué ou a été annulée.",
     "error.fields_mandatory": "Tous les champs sont obligatoire.",
     "error.title_required": "Le titre est obligatoire.",
     "error.different_passwords": "Les mots de passe ne sont pas les mêmes.",
@@ -1288,6 +1917,10 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "Le nom d'utilisateur, le thème, la langue et le fuseau horaire sont obligatoire.",
     "error.entries_per_page_invalid": "Le nombre d'entrées par page n'est pas valide.",
     "error.feed_mandatory_fields": "L'URL et la catégorie sont obligatoire.",
+    "error.newsletter_mandatory_fields": "Le titre et la catégorie sont obligatoires.",
+    "error.scraped_feed_mandatory_fields": "L'URL de la page, la catégorie et le sélecteur d'élément sont obligatoires.",
+    "error.scraped_feed_item_selector_not_empty": "Le sélecteur d'élément ne peut pas être vide.",
+    "error.watch_page_mandatory_fields": "L'URL de la page et la catégorie sont obligatoires.",
     "error.feed_already_exists": "Ce flux existe déjà.",
     "error.invalid_feed_url": "URL de flux non valide.",
     "error.invalid_site_url": "URL de site non valide.",
@@ -1314,14 +1947,28 @@ var translations = map[string]string{
     "form.feed.label.rewrite_rules": "Règles de réécriture",
     "form.feed.label.blocklist_rules": "Règles de blocage",
     "form.feed.label.keeplist_rules": "Règles d'autorisation",
+    "form.feed.label.watch_selector": "Partie surveillée de la page (sélecteur CSS)",
+    "form.feed.help.watch_selector": "Laisser vide pour surveiller toute la page. Permet d'ignorer les parties qui changent à chaque visite.",
     "form.feed.label.ignore_http_cache": "Ignore cache HTTP",
     "form.feed.label.fetch_via_proxy": "Récupérer via proxy",
     "form.feed.label.disabled": "Ne pas actualiser ce flux",
+    "form.newsletter.label.address": "Adresse email de la newsletter",
+    "form.scraped_feed.label.page_url": "URL de la page web",
+    "form.scraped_feed.legend.selectors": "Sélecteurs CSS",
+    "form.scraped_feed.label.item": "Élément",
+    "form.scraped_feed.label.title": "Titre",
+    "form.scraped_feed.label.link": "Lien",
+    "form.scraped_feed.label.date": "Date",
+    "form.scraped_feed.label.content": "Contenu",
+    "form.scraped_feed.help": "Sans sélecteur de lien, le premier lien de l'élément est utilisé. Sans sélecteur de titre, le texte du lien est utilisé. Sans sélecteur de contenu, l'élément entier est utilisé.",
     "form.category.label.title": "Titre",
     "form.user.label.username": "Nom d'utilisateur",
     "form.user.label.password": "Mot de passe",
     "form.user.label.confirmation": "Confirmation du mot de passe",
     "form.user.label.admin": "Administrateur",
+    "form.totp.label.code": "Code d'authentification",
+    "form.totp.label.code_or_recovery_code": "Code d'authentification ou de récupération",
+    "form.webauthn.label.name": "Nom",
     "form.prefs.label.language": "Langue",
     "form.prefs.label.timezone": "Fuseau horaire",
     "form.prefs.label.theme": "Thème",
@@ -1331,10 +1978,16 @@ var translations = map[string]string{
     "form.prefs.select.recent_first": "Éléments récents en premier",
     "form.prefs.label.keyboard_shortcuts": "Activer les raccourcis clavier",
     "form.prefs.label.entry_swipe": "Activer le geste de balayage sur les entrées sur mobile",
+    "form.prefs.label.archive_starred": "Conserver une copie locale des articles favoris",
     "form.prefs.label.show_reading_time": "Afficher le temps de lecture estimé des articles",
     "form.prefs.label.custom_css": "CSS personnalisé",
     "form.import.label.file": "Fichier OPML",
     "form.import.label.url": "URL",
+    "form.import.label.apply_settings": "Appliquer les paramètres Miniflux des abonnements (règles, agent utilisateur, robot, proxy)",
+    "form.import.label.validate": "Vérifier chaque abonnement avant de l'ajouter (plus lent)",
+    "form.import.help.file": "Les fichiers OPML, les exports JSON au format Google Reader (Feedly, Inoreader), les exports XML de Tiny Tiny RSS et les archives ZIP de FreshRSS sont supportés.",
+    "form.opml_source.label.url": "URL de la liste OPML",
+    "form.opml_source.label.disable_removed": "Désactiver les abonnements retirés de la liste",
     "form.integration.fever_activate": "Activer l'API de Fever",
     "form.integration.fever_username": "Nom d'utilisateur pour l'API de Fever",
     "form.integration.fever_password": "Mot de passe pour l'API de Fever",
@@ -1406,6 +2059,7 @@ var translations = map[string]string{
     "This website is temporarily unreachable (original error: %q)": "Ce site web est temporairement injoignable (erreur originale : %q)",
     "This website is permanently unreachable (original error: %q)": "Ce site web n'est pas joignable de façon permanente (erreur originale : %q)",
     "Website unreachable, the request timed out after %d seconds": "Site web injoignable, la requête à échouée après %d secondes",
+    "This website is hosted on a private or forbidden network address (%s)": "Ce site web est hébergé sur une adresse réseau privée ou interdite (%s)",
     "You are not authorized to access this resource (invalid username/password)": "Vous n'êtes pas autorisé à accéder à cette ressource (nom d'utilisateur / mot de passe incorrect)",
     "Unable to fetch this resource (Status Code = %d)": "Impossible de récupérer cette ressource (code=%d)",
     "Resource not found (404), this feed doesn't exists anymore, check the feed URL": "Page introuvable (404), cet abonnement n'existe plus, vérifiez l'adresse du flux"
@@ -1418,15 +2072,22 @@ var translations = map[string]string{
     "confirm.loading": "In corso...",
     "action.subscribe": "Abbonati",
     "action.save": "Salva",
+    "action.preview": "Anteprima",
     "action.or": "o",
     "action.cancel": "cancella",
     "action.remove": "Elimina",
+    "action.filter": "Filtra",
+    "action.unlock": "Sblocca",
     "action.remove_feed": "Elimina questo feed",
     "action.update": "Aggiorna",
+    "action.refresh_archive": "Aggiorna l'istantanea",
     "action.edit": "Modifica",
     "action.download": "Scarica",
     "action.import": "Importa",
     "action.login": "Accedi",
+    "action.enable_totp": "Attiva l'autenticazione a due fattori",
+    "action.disable_totp": "Disattiva l'autenticazione a due fattori",
+    "action.register_webauthn": "Registra una chiave di sicurezza o passkey",
     "action.home_screen": "Aggiungere alla schermata Home",
     "tooltip.keyboard_shortcuts": "Scorciatoia da tastiera: %s",
     "tooltip.logged_user": "Autenticato come %s",
@@ -1440,12 +2101,18 @@ var translations = map[string]string{
     "menu.preferences": "Preferenze",
     "menu.integrations": "Integrazioni",
     "menu.sessions": "Sessioni",
+    "menu.totp": "Autenticazione a due fattori",
+    "menu.webauthn": "Passkey",
+    "menu.security_activity": "Attività di sicurezza",
+    "menu.audit": "Registro di controllo",
     "menu.users": "Utenti",
     "menu.about": "Informazioni",
     "menu.export": "Esporta",
     "menu.import": "Importa",
+    "menu.opml_sources": "Liste remote",
     "menu.create_category": "Aggiungi una categoria",
     "menu.mark_page_as_read": "Segna questa pagina come letta",
+    "menu.export_epub": "Esporta in EPUB",
     "menu.mark_all_as_read": "Segna tutti gli articoli come letti",
     "menu.show_all_entries": "Mostra tutte le voci",
     "menu.show_only_unread_entries": "Mostra solo voci non lette",
@@ -1481,6 +2148,8 @@ var translations = map[string]string{
     "entry.save.toast.completed": "Articolo salvato",
     "entry.scraper.label": "Scarica",
     "entry.scraper.title": "Scarica il contenuto integrale",
+    "entry.archive.label": "Istantanea",
+    "entry.archive.title": "Conserva una copia locale dell'articolo",
     "entry.scraper.completed": "Fatto!",
     "entry.external_link.label": "Link esterno",
     "entry.comments.label": "Commenti",
@@ -1494,6 +2163,7 @@ var translations = map[string]string{
         "%d minuto di lettura",
         "%d minuti di lettura"
     ],
+    "entry.watch_page.title": "%s è cambiata",
     "page.shared_entries.title": "Voci condivise",
     "page.unread.title": "Da leggere",
     "page.starred.title": "Preferiti",
@@ -1506,6 +2176,17 @@ var translations = map[string]string{
         "Ci sono %d feed."
     ],
     "page.new_category.title": "Nuova categoria",
+    "page.new_newsletter.title": "Nuova newsletter",
+    "page.new_newsletter.help": "Verrà generato un indirizzo email univoco. Usalo per iscriverti a una newsletter e ogni email ricevuta apparirà come un nuovo articolo.",
+    "page.new_scraped_feed.title": "Nuovo feed da una pagina web",
+    "page.new_scraped_feed.help": "Per i siti web senza feed: ogni elemento trovato dal selettore degli elementi diventa un articolo. Gli altri selettori vengono applicati all'interno di ogni elemento.",
+    "page.new_scraped_feed.preview": [
+        "%d articolo trovato",
+        "%d articoli trovati"
+    ],
+    "page.new_scraped_feed.content": "Contenuto",
+    "page.new_watch_page.title": "Monitora una pagina web",
+    "page.new_watch_page.help": "La pagina viene controllata come gli altri feed e ogni volta che il suo testo cambia viene creato un nuovo articolo con le differenze.",
     "page.new_user.title": "Nuovo utente",
     "page.edit_category.title": "Modifica categoria: %s",
     "page.edit_user.title": "Modifica utente: %s",
@@ -1519,7 +2200,40 @@ var translations = map[string]string{
     ],
     "page.history.title": "Cronologia",
     "page.import.title": "Importa",
+    "page.import.report": "Importazione completata: %d feed, %d categorie e %d articoli (%d preferiti) creati, %d articoli ignorati.",
+    "page.import_job.title": "Risultati dell'importazione",
+    "page.import_job.running": "Importazione in corso: %d di %d abbonamenti elaborati.",
+    "page.import_job.running_items": "L'importazione è in corso: %d elementi su %d elaborati.",
+    "page.import_job.refresh": "Aggiorna",
+    "page.import_job.finished": "Importazione completata: %d feed creati, %d duplicati, %d irraggiungibili, %d errori di analisi, %d altri errori.",
+    "page.import_job.failed": "L'importazione non è riuscita: %s",
+    "page.import_job.table.feed": "Feed",
+    "page.import_job.table.category": "Categoria",
+    "page.import_job.table.result": "Risultato",
+    "page.import_job.status.created": "Creato",
+    "page.import_job.status.duplicate": "Già iscritto",
+    "page.import_job.status.unreachable": "Irraggiungibile",
+    "page.import_job.status.parse_error": "Feed non valido",
+    "page.import_job.status.error": "Errore",
+    "page.opml_sources.title": "Liste OPML remote",
+    "page.opml_sources.help": "La lista remota viene scaricata periodicamente e i suoi nuovi feed vengono aggiunti alla categoria scelta.",
+    "page.opml_sources.no_source": "Non sei abbonato a nessuna lista remota.",
+    "page.opml_sources.new": "Abbonati a una lista remota",
+    "page.opml_sources.table.url": "Lista",
+    "page.opml_sources.table.category": "Categoria",
+    "page.opml_sources.table.feeds": "Feed",
+    "page.opml_sources.table.checked_at": "Ultimo controllo",
+    "page.opml_source.title": "Lista OPML remota",
+    "page.opml_source.never_checked": "Mai",
+    "page.opml_source.error": "Ultimo errore",
+    "page.opml_source.disable_removed": "I feed rimossi dalla lista vengono disattivati.",
+    "page.opml_source.history": "Cronologia",
+    "page.opml_source.no_history": "Le sincronizzazioni non hanno ancora modificato alcun abbonamento.",
+    "page.opml_source.change.added": "Aggiunto",
+    "page.opml_source.change.disabled": "Disattivato",
+    "page.opml_source.change.enabled": "Riattivato",
     "page.search.title": "Risultati della ricerca",
+    "page.tag_entries.title": "Etichetta: %s",
     "page.about.title": "Informazioni",
     "page.about.credits": "Crediti",
     "page.about.version": "Versione:",
@@ -1533,6 +2247,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "Abbonati al feed",
     "page.add_feed.legend.advanced_options": "Opzioni avanzate",
     "page.add_feed.choose_feed": "Scegli un feed",
+    "page.add_feed.newsletter": "Ricevi invece una newsletter via email",
+    "page.add_feed.scraped_feed": "Nessun feed su questo sito? Estrai invece gli articoli di una pagina web",
+    "page.add_feed.watch_page": "Monitora invece le modifiche di una pagina web",
     "page.edit_feed.title": "Modifica feed: %s",
     "page.edit_feed.last_check": "Ultimo controllo:",
     "page.edit_feed.last_modified_header": "Header LastModified:",
@@ -1540,6 +2257,14 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "Nessun header",
     "page.edit_feed.last_parsing_error": "Ultimo errore di parsing",
     "page.entry.attachments": "Allegati",
+    "page.entry.podcast.season": "Stagione %d",
+    "page.entry.podcast.episode": "Episodio %s",
+    "page.entry.podcast.chapters": "Capitoli",
+    "page.entry.podcast.transcript": "Trascrizione",
+    "page.entry.podcast.transcripts": "Trascrizioni",
+    "page.entry.podcast.captions": "sottotitoli",
+    "page.entry.podcast.persons": "Persone",
+    "page.entry.podcast.funding": "Sostieni questo podcast:",
     "page.keyboard_shortcuts.title": "Scorciatoie da tastiera",
     "page.keyboard_shortcuts.subtitle.sections": "Navigazione sezioni",
     "page.keyboard_shortcuts.subtitle.items": "Navigazione articoli",
@@ -1580,14 +2305,24 @@ var translations = map[string]string{
     "page.users.actions": "Azioni",
     "page.users.last_login": "Ultimo accesso",
     "page.users.is_admin": "Amministratore",
+    "page.users.locked": "Bloccato dopo troppi accessi non riusciti",
     "page.settings.title": "Impostazioni",
     "page.settings.link_google_account": "Collega il mio account Google",
     "page.settings.unlink_google_account": "Scollega il mio account Google",
     "page.settings.link_oidc_account": "Collega il mio account OpenID Connect",
     "page.settings.unlink_oidc_account": "Scollega il mio account OpenID Connect",
+    "page.settings.link_github_account": "Collega il mio account GitHub",
+    "page.settings.unlink_github_account": "Scollega il mio account GitHub",
+    "page.settings.link_gitlab_account": "Collega il mio account GitLab",
+    "page.settings.unlink_gitlab_account": "Scollega il mio account GitLab",
     "page.login.title": "Accedi",
     "page.login.google_signin": "Accedi tramite Google",
     "page.login.oidc_signin": "Accedi tramite OpenID Connect",
+    "page.login.github_signin": "Accedi tramite GitHub",
+    "page.login.gitlab_signin": "Accedi tramite GitLab",
+    "page.login.webauthn_signin": "Accedi con una passkey",
+    "page.login_totp.title": "Autenticazione a due fattori",
+    "page.login_totp.help": "Inserisci il codice mostrato dalla tua app di autenticazione o uno dei tuoi codici di recupero.",
     "page.integrations.title": "Integrazioni",
     "page.integration.miniflux_api": "API di Miniflux",
     "page.integration.miniflux_api_endpoint": "Endpoint dell'API di Miniflux",
@@ -1599,11 +2334,58 @@ var translations = map[string]string{
     "page.integration.bookmarklet.instructions": "Trascina questo collegamento sui tuoi segnalibri.",
     "page.integration.bookmarklet.help": "Questo collegamento speciale ti consente di abbonarti ad un sito web semplicemente usando un segnalibro del tuo browser.",
     "page.sessions.title": "Sessioni",
+    "page.totp.title": "Autenticazione a due fattori",
+    "page.totp.enroll_help": "Scansiona questo codice QR con un'app di autenticazione, poi inserisci il codice mostrato per confermare.",
+    "page.totp.qr_code": "Codice QR",
+    "page.totp.secret": "Chiave segreta:",
+    "page.totp.recovery_codes_help": "Conserva questi codici di recupero in un luogo sicuro. Ogni codice può essere usato una volta per accedere se perdi l'accesso alla tua app di autenticazione. Non verranno mostrati di nuovo.",
+    "page.totp.enabled": [
+        "L'autenticazione a due fattori è attiva. Rimane %d codice di recupero.",
+        "L'autenticazione a due fattori è attiva. Rimangono %d codici di recupero."
+    ],
+    "page.webauthn.title": "Chiavi di sicurezza e passkey",
+    "page.webauthn.help": "Le chiavi di sicurezza e le passkey consentono di accedere senza digitare la password.",
+    "page.webauthn.unsupported": "Il tuo browser non supporta le chiavi di sicurezza e le passkey.",
+    "page.webauthn.never_used": "Mai usata",
+    "page.webauthn.table.name": "Nome",
+    "page.webauthn.table.last_used_at": "Ultimo utilizzo",
+    "page.webauthn.table.created_at": "Data di creazione",
+    "page.webauthn.table.actions": "Azioni",
     "page.sessions.table.date": "Data",
     "page.sessions.table.ip": "Indirizzo IP",
     "page.sessions.table.user_agent": "User Agent",
     "page.sessions.table.actions": "Azioni",
     "page.sessions.table.current_session": "Sessione corrente",
+    "page.security_activity.title": "Attività di sicurezza recente",
+    "page.entry_archive.created_at": "Istantanea acquisita",
+    "page.entry_archive.pending": "È in corso l'acquisizione di una nuova istantanea, ricarica la pagina tra un momento.",
+    "page.entry_archive.unavailable": "Troppe istantanee in corso di acquisizione, riprova più tardi.",
+    "page.audit.title": "Registro di controllo",
+    "page.audit.date": "Data",
+    "page.audit.action": "Azione",
+    "page.audit.actor": "Eseguito da",
+    "page.audit.target": "Dettagli",
+    "page.audit.client_ip": "Indirizzo IP",
+    "page.audit.user": "Utente",
+    "page.audit.all_users": "Tutti gli utenti",
+    "page.audit.all_actions": "Tutte le azioni",
+    "audit.action.login": "Accesso",
+    "audit.action.login_failed": "Accesso non riuscito",
+    "audit.action.logout": "Disconnessione",
+    "audit.action.password_changed": "Password modificata",
+    "audit.action.api_key_created": "Chiave API creata",
+    "audit.action.api_key_removed": "Chiave API rimossa",
+    "audit.action.user_created": "Utente creato",
+    "audit.action.user_removed": "Utente rimosso",
+    "audit.action.user_promoted": "Diritti di amministratore concessi",
+    "audit.action.user_demoted": "Diritti di amministratore revocati",
+    "audit.action.user_unlocked": "Utente sbloccato",
+    "audit.action.feed_removed": "Feed rimosso",
+    "audit.action.totp_enabled": "Autenticazione a due fattori attivata",
+    "audit.action.totp_disabled": "Autenticazione a due fattori disattivata",
+    "audit.action.webauthn_registered": "Passkey registrata",
+    "audit.action.webauthn_removed": "Passkey rimossa",
+    "audit.action.session_removed": "Sessione rimossa",
     "page.api_keys.title": "Chiavi API",
     "page.api_keys.table.description": "Descrizione",
     "page.api_keys.table.token": "Gettone",
@@ -1622,12 +2404,15 @@ var translations = map[string]string{
     "alert.no_history": "La tua cronologia al momento è vuota.",
     "alert.feed_error": "Sembra ci sia un problema con questo feed",
     "alert.no_search_result": "La ricerca non ha prodotto risultati.",
+    "alert.no_tag_entry": "Non ci sono articoli con questa etichetta.",
     "alert.no_unread_entry": "Nessun articolo da leggere.",
     "alert.no_user": "Tu sei l'unico utente.",
+    "alert.no_audit_event": "Non c'è alcun evento.",
     "alert.account_unlinked": "Il tuo account esterno ora è scollegato!",
     "alert.account_linked": "Il tuo account esterno ora è collegato!",
     "alert.pocket_linked": "Il tuo account Pocket ora è collegato!",
     "alert.prefs_saved": "Preferenze salvate!",
+    "alert.totp_disabled": "L'autenticazione a due fattori è ora disattivata.",
     "error.unlink_account_without_password": "Devi scegliere una password altrimenti la prossima volta non riuscirai ad accedere.",
     "error.duplicate_linked_account": "Esiste già un account configurato per questo servizio!",
     "error.duplicate_fever_username": "Esiste già un account Fever con lo stesso nome utente!",
@@ -1642,7 +2427,15 @@ var translations = map[string]string{
     "error.unable_to_update_feed": "Non sono riuscito ad aggiornare questo feed.",
     "error.subscription_not_found": "Non ho trovato nessun feed.",
     "error.empty_file": "Questo file è vuoto.",
+    "error.file_too_large": "Questo file è troppo grande (massimo 50 MB).",
+    "error.unsupported_import_format": "Questo formato di file non è supportato.",
+    "error.invalid_opml_source_url": "URL della lista non valido.",
+    "error.opml_source_already_exists": "Sei già abbonato a questa lista.",
     "error.bad_credentials": "Nome utente o password non validi.",
+    "error.too_many_login_attempts": "Troppi tentativi di accesso non riusciti, riprova più tardi.",
+    "error.invalid_totp_code": "Codice di autenticazione a due fattori non valido.",
+    "error.totp_code_required": "Il codice è obbligatorio.",
+    "error.webauthn_failed": "L'operazione con la chiave di sicurezza o la passkey non è riuscita o è stata annullata.",
     "error.fields_mandatory": "Tutti i campi sono obbligatori.",
     "error.title_required": "Il titolo è obbligatorio.",
     "error.different_passwords": "Le password non coincidono.",
@@ -1650,6 +2443,10 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "Il nome utente, il tema, la lingua ed il fuso orario sono campi obbligatori.",
     "error.entries_per_page_invalid": "Il numero di articoli per pagina non è valido.",
     "error.feed_mandatory_fields": "L'URL e la categoria sono obbligatori.",
+    "error.newsletter_mandatory_fields": "Il titolo e la categoria sono obbligatori.",
+    "error.scraped_feed_mandatory_fields": "L'URL della pagina, la categoria e il selettore degli elementi sono obbligatori.",
+    "error.scraped_feed_item_selector_not_empty": "Il selettore degli elementi non può essere vuoto.",
+    "error.watch_page_mandatory_fields": "L'URL della pagina e la categoria sono obbligatori.",
     "error.feed_already_exists": "Questo feed esiste già.",
     "error.invalid_feed_url": "URL del feed non valido.",
     "error.invalid_site_url": "URL del sito non valido.",
@@ -1676,14 +2473,28 @@ var translations = map[string]string{
     "form.feed.label.rewrite_rules": "Regole di impaginazione del contenuto",
     "form.feed.label.blocklist_rules": "Regole di blocco",
     "form.feed.label.keeplist_rules": "Regole di autorizzazione",
+    "form.feed.label.watch_selector": "Parte monitorata della pagina (selettore CSS)",
+    "form.feed.help.watch_selector": "Lascia vuoto per monitorare l'intera pagina. Usalo per ignorare le parti che cambiano a ogni visita.",
     "form.feed.label.ignore_http_cache": "Ignora cache HTTP",
     "form.feed.label.fetch_via_proxy": "Recuperare tramite proxy",
     "form.feed.label.disabled": "Non aggiornare questo feed",
+    "form.newsletter.label.address": "Indirizzo email della newsletter",
+    "form.scraped_feed.label.page_url": "URL della pagina web",
+    "form.scraped_feed.legend.selectors": "Selettori CSS",
+    "form.scraped_feed.label.item": "Elemento",
+    "form.scraped_feed.label.title": "Titolo",
+    "form.scraped_feed.label.link": "Link",
+    "form.scraped_feed.label.date": "Data",
+    "form.scraped_feed.label.content": "Contenuto",
+    "form.scraped_feed.help": "Senza selettore del link, viene usato il primo link dell'elemento. Senza selettore del titolo, viene usato il testo del link. Senza selettore del contenuto, viene usato l'intero elemento.",
     "form.category.label.title": "Titolo",
     "form.user.label.username": "Nome utente",
     "form.user.label.password": "Password",
     "form.user.label.confirmation": "Conferma password",
     "form.user.label.admin": "Amministratore",
+    "form.totp.label.code": "Codice di autenticazione",
+    "form.totp.label.code_or_recovery_code": "Codice di autenticazione o di recupero",
+    "form.webauthn.label.name": "Nome",
     "form.prefs.label.language": "Lingua",
     "form.prefs.label.timezone": "Fuso orario",
     "form.prefs.label.theme": "Tema",
@@ -1693,10 +2504,16 @@ var translations = map[string]string{
     "form.prefs.select.recent_first": "Prima i più recenti",
     "form.prefs.label.keyboard_shortcuts": "Abilita le scorciatoie da tastiera",
     "form.prefs.label.entry_swipe": "Abilita il gesto di scorrimento sulle voci sul cellulare",
+    "form.prefs.label.archive_starred": "Conserva una copia locale degli articoli preferiti",
     "form.prefs.label.show_reading_time": "Mostra il tempo di lettura stimato per gli articoli",
     "form.prefs.label.custom_css": "CSS personalizzati",
     "form.import.label.file": "File OPML",
     "form.import.label.url": "URL",
+    "form.import.label.apply_settings": "Applica le impostazioni Miniflux dei feed (regole, user agent, crawler, proxy)",
+    "form.import.label.validate": "Verifica ogni feed prima di iscriversi (più lento)",
+    "form.import.help.file": "Sono supportati file OPML, esportazioni JSON di Google Reader (Feedly, Inoreader), esportazioni XML di Tiny Tiny RSS e archivi ZIP di FreshRSS.",
+    "form.opml_source.label.url": "URL della lista OPML",
+    "form.opml_source.label.disable_removed": "Disattiva i feed rimossi dalla lista",
     "form.integration.fever_activate": "Abilita l'API di Fever",
     "form.integration.fever_username": "Nome utente dell'account Fever",
     "form.integration.fever_password": "Password dell'account Fever",
@@ -1760,15 +2577,22 @@ var translations = map[string]string{
     "confirm.loading": "実行中…",
     "action.subscribe": "フィードを購読",
     "action.save": "保存",
+    "action.preview": "プレビュー",
     "action.or": "または",
     "action.cancel": "取り消し",
     "action.remove": "削除",
+    "action.filter": "絞り込む",
+    "action.unlock": "ロック解除",
     "action.remove_feed": "このフィードを削除",
     "action.update": "更新",
+    "action.refresh_archive": "スナップショットを更新",
     "action.edit": "編集",
     "action.download": "ダウンロード",
     "action.import": "インポート",
     "action.login": "ログイン",
+    "action.enable_totp": "二要素認証を有効にする",
+    "action.disable_totp": "二要素認証を無効にする",
+    "action.register_webauthn": "セキュリティキーまたはパスキーを登録",
     "action.home_screen": "ホームスクリーンに追加",
     "tooltip.keyboard_shortcuts": "キーボード・ショートカット: %s",
     "tooltip.logged_user": "%s としてログイン中",
@@ -1782,12 +2606,18 @@ var translations = map[string]string{
     "menu.preferences": "設定情報",
     "menu.integrations": "関連付け",
     "menu.sessions": "セッション",
+    "menu.totp": "二要素認証",
+    "menu.webauthn": "パスキー",
+    "menu.security_activity": "セキュリティアクティビティ",
+    "menu.audit": "監査ログ",
     "menu.users": "ユーザー一覧",
     "menu.about": "ソフトウエア情報",
     "menu.export": "エクスポート",
     "menu.import": "インポート",
+    "menu.opml_sources": "リモートリスト",
     "menu.create_category": "カテゴリを作成",
     "menu.mark_page_as_read": "このページを既読にする",
+    "menu.export_epub": "EPUB にエクスポート",
     "menu.mark_all_as_read": "全て既読にする",
     "menu.show_all_entries": "全ての記事を表示",
     "menu.show_only_unread_entries": "未読の記事だけを表示",
@@ -1823,6 +2653,8 @@ var translations = map[string]string{
     "entry.save.toast.completed": "記事は保存されました",
     "entry.scraper.label": "ダウンロード",
     "entry.scraper.title": "オリジナルの内容を取得",
+    "entry.archive.label": "スナップショット",
+    "entry.archive.title": "記事のローカルコピーを保存する",
     "entry.scraper.completed": "完了!",
     "entry.external_link.label": "外部リンク",
     "entry.comments.label": "コメント",
@@ -1836,6 +2668,7 @@ var translations = map[string]string{
         "%d分で読む",
         "%d分で読む"
     ],
+    "entry.watch_page.title": "%s が変更されました",
     "page.shared_entries.title": "共有エントリ",
     "page.unread.title": "未読",
     "page.starred.title": "星付き",
@@ -1848,6 +2681,17 @@ var translations = map[string]string{
         "%d 個の記事があります。"
     ],
     "page.new_category.title": "新規カテゴリ",
+    "page.new_newsletter.title": "新しいニュースレター",
+    "page.new_newsletter.help": "固有のメールアドレスが生成されます。このアドレスでニュースレターを購読すると、受信したメールが新しい記事として表示されます。",
+    "page.new_scraped_feed.title": "ウェブページから新しいフィード",
+    "page.new_scraped_feed.help": "フィードのないウェブサイト向け：項目セレクタに一致する各要素が記事になります。その他のセレクタは各項目の内部に適用されます。",
+    "page.new_scraped_feed.preview": [
+        "%d 件の記事が見つかりました",
+        "%d 件の記事が見つかりました"
+    ],
+    "page.new_scraped_feed.content": "コンテンツ",
+    "page.new_watch_page.title": "ウェブページを監視",
+    "page.new_watch_page.help": "ページは他のフィードと同様にチェックされ、テキストが変更されるたびに差分を示す新しい記事が作成されます。",
     "page.new_user.title": "新規ユーザー",
     "page.edit_category.title": "カテゴリーを編集: %s",
     "page.edit_user.title": "ユーザーを編集: %s",
@@ -1861,7 +2705,40 @@ var translations = map[string]string{
     ],
     "page.history.title": "履歴",
     "page.import.title": "インポート",
+    "page.import.report": "インポート完了：%d 個のフィード、%d 個のカテゴリ、%d 個の記事（うち星付き %d 個）を作成し、%d 個の記事をスキップしました。",
+    "page.import_job.title": "インポート結果",
+    "page.import_job.running": "インポート中です：%d / %d 件の購読を処理しました。",
+    "page.import_job.running_items": "インポート中です: %d / %d 件を処理しました。",
+    "page.import_job.refresh": "更新",
+    "page.import_job.finished": "インポート完了：%d 個のフィードを作成、重複 %d 個、到達不能 %d 個、解析エラー %d 個、その他のエラー %d 個。",
+    "page.import_job.failed": "インポートに失敗しました：%s",
+    "page.import_job.table.feed": "フィード",
+    "page.import_job.table.category": "カテゴリ",
+    "page.import_job.table.result": "結果",
+    "page.import_job.status.created": "作成済み",
+    "page.import_job.status.duplicate": "購読済み",
+    "page.import_job.status.unreachable": "到達不能",
+    "page.import_job.status.parse_error": "無効なフィード",
+    "page.import_job.status.error": "エラー",
+    "page.opml_sources.title": "リモート OPML リスト",
+    "page.opml_sources.help": "リモートリストは定期的に取得され、新しいフィードは選択したカテゴリに追加されます。",
+    "page.opml_sources.no_source": "リモートリストを購読していません。",
+    "page.opml_sources.new": "リモートリストを購読",
+    "page.opml_sources.table.url": "リスト",
+    "page.opml_sources.table.category": "カテゴリ",
+    "page.opml_sources.table.feeds": "フィード",
+    "page.opml_sources.table.checked_at": "最終チェック",
+    "page.opml_source.title": "リモート OPML リスト",
+    "page.opml_source.never_checked": "なし",
+    "page.opml_source.error": "最後のエラー",
+    "page.opml_source.disable_removed": "リストから削除されたフィードは無効になります。",
+    "page.opml_source.history": "履歴",
+    "page.opml_source.no_history": "同期によって変更された購読はまだありません。",
+    "page.opml_source.change.added": "追加",
+    "page.opml_source.change.disabled": "無効化",
+    "page.opml_source.change.enabled": "再有効化",
     "page.search.title": "検索結果",
+    "page.tag_entries.title": "タグ: %s",
     "page.about.title": "ソフトウエア情報",
     "page.about.credits": "著作権表示",
     "page.about.version": "バージョン:",
@@ -1875,6 +2752,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "購読フィードを探して追加",
     "page.add_feed.legend.advanced_options": "追加の設定",
     "page.add_feed.choose_feed": "購読を選択",
+    "page.add_feed.newsletter": "代わりにメールでニュースレターを受信する",
+    "page.add_feed.scraped_feed": "このサイトにフィードがありませんか？代わりにウェブページから記事を抽出する",
+    "page.add_feed.watch_page": "代わりにウェブページの変更を監視する",
     "page.edit_feed.title": "フィード(%s)を編集",
     "page.edit_feed.last_check": "最終チェック:",
     "page.edit_feed.last_modified_header": "最後に更新されたヘッダー:",
@@ -1882,6 +2762,14 @@ var translations = map[string]string{
     "page.edit_feed.no_header": " なし",
     "page.edit_feed.last_parsing_error": "最新の解析エラー",
     "page.entry.attachments": "添付物",
+    "page.entry.podcast.season": "シーズン %d",
+    "page.entry.podcast.episode": "エピソード %s",
+    "page.entry.podcast.chapters": "チャプター",
+    "page.entry.podcast.transcript": "文字起こし",
+    "page.entry.podcast.transcripts": "文字起こし",
+    "page.entry.podcast.captions": "字幕",
+    "page.entry.podcast.persons": "出演者",
+    "page.entry.podcast.funding": "このポッドキャストを支援:",
     "page.keyboard_shortcuts.title": "キーボード・ショートカット",
     "page.keyboard_shortcuts.subtitle.sections": "セクション 移動",
     "page.keyboard_shortcuts.subtitle.items": "アイテム 移動",
@@ -1922,14 +2810,24 @@ var translations = map[string]string{
     "page.users.actions": "アクション",
     "page.users.last_login": "最終ログイン",
     "page.users.is_admin": "管理者",
+    "page.users.locked": "ログインの失敗が多すぎるためロックされています",
     "page.settings.title": "設定",
     "page.settings.link_google_account": "Google アカウントと接続する",
     "page.settings.unlink_google_account": "Google アカウントと接続を解除する",
     "page.settings.link_oidc_account": "OpenID Connect アカウントと接続する",
     "page.settings.unlink_oidc_account": "OpenID Connect アカウントと接続を解除する",
+    "page.settings.link_github_account": "GitHub アカウントと接続する",
+    "page.settings.unlink_github_account": "GitHub アカウントと接続を解除する",
+    "page.settings.link_gitlab_account": "GitLab アカウントと接続する",
+    "page.settings.unlink_gitlab_account": "GitLab アカウントと接続を解除する",
     "page.login.title": "ログイン",
     "page.login.google_signin": "Google アカウントでログイン",
     "page.login.oidc_signin": "OpenID Connect アカウントでログイン",
+    "page.login.github_signin": "GitHub アカウントでログイン",
+    "page.login.gitlab_signin": "GitLab アカウントでログイン",
+    "page.login.webauthn_signin": "パスキーでログイン",
+    "page.login_totp.title": "二要素認証",
+    "page.login_totp.help": "認証アプリに表示されたコード、またはリカバリーコードのいずれかを入力してください。",
     "page.integrations.title": "関連付け",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "API Endpoint",
@@ -1941,11 +2839,58 @@ var translations = map[string]string{
     "page.integration.bookmarklet.instructions": "このリンクをブラウザのブックマークへドラッグしてください。",
     "page.integration.bookmarklet.help": "この特別なリンクを使ってブラウザから直接ウェブサイトのフィードを購読できます。",
     "page.sessions.title": "セッション",
+    "page.totp.title": "二要素認証",
+    "page.totp.enroll_help": "認証アプリでこの QR コードをスキャンし、表示されたコードを入力して確認してください。",
+    "page.totp.qr_code": "QR コード",
+    "page.totp.secret": "秘密鍵：",
+    "page.totp.recovery_codes_help": "これらのリカバリーコードを安全な場所に保管してください。認証アプリを使えなくなった場合、各コードは一度だけログインに使用できます。再表示はされません。",
+    "page.totp.enabled": [
+        "二要素認証は有効です。残りのリカバリーコード：%d",
+        "二要素認証は有効です。残りのリカバリーコード：%d"
+    ],
+    "page.webauthn.title": "セキュリティキーとパスキー",
+    "page.webauthn.help": "セキュリティキーやパスキーを使うと、パスワードを入力せずにログインできます。",
+    "page.webauthn.unsupported": "お使いのブラウザはセキュリティキーとパスキーに対応していません。",
+    "page.webauthn.never_used": "未使用",
+    "page.webauthn.table.name": "名前",
+    "page.webauthn.table.last_used_at": "最終使用日時",
+    "page.webauthn.table.created_at": "作成日時",
+    "page.webauthn.table.actions": "操作",
     "page.sessions.table.date": "日付",
     "page.sessions.table.ip": "IP アドレス",
     "page.sessions.table.user_agent": "User Agent",
     "page.sessions.table.actions": "アクション",
     "page.sessions.table.current_session": "現在のセッション",
+    "page.security_activity.title": "最近のセキュリティアクティビティ",
+    "page.entry_archive.created_at": "スナップショット取得",
+    "page.entry_archive.pending": "新しいスナップショットを取得しています。しばらくしてからページを再読み込みしてください。",
+    "page.entry_archive.unavailable": "取得中のスナップショットが多すぎます。後でもう一度お試しください。",
+    "page.audit.title": "監査ログ",
+    "page.audit.date": "日付",
+    "page.audit.action": "アクション",
+    "page.audit.actor": "実行者",
+    "page.audit.target": "詳細",
+    "page.audit.client_ip": "IP アドレス",
+    "page.audit.user": "ユーザー",
+    "page.audit.all_users": "すべてのユーザー",
+    "page.audit.all_actions": "すべてのアクション",
+    "audit.action.login": "ログイン",
+    "audit.action.login_failed": "ログイン失敗",
+    "audit.action.logout": "ログアウト",
+    "audit.action.password_changed": "パスワード変更",
+    "audit.action.api_key_created": "API キー作成",
+    "audit.action.api_key_removed": "API キー削除",
+    "audit.action.user_created": "ユーザー作成",
+    "audit.action.user_removed": "ユーザー削除",
+    "audit.action.user_promoted": "管理者権限の付与",
+    "audit.action.user_demoted": "管理者権限の取り消し",
+    "audit.action.user_unlocked": "ユーザーのロック解除",
+    "audit.action.feed_removed": "フィード削除",
+    "audit.action.totp_enabled": "二要素認証の有効化",
+    "audit.action.totp_disabled": "二要素認証の無効化",
+    "audit.action.webauthn_registered": "パスキー登録",
+    "audit.action.webauthn_removed": "パスキー削除",
+    "audit.action.session_removed": "セッション削除",
     "page.api_keys.title": "APIキー",
     "page.api_keys.table.description": "説明",
     "page.api_keys.table.token": "トークン",
@@ -1964,12 +2909,15 @@ var translations = map[string]string{
     "alert.no_history": "現時点では履歴がありません。",
     "alert.feed_error": "このフィードには問題があります。",
     "alert.no_search_result": "検索で何も見つかりませんでした。",
+    "alert.no_tag_entry": "このタグの記事はありません。",
     "alert.no_unread_entry": "未読の記事はありません。",
     "alert.no_user": "あなたが唯一のユーザーです。",
+    "alert.no_audit_event": "イベントはありません。",
     "alert.account_unlinked": "外部アカウントとのリンクが解除されました!",
     "alert.account_linked": "外部アカウントとリンクされました!",
     "alert.pocket_linked": "Pocket アカウントとリンクされました!",
     "alert.prefs_saved": "設定情報は保存されました!",
+    "alert.totp_disabled": "二要素認証を無効にしました。",
     "error.unlink_account_without_password": "パスワードを設定しなければ再びログインすることはできません。",
     "error.duplicate_linked_account": "別なユーザーが既にこのサービスの同じユーザーとリンクしています。",
     "error.duplicate_fever_username": "既に同じ名前の Fever ユーザー名が使われています!",
@@ -1984,7 +2932,15 @@ var translations = map[string]string{
     "error.unable_to_update_feed": "このフィードを更新することはできません。",
     "error.subscription_not_found": "購読フィードが見つかりません。",
     "error.empty_file": "このファイルは空です。",
+    "error.file_too_large": "このファイルは大きすぎます（最大 50 MB）。",
+    "error.unsupported_import_format": "このファイル形式はサポートされていません。",
+    "error.invalid_opml_source_url": "リストの URL が無効です。",
+    "error.opml_source_already_exists": "このリストはすでに購読しています。",
     "error.bad_credentials": "ユーザー名かパスワードが間違っています。",
+    "error.too_many_login_attempts": "ログインの失敗が多すぎます。しばらくしてから再度お試しください。",
+    "error.invalid_totp_code": "二要素認証コードが無効です。",
+    "error.totp_code_required": "コードが必要です。",
+    "error.webauthn_failed": "セキュリティキーまたはパスキーでの操作が失敗したか、キャンセルされました。",
     "error.fields_mandatory": "全ての項目が必要です。",
     "error.title_required": "タイトルが必要です。",
     "error.different_passwords": "パスワードが一致しません。",
@@ -1992,6 +2948,10 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "ユーザー名、テーマ、言語、タイムゾーンの全てが必要です。",
     "error.entries_per_page_invalid": "ページあたりのエントリ数が無効です。",
     "error.feed_mandatory_fields": "URL と カテゴリが必要です。",
+    "error.newsletter_mandatory_fields": "タイトルとカテゴリーが必要です。",
+    "error.scraped_feed_mandatory_fields": "ページの URL、カテゴリ、項目セレクタは必須です。",
+    "error.scraped_feed_item_selector_not_empty": "項目セレクタを空にすることはできません。",
+    "error.watch_page_mandatory_fields": "ページの URL とカテゴリは必須です。",
     "error.feed_already_exists": "このフィードはすでに存在します。",
     "error.invalid_feed_url": "無効なフィードURL。",
     "error.invalid_site_url": "無効なサイトURL。",
@@ -2018,14 +2978,28 @@ var translations = map[string]string{
     "form.feed.label.rewrite_rules": "Rewrite ルール",
     "form.feed.label.blocklist_rules": "ブロックルール",
     "form.feed.label.keeplist_rules": "許可規則",
+    "form.feed.label.watch_selector": "監視するページの部分（CSS セレクタ）",
+    "form.feed.help.watch_selector": "ページ全体を監視する場合は空のままにします。訪問のたびに変わる部分を無視するために使用します。",
     "form.feed.label.ignore_http_cache": "HTTPキャッシュを無視",
     "form.feed.label.fetch_via_proxy": "プロキシ経由でフェッチ",
     "form.feed.label.disabled": "このフィードを更新しない",
+    "form.newsletter.label.address": "ニュースレターのメールアドレス",
+    "form.scraped_feed.label.page_url": "ウェブページの URL",
+    "form.scraped_feed.legend.selectors": "CSS セレクタ",
+    "form.scraped_feed.label.item": "項目",
+    "form.scraped_feed.label.title": "タイトル",
+    "form.scraped_feed.label.link": "リンク",
+    "form.scraped_feed.label.date": "日付",
+    "form.scraped_feed.label.content": "コンテンツ",
+    "form.scraped_feed.help": "リンクセレクタがない場合は項目の最初のリンクが使われます。タイトルセレクタがない場合はリンクのテキストが使われます。コンテンツセレクタがない場合は項目全体が使われます。",
     "form.category.label.title": "タイトル",
     "form.user.label.username": "ユーザー名",
     "form.user.label.password": "パスワード",
     "form.user.label.confirmation": "パスワード確認",
     "form.user.label.admin": "管理者",
+    "form.totp.label.code": "認証コード",
+    "form.totp.label.code_or_recovery_code": "認証コードまたはリカバリーコード",
+    "form.webauthn.label.name": "名前",
     "form.prefs.label.language": "言語",
     "form.prefs.label.timezone": "タイムゾーン",
     "form.prefs.label.theme": "テーマ",
@@ -2035,10 +3009,16 @@ var translations = map[string]string{
     "form.prefs.select.recent_first": "新しい記事を最初に",
     "form.prefs.label.keyboard_shortcuts": "キーボード・ショートカットを有効にする",
     "form.prefs.label.entry_swipe": "モバイルのエントリでスワイプジェスチャーを有効にする",
+    "form.prefs.label.archive_starred": "星付き記事のローカルコピーを保存する",
     "form.prefs.label.show_reading_time": "記事の推定読書時間を表示する",
     "form.prefs.label.custom_css": "カスタムCSS",
     "form.import.label.file": "OPML ファイル",
     "form.import.label.url": "URL",
+    "form.import.label.apply_settings": "Miniflux のフィード設定を適用する（ルール、ユーザーエージェント、クローラー、プロキシ）",
+    "form.import.label.validate": "購読前に各フィードを確認する（低速）",
+    "form.import.help.file": "OPML ファイル、Google Reader 形式の JSON エクスポート（Feedly、Inoreader）、Tiny Tiny RSS の XML エクスポート、FreshRSS の ZIP アーカイブに対応しています。",
+    "form.opml_source.label.url": "OPML リストの URL",
+    "form.opml_source.label.disable_removed": "リストから削除されたフィードを無効にする",
     "form.integration.fever_activate": "Fever API を有効にする",
     "form.integration.fever_username": "Fever の ユーザー名",
     "form.integration.fever_password": "Fever の パスワード",
@@ -2102,15 +3082,22 @@ var translations = map[string]string{
     "confirm.loading": "Bezig...",
     "action.subscribe": "Abboneren",
     "action.save": "Opslaan",
+    "action.preview": "Voorbeeld",
     "action.or": "of",
     "action.cancel": "annuleren",
     "action.remove": "Verwijderen",
+    "action.filter": "Filteren",
+    "action.unlock": "Ontgrendelen",
     "action.remove_feed": "Verwijder deze feed",
     "action.update": "Updaten",
+    "action.refresh_archive": "Momentopname vernieuwen",
     "action.edit": "Bewerken",
     "action.download": "Download",
     "action.import": "Importeren",
     "action.login": "Inloggen",
+    "action.enable_totp": "Tweestapsverificatie inschakelen",
+    "action.disable_totp": "Tweestapsverificatie uitschakelen",
+    "action.register_webauthn": "Beveiligingssleutel of passkey registreren",
     "action.home_screen": "Toevoegen aan startscherm",
     "tooltip.keyboard_shortcuts": "Sneltoets: %s",
     "tooltip.logged_user": "Ingelogd als %s",
@@ -2124,12 +3111,18 @@ var translations = map[string]string{
     "menu.preferences": "Voorkeuren",
     "menu.integrations": "Integraties",
     "menu.sessions": "Sessies",
+    "menu.totp": "Tweestapsverificatie",
+    "menu.webauthn": "Passkeys",
+    "menu.security_activity": "Beveiligingsactiviteit",
+    "menu.audit": "Auditlogboek",
     "menu.users": "Users",
     "menu.about": "Over",
     "menu.export": "Exporteren",
     "menu.import": "Importeren",
+    "menu.opml_sources": "Externe lijsten",
     "menu.create_category": "Categorie toevoegen",
     "menu.mark_page_as_read": "Markeer deze pagina als gelezen",
+    "menu.export_epub": "Exporteren naar EPUB",
     "menu.mark_all_as_read": "Markeer alle items als gelezen",
     "menu.show_all_entries": "Toon alle artikelen",
     "menu.show_only_unread_entries": "Toon alleen ongelezen artikelen",
@@ -2165,6 +3158,8 @@ var translations = map[string]string{
     "entry.save.toast.completed": "Artikel opgeslagen",
     "entry.scraper.label": "Downloaden",
     "entry.scraper.title": "Fetch original content",
+    "entry.archive.label": "Momentopname",
+    "entry.archive.title": "Een lokale kopie van het artikel bewaren",
     "entry.scraper.completed": "Klaar!",
     "entry.external_link.label": "Externe link",
     "entry.comments.label": "Comments",
@@ -2178,6 +3173,7 @@ var translations = map[string]string{
         "%d minuut gelezen",
         "%d minuten gelezen"
     ],
+    "entry.watch_page.title": "%s is gewijzigd",
     "page.shared_entries.title": "Gedeelde vermeldingen",
     "page.unread.title": "Ongelezen",
     "page.starred.title": "Favorieten",
@@ -2190,6 +3186,17 @@ var translations = map[string]string{
         "Er zijn %d feeds."
     ],
     "page.new_category.title": "Nieuwe categorie",
+    "page.new_newsletter.title": "Nieuwe nieuwsbrief",
+    "page.new_newsletter.help": "Er wordt een uniek e-mailadres aangemaakt. Gebruik het om je op een nieuwsbrief te abonneren; elke ontvangen e-mail verschijnt als een nieuw artikel.",
+    "page.new_scraped_feed.title": "Nieuwe feed uit een webpagina",
+    "page.new_scraped_feed.help": "Voor websites zonder feed: elk element dat door de itemselector wordt gevonden, wordt een artikel. De andere selectors worden binnen elk item toegepast.",
+    "page.new_scraped_feed.preview": [
+        "%d artikel gevonden",
+        "%d artikelen gevonden"
+    ],
+    "page.new_scraped_feed.content": "Inhoud",
+    "page.new_watch_page.title": "Een webpagina volgen",
+    "page.new_watch_page.help": "De pagina wordt gecontroleerd zoals de andere feeds en telkens wanneer de tekst verandert, wordt een nieuw artikel met de verschillen aangemaakt.",
     "page.new_user.title": "Nieuwe gebruiker",
     "page.edit_category.title": "Bewerken van categorie: %s",
     "page.edit_user.title": "Bewerk gebruiker: %s",
@@ -2203,8 +3210,41 @@ var translations = map[string]string{
     ],
     "page.history.title": "Geschiedenis",
     "page.import.title": "Importeren",
+    "page.import.report": "Import voltooid: %d feeds, %d categorieën en %d artikelen (%d met ster) aangemaakt, %d artikelen overgeslagen.",
+    "page.import_job.title": "Importresultaten",
+    "page.import_job.running": "Het importeren is bezig: %d van %d abonnementen verwerkt.",
+    "page.import_job.running_items": "Het importeren is bezig: %d van %d items verwerkt.",
+    "page.import_job.refresh": "Vernieuwen",
+    "page.import_job.finished": "Het importeren is voltooid: %d feeds aangemaakt, %d duplicaten, %d onbereikbaar, %d verwerkingsfouten, %d andere fouten.",
+    "page.import_job.failed": "Het importeren is mislukt: %s",
+    "page.import_job.table.feed": "Feed",
+    "page.import_job.table.category": "Categorie",
+    "page.import_job.table.result": "Resultaat",
+    "page.import_job.status.created": "Aangemaakt",
+    "page.import_job.status.duplicate": "Al geabonneerd",
+    "page.import_job.status.unreachable": "Onbereikbaar",
+    "page.import_job.status.parse_error": "Ongeldige feed",
+    "page.import_job.status.error": "Fout",
+    "page.opml_sources.title": "Externe OPML-lijsten",
+    "page.opml_sources.help": "De externe lijst wordt regelmatig opgehaald en de nieuwe feeds worden aan de gekozen categorie toegevoegd.",
+    "page.opml_sources.no_source": "U bent niet geabonneerd op een externe lijst.",
+    "page.opml_sources.new": "Abonneren op een externe lijst",
+    "page.opml_sources.table.url": "Lijst",
+    "page.opml_sources.table.category": "Categorie",
+    "page.opml_sources.table.feeds": "Feeds",
+    "page.opml_sources.table.checked_at": "Laatste controle",
+    "page.opml_source.title": "Externe OPML-lijst",
+    "page.opml_source.never_checked": "Nooit",
+    "page.opml_source.error": "Laatste fout",
+    "page.opml_source.disable_removed": "De feeds die uit de lijst zijn verwijderd, worden uitgeschakeld.",
+    "page.opml_source.history": "Geschiedenis",
+    "page.opml_source.no_history": "De synchronisaties hebben nog geen abonnement gewijzigd.",
+    "page.opml_source.change.added": "Toegevoegd",
+    "page.opml_source.change.disabled": "Uitgeschakeld",
+    "page.opml_source.change.enabled": "Opnieuw ingeschakeld",
     "page.login.title": "Inloggen",
     "page.search.title": "Zoekresultaten",
+    "page.tag_entries.title": "Label: %s",
     "page.about.title": "Over",
     "page.about.credits": "Copyrights",
     "page.about.version": "Versie:",
@@ -2218,6 +3258,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "Feed zoeken",
     "page.add_feed.legend.advanced_options": "Geavanceerde mogelijkheden",
     "page.add_feed.choose_feed": "Feed kiezen",
+    "page.add_feed.newsletter": "In plaats daarvan een nieuwsbrief per e-mail ontvangen",
+    "page.add_feed.scraped_feed": "Geen feed op deze website? Haal in plaats daarvan de artikelen uit een webpagina",
+    "page.add_feed.watch_page": "Volg in plaats daarvan de wijzigingen van een webpagina",
     "page.edit_feed.title": "Bewerken van feed: %s",
     "page.edit_feed.last_check": "Laatste update:",
     "page.edit_feed.last_modified_header": "LastModified-header:",
@@ -2225,6 +3268,14 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "Geen",
     "page.edit_feed.last_parsing_error": "Laatste parse error",
     "page.entry.attachments": "Bijlagen",
+    "page.entry.podcast.season": "Seizoen %d",
+    "page.entry.podcast.episode": "Aflevering %s",
+    "page.entry.podcast.chapters": "Hoofdstukken",
+    "page.entry.podcast.transcript": "Transcriptie",
+    "page.entry.podcast.transcripts": "Transcripties",
+    "page.entry.podcast.captions": "ondertitels",
+    "page.entry.podcast.persons": "Personen",
+    "page.entry.podcast.funding": "Steun deze podcast:",
     "page.keyboard_shortcuts.title": "Sneltoetsen",
     "page.keyboard_shortcuts.subtitle.sections": "Naviguatie tussen menu's",
     "page.keyboard_shortcuts.subtitle.items": "Navigatie tussen items",
@@ -2265,12 +3316,22 @@ var translations = map[string]string{
     "page.users.actions": "Acties",
     "page.users.last_login": "Laatste login",
     "page.users.is_admin": "Administrator",
+    "page.users.locked": "Vergrendeld na te veel mislukte aanmeldingen",
     "page.settings.title": "Instellingen",
     "page.settings.link_google_account": "Koppel mijn Google-account",
     "page.settings.unlink_google_account": "Ontkoppel mijn Google-account",
     "page.settings.link_oidc_account": "Koppel mijn OpenID Connect-account",
     "page.settings.unlink_oidc_account": "Ontkoppel mijn OpenID Connect-account",
+    "page.settings.link_github_account": "Koppel mijn GitHub-account",
+    "page.settings.unlink_github_account": "Ontkoppel mijn GitHub-account",
+    "page.settings.link_gitlab_account": "Koppel mijn GitLab-account",
+    "page.settings.unlink_gitlab_account": "Ontkoppel mijn GitLab-account",
     "page.login.oidc_signin": "Inloggen via OpenID Connect",
+    "page.login.github_signin": "Inloggen via GitHub",
+    "page.login.gitlab_signin": "Inloggen via GitLab",
+    "page.login.webauthn_signin": "Inloggen met een passkey",
+    "page.login_totp.title": "Tweestapsverificatie",
+    "page.login_totp.help": "Voer de code uit je authenticator-app of een van je herstelcodes in.",
     "page.login.google_signin": "Inloggen via Google",
     "page.integrations.title": "Integraties",
     "page.integration.miniflux_api": "Miniflux API",
@@ -2283,11 +3344,58 @@ var translations = map[string]string{
     "page.integration.bookmarklet.instructions": "Sleep deze link naar je bookmarks.",
     "page.integration.bookmarklet.help": "Gebruik deze link als bookmark in je browser om je direct te abboneren op een website.",
     "page.sessions.title": "Sessies",
+    "page.totp.title": "Tweestapsverificatie",
+    "page.totp.enroll_help": "Scan deze QR-code met een authenticator-app en voer ter bevestiging de getoonde code in.",
+    "page.totp.qr_code": "QR-code",
+    "page.totp.secret": "Geheime sleutel:",
+    "page.totp.recovery_codes_help": "Bewaar deze herstelcodes op een veilige plek. Elke code kan één keer worden gebruikt om in te loggen als je geen toegang meer hebt tot je authenticator-app. Ze worden niet opnieuw getoond.",
+    "page.totp.enabled": [
+        "Tweestapsverificatie is ingeschakeld. Nog %d herstelcode over.",
+        "Tweestapsverificatie is ingeschakeld. Nog %d herstelcodes over."
+    ],
+    "page.webauthn.title": "Beveiligingssleutels en passkeys",
+    "page.webauthn.help": "Met beveiligingssleutels en passkeys kunt u inloggen zonder uw wachtwoord te typen.",
+    "page.webauthn.unsupported": "Uw browser ondersteunt geen beveiligingssleutels en passkeys.",
+    "page.webauthn.never_used": "Nooit gebruikt",
+    "page.webauthn.table.name": "Naam",
+    "page.webauthn.table.last_used_at": "Laatst gebruikt",
+    "page.webauthn.table.created_at": "Aanmaakdatum",
+    "page.webauthn.table.actions": "Acties",
     "page.sessions.table.date": "Datum",
     "page.sessions.table.ip": "IP-adres",
     "page.sessions.table.user_agent": "User-agent",
     "page.sessions.table.actions": "Acties",
     "page.sessions.table.current_session": "Huidige sessie",
+    "page.security_activity.title": "Recente beveiligingsactiviteit",
+    "page.entry_archive.created_at": "Momentopname gemaakt",
+    "page.entry_archive.pending": "Er wordt een nieuwe momentopname gemaakt, laad de pagina zo opnieuw.",
+    "page.entry_archive.unavailable": "Er worden te veel momentopnames gemaakt, probeer het later opnieuw.",
+    "page.audit.title": "Auditlogboek",
+    "page.audit.date": "Datum",
+    "page.audit.action": "Actie",
+    "page.audit.actor": "Uitgevoerd door",
+    "page.audit.target": "Details",
+    "page.audit.client_ip": "IP-adres",
+    "page.audit.user": "Gebruiker",
+    "page.audit.all_users": "Alle gebruikers",
+    "page.audit.all_actions": "Alle acties",
+    "audit.action.login": "Aanmelding",
+    "audit.action.login_failed": "Mislukte aanmelding",
+    "audit.action.logout": "Afmelding",
+    "audit.action.password_changed": "Wachtwoord gewijzigd",
+    "audit.action.api_key_created": "API-sleutel aangemaakt",
+    "audit.action.api_key_removed": "API-sleutel verwijderd",
+    "audit.action.user_created": "Gebruiker aangemaakt",
+    "audit.action.user_removed": "Gebruiker verwijderd",
+    "audit.action.user_promoted": "Beheerdersrechten toegekend",
+    "audit.action.user_demoted": "Beheerdersrechten ingetrokken",
+    "audit.action.user_unlocked": "Gebruiker ontgrendeld",
+    "audit.action.feed_removed": "Feed verwijderd",
+    "audit.action.totp_enabled": "Tweestapsverificatie ingeschakeld",
+    "audit.action.totp_disabled": "Tweestapsverificatie uitgeschakeld",
+    "audit.action.webauthn_registered": "Passkey geregistreerd",
+    "audit.action.webauthn_removed": "Passkey verwijderd",
+    "audit.action.session_removed": "Sessie verwijderd",
     "page.api_keys.title": "API-sleutels",
     "page.api_keys.table.description": "Beschrijving",
     "page.api_keys.table.token": "Blijk",
@@ -2306,12 +3414,15 @@ var translations = map[string]string{
     "alert.no_history": "Geschiedenis is op dit moment leeg.",
     "alert.feed_error": "Er is een probleem met deze feed",
     "alert.no_search_result": "Er is geen resultaat voor deze zoekopdracht.",
+    "alert.no_tag_entry": "Er zijn geen artikelen met dit label.",
     "alert.no_unread_entry": "Er zijn geen ongelezen artikelen.",
     "alert.no_user": "Je bent de enige gebruiker.",
+    "alert.no_audit_event": "Er zijn geen gebeurtenissen.",
     "alert.account_unlinked": "Uw externe account is nu gedissocieerd!",
     "alert.account_linked": "Uw externe account is nu gekoppeld!",
     "alert.pocket_linked": "Uw Pocket-account is nu gekoppeld!",
     "alert.prefs_saved": "Instellingen opgeslagen!",
+    "alert.totp_disabled": "Tweestapsverificatie is nu uitgeschakeld.",
     "error.unlink_account_without_password": "U moet een wachtwoord definiëren anders kunt u zich niet opnieuw aanmelden.",
     "error.duplicate_linked_account": "Er is al iemand geregistreerd met deze provider!",
     "error.duplicate_fever_username": "Er is al iemand met dezelfde Fever gebruikersnaam!",
@@ -2326,7 +3437,15 @@ var translations = map[string]string{
     "error.unable_to_update_feed": "Kan deze feed niet bijwerken.",
     "error.subscription_not_found": "Kon geen feeds vinden.",
     "error.empty_file": "Dit bestand is leeg.",
+    "error.file_too_large": "Dit bestand is te groot (maximaal 50 MB).",
+    "error.unsupported_import_format": "Dit bestandsformaat wordt niet ondersteund.",
+    "error.invalid_opml_source_url": "Ongeldige URL van de lijst.",
+    "error.opml_source_already_exists": "U bent al geabonneerd op deze lijst.",
     "error.bad_credentials": "Onjuiste gebruikersnaam of wachtwoord.",
+    "error.too_many_login_attempts": "Te veel mislukte aanmeldpogingen, probeer het later opnieuw.",
+    "error.invalid_totp_code": "Ongeldige code voor tweestapsverificatie.",
+    "error.totp_code_required": "De code is verplicht.",
+    "error.webauthn_failed": "De bewerking met uw beveiligingssleutel of passkey is mislukt of geannuleerd.",
     "error.fields_mandatory": "Alle velden moeten ingevuld zijn.",
     "error.title_required": "Naam van categorie is verplicht.",
     "error.different_passwords": "Wachtwoorden zijn niet hetzelfde.",
@@ -2334,6 +3453,10 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "Gebruikersnaam, skin, taal en tijdzone zijn verplicht.",
     "error.entries_per_page_invalid": "Het aantal inzendingen per pagina is niet geldig.",
     "error.feed_mandatory_fields": "The URL en de categorie zijn verplicht.",
+    "error.newsletter_mandatory_fields": "De titel en de categorie zijn verplicht.",
+    "error.scraped_feed_mandatory_fields": "De pagina-URL, de categorie en de itemselector zijn verplicht.",
+    "error.scraped_feed_item_selector_not_empty": "De itemselector mag niet leeg zijn.",
+    "error.watch_page_mandatory_fields": "De pagina-URL en de categorie zijn verplicht.",
     "error.feed_already_exists": "Deze feed bestaat al.",
     "error.invalid_feed_url": "Ongeldige feed-URL.",
     "error.invalid_site_url": "Ongeldige site-URL.",
@@ -2360,14 +3483,28 @@ var translations = map[string]string{
     "form.feed.label.rewrite_rules": "Rewrite regels",
     "form.feed.label.blocklist_rules": "Blokkeer regels",
     "form.feed.label.keeplist_rules": "toestemmingsregels",
+    "form.feed.label.watch_selector": "Gevolgd deel van de pagina (CSS-selector)",
+    "form.feed.help.watch_selector": "Laat leeg om de hele pagina te volgen. Gebruik het om delen te negeren die bij elk bezoek veranderen.",
     "form.feed.label.ignore_http_cache": "Negeer HTTP-cache",
     "form.feed.label.fetch_via_proxy": "Ophalen via proxy",
     "form.feed.label.disabled": "Vernieuw deze feed niet",
+    "form.newsletter.label.address": "E-mailadres van de nieuwsbrief",
+    "form.scraped_feed.label.page_url": "URL van de webpagina",
+    "form.scraped_feed.legend.selectors": "CSS-selectors",
+    "form.scraped_feed.label.item": "Item",
+    "form.scraped_feed.label.title": "Titel",
+    "form.scraped_feed.label.link": "Link",
+    "form.scraped_feed.label.date": "Datum",
+    "form.scraped_feed.label.content": "Inhoud",
+    "form.scraped_feed.help": "Zonder linkselector wordt de eerste link van het item gebruikt. Zonder titelselector wordt de tekst van de link gebruikt. Zonder inhoudsselector wordt het hele item gebruikt.",
     "form.category.label.title": "Naam",
     "form.user.label.username": "Gebruikersnaam",
     "form.user.label.password": "Wachtwoord",
     "form.user.label.confirmation": "Bevestig wachtwoord",
     "form.user.label.admin": "Administrator",
+    "form.totp.label.code": "Verificatiecode",
+    "form.totp.label.code_or_recovery_code": "Verificatiecode of herstelcode",
+    "form.webauthn.label.name": "Naam",
     "form.prefs.label.language": "Taal",
     "form.prefs.label.timezone": "Tijdzone",
     "form.prefs.label.theme": "Skin",
@@ -2377,10 +3514,16 @@ var translations = map[string]string{
     "form.prefs.select.recent_first": "Recente items eerst",
     "form.prefs.label.keyboard_shortcuts": "Schakel sneltoetsen in",
     "form.prefs.label.entry_swipe": "Schakel veegbewegingen in voor items op mobiel",
+    "form.prefs.label.archive_starred": "Een lokale kopie van artikelen met ster bewaren",
     "form.prefs.label.show_reading_time": "Toon geschatte leestijd voor artikelen",
     "form.prefs.label.custom_css": "Aangepaste CSS",
     "form.import.label.file": "OPML-bestand",
     "form.import.label.url": "URL",
+    "form.import.label.apply_settings": "Miniflux-instellingen van de feeds toepassen (regels, user-agent, crawler, proxy)",
+    "form.import.label.validate": "Elke feed controleren voor het abonneren (langzamer)",
+    "form.import.help.file": "OPML-bestanden, JSON-exports in Google Reader-formaat (Feedly, Inoreader), XML-exports van Tiny Tiny RSS en ZIP-archieven van FreshRSS worden ondersteund.",
+    "form.opml_source.label.url": "URL van de OPML-lijst",
+    "form.opml_source.label.disable_removed": "Feeds die uit de lijst zijn verwijderd uitschakelen",
     "form.integration.fever_activate": "Activeer Fever API",
     "form.integration.fever_username": "Fever gebruikersnaam",
     "form.integration.fever_password": "Fever wachtwoord",
@@ -2452,7 +3595,8 @@ var translations = map[string]string{
     "Invalid SSL certificate (original error: %q)": "Ongeldig SSL-certificaat (originele error: %q)",
     "This website is temporarily unreachable (original error: %q)": "Deze website is tijdelijk onbereikbaar (originele error: %q)",
     "This website is permanently unreachable (original error: %q)": "Deze website is permanent onbereikbaar (originele error: %q)",
-    "Website unreachable, the request timed out after %d seconds": "Website onbereikbaar, de request gaf een timeout na %d seconden"
+    "Website unreachable, the request timed out after %d seconds": "Website onbereikbaar, de request gaf een timeout na %d seconden",
+    "This website is hosted on a private or forbidden network address (%s)": "Deze website wordt gehost op een privé- of verboden netwerkadres (%s)"
 }
 `,
 	"pl_PL": `{
@@ -2462,15 +3606,22 @@ var translations = map[string]string{
     "confirm.loading": "W toku...",
     "action.subscribe": "Subskrypcja",
     "action.save": "Zapisz",
+    "action.preview": "Podgląd",
     "action.or": "lub",
     "action.cancel": "anuluj",
     "action.remove": "Usuń",
+    "action.filter": "Filtruj",
+    "action.unlock": "Odblokuj",
     "action.remove_feed": "Usuń ten kanał",
     "action.update": "Zaktualizuj",
+    "action.refresh_archive": "Odśwież migawkę",
     "action.edit": "Edytuj",
     "action.download": "Pobierz",
     "action.import": "Importuj",
     "action.login": "Zaloguj się",
+    "action.enable_totp": "Włącz uwierzytelnianie dwuskładnikowe",
+    "action.disable_totp": "Wyłącz uwierzytelnianie dwuskładnikowe",
+    "action.register_webauthn": "Zarejestruj klucz bezpieczeństwa lub passkey",
     "action.home_screen": "Dodaj do ekranu głównego",
     "tooltip.keyboard_shortcuts": "Skróty klawiszowe: %s",
     "tooltip.logged_user": "Zalogowany jako %s",
@@ -2484,12 +3635,18 @@ var translations = map[string]string{
     "menu.preferences": "Preferencje",
     "menu.integrations": "Usługi",
     "menu.sessions": "Sesje",
+    "menu.totp": "Uwierzytelnianie dwuskładnikowe",
+    "menu.webauthn": "Passkeys",
+    "menu.security_activity": "Aktywność bezpieczeństwa",
+    "menu.audit": "Dziennik audytu",
     "menu.users": "Użytkownicy",
     "menu.about": "O stronie",
     "menu.export": "Eksportuj",
     "menu.import": "Importuj",
+    "menu.opml_sources": "Zdalne listy",
     "menu.create_category": "Utwórz kategorię",
     "menu.mark_page_as_read": "Oznacz jako przeczytane",
+    "menu.export_epub": "Eksportuj do EPUB",
     "menu.mark_all_as_read": "Oznacz wszystko jako przeczytane",
     "menu.show_all_entries": "Pokaż wszystkie artykuły",
     "menu.show_only_unread_entries": "Pokaż tylko nieprzeczytane artykuły",
@@ -2525,6 +3682,8 @@ var translations = map[string]string{
     "entry.save.toast.completed": "Artykuł zapisany",
     "entry.scraper.label": "Ściągnij",
     "entry.scraper.title": "Pobierz oryginalną treść",
+    "entry.archive.label": "Migawka",
+    "entry.archive.title": "Zachowaj lokalną kopię artykułu",
     "entry.scraper.completed": "Gotowe!",
     "entry.external_link.label": "Link zewnętrzny",
     "entry.comments.label": "Komentarze",
@@ -2538,6 +3697,7 @@ var translations = map[string]string{
         "%d minuta czytania",
         "%d minut czytania"
     ],
+    "entry.watch_page.title": "%s została zmieniona",
     "page.shared_entries.title": "Udostępnione wpisy",
     "page.unread.title": "Nieprzeczytane",
     "page.starred.title": "Oznaczone gwiazdką",
@@ -2551,6 +3711,18 @@ var translations = map[string]string{
         "Jest %d kanałów."
     ],
     "page.new_category.title": "Nowa kategoria",
+    "page.new_newsletter.title": "Nowy newsletter",
+    "page.new_newsletter.help": "Zostanie wygenerowany unikalny adres e-mail. Użyj go, aby zapisać się do newslettera, a każda otrzymana wiadomość pojawi się jako nowy artykuł.",
+    "page.new_scraped_feed.title": "Nowy kanał ze strony internetowej",
+    "page.new_scraped_feed.help": "Dla stron bez kanału: każdy element znaleziony przez selektor elementu staje się artykułem. Pozostałe selektory są stosowane wewnątrz każdego elementu.",
+    "page.new_scraped_feed.preview": [
+        "Znaleziono %d artykuł",
+        "Znaleziono %d artykuły",
+        "Znaleziono %d artykułów"
+    ],
+    "page.new_scraped_feed.content": "Treść",
+    "page.new_watch_page.title": "Obserwuj stronę internetową",
+    "page.new_watch_page.help": "Strona jest sprawdzana jak inne kanały, a przy każdej zmianie jej tekstu tworzony jest nowy artykuł pokazujący różnice.",
     "page.new_user.title": "Nowy użytkownik",
     "page.edit_category.title": "Edycja Kategorii: %s",
     "page.edit_user.title": "Edytuj użytkownika: %s",
@@ -2565,7 +3737,40 @@ var translations = map[string]string{
     ],
     "page.history.title": "Historia",
     "page.import.title": "Importuj",
+    "page.import.report": "Import zakończony: utworzono %d kanałów, %d kategorii i %d artykułów (%d oznaczonych gwiazdką), pominięto %d artykułów.",
+    "page.import_job.title": "Wyniki importu",
+    "page.import_job.running": "Import w toku: przetworzono %d z %d subskrypcji.",
+    "page.import_job.running_items": "Import w toku: przetworzono %d z %d elementów.",
+    "page.import_job.refresh": "Odśwież",
+    "page.import_job.finished": "Import zakończony: utworzono %d kanałów, %d duplikatów, %d niedostępnych, %d błędów analizy, %d innych błędów.",
+    "page.import_job.failed": "Import nie powiódł się: %s",
+    "page.import_job.table.feed": "Kanał",
+    "page.import_job.table.category": "Kategoria",
+    "page.import_job.table.result": "Wynik",
+    "page.import_job.status.created": "Utworzony",
+    "page.import_job.status.duplicate": "Już subskrybowany",
+    "page.import_job.status.unreachable": "Niedostępny",
+    "page.import_job.status.parse_error": "Nieprawidłowy kanał",
+    "page.import_job.status.error": "Błąd",
+    "page.opml_sources.title": "Zdalne listy OPML",
+    "page.opml_sources.help": "Zdalna lista jest pobierana okresowo, a jej nowe kanały są dodawane do wybranej kategorii.",
+    "page.opml_sources.no_source": "Nie subskrybujesz żadnej zdalnej listy.",
+    "page.opml_sources.new": "Subskrybuj zdalną listę",
+    "page.opml_sources.table.url": "Lista",
+    "page.opml_sources.table.category": "Kategoria",
+    "page.opml_sources.table.feeds": "Kanały",
+    "page.opml_sources.table.checked_at": "Ostatnie sprawdzenie",
+    "page.opml_source.title": "Zdalna lista OPML",
+    "page.opml_source.never_checked": "Nigdy",
+    "page.opml_source.error": "Ostatni błąd",
+    "page.opml_source.disable_removed": "Kanały usunięte z listy są wyłączane.",
+    "page.opml_source.history": "Historia",
+    "page.opml_source.no_history": "Synchronizacje nie zmieniły jeszcze żadnej subskrypcji.",
+    "page.opml_source.change.added": "Dodano",
+    "page.opml_source.change.disabled": "Wyłączono",
+    "page.opml_source.change.enabled": "Ponownie włączono",
     "page.search.title": "Wyniki wyszukiwania",
+    "page.tag_entries.title": "Tag: %s",
     "page.about.title": "O",
     "page.about.credits": "Prawa autorskie",
     "page.about.version": "Wersja:",
@@ -2579,6 +3784,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "Znajdź subskrypcję",
     "page.add_feed.legend.advanced_options": "Zaawansowane opcje",
     "page.add_feed.choose_feed": "Wybierz subskrypcję",
+    "page.add_feed.newsletter": "Zamiast tego odbieraj newsletter e-mailem",
+    "page.add_feed.scraped_feed": "Brak kanału na tej stronie? Wyodrębnij artykuły ze strony internetowej",
+    "page.add_feed.watch_page": "Obserwuj zmiany strony internetowej",
     "page.edit_feed.title": "Edytuj kanał: %s",
     "page.edit_feed.last_check": "Ostatnia aktualizacja:",
     "page.edit_feed.last_modified_header": "Ostatnio zmienione:",
@@ -2586,6 +3794,14 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "Brak",
     "page.edit_feed.last_parsing_error": "Ostatni błąd analizy",
     "page.entry.attachments": "Załączniki",
+    "page.entry.podcast.season": "Sezon %d",
+    "page.entry.podcast.episode": "Odcinek %s",
+    "page.entry.podcast.chapters": "Rozdziały",
+    "page.entry.podcast.transcript": "Transkrypcja",
+    "page.entry.podcast.transcripts": "Transkrypcje",
+    "page.entry.podcast.captions": "napisy",
+    "page.entry.podcast.persons": "Osoby",
+    "page.entry.podcast.funding": "Wesprzyj ten podcast:",
     "page.keyboard_shortcuts.title": "Skróty klawiszowe",
     "page.keyboard_shortcuts.subtitle.sections": "Nawigacja między punktami menu",
     "page.keyboard_shortcuts.subtitle.items": "Nawigacja między artykułami",
@@ -2626,14 +3842,24 @@ var translations = map[string]string{
     "page.users.actions": "Działania",
     "page.users.last_login": "Ostatnie logowanie",
     "page.users.is_admin": "Administrator",
+    "page.users.locked": "Zablokowany po zbyt wielu nieudanych logowaniach",
     "page.settings.title": "Ustawienia",
     "page.settings.link_google_account": "Połącz z moim kontem Google",
     "page.settings.unlink_google_account": "Odłącz moje konto Google",
     "page.settings.link_oidc_account": "Połącz z moim kontem OpenID Connect",
     "page.settings.unlink_oidc_account": "Odłącz moje konto OpenID Connect",
+    "page.settings.link_github_account": "Połącz z moim kontem GitHub",
+    "page.settings.unlink_github_account": "Odłącz moje konto GitHub",
+    "page.settings.link_gitlab_account": "Połącz z moim kontem GitLab",
+    "page.settings.unlink_gitlab_account": "Odłącz moje konto GitLab",
     "page.login.title": "Zaloguj się",
     "page.login.google_signin": "Zaloguj przez Google",
     "page.login.oidc_signin": "Zaloguj przez OpenID Connect",
+    "page.login.github_signin": "Zaloguj przez GitHub",
+    "page.login.gitlab_signin": "Zaloguj przez GitLab",
+    "page.login.webauthn_signin": "Zaloguj się za pomocą passkey",
+    "page.login_totp.title": "Uwierzytelnianie dwuskładnikowe",
+    "page.login_totp.help": "Wprowadź kod wyświetlony w aplikacji uwierzytelniającej lub jeden z kodów odzyskiwania.",
     "page.integrations.title": "Usługi",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "Punkt końcowy API",
@@ -2645,11 +3871,59 @@ var translations = map[string]string{
     "page.integration.bookmarklet.instructions": "Przeciągnij i upuść to łącze do zakładek.",
     "page.integration.bookmarklet.help": "Ten link umożliwia subskrypcję strony internetowej bezpośrednio za pomocą zakładki w przeglądarce internetowej.",
     "page.sessions.title": "Sesje",
+    "page.totp.title": "Uwierzytelnianie dwuskładnikowe",
+    "page.totp.enroll_help": "Zeskanuj ten kod QR aplikacją uwierzytelniającą, a następnie wprowadź wyświetlony kod, aby potwierdzić.",
+    "page.totp.qr_code": "Kod QR",
+    "page.totp.secret": "Klucz tajny:",
+    "page.totp.recovery_codes_help": "Zapisz te kody odzyskiwania w bezpiecznym miejscu. Każdy kod może zostać użyty raz do zalogowania, jeśli utracisz dostęp do aplikacji uwierzytelniającej. Nie zostaną ponownie wyświetlone.",
+    "page.totp.enabled": [
+        "Uwierzytelnianie dwuskładnikowe jest włączone. Pozostał %d kod odzyskiwania.",
+        "Uwierzytelnianie dwuskładnikowe jest włączone. Pozostały %d kody odzyskiwania.",
+        "Uwierzytelnianie dwuskładnikowe jest włączone. Pozostało %d kodów odzyskiwania."
+    ],
+    "page.webauthn.title": "Klucze bezpieczeństwa i passkeys",
+    "page.webauthn.help": "Klucze bezpieczeństwa i passkeys pozwalają zalogować się bez wpisywania hasła.",
+    "page.webauthn.unsupported": "Twoja przeglądarka nie obsługuje kluczy bezpieczeństwa ani passkeys.",
+    "page.webauthn.never_used": "Nigdy nie używany",
+    "page.webauthn.table.name": "Nazwa",
+    "page.webauthn.table.last_used_at": "Ostatnio używany",
+    "page.webauthn.table.created_at": "Data utworzenia",
+    "page.webauthn.table.actions": "Działania",
     "page.sessions.table.date": "Data",
     "page.sessions.table.ip": "Adres IP",
     "page.sessions.table.user_agent": "Agent użytkownika",
     "page.sessions.table.actions": "Działania",
     "page.sessions.table.current_session": "Bieżąca sesja",
+    "page.security_activity.title": "Ostatnia aktywność bezpieczeństwa",
+    "page.entry_archive.created_at": "Migawka wykonana",
+    "page.entry_archive.pending": "Trwa wykonywanie nowej migawki, odśwież stronę za chwilę.",
+    "page.entry_archive.unavailable": "Wykonywanych jest zbyt wiele migawek, spróbuj ponownie później.",
+    "page.audit.title": "Dziennik audytu",
+    "page.audit.date": "Data",
+    "page.audit.action": "Działanie",
+    "page.audit.actor": "Wykonane przez",
+    "page.audit.target": "Szczegóły",
+    "page.audit.client_ip": "Adres IP",
+    "page.audit.user": "Użytkownik",
+    "page.audit.all_users": "Wszyscy użytkownicy",
+    "page.audit.all_actions": "Wszystkie działania",
+    "audit.action.login": "Logowanie",
+    "audit.action.login_failed": "Nieudane logowanie",
+    "audit.action.logout": "Wylogowanie",
+    "audit.action.password_changed": "Zmiana hasła",
+    "audit.action.api_key_created": "Utworzono klucz API",
+    "audit.action.api_key_removed": "Usunięto klucz API",
+    "audit.action.user_created": "Utworzono użytkownika",
+    "audit.action.user_removed": "Usunięto użytkownika",
+    "audit.action.user_promoted": "Nadano uprawnienia administratora",
+    "audit.action.user_demoted": "Odebrano uprawnienia administratora",
+    "audit.action.user_unlocked": "Odblokowano użytkownika",
+    "audit.action.feed_removed": "Usunięto kanał",
+    "audit.action.totp_enabled": "Włączono uwierzytelnianie dwuskładnikowe",
+    "audit.action.totp_disabled": "Wyłączono uwierzytelnianie dwuskładnikowe",
+    "audit.action.webauthn_registered": "Zarejestrowano klucz dostępu",
+    "audit.action.webauthn_removed": "Usunięto klucz dostępu",
+    "audit.action.session_removed": "Usunięto sesję",
     "page.api_keys.title": "Klucze API",
     "page.api_keys.table.description": "Opis",
     "page.api_keys.table.token": "Znak",
@@ -2668,12 +3942,15 @@ var translations = map[string]string{
     "alert.no_history": "Obecnie nie ma żadnej historii.",
     "alert.feed_error": "Z tym kanałem jest problem",
     "alert.no_search_result": "Brak wyników dla tego wyszukiwania.",
+    "alert.no_tag_entry": "Brak artykułów z tym tagiem.",
     "alert.no_unread_entry": "Nie ma żadnych nieprzeczytanych artykułów.",
     "alert.no_user": "Jesteś jedynym użytkownikiem.",
+    "alert.no_audit_event": "Brak zdarzeń.",
     "alert.account_unlinked": "Twoje konto zewnętrzne jest teraz zdysocjowane!",
     "alert.account_linked": "Twoje konto zewnętrzne jest teraz połączone!",
     "alert.pocket_linked": "Twoje konto Pocket jest teraz połączone!",
     "alert.prefs_saved": "Ustawienia zapisane!",
+    "alert.totp_disabled": "Uwierzytelnianie dwuskładnikowe zostało wyłączone.",
     "error.unlink_account_without_password": "Musisz zdefiniować hasło, inaczej nie będziesz mógł się ponownie zalogować.",
     "error.duplicate_linked_account": "Już ktoś jest powiązany z tym dostawcą!",
     "error.duplicate_fever_username": "Już ktoś inny używa tej nazwy użytkownika Fever!",
@@ -2688,7 +3965,15 @@ var translations = map[string]string{
     "error.unable_to_update_feed": "Nie można zaktualizować tego kanału.",
     "error.subscription_not_found": "Nie znaleziono żadnych subskrypcji.",
     "error.empty_file": "Ten plik jest pusty.",
+    "error.file_too_large": "Ten plik jest za duży (maksymalnie 50 MB).",
+    "error.unsupported_import_format": "Ten format pliku nie jest obsługiwany.",
+    "error.invalid_opml_source_url": "Nieprawidłowy adres URL listy.",
+    "error.opml_source_already_exists": "Już subskrybujesz tę listę.",
     "error.bad_credentials": "Nieprawidłowa nazwa użytkownika lub hasło.",
+    "error.too_many_login_attempts": "Zbyt wiele nieudanych prób logowania, spróbuj ponownie później.",
+    "error.invalid_totp_code": "Nieprawidłowy kod uwierzytelniania dwuskładnikowego.",
+    "error.totp_code_required": "Kod jest obowiązkowy.",
+    "error.webauthn_failed": "Operacja z kluczem bezpieczeństwa lub passkey nie powiodła się lub została anulowana.",
     "error.fields_mandatory": "Wszystkie pola są obowiązkowe.",
     "error.title_required": "Tytuł jest obowiązkowy.",
     "error.different_passwords": "Hasła nie są identyczne.",
@@ -2696,6 +3981,10 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "Pola nazwy użytkownika, tematu, języka i strefy czasowej są obowiązkowe.",
     "error.entries_per_page_invalid": "Liczba wpisów na stronę jest nieprawidłowa.",
     "error.feed_mandatory_fields": "URL i kategoria są obowiązkowe.",
+    "error.newsletter_mandatory_fields": "Tytuł i kategoria są obowiązkowe.",
+    "error.scraped_feed_mandatory_fields": "Adres URL strony, kategoria i selektor elementu są wymagane.",
+    "error.scraped_feed_item_selector_not_empty": "Selektor elementu nie może być pusty.",
+    "error.watch_page_mandatory_fields": "Adres URL strony i kategoria są wymagane.",
     "error.feed_already_exists": "Ten kanał już istnieje.",
     "error.invalid_feed_url": "Nieprawidłowy adres URL kanału.",
     "error.invalid_site_url": "Nieprawidłowy adres URL witryny.",
@@ -2722,14 +4011,28 @@ var translations = map[string]string{
     "form.feed.label.rewrite_rules": "Reguły zapisu",
     "form.feed.label.blocklist_rules": "Zasady blokowania",
     "form.feed.label.keeplist_rules": "Zasady zezwoleń",
+    "form.feed.label.watch_selector": "Obserwowana część strony (selektor CSS)",
+    "form.feed.help.watch_selector": "Pozostaw puste, aby obserwować całą stronę. Pozwala pominąć części zmieniające się przy każdej wizycie.",
     "form.feed.label.ignore_http_cache": "Zignoruj ​​pamięć podręczną HTTP",
     "form.feed.label.fetch_via_proxy": "Pobierz przez proxy",
     "form.feed.label.disabled": "Не обновлять этот канал",
+    "form.newsletter.label.address": "Adres e-mail newslettera",
+    "form.scraped_feed.label.page_url": "Adres URL strony",
+    "form.scraped_feed.legend.selectors": "Selektory CSS",
+    "form.scraped_feed.label.item": "Element",
+    "form.scraped_feed.label.title": "Tytuł",
+    "form.scraped_feed.label.link": "Link",
+    "form.scraped_feed.label.date": "Data",
+    "form.scraped_feed.label.content": "Treść",
+    "form.scraped_feed.help": "Bez selektora linku używany jest pierwszy link elementu. Bez selektora tytułu używany jest tekst linku. Bez selektora treści używany jest cały element.",
     "form.category.label.title": "Tytuł",
     "form.user.label.username": "Nazwa użytkownika",
     "form.user.label.password": "Hasło",
     "form.user.label.confirmation": "Potwierdzenie hasła",
     "form.user.label.admin": "Administrator",
+    "form.totp.label.code": "Kod uwierzytelniający",
+    "form.totp.label.code_or_recovery_code": "Kod uwierzytelniający lub kod odzyskiwania",
+    "form.webauthn.label.name": "Nazwa",
     "form.prefs.label.language": "Język",
     "form.prefs.label.timezone": "Strefa czasowa",
     "form.prefs.label.theme": "Wygląd",
@@ -2738,11 +4041,17 @@ var translations = map[string]string{
     "form.prefs.select.older_first": "Najstarsze wpisy jako pierwsze",
     "form.prefs.label.keyboard_shortcuts": "Włącz skróty klawiaturowe",
     "form.prefs.label.entry_swipe": "Włącz gest przesuwania na wpisach na telefonie komórkowym",
+    "form.prefs.label.archive_starred": "Zachowaj lokalną kopię ulubionych artykułów",
     "form.prefs.label.show_reading_time": "Pokaż szacowany czas czytania artykułów",
     "form.prefs.select.recent_first": "Najnowsze wpisy jako pierwsze",
     "form.prefs.label.custom_css": "Niestandardowy CSS",
     "form.import.label.file": "Plik OPML",
     "form.import.label.url": "URL",
+    "form.import.label.apply_settings": "Zastosuj ustawienia kanałów Miniflux (reguły, user agent, crawler, proxy)",
+    "form.import.label.validate": "Sprawdź każdy kanał przed subskrypcją (wolniej)",
+    "form.import.help.file": "Obsługiwane są pliki OPML, eksporty JSON w formacie Google Reader (Feedly, Inoreader), eksporty XML z Tiny Tiny RSS oraz archiwa ZIP z FreshRSS.",
+    "form.opml_source.label.url": "Adres URL listy OPML",
+    "form.opml_source.label.disable_removed": "Wyłącz kanały usunięte z listy",
     "form.integration.fever_activate": "Aktywuj Fever API",
     "form.integration.fever_username": "Login do Fever",
     "form.integration.fever_password": "Hasło do Fever",
@@ -2820,7 +4129,8 @@ var translations = map[string]string{
     "Invalid SSL certificate (original error: %q)": "Certyfikat SSL jest nieprawidłowy (błąd: %q)",
     "This website is temporarily unreachable (original error: %q)": "Ta strona jest tymczasowo niedostępna (błąd: %q)",
     "This website is permanently unreachable (original error: %q)": "Ta strona jest niedostępna (błąd: %q)",
-    "Website unreachable, the request timed out after %d seconds": "Strona internetowa nieosiągalna, żądanie wygasło po %d sekundach"
+    "Website unreachable, the request timed out after %d seconds": "Strona internetowa nieosiągalna, żądanie wygasło po %d sekundach",
+    "This website is hosted on a private or forbidden network address (%s)": "Ta strona jest hostowana pod prywatnym lub zabronionym adresem sieciowym (%s)"
 }
 `,
 	"pt_BR": `{
@@ -2830,15 +4140,22 @@ var translations = map[string]string{
     "confirm.loading": "Carregando...",
     "action.subscribe": "Inscrever",
     "action.save": "Salvar",
+    "action.preview": "Pré-visualizar",
     "action.or": "Ou",
     "action.cancel": "Cancelar",
     "action.remove": "Remover",
+    "action.filter": "Filtrar",
+    "action.unlock": "Desbloquear",
     "action.remove_feed": "Remover fonte",
     "action.update": "Atualizar",
+    "action.refresh_archive": "Atualizar o instantâneo",
     "action.edit": "Editar",
     "action.download": "Baixar",
     "action.import": "Importar",
     "action.login": "Iniciar sessão",
+    "action.enable_totp": "Ativar a autenticação de dois fatores",
+    "action.disable_totp": "Desativar a autenticação de dois fatores",
+    "action.register_webauthn": "Registrar uma chave de segurança ou passkey",
     "action.home_screen": "Voltar para a tela inicial",
     "tooltip.keyboard_shortcuts": "Atalho do teclado: %s",
     "tooltip.logged_user": "Autenticado como %s",
@@ -2852,12 +4169,18 @@ var translations = map[string]string{
     "menu.preferences": "Preferências",
     "menu.integrations": "Integrações",
     "menu.sessions": "Sessões",
+    "menu.totp": "Autenticação de dois fatores",
+    "menu.webauthn": "Passkeys",
+    "menu.security_activity": "Atividade de segurança",
+    "menu.audit": "Registro de auditoria",
     "menu.users": "Usuários",
     "menu.about": "Sobre",
     "menu.export": "Exportar",
     "menu.import": "Importar",
+    "menu.opml_sources": "Listas remotas",
     "menu.create_category": "Criar uma categoria",
     "menu.mark_page_as_read": "Marcar essa página como lída",
+    "menu.export_epub": "Exportar para EPUB",
     "menu.mark_all_as_read": "Marcar todos como lido",
     "menu.show_all_entries": "Mostrar todas os itens",
     "menu.show_only_unread_entries": "Mostrar apenas itens não lidos",
@@ -2893,6 +4216,8 @@ var translations = map[string]string{
     "entry.save.toast.completed": "Item guardado",
     "entry.scraper.label": "Baixar",
     "entry.scraper.title": "Obter conteúdo completo",
+    "entry.archive.label": "Instantâneo",
+    "entry.archive.title": "Manter uma cópia local do artigo",
     "entry.scraper.completed": "Feito!",
     "entry.external_link.label": "Link externo",
     "entry.comments.label": "Comentários",
@@ -2906,6 +4231,7 @@ var translations = map[string]string{
         "Leitura de %d minuto",
         "Leitura de %d minutos"
     ],
+    "entry.watch_page.title": "%s foi alterada",
     "page.shared_entries.title": "Itens compartilhados",
     "page.unread.title": "Não lídos",
     "page.starred.title": "Favoritos",
@@ -2918,6 +4244,17 @@ var translations = map[string]string{
         "Existem %d fontes."
     ],
     "page.new_category.title": "Nova categoria",
+    "page.new_newsletter.title": "Nova newsletter",
+    "page.new_newsletter.help": "Um endereço de e-mail único será gerado. Use-o para assinar uma newsletter e cada e-mail recebido aparecerá como um novo item.",
+    "page.new_scraped_feed.title": "Nova fonte a partir de uma página web",
+    "page.new_scraped_feed.help": "Para sites sem fonte: cada elemento encontrado pelo seletor de itens se torna um item. Os outros seletores são aplicados dentro de cada item.",
+    "page.new_scraped_feed.preview": [
+        "%d item encontrado",
+        "%d itens encontrados"
+    ],
+    "page.new_scraped_feed.content": "Conteúdo",
+    "page.new_watch_page.title": "Monitorar uma página web",
+    "page.new_watch_page.help": "A página é verificada como as outras fontes e um novo item mostrando as diferenças é criado sempre que o seu texto muda.",
     "page.new_user.title": "Novo usuário",
     "page.edit_category.title": "Editar categoria: %s",
     "page.edit_user.title": "Editar usuário: %s",
@@ -2931,7 +4268,40 @@ var translations = map[string]string{
     ],
     "page.history.title": "Histórico",
     "page.import.title": "Importar",
+    "page.import.report": "Importação concluída: %d fontes, %d categorias e %d itens (%d favoritos) criados, %d itens ignorados.",
+    "page.import_job.title": "Resultados da importação",
+    "page.import_job.running": "A importação está em andamento: %d de %d inscrições processadas.",
+    "page.import_job.running_items": "A importação está em andamento: %d de %d itens processados.",
+    "page.import_job.refresh": "Atualizar",
+    "page.import_job.finished": "A importação foi concluída: %d fontes criadas, %d duplicadas, %d inacessíveis, %d erros de análise, %d outros erros.",
+    "page.import_job.failed": "A importação falhou: %s",
+    "page.import_job.table.feed": "Fonte",
+    "page.import_job.table.category": "Categoria",
+    "page.import_job.table.result": "Resultado",
+    "page.import_job.status.created": "Criada",
+    "page.import_job.status.duplicate": "Já inscrito",
+    "page.import_job.status.unreachable": "Inacessível",
+    "page.import_job.status.parse_error": "Fonte inválida",
+    "page.import_job.status.error": "Erro",
+    "page.opml_sources.title": "Listas OPML remotas",
+    "page.opml_sources.help": "A lista remota é buscada periodicamente e as suas novas fontes são adicionadas à categoria escolhida.",
+    "page.opml_sources.no_source": "Você não está inscrito em nenhuma lista remota.",
+    "page.opml_sources.new": "Inscrever-se em uma lista remota",
+    "page.opml_sources.table.url": "Lista",
+    "page.opml_sources.table.category": "Categoria",
+    "page.opml_sources.table.feeds": "Fontes",
+    "page.opml_sources.table.checked_at": "Última verificação",
+    "page.opml_source.title": "Lista OPML remota",
+    "page.opml_source.never_checked": "Nunca",
+    "page.opml_source.error": "Último erro",
+    "page.opml_source.disable_removed": "As fontes removidas da lista são desativadas.",
+    "page.opml_source.history": "Histórico",
+    "page.opml_source.no_history": "As sincronizações ainda não alteraram nenhuma inscrição.",
+    "page.opml_source.change.added": "Adicionada",
+    "page.opml_source.change.disabled": "Desativada",
+    "page.opml_source.change.enabled": "Reativada",
     "page.search.title": "Resultados da busca",
+    "page.tag_entries.title": "Etiqueta: %s",
     "page.about.title": "Sobre",
     "page.about.credits": "Créditos",
     "page.about.version": "Versão:",
@@ -2945,6 +4315,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "Buscar uma fonte",
     "page.add_feed.legend.advanced_options": "Opções avançadas",
     "page.add_feed.choose_feed": "Escolher uma fonte",
+    "page.add_feed.newsletter": "Receber uma newsletter por e-mail",
+    "page.add_feed.scraped_feed": "Sem fonte neste site? Extraia os itens de uma página web",
+    "page.add_feed.watch_page": "Monitorar as mudanças de uma página web",
     "page.edit_feed.title": "Editar fonte: %s",
     "page.edit_feed.last_check": "Última verificação:",
     "page.edit_feed.last_modified_header": "Cabeçalho 'LastModified':",
@@ -2952,6 +4325,14 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "Sem cabeçalhos",
     "page.edit_feed.last_parsing_error": "Último erro durante processamento",
     "page.entry.attachments": "Anexos",
+    "page.entry.podcast.season": "Temporada %d",
+    "page.entry.podcast.episode": "Episódio %s",
+    "page.entry.podcast.chapters": "Capítulos",
+    "page.entry.podcast.transcript": "Transcrição",
+    "page.entry.podcast.transcripts": "Transcrições",
+    "page.entry.podcast.captions": "legendas",
+    "page.entry.podcast.persons": "Pessoas",
+    "page.entry.podcast.funding": "Apoie este podcast:",
     "page.keyboard_shortcuts.title": "Atalhos de teclado",
     "page.keyboard_shortcuts.subtitle.sections": "Navegação de seções",
     "page.keyboard_shortcuts.subtitle.items": "Navegação de itens",
@@ -2992,14 +4373,24 @@ var translations = map[string]string{
     "page.users.actions": "Ações",
     "page.users.last_login": "Último acesso",
     "page.users.is_admin": "Administrador",
+    "page.users.locked": "Bloqueado após muitas falhas de login",
     "page.settings.title": "Ajustes",
     "page.settings.link_google_account": "Vincular minha conta do Google",
     "page.settings.unlink_google_account": "Desvincular minha conta do Google",
     "page.settings.link_oidc_account": "Vincular minha conta do OpenID Connect",
     "page.settings.unlink_oidc_account": "Desvincular minha conta do OpenID Connect",
+    "page.settings.link_github_account": "Vincular minha conta do GitHub",
+    "page.settings.unlink_github_account": "Desvincular minha conta do GitHub",
+    "page.settings.link_gitlab_account": "Vincular minha conta do GitLab",
+    "page.settings.unlink_gitlab_account": "Desvincular minha conta do GitLab",
     "page.login.title": "Iniciar Sessão",
     "page.login.google_signin": "Iniciar Sessão com sua conta do Google",
     "page.login.oidc_signin": "Iniciar Sessão com sua conta do OpenID Connect",
+    "page.login.github_signin": "Iniciar Sessão com sua conta do GitHub",
+    "page.login.gitlab_signin": "Iniciar Sessão com sua conta do GitLab",
+    "page.login.webauthn_signin": "Entrar com uma passkey",
+    "page.login_totp.title": "Autenticação de dois fatores",
+    "page.login_totp.help": "Digite o código exibido pelo seu aplicativo autenticador ou um dos seus códigos de recuperação.",
     "page.integrations.title": "Integrações",
     "page.integration.miniflux_api": "API do Miniflux",
     "page.integration.miniflux_api_endpoint": "Endpoint da API",
@@ -3011,11 +4402,58 @@ var translations = map[string]string{
     "page.integration.bookmarklet.instructions": "Arrasta e solta esse link para os favoritos do teu navegador.",
     "page.integration.bookmarklet.help": "Esse link especial permite você se inscrever a um site diretamente usando favorito do navegador.",
     "page.sessions.title": "Sessões",
+    "page.totp.title": "Autenticação de dois fatores",
+    "page.totp.enroll_help": "Escaneie este código QR com um aplicativo autenticador e digite o código exibido para confirmar.",
+    "page.totp.qr_code": "Código QR",
+    "page.totp.secret": "Chave secreta:",
+    "page.totp.recovery_codes_help": "Guarde estes códigos de recuperação em um local seguro. Cada código pode ser usado uma vez para entrar caso você perca o acesso ao seu aplicativo autenticador. Eles não serão exibidos novamente.",
+    "page.totp.enabled": [
+        "A autenticação de dois fatores está ativada. Resta %d código de recuperação.",
+        "A autenticação de dois fatores está ativada. Restam %d códigos de recuperação."
+    ],
+    "page.webauthn.title": "Chaves de segurança e passkeys",
+    "page.webauthn.help": "Chaves de segurança e passkeys permitem entrar sem digitar sua senha.",
+    "page.webauthn.unsupported": "Seu navegador não suporta chaves de segurança e passkeys.",
+    "page.webauthn.never_used": "Nunca usada",
+    "page.webauthn.table.name": "Nome",
+    "page.webauthn.table.last_used_at": "Último uso",
+    "page.webauthn.table.created_at": "Data de criação",
+    "page.webauthn.table.actions": "Ações",
     "page.sessions.table.date": "Data",
     "page.sessions.table.ip": "Endereço IP",
     "page.sessions.table.user_agent": "Agente de usuário",
     "page.sessions.table.actions": "Ações",
     "page.sessions.table.current_session": "Sessão Atual",
+    "page.security_activity.title": "Atividade de segurança recente",
+    "page.entry_archive.created_at": "Instantâneo criado",
+    "page.entry_archive.pending": "Um novo instantâneo está sendo criado, recarregue a página em instantes.",
+    "page.entry_archive.unavailable": "Muitos instantâneos estão sendo criados, tente novamente mais tarde.",
+    "page.audit.title": "Registro de auditoria",
+    "page.audit.date": "Data",
+    "page.audit.action": "Ação",
+    "page.audit.actor": "Realizado por",
+    "page.audit.target": "Detalhes",
+    "page.audit.client_ip": "Endereço IP",
+    "page.audit.user": "Usuário",
+    "page.audit.all_users": "Todos os usuários",
+    "page.audit.all_actions": "Todas as ações",
+    "audit.action.login": "Login",
+    "audit.action.login_failed": "Falha de login",
+    "audit.action.logout": "Logout",
+    "audit.action.password_changed": "Senha alterada",
+    "audit.action.api_key_created": "Chave de API criada",
+    "audit.action.api_key_removed": "Chave de API removida",
+    "audit.action.user_created": "Usuário criado",
+    "audit.action.user_removed": "Usuário removido",
+    "audit.action.user_promoted": "Direitos de administrador concedidos",
+    "audit.action.user_demoted": "Direitos de administrador revogados",
+    "audit.action.user_unlocked": "Usuário desbloqueado",
+    "audit.action.feed_removed": "Fonte removida",
+    "audit.action.totp_enabled": "Autenticação de dois fatores ativada",
+    "audit.action.totp_disabled": "Autenticação de dois fatores desativada",
+    "audit.action.webauthn_registered": "Chave de acesso registrada",
+    "audit.action.webauthn_removed": "Chave de acesso removida",
+    "audit.action.session_removed": "Sessão removida",
     "page.api_keys.title": "Chaves de API",
     "page.api_keys.table.description": "Descrição",
     "page.api_keys.table.token": "Token",
@@ -3034,12 +4472,15 @@ var translations = map[string]string{
     "alert.no_history": "Não há histórico nesse momento.",
     "alert.feed_error": "Ocorreu um problema com esta fonte.",
     "alert.no_search_result": "Não há resultados para essa busca.",
+    "alert.no_tag_entry": "Não há itens com essa etiqueta.",
     "alert.no_unread_entry": "Não há itens não lidos.",
     "alert.no_user": "Você é o único usuário.",
+    "alert.no_audit_event": "Não há nenhum evento.",
     "alert.account_unlinked": "Sua conta externa está desvinculada!",
     "alert.account_linked": "Sua conta externa está vinculada!",
     "alert.pocket_linked": "Sua conta do Pocket está vinculada!",
     "alert.prefs_saved": "Suas preferências foram salvas!",
+    "alert.totp_disabled": "A autenticação de dois fatores agora está desativada.",
     "error.unlink_account_without_password": "Você deve definir uma senha, senão não será possível efetuar a sessão novamente.",
     "error.duplicate_linked_account": "Alguém já está vinculado a esse serviço!",
     "error.duplicate_fever_username": "Alguém já está utilizando esse nome de usuário do Fever!",
@@ -3054,7 +4495,15 @@ var translations = map[string]string{
     "error.unable_to_update_feed": "Não foi possível atualizar essa fonte.",
     "error.subscription_not_found": "Não foi possível encontrar uma inscrição.",
     "error.empty_file": "Esse arquivo está vazio.",
+    "error.file_too_large": "Esse arquivo é muito grande (máximo de 50 MB).",
+    "error.unsupported_import_format": "Este formato de arquivo não é suportado.",
+    "error.invalid_opml_source_url": "URL da lista inválida.",
+    "error.opml_source_already_exists": "Você já está inscrito nesta lista.",
     "error.bad_credentials": "Usuário ou senha são inválidos.",
+    "error.too_many_login_attempts": "Muitas tentativas de login malsucedidas, tente novamente mais tarde.",
+    "error.invalid_totp_code": "Código de autenticação de dois fatores inválido.",
+    "error.totp_code_required": "O código é obrigatório.",
+    "error.webauthn_failed": "A operação com sua chave de segurança ou passkey falhou ou foi cancelada.",
     "error.fields_mandatory": "Todos os campos são obrigatórios.",
     "error.title_required": "O título é obrigatório.",
     "error.different_passwords": "As senhas não são iguais.",
@@ -3062,6 +4511,10 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "Os campos de nome de usuário, tema, idioma e fuso horário são obrigatórios.",
     "error.entries_per_page_invalid": "O número de itens por página é inválido.",
     "error.feed_mandatory_fields": "O campo de URL e categoria são obrigatórios.",
+    "error.newsletter_mandatory_fields": "O título e a categoria são obrigatórios.",
+    "error.scraped_feed_mandatory_fields": "A URL da página, a categoria e o seletor de itens são obrigatórios.",
+    "error.scraped_feed_item_selector_not_empty": "O seletor de itens não pode ficar vazio.",
+    "error.watch_page_mandatory_fields": "A URL da página e a categoria são obrigatórias.",
     "error.feed_already_exists": "Este feed já existe.",
     "error.invalid_feed_url": "URL de feed inválido.",
     "error.invalid_site_url": "URL de site inválido.",
@@ -3088,14 +4541,28 @@ var translations = map[string]string{
     "form.feed.label.rewrite_rules": "Regras para o Rewrite",
     "form.feed.label.blocklist_rules": "Regras de bloqueio",
     "form.feed.label.keeplist_rules": "Regras de permissão",
+    "form.feed.label.watch_selector": "Parte monitorada da página (seletor CSS)",
+    "form.feed.help.watch_selector": "Deixe vazio para monitorar a página inteira. Use-o para ignorar as partes que mudam a cada visita.",
     "form.feed.label.ignore_http_cache": "Ignorar cache HTTP",
     "form.feed.label.disabled": "Não atualizar esta fonte",
+    "form.newsletter.label.address": "Endereço de e-mail da newsletter",
+    "form.scraped_feed.label.page_url": "URL da página web",
+    "form.scraped_feed.legend.selectors": "Seletores CSS",
+    "form.scraped_feed.label.item": "Item",
+    "form.scraped_feed.label.title": "Título",
+    "form.scraped_feed.label.link": "Link",
+    "form.scraped_feed.label.date": "Data",
+    "form.scraped_feed.label.content": "Conteúdo",
+    "form.scraped_feed.help": "Sem seletor de link, o primeiro link do item é usado. Sem seletor de título, o texto do link é usado. Sem seletor de conteúdo, o item inteiro é usado.",
     "form.feed.label.fetch_via_proxy": "Buscar via proxy",
     "form.category.label.title": "Título",
     "form.user.label.username": "Nome de usuário",
     "form.user.label.password": "Senha",
     "form.user.label.confirmation": "Confirmação de senha",
     "form.user.label.admin": "Administrador",
+    "form.totp.label.code": "Código de autenticação",
+    "form.totp.label.code_or_recovery_code": "Código de autenticação ou de recuperação",
+    "form.webauthn.label.name": "Nome",
     "form.prefs.label.language": "Idioma",
     "form.prefs.label.timezone": "Fuso horário",
     "form.prefs.label.theme": "Tema",
@@ -3105,10 +4572,16 @@ var translations = map[string]string{
     "form.prefs.select.recent_first": "Itens mais recentes",
     "form.prefs.label.keyboard_shortcuts": "Habilitar atalhos do teclado",
     "form.prefs.label.entry_swipe": "Ativar gesto de deslizar nas entradas no celular",
+    "form.prefs.label.archive_starred": "Manter uma cópia local dos artigos favoritos",
     "form.prefs.label.show_reading_time": "Mostrar tempo estimado de leitura de artigos",
     "form.prefs.label.custom_css": "CSS customizado",
     "form.import.label.file": "Arquivo OPML",
     "form.import.label.url": "URL",
+    "form.import.label.apply_settings": "Aplicar as configurações do Miniflux das fontes (regras, user agent, crawler, proxy)",
+    "form.import.label.validate": "Verificar cada fonte antes de se inscrever (mais lento)",
+    "form.import.help.file": "São suportados arquivos OPML, exportações JSON do Google Reader (Feedly, Inoreader), exportações XML do Tiny Tiny RSS e arquivos ZIP do FreshRSS.",
+    "form.opml_source.label.url": "URL da lista OPML",
+    "form.opml_source.label.disable_removed": "Desativar as fontes removidas da lista",
     "form.integration.fever_activate": "Ativar API do Fever",
     "form.integration.fever_username": "Nome de usuário do Fever",
     "form.integration.fever_password": "Senha do Fever",
@@ -3172,15 +4645,22 @@ var translations = map[string]string{
     "confirm.loading": "В процессе…",
     "action.subscribe": "Подписаться",
     "action.save": "Сохранить",
+    "action.preview": "Предпросмотр",
     "action.or": "или",
     "action.cancel": "закрыть",
     "action.remove": "Удалить",
+    "action.filter": "Фильтровать",
+    "action.unlock": "Разблокировать",
     "action.remove_feed": "Удалить эту подписку",
     "action.update": "Обновить",
+    "action.refresh_archive": "Обновить снимок",
     "action.edit": "Изменить",
     "action.download": "Загрузить",
     "action.import": "Импорт",
     "action.login": "Войти",
+    "action.enable_totp": "Включить двухфакторную аутентификацию",
+    "action.disable_totp": "Отключить двухфакторную аутентификацию",
+    "action.register_webauthn": "Зарегистрировать ключ безопасности или passkey",
     "action.home_screen": "Добавить на домашний экран",
     "tooltip.keyboard_shortcuts": "Сочетания клавиш: %s",
     "tooltip.logged_user": "Авторизован как %s",
@@ -3194,12 +4674,18 @@ var translations = map[string]string{
     "menu.preferences": "Предпочтения",
     "menu.integrations": "Интеграции",
     "menu.sessions": "Сессии",
+    "menu.totp": "Двухфакторная аутентификация",
+    "menu.webauthn": "Passkeys",
+    "menu.security_activity": "Активность безопасности",
+    "menu.audit": "Журнал аудита",
     "menu.users": "Пользователи",
     "menu.about": "О приложении",
     "menu.export": "Экспорт",
     "menu.import": "Импорт",
+    "menu.opml_sources": "Удалённые списки",
     "menu.create_category": "Создать категорию",
     "menu.mark_page_as_read": "Отметить эту страницу прочитанной",
+    "menu.export_epub": "Экспорт в EPUB",
     "menu.mark_all_as_read": "Отметить всё как прочитанное",
     "menu.show_all_entries": "Показать все статьи",
     "menu.show_only_unread_entries": "Показывать только непрочитанные статьи",
@@ -3235,6 +4721,8 @@ var translations = map[string]string{
     "entry.save.toast.completed": "Статья сохранена",
     "entry.scraper.label": "Скачать",
     "entry.scraper.title": "Извлечь оригинальное содержимое",
+    "entry.archive.label": "Снимок",
+    "entry.archive.title": "Сохранить локальную копию статьи",
     "entry.scraper.completed": "Готово!",
     "entry.external_link.label": "Внешняя ссылка",
     "entry.comments.label": "Комментарии",
@@ -3248,6 +4736,7 @@ var translations = map[string]string{
         "%d минута чтения",
         "%d минут чтения"
     ],
+    "entry.watch_page.title": "%s изменилась",
     "page.shared_entries.title": "Общедоступные записи",
     "page.unread.title": "Непрочитанное",
     "page.starred.title": "Избранное",
@@ -3261,6 +4750,18 @@ var translations = map[string]string{
         "Есть %d подписок."
     ],
     "page.new_category.title": "Новая категория",
+    "page.new_newsletter.title": "Новая рассылка",
+    "page.new_newsletter.help": "Будет создан уникальный адрес электронной почты. Используйте его для подписки на рассылку, и каждое полученное письмо появится как новая статья.",
+    "page.new_scraped_feed.title": "Новая подписка из веб-страницы",
+    "page.new_scraped_feed.help": "Для сайтов без ленты: каждый элемент, найденный селектором элементов, становится статьёй. Остальные селекторы применяются внутри каждого элемента.",
+    "page.new_scraped_feed.preview": [
+        "Найдена %d статья",
+        "Найдено %d статьи",
+        "Найдено %d статей"
+    ],
+    "page.new_scraped_feed.content": "Содержимое",
+    "page.new_watch_page.title": "Отслеживать веб-страницу",
+    "page.new_watch_page.help": "Страница проверяется как остальные подписки, и при каждом изменении её текста создаётся новая статья с отличиями.",
     "page.new_user.title": "Новый пользователь",
     "page.edit_category.title": "Изменить категорию: %s",
     "page.edit_user.title": "Изменить пользователя: %s",
@@ -3275,7 +4776,40 @@ var translations = map[string]string{
     ],
     "page.history.title": "История",
     "page.import.title": "Импорт",
+    "page.import.report": "Импорт завершён: создано подписок — %d, категорий — %d, статей — %d (в избранном — %d), пропущено статей — %d.",
+    "page.import_job.title": "Результаты импорта",
+    "page.import_job.running": "Импорт выполняется: обработано подписок — %d из %d.",
+    "page.import_job.running_items": "Импорт выполняется: обработано %d из %d элементов.",
+    "page.import_job.refresh": "Обновить",
+    "page.import_job.finished": "Импорт завершён: создано подписок — %d, дубликатов — %d, недоступных — %d, ошибок разбора — %d, других ошибок — %d.",
+    "page.import_job.failed": "Импорт не удался: %s",
+    "page.import_job.table.feed": "Подписка",
+    "page.import_job.table.category": "Категория",
+    "page.import_job.table.result": "Результат",
+    "page.import_job.status.created": "Создана",
+    "page.import_job.status.duplicate": "Уже подписаны",
+    "page.import_job.status.unreachable": "Недоступна",
+    "page.import_job.status.parse_error": "Неверный формат",
+    "page.import_job.status.error": "Ошибка",
+    "page.opml_sources.title": "Удалённые списки OPML",
+    "page.opml_sources.help": "Удалённый список периодически загружается, а новые подписки добавляются в выбранную категорию.",
+    "page.opml_sources.no_source": "Вы не подписаны ни на один удалённый список.",
+    "page.opml_sources.new": "Подписаться на удалённый список",
+    "page.opml_sources.table.url": "Список",
+    "page.opml_sources.table.category": "Категория",
+    "page.opml_sources.table.feeds": "Подписки",
+    "page.opml_sources.table.checked_at": "Последняя проверка",
+    "page.opml_source.title": "Удалённый список OPML",
+    "page.opml_source.never_checked": "Никогда",
+    "page.opml_source.error": "Последняя ошибка",
+    "page.opml_source.disable_removed": "Подписки, удалённые из списка, отключаются.",
+    "page.opml_source.history": "История",
+    "page.opml_source.no_history": "Синхронизации ещё не изменили ни одной подписки.",
+    "page.opml_source.change.added": "Добавлено",
+    "page.opml_source.change.disabled": "Отключено",
+    "page.opml_source.change.enabled": "Снова включено",
     "page.search.title": "Результаты поиска",
+    "page.tag_entries.title": "Тег: %s",
     "page.about.title": "О приложении",
     "page.about.credits": "Авторы",
     "page.about.version": "Версия:",
@@ -3289,6 +4823,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "Найти подписку",
     "page.add_feed.legend.advanced_options": "Расширенные настройки",
     "page.add_feed.choose_feed": "Выбрать подписку",
+    "page.add_feed.newsletter": "Получать рассылку по электронной почте",
+    "page.add_feed.scraped_feed": "На сайте нет ленты? Извлечь статьи из веб-страницы",
+    "page.add_feed.watch_page": "Отслеживать изменения веб-страницы",
     "page.edit_feed.title": "Изменить подписку: %s",
     "page.edit_feed.last_check": "Последняя проверка:",
     "page.edit_feed.last_modified_header": "Заголовок LastModified:",
@@ -3296,6 +4833,14 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "Отсутствует",
     "page.edit_feed.last_parsing_error": "Последняя ошибка парсинга",
     "page.entry.attachments": "Вложения",
+    "page.entry.podcast.season": "Сезон %d",
+    "page.entry.podcast.episode": "Эпизод %s",
+    "page.entry.podcast.chapters": "Главы",
+    "page.entry.podcast.transcript": "Расшифровка",
+    "page.entry.podcast.transcripts": "Расшифровки",
+    "page.entry.podcast.captions": "субтитры",
+    "page.entry.podcast.persons": "Участники",
+    "page.entry.podcast.funding": "Поддержать подкаст:",
     "page.keyboard_shortcuts.title": "Сочетания клавиш",
     "page.keyboard_shortcuts.subtitle.sections": "Навигация по секциям",
     "page.keyboard_shortcuts.subtitle.items": "Навигация по элементам",
@@ -3336,14 +4881,24 @@ var translations = map[string]string{
     "page.users.actions": "Действия",
     "page.users.last_login": "Последний вход",
     "page.users.is_admin": "Администратор",
+    "page.users.locked": "Заблокирован после слишком большого числа неудачных входов",
     "page.settings.title": "Настройки",
     "page.settings.link_google_account": "Привязать мой Google аккаунт",
     "page.settings.unlink_google_account": "Отвязать мой Google аккаунт",
     "page.settings.link_oidc_account": "Привязать мой OpenID Connect аккаунт",
     "page.settings.unlink_oidc_account": "Отвязать мой OpenID Connect аккаунт",
+    "page.settings.link_github_account": "Привязать мой GitHub аккаунт",
+    "page.settings.unlink_github_account": "Отвязать мой GitHub аккаунт",
+    "page.settings.link_gitlab_account": "Привязать мой GitLab аккаунт",
+    "page.settings.unlink_gitlab_account": "Отвязать мой GitLab аккаунт",
     "page.login.title": "Войти",
     "page.login.google_signin": "Войти с помощью Google",
     "page.login.oidc_signin": "Войти с помощью OpenID Connect",
+    "page.login.github_signin": "Войти с помощью GitHub",
+    "page.login.gitlab_signin": "Войти с помощью GitLab",
+    "page.login.webauthn_signin": "Войти с помощью passkey",
+    "page.login_totp.title": "Двухфакторная аутентификация",
+    "page.login_totp.help": "Введите код из приложения-аутентификатора или один из кодов восстановления.",
     "page.integrations.title": "Интеграции",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "Конечная точка API",
@@ -3355,11 +4910,59 @@ var translations = map[string]string{
     "page.integration.bookmarklet.instructions": "Перетащите эту ссылку в ваши закладки.",
     "page.integration.bookmarklet.help": "Эта специальная ссылка позволит вам подписаться на сайт, используя обыкновенную закладку в вашем браузере.",
     "page.sessions.title": "Сессии",
+    "page.totp.title": "Двухфакторная аутентификация",
+    "page.totp.enroll_help": "Отсканируйте этот QR-код приложением-аутентификатором и введите показанный код для подтверждения.",
+    "page.totp.qr_code": "QR-код",
+    "page.totp.secret": "Секретный ключ:",
+    "page.totp.recovery_codes_help": "Сохраните эти коды восстановления в надёжном месте. Каждый код можно использовать один раз для входа, если вы потеряете доступ к приложению-аутентификатору. Они больше не будут показаны.",
+    "page.totp.enabled": [
+        "Двухфакторная аутентификация включена. Остался %d код восстановления.",
+        "Двухфакторная аутентификация включена. Осталось %d кода восстановления.",
+        "Двухфакторная аутентификация включена. Осталось %d кодов восстановления."
+    ],
+    "page.webauthn.title": "Ключи безопасности и passkeys",
+    "page.webauthn.help": "Ключи безопасности и passkeys позволяют входить без ввода пароля.",
+    "page.webauthn.unsupported": "Ваш браузер не поддерживает ключи безопасности и passkeys.",
+    "page.webauthn.never_used": "Никогда не использовался",
+    "page.webauthn.table.name": "Название",
+    "page.webauthn.table.last_used_at": "Последнее использование",
+    "page.webauthn.table.created_at": "Дата создания",
+    "page.webauthn.table.actions": "Действия",
     "page.sessions.table.date": "Время",
     "page.sessions.table.ip": "IP адрес",
     "page.sessions.table.user_agent": "User Agent",
     "page.sessions.table.actions": "Действия",
     "page.sessions.table.current_session": "Текущая сессия",
+    "page.security_activity.title": "Недавняя активность безопасности",
+    "page.entry_archive.created_at": "Снимок сделан",
+    "page.entry_archive.pending": "Создаётся новый снимок, обновите страницу через несколько секунд.",
+    "page.entry_archive.unavailable": "Создаётся слишком много снимков, попробуйте позже.",
+    "page.audit.title": "Журнал аудита",
+    "page.audit.date": "Дата",
+    "page.audit.action": "Действие",
+    "page.audit.actor": "Выполнено",
+    "page.audit.target": "Подробности",
+    "page.audit.client_ip": "IP-адрес",
+    "page.audit.user": "Пользователь",
+    "page.audit.all_users": "Все пользователи",
+    "page.audit.all_actions": "Все действия",
+    "audit.action.login": "Вход",
+    "audit.action.login_failed": "Неудачный вход",
+    "audit.action.logout": "Выход",
+    "audit.action.password_changed": "Пароль изменён",
+    "audit.action.api_key_created": "Ключ API создан",
+    "audit.action.api_key_removed": "Ключ API удалён",
+    "audit.action.user_created": "Пользователь создан",
+    "audit.action.user_removed": "Пользователь удалён",
+    "audit.action.user_promoted": "Права администратора предоставлены",
+    "audit.action.user_demoted": "Права администратора отозваны",
+    "audit.action.user_unlocked": "Пользователь разблокирован",
+    "audit.action.feed_removed": "Подписка удалена",
+    "audit.action.totp_enabled": "Двухфакторная аутентификация включена",
+    "audit.action.totp_disabled": "Двухфакторная аутентификация отключена",
+    "audit.action.webauthn_registered": "Ключ доступа зарегистрирован",
+    "audit.action.webauthn_removed": "Ключ доступа удалён",
+    "audit.action.session_removed": "Сеанс удалён",
     "page.api_keys.title": "API-ключи",
     "page.api_keys.table.description": "Описание",
     "page.api_keys.table.token": "Токен",
@@ -3378,12 +4981,15 @@ var translations = map[string]string{
     "alert.no_history": "Истории пока нет.",
     "alert.feed_error": "С этой подпиской есть проблема",
     "alert.no_search_result": "Нет результатов для данного поискового запроса.",
+    "alert.no_tag_entry": "Нет статей с этим тегом.",
     "alert.no_unread_entry": "Нет непрочитанных статей.",
     "alert.no_user": "Вы единственный пользователь.",
+    "alert.no_audit_event": "Нет событий.",
     "alert.account_unlinked": "Ваш внешний аккаунт теперь отвязан!",
     "alert.account_linked": "Ваш внешний аккаунт теперь привязан!",
     "alert.pocket_linked": "Ваш Pocket аккаунт теперь привязан!",
     "alert.prefs_saved": "Предпочтения сохранены!",
+    "alert.totp_disabled": "Двухфакторная аутентификация отключена.",
     "error.unlink_account_without_password": "Вы должны установить пароль, иначе вы не сможете войти снова.",
     "error.duplicate_linked_account": "Уже есть кто-то, кто ассоциирован с этим аккаунтом!",
     "error.duplicate_fever_username": "Уже есть кто-то с таким же именем пользователя Fever!",
@@ -3398,7 +5004,15 @@ var translations = map[string]string{
     "error.unable_to_update_feed": "Не удается обновить эту подписку.",
     "error.subscription_not_found": "Не удается найти подписки.",
     "error.empty_file": "Этот файл пуст.",
+    "error.file_too_large": "Этот файл слишком большой (не более 50 МБ).",
+    "error.unsupported_import_format": "Этот формат файла не поддерживается.",
+    "error.invalid_opml_source_url": "Недопустимый URL списка.",
+    "error.opml_source_already_exists": "Вы уже подписаны на этот список.",
     "error.bad_credentials": "Неверное имя пользователя или пароль.",
+    "error.too_many_login_attempts": "Слишком много неудачных попыток входа, повторите попытку позже.",
+    "error.invalid_totp_code": "Неверный код двухфакторной аутентификации.",
+    "error.totp_code_required": "Код обязателен.",
+    "error.webauthn_failed": "Операция с ключом безопасности или passkey не удалась или была отменена.",
     "error.fields_mandatory": "Все поля обязательны.",
     "error.title_required": "Название обязательно.",
     "error.different_passwords": "Пароли не совпадают.",
@@ -3406,6 +5020,10 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "Имя пользователя, тема, язык и часовой пояс обязательны.",
     "error.entries_per_page_invalid": "Количество записей на странице недействительно.",
     "error.feed_mandatory_fields": "URL и категория обязательны.",
+    "error.newsletter_mandatory_fields": "Название и категория обязательны.",
+    "error.scraped_feed_mandatory_fields": "URL страницы, категория и селектор элементов обязательны.",
+    "error.scraped_feed_item_selector_not_empty": "Селектор элементов не может быть пустым.",
+    "error.watch_page_mandatory_fields": "URL страницы и категория обязательны.",
     "error.feed_already_exists": "Этот фид уже существует.",
     "error.invalid_feed_url": "Недействительный URL фида.",
     "error.invalid_site_url": "Недействительный URL сайта.",
@@ -3432,14 +5050,28 @@ var translations = map[string]string{
     "form.feed.label.rewrite_rules": "Правила Rewrite",
     "form.feed.label.blocklist_rules": "Правила блокировки",
     "form.feed.label.keeplist_rules": "правила разрешений",
+    "form.feed.label.watch_selector": "Отслеживаемая часть страницы (CSS-селектор)",
+    "form.feed.help.watch_selector": "Оставьте пустым, чтобы отслеживать всю страницу. Позволяет игнорировать части, меняющиеся при каждом посещении.",
     "form.feed.label.ignore_http_cache": "Игнорировать HTTP-кеш",
     "form.feed.label.fetch_via_proxy": "Получить через прокси",
     "form.feed.label.disabled": "Не обновлять этот канал",
+    "form.newsletter.label.address": "Адрес электронной почты рассылки",
+    "form.scraped_feed.label.page_url": "URL веб-страницы",
+    "form.scraped_feed.legend.selectors": "CSS-селекторы",
+    "form.scraped_feed.label.item": "Элемент",
+    "form.scraped_feed.label.title": "Заголовок",
+    "form.scraped_feed.label.link": "Ссылка",
+    "form.scraped_feed.label.date": "Дата",
+    "form.scraped_feed.label.content": "Содержимое",
+    "form.scraped_feed.help": "Без селектора ссылки используется первая ссылка элемента. Без селектора заголовка используется текст ссылки. Без селектора содержимого используется весь элемент.",
     "form.category.label.title": "Название",
     "form.user.label.username": "Имя пользователя",
     "form.user.label.password": "Пароль",
     "form.user.label.confirmation": "Подтверждение пароля",
     "form.user.label.admin": "Администратор",
+    "form.totp.label.code": "Код аутентификации",
+    "form.totp.label.code_or_recovery_code": "Код аутентификации или код восстановления",
+    "form.webauthn.label.name": "Название",
     "form.prefs.label.language": "Язык",
     "form.prefs.label.timezone": "Часовой пояс",
     "form.prefs.label.theme": "Тема",
@@ -3449,10 +5081,16 @@ var translations = map[string]string{
     "form.prefs.select.recent_first": "Сначала последние записи",
     "form.prefs.label.keyboard_shortcuts": "Включить сочетания клавиш",
     "form.prefs.label.entry_swipe": "Включить жест смахивания для записей на мобильном устройстве",
+    "form.prefs.label.archive_starred": "Сохранять локальные копии избранных статей",
     "form.prefs.label.show_reading_time": "Показать примерное время чтения статей",
     "form.prefs.label.custom_css": "Пользовательские CSS",
     "form.import.label.file": "OPML файл",
     "form.import.label.url": "URL",
+    "form.import.label.apply_settings": "Применить настройки подписок Miniflux (правила, user agent, краулер, прокси)",
+    "form.import.label.validate": "Проверять каждую подписку перед добавлением (медленнее)",
+    "form.import.help.file": "Поддерживаются файлы OPML, экспорт JSON в формате Google Reader (Feedly, Inoreader), экспорт XML из Tiny Tiny RSS и ZIP-архивы FreshRSS.",
+    "form.opml_source.label.url": "URL списка OPML",
+    "form.opml_source.label.disable_removed": "Отключать подписки, удалённые из списка",
     "form.integration.fever_activate": "Активировать Fever API",
     "form.integration.fever_username": "Имя пользователя Fever",
     "form.integration.fever_password": "Пароль Fever",
@@ -3522,15 +5160,22 @@ var translations = map[string]string{
     "confirm.loading": "执行中…",
     "action.subscribe": "订阅",
     "action.save": "保存",
+    "action.preview": "预览",
     "action.or": "或",
     "action.cancel": "取消",
     "action.remove": "删除",
+    "action.filter": "筛选",
+    "action.unlock": "解锁",
     "action.remove_feed": "删除此源",
     "action.update": "更新",
+    "action.refresh_archive": "刷新快照",
     "action.edit": "编辑",
     "action.download": "下载",
     "action.import": "导入",
     "action.login": "登陆",
+    "action.enable_totp": "启用双重认证",
+    "action.disable_totp": "停用双重认证",
+    "action.register_webauthn": "注册安全密钥或通行密钥",
     "action.home_screen": "添加到主屏幕",
     "tooltip.keyboard_shortcuts": "快捷键: %s",
     "tooltip.logged_user": "当前登录 %s",
@@ -3544,12 +5189,18 @@ var translations = map[string]string{
     "menu.preferences": "设置",
     "menu.integrations": "集成",
     "menu.sessions": "会话",
+    "menu.totp": "双重认证",
+    "menu.webauthn": "通行密钥",
+    "menu.security_activity": "安全活动",
+    "menu.audit": "审计日志",
     "menu.users": "用户",
     "menu.about": "关于",
     "menu.export": "导出",
     "menu.import": "导入",
+    "menu.opml_sources": "远程列表",
     "menu.create_category": "新建分类",
     "menu.mark_page_as_read": "标记为已读",
+    "menu.export_epub": "导出为 EPUB",
     "menu.mark_all_as_read": "全部标为已读",
     "menu.show_all_entries": "显示所有条目",
     "menu.show_only_unread_entries": "仅显示未读文章",
@@ -3585,6 +5236,8 @@ var translations = map[string]string{
     "entry.save.toast.completed": "已保存文章",
     "entry.scraper.label": "下载",
     "entry.scraper.title": "抓取原内容",
+    "entry.archive.label": "快照",
+    "entry.archive.title": "保留文章的本地副本",
     "entry.scraper.completed": "完成",
     "entry.external_link.label": "外部链接",
     "entry.comments.label": "评论",
@@ -3598,6 +5251,7 @@ var translations = map[string]string{
         "%d分钟阅读",
         "%d分钟阅读"
     ],
+    "entry.watch_page.title": "%s 已更改",
     "page.shared_entries.title": "共享条目",
     "page.unread.title": "未读",
     "page.starred.title": "星标",
@@ -3609,6 +5263,16 @@ var translations = map[string]string{
         "有 %d 个源"
     ],
     "page.new_category.title": "新分类",
+    "page.new_newsletter.title": "新建邮件订阅",
+    "page.new_newsletter.help": "将生成一个唯一的电子邮件地址。使用它订阅邮件通讯，收到的每封邮件都会显示为一篇新文章。",
+    "page.new_scraped_feed.title": "从网页创建新源",
+    "page.new_scraped_feed.help": "适用于没有源的网站：项目选择器匹配的每个元素都会成为一篇文章。其他选择器在每个项目内部应用。",
+    "page.new_scraped_feed.preview": [
+        "找到 %d 篇文章"
+    ],
+    "page.new_scraped_feed.content": "内容",
+    "page.new_watch_page.title": "监视网页",
+    "page.new_watch_page.help": "页面会像其他源一样被检查，每当其文本发生变化时，都会创建一篇显示差异的新文章。",
     "page.new_user.title": "新用户",
     "page.edit_category.title": "编辑分类 : %s",
     "page.edit_user.title": "编辑用户 : %s",
@@ -3621,7 +5285,40 @@ var translations = map[string]string{
     ],
     "page.history.title": "历史",
     "page.import.title": "导入",
+    "page.import.report": "导入完成：创建了 %d 个源、%d 个分类和 %d 篇文章（其中 %d 篇已加星标），跳过了 %d 篇文章。",
+    "page.import_job.title": "导入结果",
+    "page.import_job.running": "正在导入：已处理 %d / %d 个订阅。",
+    "page.import_job.running_items": "正在导入：已处理 %d / %d 个项目。",
+    "page.import_job.refresh": "刷新",
+    "page.import_job.finished": "导入完成：创建了 %d 个源，%d 个重复，%d 个无法访问，%d 个解析错误，%d 个其他错误。",
+    "page.import_job.failed": "导入失败：%s",
+    "page.import_job.table.feed": "源",
+    "page.import_job.table.category": "分类",
+    "page.import_job.table.result": "结果",
+    "page.import_job.status.created": "已创建",
+    "page.import_job.status.duplicate": "已订阅",
+    "page.import_job.status.unreachable": "无法访问",
+    "page.import_job.status.parse_error": "无效的源",
+    "page.import_job.status.error": "错误",
+    "page.opml_sources.title": "远程 OPML 列表",
+    "page.opml_sources.help": "远程列表会定期获取，其中新的源会被添加到所选分类中。",
+    "page.opml_sources.no_source": "您没有订阅任何远程列表。",
+    "page.opml_sources.new": "订阅远程列表",
+    "page.opml_sources.table.url": "列表",
+    "page.opml_sources.table.category": "分类",
+    "page.opml_sources.table.feeds": "源",
+    "page.opml_sources.table.checked_at": "最后检查",
+    "page.opml_source.title": "远程 OPML 列表",
+    "page.opml_source.never_checked": "从未",
+    "page.opml_source.error": "最后的错误",
+    "page.opml_source.disable_removed": "从列表中移除的源会被禁用。",
+    "page.opml_source.history": "历史",
+    "page.opml_source.no_history": "同步尚未更改任何订阅。",
+    "page.opml_source.change.added": "已添加",
+    "page.opml_source.change.disabled": "已禁用",
+    "page.opml_source.change.enabled": "已重新启用",
     "page.search.title": "搜索结果",
+    "page.tag_entries.title": "标签：%s",
     "page.about.title": "关于",
     "page.about.credits": "版权",
     "page.about.version": "版本号：",
@@ -3635,6 +5332,9 @@ var translations = map[string]string{
     "page.add_feed.submit": "查找订阅",
     "page.add_feed.legend.advanced_options": "高级选项",
     "page.add_feed.choose_feed": "选择一个订阅",
+    "page.add_feed.newsletter": "改为通过电子邮件接收邮件通讯",
+    "page.add_feed.scraped_feed": "此网站没有源？改为从网页中提取文章",
+    "page.add_feed.watch_page": "改为监视网页的变化",
     "page.edit_feed.title": "编辑源 : %s",
     "page.edit_feed.last_check": "最后检查时间：",
     "page.edit_feed.last_modified_header": "最后修改的 Header：",
@@ -3642,6 +5342,14 @@ var translations = map[string]string{
     "page.edit_feed.no_header": "无",
     "page.edit_feed.last_parsing_error": "最后一次解析错误",
     "page.entry.attachments": "附件",
+    "page.entry.podcast.season": "第 %d 季",
+    "page.entry.podcast.episode": "第 %s 集",
+    "page.entry.podcast.chapters": "章节",
+    "page.entry.podcast.transcript": "文字稿",
+    "page.entry.podcast.transcripts": "文字稿",
+    "page.entry.podcast.captions": "字幕",
+    "page.entry.podcast.persons": "人物",
+    "page.entry.podcast.funding": "支持此播客：",
     "page.keyboard_shortcuts.title": "快捷键",
     "page.keyboard_shortcuts.subtitle.sections": "分区导航",
     "page.keyboard_shortcuts.subtitle.items": "条目导航",
@@ -3682,14 +5390,24 @@ var translations = map[string]string{
     "page.users.actions": "操作",
     "page.users.last_login": "最后登录时间",
     "page.users.is_admin": "管理员",
+    "page.users.locked": "登录失败次数过多，已被锁定",
     "page.settings.title": "设置",
     "page.settings.link_google_account": "关联我的 Google 账户",
     "page.settings.unlink_google_account": "解除 Google 账号关联",
     "page.settings.link_oidc_account": "关联我的 OpenID Connect 账户",
     "page.settings.unlink_oidc_account": "解除 OpenID Connect 账号关联",
+    "page.settings.link_github_account": "关联我的 GitHub 账户",
+    "page.settings.unlink_github_account": "解除 GitHub 账号关联",
+    "page.settings.link_gitlab_account": "关联我的 GitLab 账户",
+    "page.settings.unlink_gitlab_account": "解除 GitLab 账号关联",
     "page.login.title": "登陆",
     "page.login.google_signin": "使用 Google 登陆",
     "page.login.oidc_signin": "使用 OpenID Connect 登陆",
+    "page.login.github_signin": "使用 GitHub 登陆",
+    "page.login.gitlab_signin": "使用 GitLab 登陆",
+    "page.login.webauthn_signin": "使用通行密钥登录",
+    "page.login_totp.title": "双重认证",
+    "page.login_totp.help": "请输入身份验证器应用显示的代码，或您的恢复代码之一。",
     "page.integrations.title": "集成",
     "page.integration.miniflux_api": "Miniflux API",
     "page.integration.miniflux_api_endpoint": "API Endpoint",
@@ -3701,11 +5419,57 @@ var translations = map[string]string{
     "page.integration.bookmarklet.instructions": "拖动这个链接到书签",
     "page.integration.bookmarklet.help": "你可以打开这个特殊的书签来直接订阅网站",
     "page.sessions.title": "会话",
+    "page.totp.title": "双重认证",
+    "page.totp.enroll_help": "使用身份验证器应用扫描此二维码，然后输入其显示的代码进行确认。",
+    "page.totp.qr_code": "二维码",
+    "page.totp.secret": "密钥：",
+    "page.totp.recovery_codes_help": "请将这些恢复代码保存在安全的地方。如果无法使用身份验证器应用，每个代码可用于登录一次。它们不会再次显示。",
+    "page.totp.enabled": [
+        "双重认证已启用。剩余 %d 个恢复代码。"
+    ],
+    "page.webauthn.title": "安全密钥和通行密钥",
+    "page.webauthn.help": "安全密钥和通行密钥让您无需输入密码即可登录。",
+    "page.webauthn.unsupported": "您的浏览器不支持安全密钥和通行密钥。",
+    "page.webauthn.never_used": "从未使用",
+    "page.webauthn.table.name": "名称",
+    "page.webauthn.table.last_used_at": "最后使用",
+    "page.webauthn.table.created_at": "创建时间",
+    "page.webauthn.table.actions": "操作",
     "page.sessions.table.date": "日期",
     "page.sessions.table.ip": "IP 地址",
     "page.sessions.table.user_agent": "User-Agent",
     "page.sessions.table.actions": "操作",
     "page.sessions.table.current_session": "当前会话",
+    "page.security_activity.title": "最近的安全活动",
+    "page.entry_archive.created_at": "快照创建于",
+    "page.entry_archive.pending": "正在创建新的快照，请稍后刷新页面。",
+    "page.entry_archive.unavailable": "正在创建的快照过多，请稍后再试。",
+    "page.audit.title": "审计日志",
+    "page.audit.date": "日期",
+    "page.audit.action": "操作",
+    "page.audit.actor": "执行者",
+    "page.audit.target": "详情",
+    "page.audit.client_ip": "IP 地址",
+    "page.audit.user": "用户",
+    "page.audit.all_users": "所有用户",
+    "page.audit.all_actions": "所有操作",
+    "audit.action.login": "登录",
+    "audit.action.login_failed": "登录失败",
+    "audit.action.logout": "退出登录",
+    "audit.action.password_changed": "密码已更改",
+    "audit.action.api_key_created": "已创建 API 密钥",
+    "audit.action.api_key_removed": "已删除 API 密钥",
+    "audit.action.user_created": "已创建用户",
+    "audit.action.user_removed": "已删除用户",
+    "audit.action.user_promoted": "已授予管理员权限",
+    "audit.action.user_demoted": "已撤销管理员权限",
+    "audit.action.user_unlocked": "已解锁用户",
+    "audit.action.feed_removed": "已删除源",
+    "audit.action.totp_enabled": "已启用双因素认证",
+    "audit.action.totp_disabled": "已停用双因素认证",
+    "audit.action.webauthn_registered": "已注册通行密钥",
+    "audit.action.webauthn_removed": "已删除通行密钥",
+    "audit.action.session_removed": "已删除会话",
     "page.api_keys.title": "API密钥",
     "page.api_keys.table.description": "描述",
     "page.api_keys.table.token": "密钥",
@@ -3723,13 +5487,16 @@ var translations = map[string]string{
     "alert.no_history": "目前没有历史",
     "alert.feed_error": "该源存在问题",
     "alert.no_search_result": "该搜索没有结果",
+    "alert.no_tag_entry": "没有带此标签的文章",
     "alert.no_feed_in_category": "没有该类别的订阅。",
     "alert.no_unread_entry": "目前没有未读文章",
     "alert.no_user": "您是目前仅有的用户",
+    "alert.no_audit_event": "没有任何事件。",
     "alert.account_unlinked": "您的外部帐户现已解除关联！",
     "alert.account_linked": "您的外部账号已关联！",
     "alert.pocket_linked": "您的Pocket帐户现已关联",
     "alert.prefs_saved": "设置已存储！",
+    "alert.totp_disabled": "双重认证现已停用。",
     "error.unlink_account_without_password": "您必须定义密码，否则您将无法再次登录。",
     "error.duplicate_linked_account": "该 Provider 已被关联！",
     "error.duplicate_fever_username": "Fever 用户名已被占用！",
@@ -3744,7 +5511,15 @@ var translations = map[string]string{
     "error.unable_to_update_feed": "无法更新此源",
     "error.subscription_not_found": "找不到任何订阅",
     "error.empty_file": "该文件为空",
+    "error.file_too_large": "该文件过大（最大 50 MB）",
+    "error.unsupported_import_format": "不支持此文件格式。",
+    "error.invalid_opml_source_url": "无效的列表 URL。",
+    "error.opml_source_already_exists": "您已经订阅了此列表。",
     "error.bad_credentials": "用户名或密码无效",
+    "error.too_many_login_attempts": "登录失败次数过多，请稍后再试。",
+    "error.invalid_totp_code": "双重认证代码无效。",
+    "error.totp_code_required": "代码是必需的。",
+    "error.webauthn_failed": "使用安全密钥或通行密钥的操作失败或已取消。",
     "error.fields_mandatory": "必须填写全部信息",
     "error.title_required": "必须填写标题",
     "error.different_passwords": "两次输入的密码不同",
@@ -3752,6 +5527,10 @@ var translations = map[string]string{
     "error.settings_mandatory_fields": "必须填写用户名、主题、语言以及时区",
     "error.entries_per_page_invalid": "每页的条目数无效。",
     "error.feed_mandatory_fields": "必须填写 URL 和分类",
+    "error.newsletter_mandatory_fields": "标题和分类是必需的。",
+    "error.scraped_feed_mandatory_fields": "页面 URL、分类和项目选择器为必填项。",
+    "error.scraped_feed_item_selector_not_empty": "项目选择器不能为空。",
+    "error.watch_page_mandatory_fields": "页面 URL 和分类为必填项。",
     "error.feed_already_exists": "此供稿已存在。",
     "error.invalid_feed_url": "供稿网址无效。",
     "error.invalid_site_url": "无效的网站网址。",
@@ -3778,14 +5557,28 @@ var translations = map[string]string{
     "form.feed.label.rewrite_rules": "重写规则",
     "form.feed.label.blocklist_rules": "封锁规则",
     "form.feed.label.keeplist_rules": "许可规则",
+    "form.feed.label.watch_selector": "监视的页面部分（CSS 选择器）",
+    "form.feed.help.watch_selector": "留空以监视整个页面。可用于忽略每次访问都会变化的部分。",
     "form.feed.label.ignore_http_cache": "忽略HTTP缓存",
     "form.feed.label.fetch_via_proxy": "通过代理获取",
     "form.feed.label.disabled": "请勿刷新此Feed",
+    "form.newsletter.label.address": "邮件通讯地址",
+    "form.scraped_feed.label.page_url": "网页 URL",
+    "form.scraped_feed.legend.selectors": "CSS 选择器",
+    "form.scraped_feed.label.item": "项目",
+    "form.scraped_feed.label.title": "标题",
+    "form.scraped_feed.label.link": "链接",
+    "form.scraped_feed.label.date": "日期",
+    "form.scraped_feed.label.content": "内容",
+    "form.scraped_feed.help": "没有链接选择器时，使用项目中的第一个链接。没有标题选择器时，使用链接的文本。没有内容选择器时，使用整个项目。",
     "form.category.label.title": "标题",
     "form.user.label.username": "用户名",
     "form.user.label.password": "密码",
     "form.user.label.confirmation": "确认",
     "form.user.label.admin": "管理员",
+    "form.totp.label.code": "认证代码",
+    "form.totp.label.code_or_recovery_code": "认证代码或恢复代码",
+    "form.webauthn.label.name": "名称",
     "form.prefs.label.language": "语言",
     "form.prefs.label.timezone": "时区",
     "form.prefs.label.theme": "主题",
@@ -3795,10 +5588,16 @@ var translations = map[string]string{
     "form.prefs.select.recent_first": "新->旧",
     "form.prefs.label.keyboard_shortcuts": "启用键盘快捷键",
     "form.prefs.label.entry_swipe": "在移动设备上的条目上启用滑动手势",
+    "form.prefs.label.archive_starred": "保留已加星标文章的本地副本",
     "form.prefs.label.show_reading_time": "显示文章的预计阅读时间",
     "form.prefs.label.custom_css": "自定义CSS",
     "form.import.label.file": "OPML 文件",
     "form.import.label.url": "URL",
+    "form.import.label.apply_settings": "应用 Miniflux 的订阅源设置（规则、用户代理、爬虫、代理）",
+    "form.import.label.validate": "订阅前检查每个源（较慢）",
+    "form.import.help.file": "支持 OPML 文件、Google Reader 格式的 JSON 导出（Feedly、Inoreader）、Tiny Tiny RSS 的 XML 导出以及 FreshRSS 的 ZIP 归档。",
+    "form.opml_source.label.url": "OPML 列表 URL",
+    "form.opml_source.label.disable_removed": "禁用从列表中移除的源",
     "form.integration.fever_activate": "启用 Fever API",
     "form.integration.fever_username": "Fever 用户名",
     "form.integration.fever_password": "Fever 密码",
@@ -3865,21 +5664,22 @@ var translations = map[string]string{
     "Invalid SSL certificate (original error: %q)": "无效的SSL证书 (原始错误: %q)",
     "This website is temporarily unreachable (original error: %q)": "该网站暂时不可达 (原始错误: %q)",
     "This website is permanently unreachable (original error: %q)": "该网站永久不可达 (原始错误: %q)",
-    "Website unreachable, the request timed out after %d seconds": "网站不可达, 请求已在 %d 秒后超时"
+    "Website unreachable, the request timed out after %d seconds": "网站不可达, 请求已在 %d 秒后超时",
+    "This website is hosted on a private or forbidden network address (%s)": "该网站位于私有或被禁止的网络地址 (%s)"
 }
 `,
 }
 
 var translationsChecksums = map[string]string{
-	"de_DE": "96616242d64bfca6bcc27762260aac38a3014ae854dfe4d85711491f7e0e52a2",
-	"en_US": "0718463b2edc0157dba7b26c033bcfa36181e57addd613a19ce60e6c6b34cb19",
-	"es_ES": "fc767762d1eb5cd44d89aab99a58a0c28b910ba06457d7ffe1b21092d473bb8e",
-	"fr_FR": "3abdb817b699ffb2c9f3c2316879c65b96510ff3e6a875860fcccdd063b29e63",
-	"it_IT": "2d2aa46e81f48494c08b78963d88d6522395856bd25f4b1504403da1f8d531e8",
-	"ja_JP": "7c1098e38962f4d285b957f7db428b28d562d1fe3b16117c0ac0340870cd43b0",
-	"nl_NL": "43093afdfa7d692d83455f5e4f3d36d4e725c704796961b749a84ba27ddbbd0b",
-	"pl_PL": "eb0baf99b46f5440a32084453091272f57c5f676b595640016f168fb65e439b7",
-	"pt_BR": "9ed1a472f60e65c4f8309417a3af17572299cd23c25ae6e1d368996578963b25",
-	"ru_RU": "de2cda1638754b7c3376749fa7d62d75d3594543465c1f78f801fe6c08d4eb2f",
-	"zh_CN": "07f1fb9efeb93f2d45b71c7028b50fe5bca378c307d1e2dadd0821fabd074918",
+	"de_DE": "f9eb10251e117e5320cb5473ac90dfd3e0e2a667b2e8fe0b5548302615e35bc1",
+	"en_US": "c88ddb6eb76e26f4780bb6555e5b28098a69d4f6c2ab2b46d04fd3a285b33708",
+	"es_ES": "764780b18658167b3f112f3c02200a0e5b028777f447a225dad015c209ded742",
+	"fr_FR": "b1f92a30131abbdb18133221bb6d0ba4a7548b5fa4c0ca1ab71855c9214b5dd4",
+	"it_IT": "d9e0f999b71d0b0c98fdff12a5acdcac0d481ff45a45e9fdfecd7d03371ba168",
+	"ja_JP": "e6a0fc6c12de5489630079dae67e6e5dc6275e7786af15a95bf91899611cb0e5",
+	"nl_NL": "8fa9765b3a6693a158d216d96ed643150717dcd1ef7872a5692ed8c383009bdb",
+	"pl_PL": "2bb077616785dde1cd5290f09d9de8a394baeea2e9c8e51991faf7f48a5da57b",
+	"pt_BR": "b45d203b2ca500beb9e8dfeb7bbc721e6d1e2072ff650a881587bd2615d655e7",
+	"ru_RU": "8150a44ee57a142be703ecbc9c234586f33324a4128590252f9402dc8d861f5d",
+	"zh_CN": "527c3dfd6a288b69f44d9cb7a3800ddc4495d2ee904d414ca3ae1a3e0e7a922e",
 }
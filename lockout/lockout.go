@@ -0,0 +1,196 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package lockout // import "miniflux.app/lockout"
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/http/request"
+	"miniflux.app/logger"
+	"miniflux.app/metric"
+	"miniflux.app/model"
+	"miniflux.app/storage"
+)
+
+// maxDelay is the longest time a client has to wait between two failed logins before the lockout.
+const maxDelay = time.Minute
+
+// Guard tracks failed logins by username and by client IP address.
+//
+// After each failure, the next attempt is refused during a delay that doubles every time.
+// After too many failures, all attempts are refused until the end of the lockout or until an administrator unlocks the user.
+type Guard struct {
+	store           *storage.Storage
+	maxFailures     int
+	lockoutDuration time.Duration
+}
+
+// NewGuard returns a Guard configured from the application options.
+func NewGuard(store *storage.Storage) *Guard {
+	return &Guard{
+		store:           store,
+		maxFailures:     config.Opts.LoginMaxFailures(),
+		lockoutDuration: time.Duration(config.Opts.LoginLockoutDuration()) * time.Minute,
+	}
+}
+
+// Check returns how long the client has to wait when the login attempt must be refused, the username is optional.
+func (g *Guard) Check(source, username, clientIP string) time.Duration {
+	if g.maxFailures <= 0 {
+		return 0
+	}
+
+	for _, key := range keys(username, clientIP) {
+		allowed, err := g.store.ReserveLoginAttempt(key.kind, key.value, maxDelay)
+		if err != nil {
+			logger.Error("[Lockout] %v", err)
+			continue
+		}
+
+		if allowed {
+			continue
+		}
+
+		now := time.Now()
+		failure, err := g.store.LoginFailure(key.kind, key.value)
+		if err != nil {
+			logger.Error("[Lockout] %v", err)
+		}
+
+		// The attempt is refused even if the delay expired in the meantime, the client retries after a second.
+		wait := retryAfter(failure, now)
+		if wait < time.Second {
+			wait = time.Second
+		}
+
+		logger.Error("[Lockout] [ClientIP=%s] Login refused source=%s %s=%q retry_after=%s",
+			clientIP, source, key.kind, key.value, wait.Round(time.Second))
+		return wait
+	}
+
+	return 0
+}
+
+// Fail records a failed login, the username is optional.
+func (g *Guard) Fail(source, username, clientIP string) {
+	metric.FailedLogins.WithLabelValues(source).Inc()
+	logger.Error("[Lockout] [ClientIP=%s] Authentication failure source=%s username=%q", clientIP, source, username)
+
+	if g.maxFailures <= 0 {
+		return
+	}
+
+	now := time.Now()
+	for _, key := range keys(username, clientIP) {
+		failure, err := g.store.AddLoginFailure(key.kind, key.value, now.Add(-g.lockoutDuration))
+		if err != nil {
+			logger.Error("[Lockout] %v", err)
+			continue
+		}
+
+		if failure.Failures < g.maxFailures || failure.IsLocked(now) {
+			continue
+		}
+
+		if err := g.store.LockLogin(key.kind, key.value, now.Add(g.lockoutDuration)); err != nil {
+			logger.Error("[Lockout] %v", err)
+			continue
+		}
+
+		metric.LoginLockouts.WithLabelValues(key.kind).Inc()
+		logger.Error("[Lockout] [ClientIP=%s] Login locked source=%s %s=%q failures=%d duration=%s",
+			clientIP, source, key.kind, key.value, failure.Failures, g.lockoutDuration)
+	}
+}
+
+// Succeed forgets the failed logins of a user after a successful login.
+// Failures of the client IP address are kept to detect attacks against several accounts.
+func (g *Guard) Succeed(username string) {
+	if g.maxFailures <= 0 {
+		return
+	}
+
+	if err := g.store.RemoveLoginFailures(model.LoginFailureUsername, NormalizeUsername(username)); err != nil {
+		logger.Error("[Lockout] %v", err)
+	}
+}
+
+var untrustedProxyWarning sync.Once
+
+// ClientIP returns the client IP address used to track the failed logins of the request.
+// Proxy headers can be spoofed, only the addresses of trusted proxies are used.
+// Behind a reverse proxy missing from TRUSTED_PROXIES, all the clients share the address of the proxy,
+// an empty value is returned to track only the usernames, otherwise a few failures would lock out everybody.
+func ClientIP(r *http.Request) string {
+	if request.IsFromUntrustedProxy(r, config.Opts.TrustedProxies()) {
+		untrustedProxyWarning.Do(func() {
+			logger.Error("[Lockout] Requests are forwarded by %s which is not a trusted proxy, failed logins are only tracked by username. Add it to TRUSTED_PROXIES to track the client IP addresses.", request.TrustedClientIP(r))
+		})
+		return ""
+	}
+
+	return request.TrustedClientIP(r)
+}
+
+// NormalizeUsername returns the username used to track failed logins.
+// Usernames are compared in lowercase during the login, so "Admin" and "admin" must share the same counter.
+func NormalizeUsername(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
+
+type key struct {
+	kind  string
+	value string
+}
+
+func keys(username, clientIP string) []key {
+	var keys []key
+	if username = NormalizeUsername(username); username != "" {
+		keys = append(keys, key{model.LoginFailureUsername, username})
+	}
+	if clientIP != "" {
+		keys = append(keys, key{model.LoginFailureClientIP, clientIP})
+	}
+	return keys
+}
+
+// retryAfter returns how long the client has to wait before the next login attempt.
+func retryAfter(failure *model.LoginFailure, now time.Time) time.Duration {
+	if failure == nil {
+		return 0
+	}
+
+	if failure.IsLocked(now) {
+		return failure.LockedUntil.Sub(now)
+	}
+
+	if next := failure.LastAttemptAt.Add(delay(failure.Failures)); now.Before(next) {
+		return next.Sub(now)
+	}
+
+	return 0
+}
+
+// delay returns 1s after the first failure, then 2s, 4s, 8s... up to maxDelay.
+func delay(failures int) time.Duration {
+	if failures <= 0 {
+		return 0
+	}
+
+	d := time.Second
+	for i := 1; i < failures && d < maxDelay; i++ {
+		d *= 2
+	}
+
+	if d > maxDelay {
+		return maxDelay
+	}
+
+	return d
+}
@@ -0,0 +1,101 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package lockout // import "miniflux.app/lockout"
+
+import (
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+func TestDelay(t *testing.T) {
+	scenarios := map[int]time.Duration{
+		0:   0,
+		1:   time.Second,
+		2:   2 * time.Second,
+		3:   4 * time.Second,
+		6:   32 * time.Second,
+		7:   time.Minute,
+		100: time.Minute,
+	}
+
+	for failures, expected := range scenarios {
+		if result := delay(failures); result != expected {
+			t.Errorf(`Unexpected delay after %d failures, got %s instead of %s`, failures, result, expected)
+		}
+	}
+}
+
+func TestRetryAfterWithoutFailure(t *testing.T) {
+	if wait := retryAfter(nil, time.Now()); wait != 0 {
+		t.Errorf(`Unexpected delay: %s`, wait)
+	}
+}
+
+func TestRetryAfterProgressiveDelay(t *testing.T) {
+	now := time.Now()
+	failure := &model.LoginFailure{Failures: 3, LastAttemptAt: now.Add(-time.Second)}
+
+	if wait := retryAfter(failure, now); wait != 3*time.Second {
+		t.Errorf(`Unexpected delay: %s`, wait)
+	}
+
+	if wait := retryAfter(failure, now.Add(5*time.Second)); wait != 0 {
+		t.Errorf(`The delay should be expired: %s`, wait)
+	}
+}
+
+func TestRetryAfterLockout(t *testing.T) {
+	now := time.Now()
+	lockedUntil := now.Add(10 * time.Minute)
+	failure := &model.LoginFailure{Failures: 10, LastAttemptAt: now.Add(-5 * time.Minute), LockedUntil: &lockedUntil}
+
+	if !failure.IsLocked(now) {
+		t.Error(`The login should be locked`)
+	}
+
+	if wait := retryAfter(failure, now); wait != 10*time.Minute {
+		t.Errorf(`Unexpected delay: %s`, wait)
+	}
+
+	if wait := retryAfter(failure, lockedUntil); wait != 0 {
+		t.Errorf(`The lockout should be expired: %s`, wait)
+	}
+}
+
+func TestKeys(t *testing.T) {
+	if k := keys("", "127.0.0.1"); len(k) != 1 || k[0].kind != model.LoginFailureClientIP {
+		t.Errorf(`Only the client IP should be tracked without username: %v`, k)
+	}
+
+	if k := keys("admin", "127.0.0.1"); len(k) != 2 || k[0].value != "admin" || k[1].value != "127.0.0.1" {
+		t.Errorf(`Unexpected keys: %v`, k)
+	}
+}
+
+func TestKeysWithoutClientIP(t *testing.T) {
+	if k := keys("admin", ""); len(k) != 1 || k[0].kind != model.LoginFailureUsername {
+		t.Errorf(`Only the username should be tracked without client IP: %v`, k)
+	}
+}
+
+func TestKeysWithMixedCaseUsernames(t *testing.T) {
+	for _, username := range []string{"admin", "Admin", "ADMIN", "aDmIn", " admin "} {
+		if k := keys(username, "127.0.0.1"); len(k) != 2 || k[0].value != "admin" {
+			t.Errorf(`The username %q should be tracked as "admin": %v`, username, k)
+		}
+	}
+
+	if k := keys("   ", "127.0.0.1"); len(k) != 1 || k[0].kind != model.LoginFailureClientIP {
+		t.Errorf(`A blank username should not be tracked: %v`, k)
+	}
+}
+
+func TestNormalizeUsername(t *testing.T) {
+	if username := NormalizeUsername(" aDmIn "); username != "admin" {
+		t.Errorf(`Unexpected username: %q`, username)
+	}
+}
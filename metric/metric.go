@@ -45,6 +45,24 @@ var (
 		[]string{"status"},
 	)
 
+	FailedLogins = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "miniflux",
+			Name:      "failed_logins_total",
+			Help:      "Number of failed logins by source",
+		},
+		[]string{"source"},
+	)
+
+	LoginLockouts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "miniflux",
+			Name:      "login_lockouts_total",
+			Help:      "Number of usernames and client IP addresses locked after too many failed logins",
+		},
+		[]string{"kind"},
+	)
+
 	usersGauge = prometheus.NewGauge(
 		prometheus.GaugeOpts{
 			Namespace: "miniflux",
@@ -91,6 +109,8 @@ func NewCollector(store *storage.Storage, refreshInterval int) *Collector {
 	prometheus.MustRegister(BackgroundFeedRefreshDuration)
 	prometheus.MustRegister(ScraperRequestDuration)
 	prometheus.MustRegister(ArchiveEntriesDuration)
+	prometheus.MustRegister(FailedLogins)
+	prometheus.MustRegister(LoginLockouts)
 	prometheus.MustRegister(usersGauge)
 	prometheus.MustRegister(feedsGauge)
 	prometheus.MustRegister(brokenFeedsGauge)
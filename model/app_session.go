@@ -9,6 +9,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"time"
 )
 
 // SessionData represents the data attached to the session.
@@ -20,11 +22,24 @@ type SessionData struct {
 	Language           string `json:"language"`
 	Theme              string `json:"theme"`
 	PocketRequestToken string `json:"pocket_request_token"`
+	TOTPUsername       string `json:"totp_username"`
+	TOTPExpiresAt      string `json:"totp_expires_at"`
+	TOTPSecret         string `json:"totp_secret"`
+	WebAuthnChallenge  string `json:"webauthn_challenge"`
 }
 
 func (s SessionData) String() string {
-	return fmt.Sprintf(`CSRF=%q, OAuth2State=%q, FlashMsg=%q, FlashErrMsg=%q, Lang=%q, Theme=%q, PocketTkn=%q`,
-		s.CSRF, s.OAuth2State, s.FlashMessage, s.FlashErrorMessage, s.Language, s.Theme, s.PocketRequestToken)
+	return fmt.Sprintf(`CSRF=%q, OAuth2State=%q, FlashMsg=%q, FlashErrMsg=%q, Lang=%q, Theme=%q, PocketTkn=%q, TOTPUsername=%q`,
+		s.CSRF, s.OAuth2State, s.FlashMessage, s.FlashErrorMessage, s.Language, s.Theme, s.PocketRequestToken, s.TOTPUsername)
+}
+
+// PendingTOTPUsername returns the user waiting for the second login step, if it has not expired.
+func (s SessionData) PendingTOTPUsername(now time.Time) string {
+	expiresAt, err := strconv.ParseInt(s.TOTPExpiresAt, 10, 64)
+	if err != nil || now.Unix() > expiresAt {
+		return ""
+	}
+	return s.TOTPUsername
 }
 
 // Value converts the session data to JSON.
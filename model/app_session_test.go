@@ -0,0 +1,34 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestPendingTOTPUsername(t *testing.T) {
+	now := time.Now()
+	data := SessionData{
+		TOTPUsername:  "john",
+		TOTPExpiresAt: strconv.FormatInt(now.Add(time.Minute).Unix(), 10),
+	}
+
+	if username := data.PendingTOTPUsername(now); username != "john" {
+		t.Errorf(`Unexpected username, got %q`, username)
+	}
+
+	if username := data.PendingTOTPUsername(now.Add(2 * time.Minute)); username != "" {
+		t.Errorf(`The pending login should be expired, got %q`, username)
+	}
+}
+
+func TestPendingTOTPUsernameWithoutExpiration(t *testing.T) {
+	data := SessionData{TOTPUsername: "john"}
+	if username := data.PendingTOTPUsername(time.Now()); username != "" {
+		t.Errorf(`The pending login should be ignored without expiration, got %q`, username)
+	}
+}
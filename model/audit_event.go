@@ -0,0 +1,81 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"time"
+
+	"miniflux.app/timezone"
+)
+
+// List of audited actions.
+const (
+	AuditActionLogin              = "login"
+	AuditActionLoginFailed        = "login_failed"
+	AuditActionLogout             = "logout"
+	AuditActionPasswordChanged    = "password_changed"
+	AuditActionAPIKeyCreated      = "api_key_created"
+	AuditActionAPIKeyRemoved      = "api_key_removed"
+	AuditActionUserCreated        = "user_created"
+	AuditActionUserRemoved        = "user_removed"
+	AuditActionUserPromoted       = "user_promoted"
+	AuditActionUserDemoted        = "user_demoted"
+	AuditActionUserUnlocked       = "user_unlocked"
+	AuditActionFeedRemoved        = "feed_removed"
+	AuditActionTOTPEnabled        = "totp_enabled"
+	AuditActionTOTPDisabled       = "totp_disabled"
+	AuditActionWebAuthnRegistered = "webauthn_registered"
+	AuditActionWebAuthnRemoved    = "webauthn_removed"
+	AuditActionSessionRemoved     = "session_removed"
+)
+
+// AuditActions returns the list of audited actions.
+func AuditActions() []string {
+	return []string{
+		AuditActionLogin,
+		AuditActionLoginFailed,
+		AuditActionLogout,
+		AuditActionPasswordChanged,
+		AuditActionAPIKeyCreated,
+		AuditActionAPIKeyRemoved,
+		AuditActionUserCreated,
+		AuditActionUserRemoved,
+		AuditActionUserPromoted,
+		AuditActionUserDemoted,
+		AuditActionUserUnlocked,
+		AuditActionFeedRemoved,
+		AuditActionTOTPEnabled,
+		AuditActionTOTPDisabled,
+		AuditActionWebAuthnRegistered,
+		AuditActionWebAuthnRemoved,
+		AuditActionSessionRemoved,
+	}
+}
+
+// AuditEvent represents a security-related action.
+//
+// The actor is the user who performed the action, it is empty for anonymous requests
+// and set to "cli" for the command line. The user is the account concerned by the action.
+type AuditEvent struct {
+	ID        int64     `json:"id"`
+	ActorID   int64     `json:"actor_id"`
+	Actor     string    `json:"actor"`
+	UserID    int64     `json:"user_id"`
+	ClientIP  string    `json:"client_ip"`
+	UserAgent string    `json:"user_agent"`
+	Action    string    `json:"action"`
+	Target    string    `json:"target"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AuditEvents represents a list of audit events.
+type AuditEvents []*AuditEvent
+
+// UseTimezone converts creation dates to the given timezone.
+func (a AuditEvents) UseTimezone(tz string) {
+	for _, event := range a {
+		event.CreatedAt = timezone.Convert(tz, event.CreatedAt)
+	}
+}
@@ -6,13 +6,36 @@ package model // import "miniflux.app/model"
 
 // Enclosure represents an attachment.
 type Enclosure struct {
-	ID       int64  `json:"id"`
-	UserID   int64  `json:"user_id"`
-	EntryID  int64  `json:"entry_id"`
-	URL      string `json:"url"`
-	MimeType string `json:"mime_type"`
-	Size     int64  `json:"size"`
+	ID               int64  `json:"id"`
+	UserID           int64  `json:"user_id"`
+	EntryID          int64  `json:"entry_id"`
+	URL              string `json:"url"`
+	MimeType         string `json:"mime_type"`
+	Size             int64  `json:"size"`
+	Duration         int    `json:"duration"`
+	MediaProgression int    `json:"media_progression"`
+}
+
+// IsMediaFinished returns true when less than 5% of the media remains to be played.
+func (e *Enclosure) IsMediaFinished() bool {
+	return e.Duration > 0 && e.MediaProgression >= e.Duration*95/100
 }
 
 // EnclosureList represents a list of attachments.
 type EnclosureList []*Enclosure
+
+// EnclosureUpdateRequest represents a request to save the playback position of an attachment.
+// The duration reported by the media player is used when the feed doesn't provide one.
+type EnclosureUpdateRequest struct {
+	MediaProgression int `json:"media_progression"`
+	Duration         int `json:"duration"`
+}
+
+// Patch updates the attachment with the playback position.
+func (e *EnclosureUpdateRequest) Patch(enclosure *Enclosure) {
+	enclosure.MediaProgression = e.MediaProgression
+
+	if enclosure.Duration == 0 && e.Duration > 0 {
+		enclosure.Duration = e.Duration
+	}
+}
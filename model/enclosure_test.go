@@ -0,0 +1,50 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "testing"
+
+func TestEnclosureIsMediaFinished(t *testing.T) {
+	scenarios := []struct {
+		duration    int
+		progression int
+		expected    bool
+	}{
+		{0, 0, false},
+		{0, 3600, false},
+		{3600, 0, false},
+		{3600, 3000, false},
+		{3600, 3420, true},
+		{3600, 3600, true},
+	}
+
+	for _, scenario := range scenarios {
+		enclosure := &Enclosure{Duration: scenario.duration, MediaProgression: scenario.progression}
+		if result := enclosure.IsMediaFinished(); result != scenario.expected {
+			t.Errorf(`Unexpected result for %d/%d, got %v instead of %v`, scenario.progression, scenario.duration, result, scenario.expected)
+		}
+	}
+}
+
+func TestEnclosureUpdateRequestPatch(t *testing.T) {
+	enclosure := &Enclosure{Duration: 0}
+	request := &EnclosureUpdateRequest{MediaProgression: 120, Duration: 3600}
+	request.Patch(enclosure)
+
+	if enclosure.MediaProgression != 120 {
+		t.Errorf(`Unexpected media progression, got %d`, enclosure.MediaProgression)
+	}
+
+	if enclosure.Duration != 3600 {
+		t.Errorf(`The duration of the player should be used when the feed doesn't provide one, got %d`, enclosure.Duration)
+	}
+
+	request = &EnclosureUpdateRequest{MediaProgression: 130, Duration: 3500}
+	request.Patch(enclosure)
+
+	if enclosure.Duration != 3600 {
+		t.Errorf(`The duration of the feed should not be replaced, got %d`, enclosure.Duration)
+	}
+}
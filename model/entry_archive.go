@@ -0,0 +1,17 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// EntryArchive represents a self-contained snapshot of the web page of an entry.
+type EntryArchive struct {
+	EntryID   int64     `json:"entry_id"`
+	UserID    int64     `json:"user_id"`
+	URL       string    `json:"url"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -7,6 +7,7 @@ package model // import "miniflux.app/model"
 import (
 	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"miniflux.app/config"
@@ -22,35 +23,50 @@ const (
 	DefaultFeedSortingDirection = "desc"
 )
 
+// List of feed kinds.
+const (
+	FeedKindDefault    = "default"
+	FeedKindNewsletter = "newsletter"
+	FeedKindScraped    = "scraped"
+	FeedKindWatchPage  = "watch_page"
+)
+
 // Feed represents a feed in the application.
 type Feed struct {
-	ID                 int64     `json:"id"`
-	UserID             int64     `json:"user_id"`
-	FeedURL            string    `json:"feed_url"`
-	SiteURL            string    `json:"site_url"`
-	Title              string    `json:"title"`
-	CheckedAt          time.Time `json:"checked_at"`
-	NextCheckAt        time.Time `json:"next_check_at"`
-	EtagHeader         string    `json:"etag_header"`
-	LastModifiedHeader string    `json:"last_modified_header"`
-	ParsingErrorMsg    string    `json:"parsing_error_message"`
-	ParsingErrorCount  int       `json:"parsing_error_count"`
-	ScraperRules       string    `json:"scraper_rules"`
-	RewriteRules       string    `json:"rewrite_rules"`
-	Crawler            bool      `json:"crawler"`
-	BlocklistRules     string    `json:"blocklist_rules"`
-	KeeplistRules      string    `json:"keeplist_rules"`
-	UserAgent          string    `json:"user_agent"`
-	Username           string    `json:"username"`
-	Password           string    `json:"password"`
-	Disabled           bool      `json:"disabled"`
-	IgnoreHTTPCache    bool      `json:"ignore_http_cache"`
-	FetchViaProxy      bool      `json:"fetch_via_proxy"`
-	Category           *Category `json:"category,omitempty"`
-	Entries            Entries   `json:"entries,omitempty"`
-	Icon               *FeedIcon `json:"icon"`
-	UnreadCount        int       `json:"-"`
-	ReadCount          int       `json:"-"`
+	ID                 int64          `json:"id"`
+	UserID             int64          `json:"user_id"`
+	FeedURL            string         `json:"feed_url"`
+	SiteURL            string         `json:"site_url"`
+	Title              string         `json:"title"`
+	Description        string         `json:"description"`
+	Language           string         `json:"language"`
+	ImageURL           string         `json:"image_url"`
+	CheckedAt          time.Time      `json:"checked_at"`
+	NextCheckAt        time.Time      `json:"next_check_at"`
+	EtagHeader         string         `json:"etag_header"`
+	LastModifiedHeader string         `json:"last_modified_header"`
+	ParsingErrorMsg    string         `json:"parsing_error_message"`
+	ParsingErrorCount  int            `json:"parsing_error_count"`
+	ScraperRules       string         `json:"scraper_rules"`
+	RewriteRules       string         `json:"rewrite_rules"`
+	Crawler            bool           `json:"crawler"`
+	BlocklistRules     string         `json:"blocklist_rules"`
+	KeeplistRules      string         `json:"keeplist_rules"`
+	UserAgent          string         `json:"user_agent"`
+	Username           string         `json:"username"`
+	Password           string         `json:"password"`
+	Disabled           bool           `json:"disabled"`
+	IgnoreHTTPCache    bool           `json:"ignore_http_cache"`
+	FetchViaProxy      bool           `json:"fetch_via_proxy"`
+	Kind               string         `json:"kind"`
+	NewsletterToken    string         `json:"-"`
+	Selectors          *FeedSelectors `json:"selectors,omitempty"`
+	WatchSelector      string         `json:"watch_selector,omitempty"`
+	Category           *Category      `json:"category,omitempty"`
+	Entries            Entries        `json:"entries,omitempty"`
+	Icon               *FeedIcon      `json:"icon"`
+	UnreadCount        int            `json:"-"`
+	ReadCount          int            `json:"-"`
 }
 
 func (f *Feed) String() string {
@@ -64,6 +80,26 @@ func (f *Feed) String() string {
 	)
 }
 
+// IsNewsletter returns true if the feed receives its entries by email instead of being fetched.
+func (f *Feed) IsNewsletter() bool {
+	return f.Kind == FeedKindNewsletter
+}
+
+// IsScraped returns true if the entries are extracted from a web page with CSS selectors.
+func (f *Feed) IsScraped() bool {
+	return f.Kind == FeedKindScraped
+}
+
+// IsWatchPage returns true if the feed reports the changes of a web page.
+func (f *Feed) IsWatchPage() bool {
+	return f.Kind == FeedKindWatchPage
+}
+
+// NewsletterAddress returns the email address used to subscribe to the newsletter.
+func (f *Feed) NewsletterAddress() string {
+	return strings.TrimPrefix(f.FeedURL, "mailto:")
+}
+
 // WithClientResponse updates feed attributes from an HTTP request.
 func (f *Feed) WithClientResponse(response *client.Response) {
 	f.EtagHeader = response.ETag
@@ -71,6 +107,13 @@ func (f *Feed) WithClientResponse(response *client.Response) {
 	f.FeedURL = response.EffectiveURL
 }
 
+// WithMetadata updates the description, the language and the image of the feed from the parsed document.
+func (f *Feed) WithMetadata(parsedFeed *Feed) {
+	f.Description = parsedFeed.Description
+	f.Language = parsedFeed.Language
+	f.ImageURL = parsedFeed.ImageURL
+}
+
 // WithCategoryID initializes the category attribute of the feed.
 func (f *Feed) WithCategoryID(categoryID int64) {
 	f.Category = &Category{ID: categoryID}
@@ -132,23 +175,31 @@ type FeedCreationRequest struct {
 	KeeplistRules   string `json:"keeplist_rules"`
 }
 
+// NewsletterCreationRequest represents the request to create a newsletter feed.
+type NewsletterCreationRequest struct {
+	Title      string `json:"title"`
+	CategoryID int64  `json:"category_id"`
+}
+
 // FeedModificationRequest represents the request to update a feed.
 type FeedModificationRequest struct {
-	FeedURL         *string `json:"feed_url"`
-	SiteURL         *string `json:"site_url"`
-	Title           *string `json:"title"`
-	ScraperRules    *string `json:"scraper_rules"`
-	RewriteRules    *string `json:"rewrite_rules"`
-	BlocklistRules  *string `json:"blocklist_rules"`
-	KeeplistRules   *string `json:"keeplist_rules"`
-	Crawler         *bool   `json:"crawler"`
-	UserAgent       *string `json:"user_agent"`
-	Username        *string `json:"username"`
-	Password        *string `json:"password"`
-	CategoryID      *int64  `json:"category_id"`
-	Disabled        *bool   `json:"disabled"`
-	IgnoreHTTPCache *bool   `json:"ignore_http_cache"`
-	FetchViaProxy   *bool   `json:"fetch_via_proxy"`
+	FeedURL         *string        `json:"feed_url"`
+	SiteURL         *string        `json:"site_url"`
+	Title           *string        `json:"title"`
+	ScraperRules    *string        `json:"scraper_rules"`
+	RewriteRules    *string        `json:"rewrite_rules"`
+	BlocklistRules  *string        `json:"blocklist_rules"`
+	KeeplistRules   *string        `json:"keeplist_rules"`
+	Crawler         *bool          `json:"crawler"`
+	UserAgent       *string        `json:"user_agent"`
+	Username        *string        `json:"username"`
+	Password        *string        `json:"password"`
+	CategoryID      *int64         `json:"category_id"`
+	Disabled        *bool          `json:"disabled"`
+	IgnoreHTTPCache *bool          `json:"ignore_http_cache"`
+	FetchViaProxy   *bool          `json:"fetch_via_proxy"`
+	Selectors       *FeedSelectors `json:"selectors"`
+	WatchSelector   *string        `json:"watch_selector"`
 }
 
 // Patch updates a feed with modified values.
@@ -212,6 +263,14 @@ func (f *FeedModificationRequest) Patch(feed *Feed) {
 	if f.FetchViaProxy != nil {
 		feed.FetchViaProxy = *f.FetchViaProxy
 	}
+
+	if f.Selectors != nil && feed.IsScraped() {
+		feed.Selectors = f.Selectors
+	}
+
+	if f.WatchSelector != nil && feed.IsWatchPage() {
+		feed.WatchSelector = *f.WatchSelector
+	}
 }
 
 // Feeds is a list of feed
@@ -0,0 +1,51 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// FeedSelectors represents the CSS selectors used to extract the entries of a web page without feed.
+type FeedSelectors struct {
+	Item    string `json:"item"`
+	Title   string `json:"title"`
+	Link    string `json:"link"`
+	Date    string `json:"date"`
+	Content string `json:"content"`
+}
+
+// Value converts the selectors to JSON.
+func (s FeedSelectors) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+// Scan converts raw JSON data.
+func (s *FeedSelectors) Scan(src interface{}) error {
+	source, ok := src.([]byte)
+	if !ok {
+		return errors.New("selectors: unable to assert type of src")
+	}
+
+	if err := json.Unmarshal(source, s); err != nil {
+		return fmt.Errorf("selectors: %v", err)
+	}
+
+	return nil
+}
+
+// ScrapedFeedCreationRequest represents the request to create a feed from a web page.
+type ScrapedFeedCreationRequest struct {
+	PageURL       string        `json:"page_url"`
+	CategoryID    int64         `json:"category_id"`
+	Selectors     FeedSelectors `json:"selectors"`
+	Title         string        `json:"title"`
+	UserAgent     string        `json:"user_agent"`
+	Crawler       bool          `json:"crawler"`
+	FetchViaProxy bool          `json:"fetch_via_proxy"`
+}
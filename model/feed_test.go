@@ -153,3 +153,19 @@ func TestFeedScheduleNextCheckEntryCountBasedMinInterval(t *testing.T) {
 		t.Error(`The next_check_at should not be before the now + min interval`)
 	}
 }
+
+func TestFeedNewsletter(t *testing.T) {
+	feed := &Feed{FeedURL: "https://example.org/feed.xml"}
+	if feed.IsNewsletter() {
+		t.Error("The feed should not be a newsletter")
+	}
+
+	feed = &Feed{Kind: FeedKindNewsletter, FeedURL: "mailto:abcdef@reader.example.org"}
+	if !feed.IsNewsletter() {
+		t.Error("The feed should be a newsletter")
+	}
+
+	if feed.NewsletterAddress() != "abcdef@reader.example.org" {
+		t.Errorf("Unexpected newsletter address, got %q", feed.NewsletterAddress())
+	}
+}
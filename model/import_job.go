@@ -0,0 +1,116 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Import job statuses.
+const (
+	ImportJobStatusRunning  = "running"
+	ImportJobStatusFinished = "finished"
+	ImportJobStatusFailed   = "failed"
+)
+
+// Import results of each subscription.
+const (
+	ImportResultCreated     = "created"
+	ImportResultDuplicate   = "duplicate"
+	ImportResultUnreachable = "unreachable"
+	ImportResultParseError  = "parse_error"
+	ImportResultError       = "error"
+)
+
+// ImportJob represents an OPML import running in the background.
+type ImportJob struct {
+	ID         int64              `json:"id"`
+	UserID     int64              `json:"user_id"`
+	Status     string             `json:"status"`
+	Validate   bool               `json:"validate"`
+	Total      int                `json:"total"`
+	Processed  int                `json:"processed"`
+	ErrorMsg   string             `json:"error_message"`
+	CreatedAt  time.Time          `json:"created_at"`
+	FinishedAt *time.Time         `json:"finished_at"`
+	Results    []*ImportJobResult `json:"results"`
+	Report     *ImportReport      `json:"report"`
+}
+
+// IsRunning returns true when the subscriptions are still being imported.
+func (j *ImportJob) IsRunning() bool {
+	return j.Status == ImportJobStatusRunning
+}
+
+// CountResults returns the number of subscriptions with the given result.
+func (j *ImportJob) CountResults(status string) int {
+	count := 0
+	for _, result := range j.Results {
+		if result.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// ImportJobResult represents the result of the import of one subscription.
+type ImportJobResult struct {
+	ID           int64  `json:"id"`
+	JobID        int64  `json:"job_id"`
+	Title        string `json:"title"`
+	FeedURL      string `json:"feed_url"`
+	CategoryName string `json:"category"`
+	Status       string `json:"status"`
+	ErrorMsg     string `json:"error_message"`
+	FeedID       int64  `json:"feed_id"`
+}
+
+// ImportReport summarizes the import of an export of another feed reader.
+type ImportReport struct {
+	Format            string   `json:"format"`
+	FeedsCreated      int      `json:"feeds_created"`
+	CategoriesCreated int      `json:"categories_created"`
+	EntriesCreated    int      `json:"entries_created"`
+	EntriesStarred    int      `json:"entries_starred"`
+	EntriesSkipped    int      `json:"entries_skipped"`
+	Errors            []string `json:"errors"`
+}
+
+// String returns a summary of the report.
+func (r *ImportReport) String() string {
+	return fmt.Sprintf(
+		"format=%s feeds=%d categories=%d entries=%d starred=%d skipped=%d errors=%d",
+		r.Format,
+		r.FeedsCreated,
+		r.CategoriesCreated,
+		r.EntriesCreated,
+		r.EntriesStarred,
+		r.EntriesSkipped,
+		len(r.Errors),
+	)
+}
+
+// Value converts the report to JSON.
+func (r ImportReport) Value() (driver.Value, error) {
+	return json.Marshal(r)
+}
+
+// Scan converts raw JSON data.
+func (r *ImportReport) Scan(src interface{}) error {
+	source, ok := src.([]byte)
+	if !ok {
+		return errors.New("import: unable to assert type of src")
+	}
+
+	if err := json.Unmarshal(source, r); err != nil {
+		return fmt.Errorf("import: %v", err)
+	}
+
+	return nil
+}
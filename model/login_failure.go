@@ -0,0 +1,28 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// Failed logins are tracked by username and by client IP address.
+const (
+	LoginFailureUsername = "username"
+	LoginFailureClientIP = "ip"
+)
+
+// LoginFailure represents the failed logins of a username or a client IP address.
+type LoginFailure struct {
+	Kind          string
+	Value         string
+	Failures      int
+	LastFailureAt time.Time
+	LastAttemptAt time.Time
+	LockedUntil   *time.Time
+}
+
+// IsLocked returns true if the lockout is not expired.
+func (l *LoginFailure) IsLocked(now time.Time) bool {
+	return l.LockedUntil != nil && now.Before(*l.LockedUntil)
+}
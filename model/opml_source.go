@@ -0,0 +1,68 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// Changes applied to the subscriptions during the synchronization of a remote OPML list.
+const (
+	OPMLSourceChangeAdded    = "added"
+	OPMLSourceChangeDisabled = "disabled"
+	OPMLSourceChangeEnabled  = "enabled"
+)
+
+// OPMLSource represents a remote OPML list kept in sync with the subscriptions of the user.
+type OPMLSource struct {
+	ID                 int64      `json:"id"`
+	UserID             int64      `json:"user_id"`
+	URL                string     `json:"url"`
+	DisableRemoved     bool       `json:"disable_removed"`
+	EtagHeader         string     `json:"-"`
+	LastModifiedHeader string     `json:"-"`
+	CheckedAt          *time.Time `json:"checked_at"`
+	ErrorMsg           string     `json:"error_message"`
+	CreatedAt          time.Time  `json:"created_at"`
+	Category           *Category  `json:"category"`
+	CountFeeds         int        `json:"-"`
+}
+
+// OPMLSources represents a list of remote OPML lists.
+type OPMLSources []*OPMLSource
+
+// OPMLSourceSync represents one synchronization of a remote OPML list that changed the subscriptions or failed.
+type OPMLSourceSync struct {
+	ID        int64               `json:"id"`
+	SourceID  int64               `json:"source_id"`
+	ErrorMsg  string              `json:"error_message"`
+	CreatedAt time.Time           `json:"created_at"`
+	Changes   []*OPMLSourceChange `json:"changes"`
+}
+
+// AddChange records a change applied to a subscription.
+func (s *OPMLSourceSync) AddChange(action string, feed *Feed) {
+	s.Changes = append(s.Changes, &OPMLSourceChange{
+		Action:  action,
+		Title:   feed.Title,
+		FeedURL: feed.FeedURL,
+		FeedID:  feed.ID,
+	})
+}
+
+// OPMLSourceChange represents a change applied to a subscription during a synchronization.
+type OPMLSourceChange struct {
+	ID      int64  `json:"id"`
+	SyncID  int64  `json:"sync_id"`
+	Action  string `json:"action"`
+	Title   string `json:"title"`
+	FeedURL string `json:"feed_url"`
+	FeedID  int64  `json:"feed_id"`
+}
+
+// OPMLSourceCreationRequest represents the request to subscribe to a remote OPML list.
+type OPMLSourceCreationRequest struct {
+	URL            string `json:"url"`
+	CategoryID     int64  `json:"category_id"`
+	DisableRemoved bool   `json:"disable_removed"`
+}
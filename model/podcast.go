@@ -0,0 +1,162 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// PodcastEpisode represents the podcast metadata of an entry (iTunes and Podcasting 2.0 namespaces).
+type PodcastEpisode struct {
+	Season      int                  `json:"season,omitempty"`
+	SeasonName  string               `json:"season_name,omitempty"`
+	Episode     string               `json:"episode,omitempty"`
+	EpisodeType string               `json:"episode_type,omitempty"`
+	ChaptersURL string               `json:"chapters_url,omitempty"`
+	Chapters    PodcastChapters      `json:"chapters,omitempty"`
+	Transcripts []*PodcastTranscript `json:"transcripts,omitempty"`
+	Transcript  PodcastCues          `json:"transcript,omitempty"`
+	Persons     []*PodcastPerson     `json:"persons,omitempty"`
+	Funding     []*PodcastFunding    `json:"funding,omitempty"`
+
+	// RetryAt is set when the download of the chapters or the transcript failed.
+	RetryAt *time.Time `json:"-"`
+}
+
+// PodcastChapter represents a chapter of an episode, the start time is in seconds.
+type PodcastChapter struct {
+	StartTime float64 `json:"start_time"`
+	Title     string  `json:"title"`
+	URL       string  `json:"url,omitempty"`
+	ImageURL  string  `json:"image_url,omitempty"`
+}
+
+// StartSeconds returns the start time of the chapter rounded down to the second.
+func (c *PodcastChapter) StartSeconds() int {
+	return int(c.StartTime)
+}
+
+// PodcastChapters represents the list of chapters of an episode.
+type PodcastChapters []*PodcastChapter
+
+// PodcastTranscript represents a link to the transcript or the captions of an episode.
+type PodcastTranscript struct {
+	URL      string `json:"url"`
+	MimeType string `json:"mime_type"`
+	Language string `json:"language,omitempty"`
+	Rel      string `json:"rel,omitempty"`
+}
+
+// PodcastCue represents a part of the transcript of an episode, the start time is in seconds.
+type PodcastCue struct {
+	StartTime float64 `json:"start_time"`
+	Speaker   string  `json:"speaker,omitempty"`
+	Text      string  `json:"text"`
+}
+
+// StartSeconds returns the start time of the cue rounded down to the second.
+func (c *PodcastCue) StartSeconds() int {
+	return int(c.StartTime)
+}
+
+// PodcastCues represents the text of a transcript.
+type PodcastCues []*PodcastCue
+
+// PodcastPerson represents a person involved in an episode.
+type PodcastPerson struct {
+	Name     string `json:"name"`
+	Role     string `json:"role,omitempty"`
+	Group    string `json:"group,omitempty"`
+	URL      string `json:"url,omitempty"`
+	ImageURL string `json:"image_url,omitempty"`
+}
+
+// PodcastFunding represents a donation link of the podcast.
+type PodcastFunding struct {
+	URL   string `json:"url"`
+	Title string `json:"title"`
+}
+
+// Value converts the episode to JSON.
+// The chapters and the transcript are stored separately because they are downloaded once and not provided by the feed.
+func (p PodcastEpisode) Value() (driver.Value, error) {
+	p.Chapters = nil
+	p.Transcript = nil
+	return json.Marshal(p)
+}
+
+// Scan converts raw JSON data.
+func (p *PodcastEpisode) Scan(src interface{}) error {
+	source, ok := src.([]byte)
+	if !ok {
+		return errors.New("podcast: unable to assert type of src")
+	}
+
+	if err := json.Unmarshal(source, p); err != nil {
+		return fmt.Errorf("podcast: %v", err)
+	}
+
+	return nil
+}
+
+// Value converts the chapters to JSON.
+func (c PodcastChapters) Value() (driver.Value, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(c)
+}
+
+// Scan converts raw JSON data.
+func (c *PodcastChapters) Scan(src interface{}) error {
+	if src == nil {
+		*c = nil
+		return nil
+	}
+
+	source, ok := src.([]byte)
+	if !ok {
+		return errors.New("podcast: unable to assert type of chapters")
+	}
+
+	if err := json.Unmarshal(source, c); err != nil {
+		return fmt.Errorf("podcast: %v", err)
+	}
+
+	return nil
+}
+
+// Value converts the transcript to JSON.
+func (c PodcastCues) Value() (driver.Value, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(c)
+}
+
+// Scan converts raw JSON data.
+func (c *PodcastCues) Scan(src interface{}) error {
+	if src == nil {
+		*c = nil
+		return nil
+	}
+
+	source, ok := src.([]byte)
+	if !ok {
+		return errors.New("podcast: unable to assert type of transcript")
+	}
+
+	if err := json.Unmarshal(source, c); err != nil {
+		return fmt.Errorf("podcast: %v", err)
+	}
+
+	return nil
+}
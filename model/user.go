@@ -23,10 +23,14 @@ type User struct {
 	Stylesheet        string     `json:"stylesheet"`
 	GoogleID          string     `json:"google_id"`
 	OpenIDConnectID   string     `json:"openid_connect_id"`
+	GitHubID          string     `json:"github_id"`
+	GitLabID          string     `json:"gitlab_id"`
+	LDAPDN            string     `json:"ldap_dn"`
 	EntriesPerPage    int        `json:"entries_per_page"`
 	KeyboardShortcuts bool       `json:"keyboard_shortcuts"`
 	ShowReadingTime   bool       `json:"show_reading_time"`
 	EntrySwipe        bool       `json:"entry_swipe"`
+	ArchiveStarred    bool       `json:"archive_starred"`
 	LastLoginAt       *time.Time `json:"last_login_at"`
 }
 
@@ -37,6 +41,9 @@ type UserCreationRequest struct {
 	IsAdmin         bool   `json:"is_admin"`
 	GoogleID        string `json:"google_id"`
 	OpenIDConnectID string `json:"openid_connect_id"`
+	GitHubID        string `json:"github_id"`
+	GitLabID        string `json:"gitlab_id"`
+	LDAPDN          string `json:"-"`
 }
 
 // UserModificationRequest represents the request to update a user.
@@ -50,11 +57,14 @@ type UserModificationRequest struct {
 	Stylesheet        *string `json:"stylesheet"`
 	GoogleID          *string `json:"google_id"`
 	OpenIDConnectID   *string `json:"openid_connect_id"`
+	GitHubID          *string `json:"github_id"`
+	GitLabID          *string `json:"gitlab_id"`
 	EntriesPerPage    *int    `json:"entries_per_page"`
 	IsAdmin           *bool   `json:"is_admin"`
 	KeyboardShortcuts *bool   `json:"keyboard_shortcuts"`
 	ShowReadingTime   *bool   `json:"show_reading_time"`
 	EntrySwipe        *bool   `json:"entry_swipe"`
+	ArchiveStarred    *bool   `json:"archive_starred"`
 }
 
 // Patch updates the User object with the modification request.
@@ -99,6 +109,14 @@ func (u *UserModificationRequest) Patch(user *User) {
 		user.OpenIDConnectID = *u.OpenIDConnectID
 	}
 
+	if u.GitHubID != nil {
+		user.GitHubID = *u.GitHubID
+	}
+
+	if u.GitLabID != nil {
+		user.GitLabID = *u.GitLabID
+	}
+
 	if u.EntriesPerPage != nil {
 		user.EntriesPerPage = *u.EntriesPerPage
 	}
@@ -114,6 +132,10 @@ func (u *UserModificationRequest) Patch(user *User) {
 	if u.EntrySwipe != nil {
 		user.EntrySwipe = *u.EntrySwipe
 	}
+
+	if u.ArchiveStarred != nil {
+		user.ArchiveStarred = *u.ArchiveStarred
+	}
 }
 
 // UseTimezone converts last login date to the given timezone.
@@ -0,0 +1,15 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+// WatchPageCreationRequest represents the request to create a feed reporting the changes of a web page.
+type WatchPageCreationRequest struct {
+	PageURL       string `json:"page_url"`
+	CategoryID    int64  `json:"category_id"`
+	Selector      string `json:"selector"`
+	Title         string `json:"title"`
+	UserAgent     string `json:"user_agent"`
+	FetchViaProxy bool   `json:"fetch_via_proxy"`
+}
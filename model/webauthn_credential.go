@@ -0,0 +1,22 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package model // import "miniflux.app/model"
+
+import "time"
+
+// WebAuthnCredential represents a security key or a passkey registered by a user.
+type WebAuthnCredential struct {
+	ID           int64
+	UserID       int64
+	CredentialID string
+	PublicKey    []byte
+	SignCount    uint32
+	Name         string
+	LastUsedAt   *time.Time
+	CreatedAt    time.Time
+}
+
+// WebAuthnCredentials represents a collection of WebAuthn credentials.
+type WebAuthnCredentials []*WebAuthnCredential
@@ -0,0 +1,121 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package newsletter // import "miniflux.app/newsletter"
+
+import (
+	"errors"
+	"net/http"
+	"net/mail"
+	"strings"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/email"
+	"miniflux.app/reader/processor"
+	"miniflux.app/storage"
+
+	"github.com/gorilla/mux"
+)
+
+// maxMessageSize is the maximum size of an email accepted by the webhook.
+const maxMessageSize = 25 << 20
+
+// Serve declares the webhook used by mail servers to deliver newsletters.
+//
+// The raw RFC 5322 message must be sent as request body. The recipient is
+// taken from the "recipient" query parameter when present, otherwise from
+// the message headers.
+func Serve(router *mux.Router, store *storage.Storage) {
+	handler := &handler{store}
+
+	sr := router.PathPrefix("/newsletter").Subrouter()
+	sr.Use(newMiddleware().serve)
+	sr.HandleFunc("/inbound", handler.receive).Name("newsletterInbound").Methods(http.MethodPost)
+}
+
+type handler struct {
+	store *storage.Storage
+}
+
+func (h *handler) receive(w http.ResponseWriter, r *http.Request) {
+	clientIP := request.ClientIP(r)
+	body := http.MaxBytesReader(w, r.Body, maxMessageSize)
+	defer body.Close()
+
+	msg, err := mail.ReadMessage(body)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	token := findToken(request.QueryStringParam(r, "recipient", ""), msg.Header)
+	if token == "" {
+		json.BadRequest(w, r, errors.New("unable to find the recipient of this email"))
+		return
+	}
+
+	feed, err := h.store.FeedByNewsletterToken(token)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		logger.Info("[Newsletter] [ClientIP=%s] No feed found for this recipient: %s", clientIP, token)
+		json.NotFound(w, r)
+		return
+	}
+
+	entry, err := email.ParseMessage(msg)
+	if err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	feed.Entries = model.Entries{entry}
+	processor.ProcessFeedEntries(h.store, feed)
+
+	if err := h.store.RefreshFeedEntries(feed.UserID, feed.ID, feed.Entries, false); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	logger.Info("[Newsletter] [ClientIP=%s] Email received for feed #%d", clientIP, feed.ID)
+	json.NoContent(w, r)
+}
+
+// findToken returns the local part of the first recipient address.
+func findToken(recipient string, header mail.Header) string {
+	candidates := []string{recipient}
+	for _, name := range []string{"X-Original-To", "Delivered-To", "To", "Cc"} {
+		candidates = append(candidates, header.Get(name))
+	}
+
+	for _, candidate := range candidates {
+		if candidate == "" {
+			continue
+		}
+
+		addresses, err := mail.ParseAddressList(candidate)
+		if err != nil || len(addresses) == 0 {
+			continue
+		}
+
+		localPart := addresses[0].Address
+		if index := strings.LastIndex(localPart, "@"); index != -1 {
+			localPart = localPart[:index]
+		}
+
+		if index := strings.Index(localPart, "+"); index != -1 {
+			localPart = localPart[:index]
+		}
+
+		return strings.ToLower(localPart)
+	}
+
+	return ""
+}
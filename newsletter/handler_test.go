@@ -0,0 +1,54 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package newsletter // import "miniflux.app/newsletter"
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+func TestFindToken(t *testing.T) {
+	scenarios := []struct {
+		recipient string
+		header    mail.Header
+		expected  string
+	}{
+		{"ABC123@news.example.org", nil, "abc123"},
+		{"", mail.Header{"To": {"Newsletter <abc123+weekly@news.example.org>"}}, "abc123"},
+		{"", mail.Header{"X-Original-To": {"first@news.example.org"}, "To": {"second@news.example.org"}}, "first"},
+		{"invalid", mail.Header{"Delivered-To": {"delivered@news.example.org"}}, "delivered"},
+		{"", mail.Header{}, ""},
+	}
+
+	for _, scenario := range scenarios {
+		if token := findToken(scenario.recipient, scenario.header); token != scenario.expected {
+			t.Errorf(`Unexpected token for %q and %v, got %q instead of %q`, scenario.recipient, scenario.header, token, scenario.expected)
+		}
+	}
+}
+
+func TestReceiveInvalidMessage(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/newsletter/inbound", strings.NewReader("not an email"))
+	w := httptest.NewRecorder()
+	(&handler{}).receive(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf(`Invalid messages should be rejected, got status %d`, w.Code)
+	}
+}
+
+func TestReceiveMessageWithoutRecipient(t *testing.T) {
+	message := "From: sender@example.org\r\nSubject: Hello\r\n\r\nBody\r\n"
+	r := httptest.NewRequest(http.MethodPost, "/newsletter/inbound", strings.NewReader(message))
+	w := httptest.NewRecorder()
+	(&handler{}).receive(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf(`Messages without recipient should be rejected, got status %d`, w.Code)
+	}
+}
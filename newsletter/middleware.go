@@ -0,0 +1,45 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package newsletter // import "miniflux.app/newsletter"
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"miniflux.app/config"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/logger"
+)
+
+type middleware struct{}
+
+func newMiddleware() *middleware {
+	return &middleware{}
+}
+
+// serve checks the shared secret sent by the mail server, either as
+// a Bearer token or as the password of an HTTP Basic authentication.
+func (m *middleware) serve(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientIP := request.ClientIP(r)
+
+		secret := ""
+		if _, password, ok := r.BasicAuth(); ok {
+			secret = password
+		} else if authorization := r.Header.Get("Authorization"); strings.HasPrefix(authorization, "Bearer ") {
+			secret = strings.TrimPrefix(authorization, "Bearer ")
+		}
+
+		if secret == "" || subtle.ConstantTimeCompare([]byte(secret), []byte(config.Opts.NewsletterWebhookSecret())) != 1 {
+			logger.Error("[Newsletter] [ClientIP=%s] Invalid webhook secret", clientIP)
+			json.Unauthorized(w, r)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package newsletter // import "miniflux.app/newsletter"
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"miniflux.app/config"
+)
+
+func parseConfig(t *testing.T, secret string) {
+	os.Clearenv()
+	if secret != "" {
+		os.Setenv("NEWSLETTER_WEBHOOK_SECRET", secret)
+	}
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+}
+
+func serveWebhook(r *http.Request) int {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	w := httptest.NewRecorder()
+	newMiddleware().serve(next).ServeHTTP(w, r)
+	return w.Code
+}
+
+func TestMiddlewareWithBearerToken(t *testing.T) {
+	parseConfig(t, "secret")
+
+	r := httptest.NewRequest(http.MethodPost, "/newsletter/inbound", nil)
+	r.Header.Set("Authorization", "Bearer secret")
+
+	if code := serveWebhook(r); code != http.StatusNoContent {
+		t.Errorf(`The request should be accepted, got status %d`, code)
+	}
+}
+
+func TestMiddlewareWithBasicAuth(t *testing.T) {
+	parseConfig(t, "secret")
+
+	r := httptest.NewRequest(http.MethodPost, "/newsletter/inbound", nil)
+	r.SetBasicAuth("mailserver", "secret")
+
+	if code := serveWebhook(r); code != http.StatusNoContent {
+		t.Errorf(`The request should be accepted, got status %d`, code)
+	}
+}
+
+func TestMiddlewareWithInvalidSecret(t *testing.T) {
+	parseConfig(t, "secret")
+
+	r := httptest.NewRequest(http.MethodPost, "/newsletter/inbound", nil)
+	r.Header.Set("Authorization", "Bearer invalid")
+
+	if code := serveWebhook(r); code != http.StatusUnauthorized {
+		t.Errorf(`The request should be rejected, got status %d`, code)
+	}
+}
+
+func TestMiddlewareWithoutSecret(t *testing.T) {
+	parseConfig(t, "secret")
+
+	r := httptest.NewRequest(http.MethodPost, "/newsletter/inbound", nil)
+	if code := serveWebhook(r); code != http.StatusUnauthorized {
+		t.Errorf(`The request should be rejected, got status %d`, code)
+	}
+}
+
+func TestMiddlewareWithoutConfiguredSecret(t *testing.T) {
+	parseConfig(t, "")
+
+	r := httptest.NewRequest(http.MethodPost, "/newsletter/inbound", nil)
+	r.Header.Set("Authorization", "Bearer ")
+	r.SetBasicAuth("mailserver", "")
+
+	if code := serveWebhook(r); code != http.StatusUnauthorized {
+		t.Errorf(`The request should be rejected when no secret is configured, got status %d`, code)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package oauth2 // import "miniflux.app/oauth2"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"miniflux.app/model"
+
+	"golang.org/x/oauth2"
+)
+
+type githubProfile struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+}
+
+type githubOrganization struct {
+	Login string `json:"login"`
+}
+
+type githubProvider struct {
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	endpoint     oauth2.Endpoint
+	apiURL       string
+}
+
+func (g *githubProvider) GetUserExtraKey() string {
+	return "github_id"
+}
+
+func (g *githubProvider) GetRedirectURL(state string) string {
+	return g.config().AuthCodeURL(state)
+}
+
+// GetProfile returns the GitHub login as username and the organizations of the user as groups.
+func (g *githubProvider) GetProfile(ctx context.Context, code string) (*Profile, error) {
+	conf := g.config()
+	token, err := conf.Exchange(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	client := conf.Client(ctx, token)
+
+	var user githubProfile
+	if err := getJSON(client, g.apiURL+"/user", &user); err != nil {
+		return nil, fmt.Errorf("oauth2: unable to fetch github profile: %v", err)
+	}
+
+	var organizations []githubOrganization
+	if err := getJSON(client, g.apiURL+"/user/orgs", &organizations); err != nil {
+		return nil, fmt.Errorf("oauth2: unable to fetch github organizations: %v", err)
+	}
+
+	profile := &Profile{Key: g.GetUserExtraKey(), ID: fmt.Sprint(user.ID), Username: user.Login}
+	for _, organization := range organizations {
+		profile.Groups = append(profile.Groups, organization.Login)
+	}
+
+	return profile, nil
+}
+
+func (g *githubProvider) PopulateUserCreationWithProfileID(user *model.UserCreationRequest, profile *Profile) {
+	user.GitHubID = profile.ID
+}
+
+func (g *githubProvider) PopulateUserWithProfileID(user *model.User, profile *Profile) {
+	user.GitHubID = profile.ID
+}
+
+func (g *githubProvider) UnsetUserProfileID(user *model.User) {
+	user.GitHubID = ""
+}
+
+func (g *githubProvider) config() *oauth2.Config {
+	return &oauth2.Config{
+		RedirectURL:  g.redirectURL,
+		ClientID:     g.clientID,
+		ClientSecret: g.clientSecret,
+		Scopes:       []string{"read:user", "read:org"},
+		Endpoint:     g.endpoint,
+	}
+}
+
+func newGithubProvider(clientID, clientSecret, redirectURL string) *githubProvider {
+	return &githubProvider{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+		endpoint: oauth2.Endpoint{
+			AuthURL:  "https://github.com/login/oauth/authorize",
+			TokenURL: "https://github.com/login/oauth/access_token",
+		},
+		apiURL: "https://api.github.com",
+	}
+}
+
+func getJSON(client *http.Client, url string, v interface{}) error {
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,87 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package oauth2 // import "miniflux.app/oauth2"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"miniflux.app/model"
+
+	"golang.org/x/oauth2"
+)
+
+type gitlabProfile struct {
+	Sub    string   `json:"sub"`
+	Email  string   `json:"email"`
+	Groups []string `json:"groups"`
+}
+
+type gitlabProvider struct {
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	baseURL      string
+}
+
+func (g *gitlabProvider) GetUserExtraKey() string {
+	return "gitlab_id"
+}
+
+func (g *gitlabProvider) GetRedirectURL(state string) string {
+	return g.config().AuthCodeURL(state)
+}
+
+// GetProfile returns the email address as username and the full path of the user's groups as groups.
+func (g *gitlabProvider) GetProfile(ctx context.Context, code string) (*Profile, error) {
+	conf := g.config()
+	token, err := conf.Exchange(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	var user gitlabProfile
+	if err := getJSON(conf.Client(ctx, token), g.baseURL+"/oauth/userinfo", &user); err != nil {
+		return nil, fmt.Errorf("oauth2: unable to fetch gitlab profile: %v", err)
+	}
+
+	profile := &Profile{Key: g.GetUserExtraKey(), ID: user.Sub, Username: user.Email, Groups: user.Groups}
+	return profile, nil
+}
+
+func (g *gitlabProvider) PopulateUserCreationWithProfileID(user *model.UserCreationRequest, profile *Profile) {
+	user.GitLabID = profile.ID
+}
+
+func (g *gitlabProvider) PopulateUserWithProfileID(user *model.User, profile *Profile) {
+	user.GitLabID = profile.ID
+}
+
+func (g *gitlabProvider) UnsetUserProfileID(user *model.User) {
+	user.GitLabID = ""
+}
+
+func (g *gitlabProvider) config() *oauth2.Config {
+	return &oauth2.Config{
+		RedirectURL:  g.redirectURL,
+		ClientID:     g.clientID,
+		ClientSecret: g.clientSecret,
+		Scopes:       []string{"openid", "email"},
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  g.baseURL + "/oauth/authorize",
+			TokenURL: g.baseURL + "/oauth/token",
+		},
+	}
+}
+
+func newGitlabProvider(clientID, clientSecret, redirectURL, baseURL string) *gitlabProvider {
+	return &gitlabProvider{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		redirectURL:  redirectURL,
+		baseURL:      strings.TrimSuffix(baseURL, "/"),
+	}
+}
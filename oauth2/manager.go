@@ -31,12 +31,14 @@ func (m *Manager) AddProvider(name string, provider Provider) {
 }
 
 // NewManager returns a new Manager.
-func NewManager(ctx context.Context, clientID, clientSecret, redirectURL, oidcDiscoveryEndpoint string) *Manager {
+func NewManager(ctx context.Context, clientID, clientSecret, redirectURL, oidcDiscoveryEndpoint, oidcUsernameClaim, oidcGroupsClaim, gitlabURL string) *Manager {
 	m := &Manager{providers: make(map[string]Provider)}
 	m.AddProvider("google", newGoogleProvider(clientID, clientSecret, redirectURL))
+	m.AddProvider("github", newGithubProvider(clientID, clientSecret, redirectURL))
+	m.AddProvider("gitlab", newGitlabProvider(clientID, clientSecret, redirectURL, gitlabURL))
 
 	if oidcDiscoveryEndpoint != "" {
-		if genericOidcProvider, err := newOidcProvider(ctx, clientID, clientSecret, redirectURL, oidcDiscoveryEndpoint); err != nil {
+		if genericOidcProvider, err := newOidcProvider(ctx, clientID, clientSecret, redirectURL, oidcDiscoveryEndpoint, oidcUsernameClaim, oidcGroupsClaim); err != nil {
 			logger.Error("[OAuth2] failed to initialize OIDC provider: %v", err)
 		} else {
 			m.AddProvider("oidc", genericOidcProvider)
@@ -6,6 +6,7 @@ package oauth2 // import "miniflux.app/oauth2"
 
 import (
 	"context"
+	"fmt"
 
 	"miniflux.app/model"
 
@@ -14,10 +15,12 @@ import (
 )
 
 type oidcProvider struct {
-	clientID     string
-	clientSecret string
-	redirectURL  string
-	provider     *oidc.Provider
+	clientID      string
+	clientSecret  string
+	redirectURL   string
+	usernameClaim string
+	groupsClaim   string
+	provider      *oidc.Provider
 }
 
 func (o *oidcProvider) GetUserExtraKey() string {
@@ -40,7 +43,22 @@ func (o *oidcProvider) GetProfile(ctx context.Context, code string) (*Profile, e
 		return nil, err
 	}
 
-	profile := &Profile{Key: o.GetUserExtraKey(), ID: userInfo.Subject, Username: userInfo.Email}
+	var claims map[string]interface{}
+	if err := userInfo.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("oauth2: unable to decode OIDC claims: %v", err)
+	}
+
+	profile := &Profile{
+		Key:      o.GetUserExtraKey(),
+		ID:       userInfo.Subject,
+		Username: claimString(claims, o.usernameClaim),
+		Groups:   claimStrings(claims, o.groupsClaim),
+	}
+
+	if profile.Username == "" {
+		return nil, fmt.Errorf("oauth2: the OIDC claim %q is missing", o.usernameClaim)
+	}
+
 	return profile, nil
 }
 
@@ -61,16 +79,45 @@ func (o *oidcProvider) config() *oauth2.Config {
 		RedirectURL:  o.redirectURL,
 		ClientID:     o.clientID,
 		ClientSecret: o.clientSecret,
-		Scopes:       []string{"openid", "email"},
+		Scopes:       []string{"openid", "email", "profile"},
 		Endpoint:     o.provider.Endpoint(),
 	}
 }
 
-func newOidcProvider(ctx context.Context, clientID, clientSecret, redirectURL, discoveryEndpoint string) (*oidcProvider, error) {
+func newOidcProvider(ctx context.Context, clientID, clientSecret, redirectURL, discoveryEndpoint, usernameClaim, groupsClaim string) (*oidcProvider, error) {
 	provider, err := oidc.NewProvider(ctx, discoveryEndpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	return &oidcProvider{clientID: clientID, clientSecret: clientSecret, redirectURL: redirectURL, provider: provider}, nil
+	return &oidcProvider{
+		clientID:      clientID,
+		clientSecret:  clientSecret,
+		redirectURL:   redirectURL,
+		usernameClaim: usernameClaim,
+		groupsClaim:   groupsClaim,
+		provider:      provider,
+	}, nil
+}
+
+// claimString returns a string claim, or an empty string when the claim is missing.
+func claimString(claims map[string]interface{}, name string) string {
+	value, _ := claims[name].(string)
+	return value
+}
+
+// claimStrings returns a claim that may be a single string or a list of strings.
+func claimStrings(claims map[string]interface{}, name string) []string {
+	var values []string
+	switch value := claims[name].(type) {
+	case string:
+		values = append(values, value)
+	case []interface{}:
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+	return values
 }
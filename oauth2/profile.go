@@ -13,8 +13,21 @@ type Profile struct {
 	Key      string
 	ID       string
 	Username string
+	Groups   []string
+}
+
+// IsMemberOf returns true if the user belongs to at least one of the given groups.
+func (p Profile) IsMemberOf(groups []string) bool {
+	for _, group := range groups {
+		for _, userGroup := range p.Groups {
+			if group == userGroup {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func (p Profile) String() string {
-	return fmt.Sprintf(`Key=%s ; ID=%s ; Username=%s`, p.Key, p.ID, p.Username)
+	return fmt.Sprintf(`Key=%s ; ID=%s ; Username=%s ; Groups=%v`, p.Key, p.ID, p.Username, p.Groups)
 }
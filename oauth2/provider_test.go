@@ -0,0 +1,183 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package oauth2 // import "miniflux.app/oauth2"
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"miniflux.app/model"
+
+	"golang.org/x/oauth2"
+)
+
+func newTestAuthorizationServer(t *testing.T, routes map[string]string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/token") {
+			r.ParseForm()
+			if r.FormValue("code") != "valid-code" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"access_token": "access-token", "token_type": "bearer"}`))
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer access-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		body, found := routes[r.URL.Path]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGithubProfile(t *testing.T) {
+	server := newTestAuthorizationServer(t, map[string]string{
+		"/user":      `{"id": 1234, "login": "octocat", "email": null}`,
+		"/user/orgs": `[{"login": "github"}, {"login": "miniflux"}]`,
+	})
+
+	provider := newGithubProvider("client", "secret", "http://localhost/oauth2/github/callback")
+	provider.endpoint = oauth2.Endpoint{AuthURL: server.URL + "/authorize", TokenURL: server.URL + "/token"}
+	provider.apiURL = server.URL
+
+	profile, err := provider.GetProfile(context.Background(), "valid-code")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &Profile{Key: "github_id", ID: "1234", Username: "octocat", Groups: []string{"github", "miniflux"}}
+	if !reflect.DeepEqual(profile, expected) {
+		t.Errorf(`Unexpected profile: got %s instead of %s`, profile, expected)
+	}
+
+	var user model.User
+	provider.PopulateUserWithProfileID(&user, profile)
+	if user.GitHubID != "1234" {
+		t.Errorf(`Unexpected GitHub ID: %q`, user.GitHubID)
+	}
+
+	if _, err := provider.GetProfile(context.Background(), "invalid-code"); err == nil {
+		t.Error(`An invalid code should be rejected`)
+	}
+}
+
+func TestGithubProfileWithoutOrganizations(t *testing.T) {
+	server := newTestAuthorizationServer(t, map[string]string{
+		"/user": `{"id": 1234, "login": "octocat"}`,
+	})
+
+	provider := newGithubProvider("client", "secret", "http://localhost/oauth2/github/callback")
+	provider.endpoint = oauth2.Endpoint{AuthURL: server.URL + "/authorize", TokenURL: server.URL + "/token"}
+	provider.apiURL = server.URL
+
+	if _, err := provider.GetProfile(context.Background(), "valid-code"); err == nil {
+		t.Error(`A failure to fetch the organizations should be reported`)
+	}
+}
+
+func TestGitlabProfile(t *testing.T) {
+	server := newTestAuthorizationServer(t, map[string]string{
+		"/oauth/userinfo": `{"sub": "42", "email": "me@example.org", "groups": ["miniflux", "miniflux/admins"]}`,
+	})
+
+	provider := newGitlabProvider("client", "secret", "http://localhost/oauth2/gitlab/callback", server.URL+"/")
+
+	if !strings.HasPrefix(provider.GetRedirectURL("state"), server.URL+"/oauth/authorize?") {
+		t.Errorf(`Unexpected redirect URL: %q`, provider.GetRedirectURL("state"))
+	}
+
+	profile, err := provider.GetProfile(context.Background(), "valid-code")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &Profile{Key: "gitlab_id", ID: "42", Username: "me@example.org", Groups: []string{"miniflux", "miniflux/admins"}}
+	if !reflect.DeepEqual(profile, expected) {
+		t.Errorf(`Unexpected profile: got %s instead of %s`, profile, expected)
+	}
+
+	var user model.User
+	provider.PopulateUserWithProfileID(&user, profile)
+	provider.UnsetUserProfileID(&user)
+	if user.GitLabID != "" {
+		t.Errorf(`The GitLab ID should be removed`)
+	}
+}
+
+func TestManagerProviders(t *testing.T) {
+	m := NewManager(context.Background(), "client", "secret", "http://localhost/callback", "", "email", "groups", "https://gitlab.example.org")
+	for _, name := range []string{"google", "github", "gitlab"} {
+		if _, err := m.FindProvider(name); err != nil {
+			t.Errorf(`The provider %q should be available`, name)
+		}
+	}
+
+	if _, err := m.FindProvider("oidc"); err == nil {
+		t.Error(`The OIDC provider requires a discovery endpoint`)
+	}
+}
+
+func TestClaims(t *testing.T) {
+	claims := map[string]interface{}{
+		"email":              "me@example.org",
+		"preferred_username": "me",
+		"groups":             []interface{}{"users", "admins", 42},
+		"role":               "editor",
+	}
+
+	if value := claimString(claims, "preferred_username"); value != "me" {
+		t.Errorf(`Unexpected username claim: %q`, value)
+	}
+
+	if value := claimString(claims, "groups"); value != "" {
+		t.Errorf(`A list claim should not be used as a string: %q`, value)
+	}
+
+	if values := claimStrings(claims, "groups"); !reflect.DeepEqual(values, []string{"users", "admins"}) {
+		t.Errorf(`Unexpected groups claim: %v`, values)
+	}
+
+	if values := claimStrings(claims, "role"); !reflect.DeepEqual(values, []string{"editor"}) {
+		t.Errorf(`A single string claim should be used as a group: %v`, values)
+	}
+
+	if values := claimStrings(claims, "missing"); len(values) != 0 {
+		t.Errorf(`A missing claim should not return groups: %v`, values)
+	}
+}
+
+func TestProfileIsMemberOf(t *testing.T) {
+	profile := Profile{Groups: []string{"users", "admins"}}
+
+	if !profile.IsMemberOf([]string{"staff", "admins"}) {
+		t.Error(`The profile should be a member of "admins"`)
+	}
+
+	if profile.IsMemberOf([]string{"staff"}) {
+		t.Error(`The profile should not be a member of "staff"`)
+	}
+
+	if profile.IsMemberOf(nil) {
+		t.Error(`The profile should not be a member of an empty list`)
+	}
+}
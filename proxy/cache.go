@@ -0,0 +1,163 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package proxy // import "miniflux.app/proxy"
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"miniflux.app/crypto"
+)
+
+// Cache stores the proxified media on disk.
+// Each file starts with the content type on the first line, followed by the body.
+type Cache struct {
+	dir string
+}
+
+// CachedMedia represents a media file stored in the cache.
+type CachedMedia struct {
+	ContentType string
+	Body        []byte
+}
+
+// NewCache returns a cache that stores the files in the given directory.
+func NewCache(dir string) *Cache {
+	return &Cache{dir: dir}
+}
+
+// CacheKey returns the cache key of the given URL and image width, 0 means the original size.
+func CacheKey(link string, width int) string {
+	return crypto.Hash(fmt.Sprintf("%s#%d", link, width))
+}
+
+// Get returns the cached media or nil if the key is not in the cache.
+func (c *Cache) Get(key string) (*CachedMedia, error) {
+	filename := c.path(key)
+	data, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("proxy: unable to read cached file: %v", err)
+	}
+
+	reader := bufio.NewReader(bytes.NewReader(data))
+	contentType, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, fmt.Errorf("proxy: invalid cached file %q", filename)
+	}
+
+	body, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("proxy: unable to read cached file: %v", err)
+	}
+
+	// The modification time is used to remove the least recently used files first.
+	now := time.Now()
+	os.Chtimes(filename, now, now)
+
+	return &CachedMedia{ContentType: strings.TrimSpace(contentType), Body: body}, nil
+}
+
+// Put stores the media in the cache.
+func (c *Cache) Put(key string, media *CachedMedia) error {
+	filename := c.path(key)
+	if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+		return fmt.Errorf("proxy: unable to create cache directory: %v", err)
+	}
+
+	// The file is written under a temporary name and renamed, so readers never see a partial file.
+	tmpfile, err := ioutil.TempFile(filepath.Dir(filename), ".tmp-")
+	if err != nil {
+		return fmt.Errorf("proxy: unable to create cached file: %v", err)
+	}
+	defer os.Remove(tmpfile.Name())
+
+	contentType := strings.Replace(media.ContentType, "\n", "", -1)
+	_, err = io.Copy(tmpfile, io.MultiReader(strings.NewReader(contentType+"\n"), bytes.NewReader(media.Body)))
+	if closeErr := tmpfile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("proxy: unable to write cached file: %v", err)
+	}
+
+	if err := os.Rename(tmpfile.Name(), filename); err != nil {
+		return fmt.Errorf("proxy: unable to write cached file: %v", err)
+	}
+
+	return nil
+}
+
+// Clean removes the files older than maxAge, then the least recently used files until the cache is smaller than maxSize.
+func (c *Cache) Clean(maxAge time.Duration, maxSize int64) (removed int, err error) {
+	type cachedFile struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+
+	var files []cachedFile
+	var totalSize int64
+	limit := time.Now().Add(-maxAge)
+
+	err = filepath.Walk(c.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		if info.ModTime().Before(limit) {
+			if os.Remove(path) == nil {
+				removed++
+			}
+			return nil
+		}
+
+		files = append(files, cachedFile{path, info.Size(), info.ModTime()})
+		totalSize += info.Size()
+		return nil
+	})
+	if err != nil {
+		return removed, fmt.Errorf("proxy: unable to clean the cache: %v", err)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].modTime.Before(files[j].modTime)
+	})
+
+	for _, file := range files {
+		if totalSize <= maxSize {
+			break
+		}
+
+		if os.Remove(file.path) == nil {
+			removed++
+			totalSize -= file.size
+		}
+	}
+
+	return removed, nil
+}
+
+// path returns the location of the cached file, files are spread in subdirectories to keep directories small.
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.dir, key[:2], key)
+}
@@ -0,0 +1,119 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package proxy // import "miniflux.app/proxy"
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestCache(t *testing.T) (*Cache, string) {
+	dir, err := ioutil.TempDir("", "miniflux-proxy-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewCache(dir), dir
+}
+
+func TestCacheGetAndPut(t *testing.T) {
+	cache, dir := newTestCache(t)
+	defer os.RemoveAll(dir)
+
+	key := CacheKey("http://website/image.png", 0)
+
+	media, err := cache.Get(key)
+	if err != nil || media != nil {
+		t.Fatalf(`An empty cache should not return any media: %v, %v`, media, err)
+	}
+
+	if err := cache.Put(key, &CachedMedia{ContentType: "image/png", Body: []byte("some\ndata")}); err != nil {
+		t.Fatal(err)
+	}
+
+	media, err = cache.Get(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if media == nil || media.ContentType != "image/png" || string(media.Body) != "some\ndata" {
+		t.Fatalf(`Unexpected cached media: %+v`, media)
+	}
+}
+
+func TestCacheKeyDependsOnWidth(t *testing.T) {
+	if CacheKey("http://website/image.png", 0) == CacheKey("http://website/image.png", 480) {
+		t.Fatal(`Each image width should have a different cache key`)
+	}
+}
+
+func TestCacheCleanRemovesExpiredFiles(t *testing.T) {
+	cache, dir := newTestCache(t)
+	defer os.RemoveAll(dir)
+
+	oldKey := CacheKey("http://website/old.png", 0)
+	newKey := CacheKey("http://website/new.png", 0)
+	cache.Put(oldKey, &CachedMedia{ContentType: "image/png", Body: []byte("old")})
+	cache.Put(newKey, &CachedMedia{ContentType: "image/png", Body: []byte("new")})
+
+	past := time.Now().Add(-48 * time.Hour)
+	os.Chtimes(filepath.Join(dir, oldKey[:2], oldKey), past, past)
+
+	removed, err := cache.Clean(24*time.Hour, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if removed != 1 {
+		t.Fatalf(`Unexpected number of removed files: got %d instead of 1`, removed)
+	}
+
+	if media, _ := cache.Get(oldKey); media != nil {
+		t.Error(`The expired file should be removed`)
+	}
+
+	if media, _ := cache.Get(newKey); media == nil {
+		t.Error(`The recent file should be kept`)
+	}
+}
+
+func TestCacheCleanRemovesLeastRecentlyUsedFiles(t *testing.T) {
+	cache, dir := newTestCache(t)
+	defer os.RemoveAll(dir)
+
+	keys := []string{
+		CacheKey("http://website/1.png", 0),
+		CacheKey("http://website/2.png", 0),
+		CacheKey("http://website/3.png", 0),
+	}
+
+	for i, key := range keys {
+		cache.Put(key, &CachedMedia{ContentType: "image/png", Body: make([]byte, 100)})
+		modTime := time.Now().Add(time.Duration(i-len(keys)) * time.Minute)
+		os.Chtimes(filepath.Join(dir, key[:2], key), modTime, modTime)
+	}
+
+	removed, err := cache.Clean(time.Hour, 250)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if removed != 1 {
+		t.Fatalf(`Unexpected number of removed files: got %d instead of 1`, removed)
+	}
+
+	if media, _ := cache.Get(keys[0]); media != nil {
+		t.Error(`The least recently used file should be removed`)
+	}
+}
+
+func TestCacheCleanWithMissingDirectory(t *testing.T) {
+	cache := NewCache(filepath.Join(os.TempDir(), "miniflux-missing-cache-dir"))
+	if _, err := cache.Clean(time.Hour, 0); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package proxy // import "miniflux.app/proxy"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/http/client"
+	"miniflux.app/logger"
+)
+
+// ErrMediaTooLarge is returned when the media is larger than the maximum body size of the HTTP client.
+var ErrMediaTooLarge = errors.New("proxy: the media is too large")
+
+var (
+	sharedClient     *http.Client
+	sharedClientOnce sync.Once
+)
+
+// NewClient returns an HTTP client that refuses to connect to internal networks.
+// The client is shared to reuse the connections to the origin servers, HTTP_CLIENT_PROXY is used when configured.
+func NewClient() *http.Client {
+	sharedClientOnce.Do(func() {
+		proxy := http.ProxyFromEnvironment
+		if clientProxy := config.Opts.HTTPClientProxy(); clientProxy != "" {
+			if proxyURL, err := url.Parse(clientProxy); err != nil {
+				logger.Error("[Proxy] Proxy URL error: %v", err)
+			} else {
+				proxy = http.ProxyURL(proxyURL)
+			}
+		}
+
+		transport := &http.Transport{
+			// The body is forwarded as is, so the browser gets the original Content-Length.
+			DisableCompression: true,
+
+			// Default is 90s.
+			IdleConnTimeout: 30 * time.Second,
+		}
+
+		sharedClient = &http.Client{Transport: client.NewGuard(config.Opts).Transport(transport, proxy)}
+	})
+
+	return sharedClient
+}
+
+// NewRequest returns a request to download the given media from the origin server.
+func NewRequest(ctx context.Context, link string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", link, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("User-Agent", config.Opts.HTTPClientUserAgent())
+	return req, nil
+}
+
+// MediaTypeFromContentType returns "image", "audio" or "video" for the given content type.
+func MediaTypeFromContentType(contentType string) string {
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+	switch {
+	case strings.HasPrefix(contentType, "image/"):
+		return MediaTypeImage
+	case strings.HasPrefix(contentType, "audio/"), strings.HasPrefix(contentType, "application/ogg"):
+		return MediaTypeAudio
+	case strings.HasPrefix(contentType, "video/"):
+		return MediaTypeVideo
+	default:
+		return ""
+	}
+}
+
+// CachedImage returns the image from the cache, or nil when the cache is disabled or the image is not cached.
+func CachedImage(link string, width int) *CachedMedia {
+	if !config.Opts.HasProxyCache() {
+		return nil
+	}
+
+	media, err := NewCache(config.Opts.ProxyCacheDir()).Get(CacheKey(link, width))
+	if err != nil {
+		logger.Error("[Proxy] %v", err)
+		return nil
+	}
+
+	return media
+}
+
+// ReadImage reads the downloaded image, scales it down to the given width and stores it in the cache.
+func ReadImage(link string, width int, contentType string, body io.Reader) (*CachedMedia, error) {
+	maxBodySize := config.Opts.HTTPClientMaxBodySize()
+	data, err := ioutil.ReadAll(io.LimitReader(body, maxBodySize+1))
+	if err != nil {
+		return nil, fmt.Errorf("proxy: unable to read %q: %v", link, err)
+	}
+
+	if int64(len(data)) > maxBodySize {
+		return nil, ErrMediaTooLarge
+	}
+
+	media := &CachedMedia{ContentType: contentType, Body: data}
+	if width != 0 {
+		if resizedData, resizedContentType, err := ResizeImage(data, width); err == nil {
+			media = &CachedMedia{ContentType: resizedContentType, Body: resizedData}
+		} else if err != ErrUnsupportedImage {
+			logger.Error("[Proxy] Unable to resize %q: %v", link, err)
+		}
+	}
+
+	if config.Opts.HasProxyCache() {
+		if err := NewCache(config.Opts.ProxyCacheDir()).Put(CacheKey(link, width), media); err != nil {
+			logger.Error("[Proxy] %v", err)
+		}
+	}
+
+	return media, nil
+}
+
+// FetchImage returns the given image from the cache or downloads it from the origin server.
+func FetchImage(link string, width int) (*CachedMedia, error) {
+	if media := CachedImage(link, width); media != nil {
+		return media, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(config.Opts.HTTPClientTimeout())*time.Second)
+	defer cancel()
+
+	req, err := NewRequest(ctx, link)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := NewClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("proxy: unable to download %q: %v", link, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("proxy: unable to download %q: status=%d", link, resp.StatusCode)
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if MediaTypeFromContentType(contentType) != MediaTypeImage {
+		return nil, fmt.Errorf("proxy: %q is not an image (%s)", link, contentType)
+	}
+
+	return ReadImage(link, width, contentType, resp.Body)
+}
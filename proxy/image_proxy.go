@@ -5,11 +5,12 @@
 package proxy // import "miniflux.app/proxy"
 
 import (
+	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"miniflux.app/config"
-	"miniflux.app/url"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/gorilla/mux"
@@ -17,10 +18,22 @@ import (
 
 var regexSplitSrcset = regexp.MustCompile(`,\s+`)
 
-// ImageProxyRewriter replaces image URLs with internal proxy URLs.
+// ImageProxyRewriter replaces image, audio and video URLs with internal proxy URLs.
 func ImageProxyRewriter(router *mux.Router, data string) string {
-	proxyImages := config.Opts.ProxyImages()
-	if proxyImages == "none" {
+	return rewriteMediaURLs(data, func(link string) string {
+		return ProxifyURL(router, link)
+	})
+}
+
+// AbsoluteImageProxyRewriter replaces image, audio and video URLs with absolute proxy URLs, for the API clients.
+func AbsoluteImageProxyRewriter(router *mux.Router, data string) string {
+	return rewriteMediaURLs(data, func(link string) string {
+		return AbsoluteProxifyURL(router, link)
+	})
+}
+
+func rewriteMediaURLs(data string, proxify func(link string) string) string {
+	if config.Opts.ProxyImages() == "none" {
 		return data
 	}
 
@@ -30,27 +43,44 @@ func ImageProxyRewriter(router *mux.Router, data string) string {
 	}
 
 	doc.Find("img").Each(func(i int, img *goquery.Selection) {
-		if srcAttr, ok := img.Attr("src"); ok {
-			if !isDataURL(srcAttr) && (proxyImages == "all" || !url.IsHTTPS(srcAttr)) {
-				img.SetAttr("src", ProxifyURL(router, srcAttr))
-			}
+		srcsetAttr, hasSrcset := img.Attr("srcset")
+		if hasSrcset && ShouldProxify(MediaTypeImage, srcsetAttr) {
+			proxifySourceSet(img, proxify, srcsetAttr)
 		}
 
-		if srcsetAttr, ok := img.Attr("srcset"); ok {
-			if proxyImages == "all" || !url.IsHTTPS(srcsetAttr) {
-				proxifySourceSet(img, router, srcsetAttr)
+		if srcAttr, ok := img.Attr("src"); ok {
+			if !isDataURL(srcAttr) && ShouldProxify(MediaTypeImage, srcAttr) {
+				img.SetAttr("src", proxify(srcAttr))
+
+				// Smaller variants let mobile clients download lighter images.
+				if !hasSrcset {
+					addResizedSourceSet(img, proxify, srcAttr)
+				}
 			}
 		}
 	})
 
 	doc.Find("picture source").Each(func(i int, sourceElement *goquery.Selection) {
-		if srcsetAttr, ok := sourceElement.Attr("srcset"); ok {
-			if proxyImages == "all" || !url.IsHTTPS(srcsetAttr) {
-				proxifySourceSet(sourceElement, router, srcsetAttr)
-			}
+		if srcsetAttr, ok := sourceElement.Attr("srcset"); ok && ShouldProxify(MediaTypeImage, srcsetAttr) {
+			proxifySourceSet(sourceElement, proxify, srcsetAttr)
 		}
 	})
 
+	doc.Find("video").Each(func(i int, video *goquery.Selection) {
+		proxifyAttribute(video, proxify, MediaTypeImage, "poster")
+		proxifyAttribute(video, proxify, MediaTypeVideo, "src")
+		video.Find("source").Each(func(i int, sourceElement *goquery.Selection) {
+			proxifyAttribute(sourceElement, proxify, MediaTypeVideo, "src")
+		})
+	})
+
+	doc.Find("audio").Each(func(i int, audio *goquery.Selection) {
+		proxifyAttribute(audio, proxify, MediaTypeAudio, "src")
+		audio.Find("source").Each(func(i int, sourceElement *goquery.Selection) {
+			proxifyAttribute(sourceElement, proxify, MediaTypeAudio, "src")
+		})
+	})
+
 	output, err := doc.Find("body").First().Html()
 	if err != nil {
 		return data
@@ -59,7 +89,13 @@ func ImageProxyRewriter(router *mux.Router, data string) string {
 	return output
 }
 
-func proxifySourceSet(element *goquery.Selection, router *mux.Router, attributeValue string) {
+func proxifyAttribute(element *goquery.Selection, proxify func(string) string, mediaType, attributeName string) {
+	if value, ok := element.Attr(attributeName); ok && !isDataURL(value) && ShouldProxify(mediaType, value) {
+		element.SetAttr(attributeName, proxify(value))
+	}
+}
+
+func proxifySourceSet(element *goquery.Selection, proxify func(string) string, attributeValue string) {
 	var proxifiedSources []string
 
 	for _, source := range regexSplitSrcset.Split(attributeValue, -1) {
@@ -69,7 +105,7 @@ func proxifySourceSet(element *goquery.Selection, router *mux.Router, attributeV
 		if nbParts > 0 {
 			rewrittenSource := parts[0]
 			if !isDataURL(rewrittenSource) {
-				rewrittenSource = ProxifyURL(router, rewrittenSource)
+				rewrittenSource = proxify(rewrittenSource)
 			}
 
 			if nbParts > 1 {
@@ -85,6 +121,31 @@ func proxifySourceSet(element *goquery.Selection, router *mux.Router, attributeV
 	}
 }
 
+// addResizedSourceSet offers the variants smaller than the displayed width of the image.
+// The width must be known, otherwise browsers would display small images like icons at the full width of the page.
+func addResizedSourceSet(element *goquery.Selection, proxify func(string) string, link string) {
+	widthAttr, _ := element.Attr("width")
+	imageWidth, err := strconv.Atoi(strings.TrimSpace(widthAttr))
+	if err != nil || imageWidth <= 0 {
+		return
+	}
+
+	var sources []string
+	for _, width := range config.Opts.ProxyImageWidths() {
+		if width < imageWidth {
+			sources = append(sources, fmt.Sprintf("%s?width=%d %dw", proxify(link), width, width))
+		}
+	}
+
+	if len(sources) == 0 {
+		return
+	}
+
+	sources = append(sources, fmt.Sprintf("%s %dw", proxify(link), imageWidth))
+	element.SetAttr("srcset", strings.Join(sources, ", "))
+	element.SetAttr("sizes", fmt.Sprintf("(max-width: %dpx) 100vw, %dpx", imageWidth, imageWidth))
+}
+
 func isDataURL(s string) bool {
 	return strings.HasPrefix(s, "data:")
 }
@@ -16,6 +16,8 @@ import (
 func TestProxyFilterWithHttpDefault(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("PROXY_IMAGES", "http-only")
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+	os.Setenv("PROXY_IMAGE_WIDTHS", "0")
 
 	var err error
 	parser := config.NewParser()
@@ -25,11 +27,11 @@ func TestProxyFilterWithHttpDefault(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="http://website/folder/image.png" alt="Test"/></p>`
 	output := ImageProxyRewriter(r, input)
-	expected := `<p><img src="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" alt="Test"/></p>`
+	expected := `<p><img src="/proxy/okK5PsdNY8F082UMQEAbLPeUFfbe2WnNfInNmR9T4WA=/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" alt="Test"/></p>`
 
 	if expected != output {
 		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
@@ -39,6 +41,8 @@ func TestProxyFilterWithHttpDefault(t *testing.T) {
 func TestProxyFilterWithHttpsDefault(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("PROXY_IMAGES", "http-only")
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+	os.Setenv("PROXY_IMAGE_WIDTHS", "0")
 
 	var err error
 	parser := config.NewParser()
@@ -48,7 +52,7 @@ func TestProxyFilterWithHttpsDefault(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="https://website/folder/image.png" alt="Test"/></p>`
 	output := ImageProxyRewriter(r, input)
@@ -62,6 +66,8 @@ func TestProxyFilterWithHttpsDefault(t *testing.T) {
 func TestProxyFilterWithHttpNever(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("PROXY_IMAGES", "none")
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+	os.Setenv("PROXY_IMAGE_WIDTHS", "0")
 
 	var err error
 	parser := config.NewParser()
@@ -71,7 +77,7 @@ func TestProxyFilterWithHttpNever(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="http://website/folder/image.png" alt="Test"/></p>`
 	output := ImageProxyRewriter(r, input)
@@ -85,6 +91,8 @@ func TestProxyFilterWithHttpNever(t *testing.T) {
 func TestProxyFilterWithHttpsNever(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("PROXY_IMAGES", "none")
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+	os.Setenv("PROXY_IMAGE_WIDTHS", "0")
 
 	var err error
 	parser := config.NewParser()
@@ -94,7 +102,7 @@ func TestProxyFilterWithHttpsNever(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="https://website/folder/image.png" alt="Test"/></p>`
 	output := ImageProxyRewriter(r, input)
@@ -108,6 +116,8 @@ func TestProxyFilterWithHttpsNever(t *testing.T) {
 func TestProxyFilterWithHttpAlways(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("PROXY_IMAGES", "all")
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+	os.Setenv("PROXY_IMAGE_WIDTHS", "0")
 
 	var err error
 	parser := config.NewParser()
@@ -117,11 +127,11 @@ func TestProxyFilterWithHttpAlways(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="http://website/folder/image.png" alt="Test"/></p>`
 	output := ImageProxyRewriter(r, input)
-	expected := `<p><img src="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" alt="Test"/></p>`
+	expected := `<p><img src="/proxy/okK5PsdNY8F082UMQEAbLPeUFfbe2WnNfInNmR9T4WA=/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" alt="Test"/></p>`
 
 	if expected != output {
 		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
@@ -131,6 +141,8 @@ func TestProxyFilterWithHttpAlways(t *testing.T) {
 func TestProxyFilterWithHttpsAlways(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("PROXY_IMAGES", "all")
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+	os.Setenv("PROXY_IMAGE_WIDTHS", "0")
 
 	var err error
 	parser := config.NewParser()
@@ -140,11 +152,11 @@ func TestProxyFilterWithHttpsAlways(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="https://website/folder/image.png" alt="Test"/></p>`
 	output := ImageProxyRewriter(r, input)
-	expected := `<p><img src="/proxy/aHR0cHM6Ly93ZWJzaXRlL2ZvbGRlci9pbWFnZS5wbmc=" alt="Test"/></p>`
+	expected := `<p><img src="/proxy/LdPNR1GBDigeeNp2ArUQRyZsVqT_PWLfHGjYFrrWWIY=/aHR0cHM6Ly93ZWJzaXRlL2ZvbGRlci9pbWFnZS5wbmc=" alt="Test"/></p>`
 
 	if expected != output {
 		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
@@ -154,6 +166,8 @@ func TestProxyFilterWithHttpsAlways(t *testing.T) {
 func TestProxyFilterWithHttpInvalid(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("PROXY_IMAGES", "invalid")
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+	os.Setenv("PROXY_IMAGE_WIDTHS", "0")
 
 	var err error
 	parser := config.NewParser()
@@ -163,11 +177,11 @@ func TestProxyFilterWithHttpInvalid(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="http://website/folder/image.png" alt="Test"/></p>`
 	output := ImageProxyRewriter(r, input)
-	expected := `<p><img src="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" alt="Test"/></p>`
+	expected := `<p><img src="/proxy/okK5PsdNY8F082UMQEAbLPeUFfbe2WnNfInNmR9T4WA=/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" alt="Test"/></p>`
 
 	if expected != output {
 		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
@@ -177,6 +191,8 @@ func TestProxyFilterWithHttpInvalid(t *testing.T) {
 func TestProxyFilterWithHttpsInvalid(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("PROXY_IMAGES", "invalid")
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+	os.Setenv("PROXY_IMAGE_WIDTHS", "0")
 
 	var err error
 	parser := config.NewParser()
@@ -186,7 +202,7 @@ func TestProxyFilterWithHttpsInvalid(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="https://website/folder/image.png" alt="Test"/></p>`
 	output := ImageProxyRewriter(r, input)
@@ -200,6 +216,8 @@ func TestProxyFilterWithHttpsInvalid(t *testing.T) {
 func TestProxyFilterWithSrcset(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("PROXY_IMAGES", "all")
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+	os.Setenv("PROXY_IMAGE_WIDTHS", "0")
 
 	var err error
 	parser := config.NewParser()
@@ -209,10 +227,10 @@ func TestProxyFilterWithSrcset(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<p><img src="http://website/folder/image.png" srcset="http://website/folder/image2.png 656w, http://website/folder/image3.png 360w" alt="test"></p>`
-	expected := `<p><img src="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" srcset="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlMi5wbmc= 656w, /proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlMy5wbmc= 360w" alt="test"/></p>`
+	expected := `<p><img src="/proxy/okK5PsdNY8F082UMQEAbLPeUFfbe2WnNfInNmR9T4WA=/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" srcset="/proxy/aY5Hb4urDnUCly2vTJ7ExQeeaVS-52O7kjUr2v9VrAs=/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlMi5wbmc= 656w, /proxy/QgAmrJWiAud_nNAsz3F8OTxaIofwAiO36EDzH_YfMzo=/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlMy5wbmc= 360w" alt="test"/></p>`
 	output := ImageProxyRewriter(r, input)
 
 	if expected != output {
@@ -223,6 +241,8 @@ func TestProxyFilterWithSrcset(t *testing.T) {
 func TestProxyFilterWithPictureSource(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("PROXY_IMAGES", "all")
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+	os.Setenv("PROXY_IMAGE_WIDTHS", "0")
 
 	var err error
 	parser := config.NewParser()
@@ -232,10 +252,10 @@ func TestProxyFilterWithPictureSource(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<picture><source srcset="http://website/folder/image2.png 656w,   http://website/folder/image3.png 360w"></picture>`
-	expected := `<picture><source srcset="/proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlMi5wbmc= 656w, /proxy/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlMy5wbmc= 360w"/></picture>`
+	expected := `<picture><source srcset="/proxy/aY5Hb4urDnUCly2vTJ7ExQeeaVS-52O7kjUr2v9VrAs=/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlMi5wbmc= 656w, /proxy/QgAmrJWiAud_nNAsz3F8OTxaIofwAiO36EDzH_YfMzo=/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlMy5wbmc= 360w"/></picture>`
 	output := ImageProxyRewriter(r, input)
 
 	if expected != output {
@@ -246,6 +266,8 @@ func TestProxyFilterWithPictureSource(t *testing.T) {
 func TestImageProxyWithImageDataURL(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("PROXY_IMAGES", "all")
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+	os.Setenv("PROXY_IMAGE_WIDTHS", "0")
 
 	var err error
 	parser := config.NewParser()
@@ -255,7 +277,7 @@ func TestImageProxyWithImageDataURL(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<img src="data:image/gif;base64,test">`
 	expected := `<img src="data:image/gif;base64,test"/>`
@@ -269,6 +291,8 @@ func TestImageProxyWithImageDataURL(t *testing.T) {
 func TestImageProxyWithImageSourceDataURL(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("PROXY_IMAGES", "all")
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+	os.Setenv("PROXY_IMAGE_WIDTHS", "0")
 
 	var err error
 	parser := config.NewParser()
@@ -278,7 +302,7 @@ func TestImageProxyWithImageSourceDataURL(t *testing.T) {
 	}
 
 	r := mux.NewRouter()
-	r.HandleFunc("/proxy/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	r.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
 
 	input := `<picture><source srcset="data:image/gif;base64,test"/></picture>`
 	expected := `<picture><source srcset="data:image/gif;base64,test"/></picture>`
@@ -288,3 +312,127 @@ func TestImageProxyWithImageSourceDataURL(t *testing.T) {
 		t.Errorf(`Not expected output: got %s`, output)
 	}
 }
+
+func TestProxyFilterWithResizedImages(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGES", "all")
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+	os.Setenv("PROXY_IMAGE_WIDTHS", "480,960")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+
+	input := `<p><img src="http://website/folder/image.png" width="1200" alt="Test"/></p>`
+	output := ImageProxyRewriter(r, input)
+	expected := `<p><img src="/proxy/okK5PsdNY8F082UMQEAbLPeUFfbe2WnNfInNmR9T4WA=/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" width="1200" alt="Test" srcset="/proxy/okK5PsdNY8F082UMQEAbLPeUFfbe2WnNfInNmR9T4WA=/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==?width=480 480w, /proxy/okK5PsdNY8F082UMQEAbLPeUFfbe2WnNfInNmR9T4WA=/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==?width=960 960w, /proxy/okK5PsdNY8F082UMQEAbLPeUFfbe2WnNfInNmR9T4WA=/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw== 1200w" sizes="(max-width: 1200px) 100vw, 1200px"/></p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestProxyFilterWithResizedImagesWithoutWidth(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGES", "all")
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+	os.Setenv("PROXY_IMAGE_WIDTHS", "480,960")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+
+	input := `<p><img src="http://website/folder/image.png" alt="Test"/><img src="http://website/folder/icon.png" width="32" alt="Icon"/></p>`
+	output := ImageProxyRewriter(r, input)
+	expected := `<p><img src="` + ProxifyURL(r, "http://website/folder/image.png") + `" alt="Test"/><img src="` + ProxifyURL(r, "http://website/folder/icon.png") + `" width="32" alt="Icon"/></p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestProxyFilterWithAudioAndVideo(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGES", "all")
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+	os.Setenv("PROXY_MEDIA_TYPES", "image,audio,video")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+
+	input := `<video src="http://website/video.mp4" poster="http://website/poster.png"></video><audio><source src="http://website/podcast.mp3" type="audio/mpeg"/></audio>`
+	output := ImageProxyRewriter(r, input)
+	expected := `<video src="` + ProxifyURL(r, "http://website/video.mp4") + `" poster="` + ProxifyURL(r, "http://website/poster.png") + `"></video><audio><source src="` + ProxifyURL(r, "http://website/podcast.mp3") + `" type="audio/mpeg"/></audio>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
+
+func TestProxyFilterWithMediaTypeDisabled(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGES", "all")
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+
+	input := `<video src="http://website/video.mp4"></video>`
+	output := ImageProxyRewriter(r, input)
+
+	if input != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, input)
+	}
+}
+
+func TestAbsoluteProxyFilter(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("BASE_URL", "https://miniflux.example.org/reader")
+	os.Setenv("PROXY_IMAGES", "http-only")
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+	os.Setenv("PROXY_IMAGE_WIDTHS", "0")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	r := mux.NewRouter().PathPrefix("/reader").Subrouter()
+	r.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+
+	input := `<p><img src="http://website/folder/image.png" alt="Test"/></p>`
+	output := AbsoluteImageProxyRewriter(r, input)
+	expected := `<p><img src="https://miniflux.example.org/reader/proxy/okK5PsdNY8F082UMQEAbLPeUFfbe2WnNfInNmR9T4WA=/aHR0cDovL3dlYnNpdGUvZm9sZGVyL2ltYWdlLnBuZw==" alt="Test"/></p>`
+
+	if expected != output {
+		t.Errorf(`Not expected output: got "%s" instead of "%s"`, output, expected)
+	}
+}
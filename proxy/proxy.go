@@ -5,14 +5,78 @@
 package proxy // import "miniflux.app/proxy"
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
 	"encoding/base64"
 
+	"miniflux.app/config"
 	"miniflux.app/http/route"
+	"miniflux.app/url"
 
 	"github.com/gorilla/mux"
 )
 
-// ProxifyURL generates an URL for a proxified resource.
+// Media types handled by the proxy.
+const (
+	MediaTypeImage = "image"
+	MediaTypeAudio = "audio"
+	MediaTypeVideo = "video"
+)
+
+// ShouldProxify returns true if the given media URL has to go through the proxy according to the configuration.
+// The data URLs are embedded in the page, there is nothing to fetch.
+func ShouldProxify(mediaType, link string) bool {
+	if !IsProxifiedMediaType(mediaType) || isDataURL(link) {
+		return false
+	}
+
+	switch config.Opts.ProxyImages() {
+	case "all":
+		return true
+	case "none":
+		return false
+	default:
+		return !url.IsHTTPS(link)
+	}
+}
+
+// IsProxifiedMediaType returns true if the proxy is enabled for the given media type.
+func IsProxifiedMediaType(mediaType string) bool {
+	for _, proxifiedType := range config.Opts.ProxyMediaTypes() {
+		if proxifiedType == mediaType {
+			return true
+		}
+	}
+	return false
+}
+
+// ProxifyURL generates a signed URL for a proxified resource.
 func ProxifyURL(router *mux.Router, link string) string {
-	return route.Path(router, "proxy", "encodedURL", base64.URLEncoding.EncodeToString([]byte(link)))
+	digest := sign(config.Opts.ProxyPrivateKey(), link)
+	return route.Path(router, "proxy",
+		"encodedDigest", base64.URLEncoding.EncodeToString(digest),
+		"encodedURL", base64.URLEncoding.EncodeToString([]byte(link)),
+	)
+}
+
+// AbsoluteProxifyURL generates an absolute signed URL for a proxified resource.
+func AbsoluteProxifyURL(router *mux.Router, link string) string {
+	return config.Opts.RootURL() + ProxifyURL(router, link)
+}
+
+// IsValidDigest returns true if the digest has been generated for this URL with the current or a previous private key.
+func IsValidDigest(link string, digest []byte) bool {
+	keys := append([][]byte{config.Opts.ProxyPrivateKey()}, config.Opts.ProxyPreviousPrivateKeys()...)
+	for _, key := range keys {
+		if hmac.Equal(sign(key, link), digest) {
+			return true
+		}
+	}
+	return false
+}
+
+func sign(key []byte, link string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(link))
+	return mac.Sum(nil)
 }
@@ -0,0 +1,134 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package proxy // import "miniflux.app/proxy"
+
+import (
+	"encoding/base64"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"miniflux.app/config"
+
+	"github.com/gorilla/mux"
+)
+
+func parseProxifiedURL(t *testing.T, proxifiedURL string) (string, []byte) {
+	parts := strings.Split(strings.TrimPrefix(proxifiedURL, "/proxy/"), "/")
+	if len(parts) != 2 {
+		t.Fatalf(`Unexpected proxified URL: %s`, proxifiedURL)
+	}
+
+	digest, err := base64.URLEncoding.DecodeString(parts[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	link, err := base64.URLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(link), digest
+}
+
+func newProxyRouter() *mux.Router {
+	r := mux.NewRouter()
+	r.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", func(w http.ResponseWriter, r *http.Request) {}).Name("proxy")
+	return r
+}
+
+func TestProxifiedURLSignature(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_PRIVATE_KEY", "test")
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	link, digest := parseProxifiedURL(t, ProxifyURL(newProxyRouter(), "http://website/image.png"))
+	if link != "http://website/image.png" {
+		t.Fatalf(`Unexpected URL: %s`, link)
+	}
+
+	if !IsValidDigest(link, digest) {
+		t.Fatal(`The digest should be valid`)
+	}
+
+	if IsValidDigest("http://website/other.png", digest) {
+		t.Fatal(`The digest should not be valid for another URL`)
+	}
+
+	if IsValidDigest(link, []byte("invalid")) {
+		t.Fatal(`An invalid digest should be rejected`)
+	}
+}
+
+func TestProxifiedURLWithKeyRotation(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_PRIVATE_KEY", "old")
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	link, digest := parseProxifiedURL(t, ProxifyURL(newProxyRouter(), "http://website/image.png"))
+
+	os.Setenv("PROXY_PRIVATE_KEY", "new")
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if IsValidDigest(link, digest) {
+		t.Fatal(`The digest signed with an unknown key should be rejected`)
+	}
+
+	os.Setenv("PROXY_PREVIOUS_PRIVATE_KEYS", "old")
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !IsValidDigest(link, digest) {
+		t.Fatal(`The digest signed with a previous key should be accepted`)
+	}
+}
+
+func TestShouldProxify(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PROXY_IMAGES", "http-only")
+	os.Setenv("PROXY_MEDIA_TYPES", "image,audio")
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	scenarios := []struct {
+		mediaType string
+		link      string
+		expected  bool
+	}{
+		{MediaTypeImage, "http://website/image.png", true},
+		{MediaTypeImage, "https://website/image.png", false},
+		{MediaTypeAudio, "http://website/podcast.mp3", true},
+		{MediaTypeVideo, "http://website/video.mp4", false},
+		{"", "http://website/file.pdf", false},
+		{MediaTypeImage, "data:image/gif;base64,R0lGODlhAQABAAAAACw=", false},
+	}
+
+	for _, scenario := range scenarios {
+		if result := ShouldProxify(scenario.mediaType, scenario.link); result != scenario.expected {
+			t.Errorf(`Unexpected result for %s %q: got %v instead of %v`, scenario.mediaType, scenario.link, result, scenario.expected)
+		}
+	}
+}
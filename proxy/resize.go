@@ -0,0 +1,136 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package proxy // import "miniflux.app/proxy"
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+)
+
+// maxResizePixels is the largest image that can be decoded for resizing, about 48 MB of RGBA pixels.
+// The decoded image is copied before scaling, so one resize uses about twice as much memory.
+// Image headers are small, the dimensions must be checked before decoding to avoid huge allocations.
+const maxResizePixels = 12000000
+
+// resizeSlots limits the number of images decoded at the same time.
+var resizeSlots = make(chan struct{}, 2)
+
+// ErrUnsupportedImage is returned when the image cannot be resized.
+var ErrUnsupportedImage = errors.New("proxy: unsupported image format")
+
+// ResizeImage scales down a JPEG, PNG or GIF image to the given width and re-encodes it in the same format.
+// The original data is returned when the image is already smaller than the requested width or too large to be decoded.
+func ResizeImage(data []byte, width int) ([]byte, string, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", ErrUnsupportedImage
+	}
+
+	contentType := "image/" + format
+	if width <= 0 || config.Width <= width {
+		return data, contentType, nil
+	}
+
+	if int64(config.Width)*int64(config.Height) > maxResizePixels {
+		return data, contentType, nil
+	}
+
+	height := config.Height * width / config.Width
+	if height < 1 {
+		height = 1
+	}
+
+	resizeSlots <- struct{}{}
+	defer func() { <-resizeSlots }()
+
+	var buffer bytes.Buffer
+	switch format {
+	case "jpeg":
+		src, err := jpeg.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, "", fmt.Errorf("proxy: unable to decode image: %v", err)
+		}
+		err = jpeg.Encode(&buffer, scaleDown(src, width, height), &jpeg.Options{Quality: 85})
+	case "png":
+		src, err := png.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, "", fmt.Errorf("proxy: unable to decode image: %v", err)
+		}
+		err = png.Encode(&buffer, scaleDown(src, width, height))
+	case "gif":
+		src, err := gif.DecodeAll(bytes.NewReader(data))
+		if err != nil {
+			return nil, "", fmt.Errorf("proxy: unable to decode image: %v", err)
+		}
+
+		// Animated images are served unchanged, the frames may only cover a part of the canvas.
+		if len(src.Image) != 1 {
+			return data, contentType, nil
+		}
+		err = gif.Encode(&buffer, scaleDown(src.Image[0], width, height), nil)
+	default:
+		return nil, "", ErrUnsupportedImage
+	}
+
+	if err != nil {
+		return nil, "", fmt.Errorf("proxy: unable to encode image: %v", err)
+	}
+
+	return buffer.Bytes(), contentType, nil
+}
+
+// scaleDown resizes the image with an area-averaging filter, each destination pixel
+// is the mean of the source pixels it covers.
+func scaleDown(img image.Image, width, height int) *image.RGBA {
+	bounds := img.Bounds()
+	src := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(src, src.Bounds(), img, bounds.Min, draw.Src)
+
+	srcWidth, srcHeight := src.Bounds().Dx(), src.Bounds().Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := y * srcHeight / height
+		y1 := (y + 1) * srcHeight / height
+		if y1 <= y0 {
+			y1 = y0 + 1
+		}
+
+		for x := 0; x < width; x++ {
+			x0 := x * srcWidth / width
+			x1 := (x + 1) * srcWidth / width
+			if x1 <= x0 {
+				x1 = x0 + 1
+			}
+
+			var r, g, b, a, n uint32
+			for sy := y0; sy < y1; sy++ {
+				offset := src.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					r += uint32(src.Pix[offset])
+					g += uint32(src.Pix[offset+1])
+					b += uint32(src.Pix[offset+2])
+					a += uint32(src.Pix[offset+3])
+					offset += 4
+					n++
+				}
+			}
+
+			offset := dst.PixOffset(x, y)
+			dst.Pix[offset] = uint8(r / n)
+			dst.Pix[offset+1] = uint8(g / n)
+			dst.Pix[offset+2] = uint8(b / n)
+			dst.Pix[offset+3] = uint8(a / n)
+		}
+	}
+
+	return dst
+}
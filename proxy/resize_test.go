@@ -0,0 +1,146 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package proxy // import "miniflux.app/proxy"
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func newTestImage(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{uint8(x), uint8(y), 128, 255})
+		}
+	}
+	return img
+}
+
+func checkResizedImage(t *testing.T, data []byte, width int, expectedFormat string, expectedWidth, expectedHeight int) {
+	output, contentType, err := ResizeImage(data, width)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if contentType != "image/"+expectedFormat {
+		t.Errorf(`Unexpected content type: got %q`, contentType)
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(output))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if format != expectedFormat {
+		t.Errorf(`Unexpected format: got %q instead of %q`, format, expectedFormat)
+	}
+
+	if config.Width != expectedWidth || config.Height != expectedHeight {
+		t.Errorf(`Unexpected size: got %dx%d instead of %dx%d`, config.Width, config.Height, expectedWidth, expectedHeight)
+	}
+}
+
+func TestResizeJPEG(t *testing.T) {
+	var buffer bytes.Buffer
+	jpeg.Encode(&buffer, newTestImage(200, 100), nil)
+	checkResizedImage(t, buffer.Bytes(), 50, "jpeg", 50, 25)
+}
+
+func TestResizePNG(t *testing.T) {
+	var buffer bytes.Buffer
+	png.Encode(&buffer, newTestImage(200, 100))
+	checkResizedImage(t, buffer.Bytes(), 64, "png", 64, 32)
+}
+
+func TestResizeGIF(t *testing.T) {
+	var buffer bytes.Buffer
+	gif.Encode(&buffer, newTestImage(200, 100), nil)
+	checkResizedImage(t, buffer.Bytes(), 100, "gif", 100, 50)
+}
+
+func TestResizeDoesNotUpscale(t *testing.T) {
+	var buffer bytes.Buffer
+	png.Encode(&buffer, newTestImage(40, 20))
+
+	output, _, err := ResizeImage(buffer.Bytes(), 480)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(output, buffer.Bytes()) {
+		t.Error(`Smaller images should be returned unchanged`)
+	}
+}
+
+func TestResizeAnimatedGIF(t *testing.T) {
+	frame := image.NewPaletted(image.Rect(0, 0, 100, 100), color.Palette{color.Black, color.White})
+	animation := &gif.GIF{Image: []*image.Paletted{frame, frame}, Delay: []int{10, 10}}
+
+	var buffer bytes.Buffer
+	gif.EncodeAll(&buffer, animation)
+
+	output, _, err := ResizeImage(buffer.Bytes(), 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(output, buffer.Bytes()) {
+		t.Error(`Animated images should be returned unchanged`)
+	}
+}
+
+func TestResizeImageTooLarge(t *testing.T) {
+	var buffer bytes.Buffer
+	png.Encode(&buffer, newTestImage(2, 2))
+	data := buffer.Bytes()
+
+	// The IHDR chunk starts after the 8 bytes signature, its data is preceded by the length and the type.
+	binary.BigEndian.PutUint32(data[16:], 30000)
+	binary.BigEndian.PutUint32(data[20:], 30000)
+	binary.BigEndian.PutUint32(data[29:], crc32.ChecksumIEEE(data[12:29]))
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || config.Width != 30000 || config.Height != 30000 {
+		t.Fatalf(`Unable to forge the image header: %v`, err)
+	}
+
+	output, contentType, err := ResizeImage(data, 480)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if contentType != "image/png" {
+		t.Errorf(`Unexpected content type: got %q`, contentType)
+	}
+
+	if !bytes.Equal(output, data) {
+		t.Error(`Images with too many pixels should be returned unchanged`)
+	}
+}
+
+func TestResizeUnsupportedImage(t *testing.T) {
+	if _, _, err := ResizeImage([]byte("<svg></svg>"), 480); err != ErrUnsupportedImage {
+		t.Errorf(`Unexpected error: %v`, err)
+	}
+}
+
+func TestScaleDownAveragesPixels(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, color.RGBA{0, 0, 0, 255})
+	img.Set(1, 0, color.RGBA{200, 100, 50, 255})
+
+	output := scaleDown(img, 1, 1)
+	if c := output.RGBAAt(0, 0); c != (color.RGBA{100, 50, 25, 255}) {
+		t.Errorf(`Unexpected pixel value: %v`, c)
+	}
+}
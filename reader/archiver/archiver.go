@@ -0,0 +1,336 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package archiver // import "miniflux.app/reader/archiver"
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/http/client"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/rewrite"
+	"miniflux.app/reader/sanitizer"
+	"miniflux.app/reader/scraper"
+	"miniflux.app/storage"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const (
+	// maxImages is the maximum number of images embedded in a snapshot.
+	maxImages = 50
+
+	// maxImageSize is the maximum size of an embedded image, larger images keep their original URL.
+	maxImageSize = 5 * 1024 * 1024
+
+	// maxImagesSize is the total size of the images embedded in a snapshot, the next images keep their original URL.
+	maxImagesSize = 20 * 1024 * 1024
+
+	// imagesTimeout is the time allowed to download the images of a snapshot, the next images keep their original URL.
+	imagesTimeout = time.Minute
+
+	// maxQueuedEntries is the number of entries waiting to be archived,
+	// the entries queued when the queue is full are skipped.
+	maxQueuedEntries = 100
+
+	// nbArchivers is the number of entries archived at the same time.
+	nbArchivers = 2
+)
+
+// queuedEntry identifies an entry waiting to be archived.
+// The starred entries are archived only if the user still wants to keep them.
+type queuedEntry struct {
+	userID  int64
+	entryID int64
+	starred bool
+}
+
+var (
+	startArchivers sync.Once
+	queue          = make(chan queuedEntry, maxQueuedEntries)
+
+	pendingMutex sync.Mutex
+	pending      = make(map[int64]bool)
+)
+
+// Only bitmap images are embedded, SVG images could contain scripts.
+var allowedImageTypes = []string{"image/jpeg", "image/png", "image/gif", "image/webp"}
+
+// Archive downloads the web page of the entry, extracts the article and embeds the images,
+// so the snapshot can be displayed without fetching anything from the original website.
+func Archive(entry *model.Entry) (*model.EntryArchive, error) {
+	content, err := scraper.Fetch(entry.URL, entry.Feed.ScraperRules, entry.Feed.UserAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	content = rewrite.Rewriter(entry.URL, content, entry.Feed.RewriteRules)
+	content = sanitizer.Sanitize(entry.URL, content)
+	if content == "" {
+		return nil, errors.New("archiver: the web page has no content")
+	}
+
+	content, err = embedImages(content, newImageDownloader(entry.Feed.UserAgent, entry.Feed.FetchViaProxy))
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.EntryArchive{
+		EntryID: entry.ID,
+		UserID:  entry.UserID,
+		URL:     entry.URL,
+		Title:   entry.Title,
+		Content: content,
+	}, nil
+}
+
+// ArchiveEntry creates or replaces the snapshot of the given entry.
+func ArchiveEntry(store *storage.Storage, userID, entryID int64) (*model.EntryArchive, error) {
+	builder := store.NewEntryQueryBuilder(userID)
+	builder.WithEntryID(entryID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	entry, err := builder.GetEntry()
+	if err != nil {
+		return nil, err
+	}
+
+	if entry == nil {
+		return nil, nil
+	}
+
+	archive, err := Archive(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := store.SaveEntryArchive(archive); err != nil {
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+// QueueEntry archives the entry in the background, the snapshot replaces the existing one.
+// It returns false when the entry cannot be queued because too many entries are waiting.
+func QueueEntry(store *storage.Storage, userID, entryID int64) bool {
+	return queueEntry(store, queuedEntry{userID: userID, entryID: entryID})
+}
+
+// ArchiveStarredEntry archives the entry in the background when it has been starred
+// by a user who enabled the automatic archiving of starred entries.
+func ArchiveStarredEntry(store *storage.Storage, userID, entryID int64) {
+	queueEntry(store, queuedEntry{userID: userID, entryID: entryID, starred: true})
+}
+
+// IsPending returns true if the entry is waiting to be archived or is being archived.
+func IsPending(entryID int64) bool {
+	pendingMutex.Lock()
+	defer pendingMutex.Unlock()
+
+	return pending[entryID]
+}
+
+func queueEntry(store *storage.Storage, job queuedEntry) bool {
+	startArchivers.Do(func() {
+		for i := 0; i < nbArchivers; i++ {
+			go archiveQueuedEntries(store)
+		}
+	})
+
+	pendingMutex.Lock()
+	defer pendingMutex.Unlock()
+
+	if pending[job.entryID] {
+		return true
+	}
+
+	select {
+	case queue <- job:
+		pending[job.entryID] = true
+		return true
+	default:
+		logger.Debug(`[Archiver] Too many entries to archive, skipping entry #%d`, job.entryID)
+		return false
+	}
+}
+
+func archiveQueuedEntries(store *storage.Storage) {
+	for job := range queue {
+		if job.starred {
+			archiveStarredEntry(store, job.userID, job.entryID)
+		} else if _, err := ArchiveEntry(store, job.userID, job.entryID); err != nil {
+			logger.Error("[Archiver] Unable to archive entry #%d: %v", job.entryID, err)
+		}
+
+		pendingMutex.Lock()
+		delete(pending, job.entryID)
+		pendingMutex.Unlock()
+	}
+}
+
+func archiveStarredEntry(store *storage.Storage, userID, entryID int64) {
+	user, err := store.UserByID(userID)
+	if err != nil || user == nil || !user.ArchiveStarred {
+		return
+	}
+
+	if store.HasEntryArchive(userID, entryID) {
+		return
+	}
+
+	builder := store.NewEntryQueryBuilder(userID)
+	builder.WithEntryID(entryID)
+	builder.WithStarred()
+
+	if entry, err := builder.GetEntry(); err != nil || entry == nil {
+		return
+	}
+
+	logger.Debug("[Archiver] Archiving starred entry #%d for user #%d", entryID, userID)
+	if _, err := ArchiveEntry(store, userID, entryID); err != nil {
+		logger.Error("[Archiver] Unable to archive entry #%d: %v", entryID, err)
+	}
+}
+
+// imageDownloader fetches the images of a snapshot until its size or time budget is used.
+type imageDownloader struct {
+	userAgent     string
+	fetchViaProxy bool
+	remainingSize int
+	deadline      time.Time
+}
+
+func newImageDownloader(userAgent string, fetchViaProxy bool) *imageDownloader {
+	return &imageDownloader{
+		userAgent:     userAgent,
+		fetchViaProxy: fetchViaProxy,
+		remainingSize: maxImagesSize,
+		deadline:      time.Now().Add(imagesTimeout),
+	}
+}
+
+// embedImages replaces the image URLs with data URLs.
+// The images that cannot be downloaded, or that do not fit in the budgets of the downloader, keep their original URL.
+func embedImages(content string, downloader *imageDownloader) (string, error) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return "", fmt.Errorf("archiver: unable to parse content: %v", err)
+	}
+
+	// The responsive variants point to the original website.
+	doc.Find("img[srcset], picture source").Each(func(i int, element *goquery.Selection) {
+		if goquery.NodeName(element) == "source" {
+			element.Remove()
+		} else {
+			element.RemoveAttr("srcset")
+		}
+	})
+
+	images := make(map[string]string)
+	doc.Find("img[src]").EachWithBreak(func(i int, img *goquery.Selection) bool {
+		src, _ := img.Attr("src")
+		if strings.HasPrefix(src, "data:") {
+			return true
+		}
+
+		dataURL, found := images[src]
+		if !found {
+			if len(images) >= maxImages || downloader.isBudgetUsed() {
+				return false
+			}
+
+			var downloadErr error
+			if dataURL, downloadErr = downloader.download(src); downloadErr != nil {
+				logger.Debug("[Archiver] %v", downloadErr)
+			}
+			images[src] = dataURL
+		}
+
+		if dataURL != "" {
+			img.SetAttr("src", dataURL)
+		}
+
+		return true
+	})
+
+	output, err := doc.Find("body").First().Html()
+	if err != nil {
+		return "", fmt.Errorf("archiver: unable to render content: %v", err)
+	}
+
+	return output, nil
+}
+
+func (d *imageDownloader) isBudgetUsed() bool {
+	return d.remainingSize <= 0 || !time.Now().Before(d.deadline)
+}
+
+func (d *imageDownloader) download(imageURL string) (string, error) {
+	clt := client.NewClientWithConfig(imageURL, config.Opts)
+	if d.userAgent != "" {
+		clt.WithUserAgent(d.userAgent)
+	}
+	if d.fetchViaProxy {
+		clt.WithProxy()
+	}
+
+	// The larger images are rejected before reading their body, and the download
+	// cannot last longer than the remaining time of the snapshot.
+	clt.ClientMaxBodySize = maxImageSize
+	if remainingTime := int(time.Until(d.deadline).Seconds()); remainingTime < clt.ClientTimeout {
+		clt.ClientTimeout = remainingTime
+		if remainingTime < 1 {
+			clt.ClientTimeout = 1
+		}
+	}
+
+	response, err := clt.Get()
+	if err != nil {
+		return "", fmt.Errorf("archiver: unable to download image %q: %v", imageURL, err)
+	}
+
+	if response.HasServerFailure() {
+		return "", fmt.Errorf("archiver: unable to download image %q: status=%d", imageURL, response.StatusCode)
+	}
+
+	contentType := strings.ToLower(strings.TrimSpace(strings.Split(response.ContentType, ";")[0]))
+	if !isAllowedImageType(contentType) {
+		return "", fmt.Errorf("archiver: the image %q has an unsupported type (%s)", imageURL, response.ContentType)
+	}
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return "", fmt.Errorf("archiver: unable to read image %q: %v", imageURL, err)
+	}
+
+	if len(body) == 0 || len(body) > maxImageSize {
+		return "", fmt.Errorf("archiver: the image %q is empty or too large", imageURL)
+	}
+
+	if len(body) > d.remainingSize {
+		d.remainingSize = 0
+		return "", fmt.Errorf("archiver: the image %q does not fit in the size of the snapshot", imageURL)
+	}
+
+	d.remainingSize -= len(body)
+	return "data:" + contentType + ";base64," + base64.StdEncoding.EncodeToString(body), nil
+}
+
+func isAllowedImageType(contentType string) bool {
+	for _, allowedType := range allowedImageTypes {
+		if contentType == allowedType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package archiver // import "miniflux.app/reader/archiver"
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/config"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_ALLOWED_NETWORKS", "127.0.0.0/8")
+
+	var err error
+	config.Opts, err = config.NewParser().ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/image.gif", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/gif")
+		w.Write([]byte("GIF89a"))
+	})
+	mux.HandleFunc("/image.svg", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/svg+xml")
+		w.Write([]byte("<svg></svg>"))
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestEmbedImages(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	input := `<p><img src="` + server.URL + `/image.gif" srcset="` + server.URL + `/image.gif 2x"/></p>`
+	output, err := embedImages(input, newImageDownloader("", false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<p><img src="data:image/gif;base64,R0lGODlh"/></p>`
+	if output != expected {
+		t.Errorf(`Unexpected output: got %q instead of %q`, output, expected)
+	}
+}
+
+func TestEmbedImagesKeepsUnsupportedImages(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	input := `<p><img src="` + server.URL + `/image.svg"/><img src="` + server.URL + `/missing.png"/></p>`
+	output, err := embedImages(input, newImageDownloader("", false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if output != input {
+		t.Errorf(`Unexpected output: got %q instead of %q`, output, input)
+	}
+}
+
+func TestEmbedImagesRemovesPictureSources(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	input := `<picture><source srcset="` + server.URL + `/image.gif"/><img src="` + server.URL + `/image.gif"/></picture>`
+	output, err := embedImages(input, newImageDownloader("", false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(output, "<source") || !strings.Contains(output, "data:image/gif;base64,") {
+		t.Errorf(`Unexpected output: %q`, output)
+	}
+}
+
+func TestEmbedImagesWithSizeBudget(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	input := `<p><img src="` + server.URL + `/image.gif"/><img src="` + server.URL + `/image.gif?2"/></p>`
+	downloader := newImageDownloader("", false)
+	downloader.remainingSize = len("GIF89a")
+
+	output, err := embedImages(input, downloader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<p><img src="data:image/gif;base64,R0lGODlh"/><img src="` + server.URL + `/image.gif?2"/></p>`
+	if output != expected {
+		t.Errorf(`Unexpected output: got %q instead of %q`, output, expected)
+	}
+}
+
+func TestEmbedImagesWithTimeBudget(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	input := `<p><img src="` + server.URL + `/image.gif"/></p>`
+	downloader := newImageDownloader("", false)
+	downloader.deadline = time.Now()
+
+	output, err := embedImages(input, downloader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if output != input {
+		t.Errorf(`Unexpected output: got %q instead of %q`, output, input)
+	}
+}
@@ -74,14 +74,15 @@ func (a *atom10Feed) Transform(baseURL string) *model.Feed {
 }
 
 type atom10Entry struct {
-	ID        string     `xml:"id"`
-	Title     atom10Text `xml:"title"`
-	Published string     `xml:"published"`
-	Updated   string     `xml:"updated"`
-	Links     atomLinks  `xml:"link"`
-	Summary   atom10Text `xml:"summary"`
-	Content   atom10Text `xml:"http://www.w3.org/2005/Atom content"`
-	Author    atomPerson `xml:"author"`
+	ID         string         `xml:"id"`
+	Title      atom10Text     `xml:"title"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Links      atomLinks      `xml:"link"`
+	Summary    atom10Text     `xml:"summary"`
+	Content    atom10Text     `xml:"http://www.w3.org/2005/Atom content"`
+	Author     atomPerson     `xml:"author"`
+	Categories []atomCategory `xml:"category"`
 	media.Element
 }
 
@@ -95,9 +96,25 @@ func (a *atom10Entry) Transform() *model.Entry {
 	entry.Title = a.entryTitle()
 	entry.Enclosures = a.entryEnclosures()
 	entry.CommentsURL = a.entryCommentsURL()
+	entry.Tags = a.entryTags()
 	return entry
 }
 
+func (a *atom10Entry) entryTags() []string {
+	var tags []string
+	seen := make(map[string]bool)
+
+	for _, category := range a.Categories {
+		tag := category.String()
+		if tag != "" && !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
 func (a *atom10Entry) entryTitle() string {
 	return a.Title.String()
 }
@@ -1030,3 +1030,38 @@ func TestAbsoluteCommentsURL(t *testing.T) {
 		t.Errorf("Incorrect entry comments URL, got: %s", feed.Entries[0].CommentsURL)
 	}
 }
+
+func TestParseEntryCategories(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<feed xmlns="http://www.w3.org/2005/Atom">
+		<title>Example Feed</title>
+		<link href="http://example.org/"/>
+		<entry>
+			<title>Test</title>
+			<link href="http://example.org/test"/>
+			<id>urn:uuid:1225c695-cfb8-4ebb-aaaa-80da344efa6a</id>
+			<updated>2003-12-13T18:30:02Z</updated>
+			<category term="golang" label="Go"/>
+			<category label="Web Development"/>
+			<category term="golang"/>
+			<category term=""/>
+		</entry>
+	</feed>`
+
+	feed, err := Parse("https://example.org/", bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"golang", "Web Development"}
+	result := feed.Entries[0].Tags
+	if len(result) != len(expected) {
+		t.Fatalf(`Unexpected categories, got %q instead of %q`, result, expected)
+	}
+
+	for i := range expected {
+		if result[i] != expected[i] {
+			t.Errorf(`Unexpected category, got %q instead of %q`, result[i], expected[i])
+		}
+	}
+}
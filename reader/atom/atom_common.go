@@ -31,6 +31,20 @@ type atomLink struct {
 	Length string `xml:"length,attr"`
 }
 
+type atomCategory struct {
+	Term  string `xml:"term,attr"`
+	Label string `xml:"label,attr"`
+}
+
+// String returns the category term, the human-readable label is used when the term is missing.
+func (a *atomCategory) String() string {
+	if term := strings.TrimSpace(a.Term); term != "" {
+		return term
+	}
+
+	return strings.TrimSpace(a.Label)
+}
+
 type atomLinks []*atomLink
 
 func (a atomLinks) originalLink() string {
@@ -0,0 +1,221 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package email // import "miniflux.app/reader/email"
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/model"
+	"miniflux.app/reader/encoding"
+)
+
+var wordDecoder = &mime.WordDecoder{CharsetReader: encoding.CharsetReader}
+
+type part struct {
+	contentType string
+	contentID   string
+	body        []byte
+}
+
+type message struct {
+	html   string
+	text   string
+	images []*part
+}
+
+// Parse returns a normalized feed entry from an email message.
+func Parse(data io.Reader) (*model.Entry, error) {
+	msg, err := mail.ReadMessage(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse email message: %v", err)
+	}
+
+	return ParseMessage(msg)
+}
+
+// ParseMessage returns a normalized feed entry from an email message already read.
+func ParseMessage(msg *mail.Message) (*model.Entry, error) {
+	m := &message{}
+	if err := m.walk(msg.Header, msg.Body); err != nil {
+		return nil, err
+	}
+
+	entry := new(model.Entry)
+	entry.Title = decodeHeader(msg.Header.Get("Subject"))
+	entry.URL = strings.Trim(msg.Header.Get("Archived-At"), "<> ")
+	entry.Author = parseAuthor(msg.Header.Get("From"))
+	entry.Date = parseDate(msg.Header)
+	entry.Content = m.content()
+	entry.Hash = parseHash(msg.Header, entry)
+
+	if entry.Title == "" {
+		entry.Title = entry.Date.Format("2006-01-02")
+	}
+
+	return entry, nil
+}
+
+type header interface {
+	Get(key string) string
+}
+
+func (m *message) walk(h header, body io.Reader) error {
+	mediaType, params, err := mime.ParseMediaType(h.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{"charset": "us-ascii"}
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			p, err := reader.NextPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("unable to read email part: %v", err)
+			}
+
+			if err := m.walk(p.Header, p); err != nil {
+				return err
+			}
+		}
+	}
+
+	content, err := decodeTransfer(h.Get("Content-Transfer-Encoding"), body)
+	if err != nil {
+		return err
+	}
+
+	disposition, _, _ := mime.ParseMediaType(h.Get("Content-Disposition"))
+
+	switch {
+	case mediaType == "text/html" && disposition != "attachment" && m.html == "":
+		m.html = decodeCharset(params["charset"], content)
+	case mediaType == "text/plain" && disposition != "attachment" && m.text == "":
+		m.text = decodeCharset(params["charset"], content)
+	case strings.HasPrefix(mediaType, "image/"):
+		m.images = append(m.images, &part{
+			contentType: mediaType,
+			contentID:   strings.Trim(h.Get("Content-ID"), "<> "),
+			body:        content,
+		})
+	}
+
+	return nil
+}
+
+func (m *message) content() string {
+	content := m.html
+	if content == "" {
+		var b strings.Builder
+		for _, paragraph := range strings.Split(strings.Replace(m.text, "\r\n", "\n", -1), "\n\n") {
+			paragraph = strings.TrimSpace(paragraph)
+			if paragraph != "" {
+				b.WriteString("<p>")
+				b.WriteString(strings.Replace(html.EscapeString(paragraph), "\n", "<br>", -1))
+				b.WriteString("</p>")
+			}
+		}
+		content = b.String()
+	}
+
+	// The attached images are displayed after the message, they are not enclosures because they have no URL.
+	for _, image := range m.images {
+		if image.contentID != "" && strings.Contains(m.html, "cid:"+image.contentID) {
+			content = strings.Replace(content, "cid:"+image.contentID, image.dataURL(), -1)
+		} else {
+			content += `<p><img src="` + image.dataURL() + `"></p>`
+		}
+	}
+
+	return content
+}
+
+func (p *part) dataURL() string {
+	return "data:" + p.contentType + ";base64," + base64.StdEncoding.EncodeToString(p.body)
+}
+
+func decodeTransfer(transferEncoding string, body io.Reader) ([]byte, error) {
+	switch strings.ToLower(strings.TrimSpace(transferEncoding)) {
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	}
+
+	content, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode email part: %v", err)
+	}
+
+	return content, nil
+}
+
+func decodeCharset(charset string, content []byte) string {
+	if charset == "" {
+		return string(content)
+	}
+
+	reader, err := encoding.CharsetReader(charset, bytes.NewReader(content))
+	if err != nil {
+		return string(content)
+	}
+
+	decoded, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return string(content)
+	}
+
+	return string(decoded)
+}
+
+func decodeHeader(value string) string {
+	decoded, err := wordDecoder.DecodeHeader(value)
+	if err != nil {
+		return strings.TrimSpace(value)
+	}
+	return strings.TrimSpace(decoded)
+}
+
+func parseAuthor(value string) string {
+	address, err := (&mail.AddressParser{WordDecoder: wordDecoder}).Parse(value)
+	if err != nil {
+		return decodeHeader(value)
+	}
+
+	if address.Name != "" {
+		return address.Name
+	}
+
+	return address.Address
+}
+
+func parseDate(h mail.Header) time.Time {
+	date, err := h.Date()
+	if err != nil {
+		return time.Now()
+	}
+	return date
+}
+
+func parseHash(h mail.Header, entry *model.Entry) string {
+	if messageID := strings.TrimSpace(h.Get("Message-ID")); messageID != "" {
+		return crypto.Hash(messageID)
+	}
+	return crypto.Hash(entry.Title + entry.Author + entry.Date.String())
+}
@@ -0,0 +1,119 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package email // import "miniflux.app/reader/email"
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParsePlainTextEmail(t *testing.T) {
+	data := "From: Weekly News <news@example.org>\r\n" +
+		"To: abcdef@reader.example.org\r\n" +
+		"Subject: Issue #42\r\n" +
+		"Date: Mon, 02 Nov 2020 10:00:00 +0000\r\n" +
+		"Message-ID: <1234@example.org>\r\n" +
+		"Content-Type: text/plain; charset=utf-8\r\n" +
+		"\r\n" +
+		"First paragraph.\r\n\r\nSecond <paragraph>\r\nwith a line break.\r\n"
+
+	entry, err := Parse(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if entry.Title != "Issue #42" {
+		t.Errorf("Incorrect title, got: %q", entry.Title)
+	}
+
+	if entry.Author != "Weekly News" {
+		t.Errorf("Incorrect author, got: %q", entry.Author)
+	}
+
+	if !entry.Date.Equal(time.Date(2020, time.November, 2, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("Incorrect date, got: %v", entry.Date)
+	}
+
+	expected := "<p>First paragraph.</p><p>Second &lt;paragraph&gt;<br>with a line break.</p>"
+	if entry.Content != expected {
+		t.Errorf("Incorrect content, got: %q", entry.Content)
+	}
+
+	if entry.Hash != "14636299e1772b65853b8adf97dc8b4c2700632464146186533e791c8657b5bb" {
+		t.Errorf("Incorrect hash, got: %q", entry.Hash)
+	}
+}
+
+func TestParseMultipartEmail(t *testing.T) {
+	data := "From: =?utf-8?q?L=C3=A9a?= <lea@example.org>\r\n" +
+		"Subject: =?utf-8?b?Q2Fmw6kgZHUgbWF0aW4=?=\r\n" +
+		"Archived-At: <https://example.org/archive/1>\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: multipart/related; boundary=\"outer\"\r\n" +
+		"\r\n" +
+		"--outer\r\n" +
+		"Content-Type: multipart/alternative; boundary=\"inner\"\r\n" +
+		"\r\n" +
+		"--inner\r\n" +
+		"Content-Type: text/plain; charset=utf-8\r\n" +
+		"\r\n" +
+		"Plain version\r\n" +
+		"--inner\r\n" +
+		"Content-Type: text/html; charset=iso-8859-1\r\n" +
+		"Content-Transfer-Encoding: quoted-printable\r\n" +
+		"\r\n" +
+		"<p>Caf=E9</p><img src=3D\"cid:logo@example.org\">\r\n" +
+		"--inner--\r\n" +
+		"--outer\r\n" +
+		"Content-Type: image/png\r\n" +
+		"Content-ID: <logo@example.org>\r\n" +
+		"Content-Transfer-Encoding: base64\r\n" +
+		"\r\n" +
+		"aGVs\r\nbG8=\r\n" +
+		"--outer\r\n" +
+		"Content-Type: image/gif\r\n" +
+		"Content-Disposition: attachment; filename=\"a.gif\"\r\n" +
+		"Content-Transfer-Encoding: base64\r\n" +
+		"\r\n" +
+		"R0lG\r\n" +
+		"--outer--\r\n"
+
+	entry, err := Parse(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if entry.Title != "Café du matin" {
+		t.Errorf("Incorrect title, got: %q", entry.Title)
+	}
+
+	if entry.Author != "Léa" {
+		t.Errorf("Incorrect author, got: %q", entry.Author)
+	}
+
+	if entry.URL != "https://example.org/archive/1" {
+		t.Errorf("Incorrect URL, got: %q", entry.URL)
+	}
+
+	expected := `<p>Café</p><img src="data:image/png;base64,aGVsbG8="><p><img src="data:image/gif;base64,R0lG"></p>`
+	if entry.Content != expected {
+		t.Errorf("Incorrect content, got: %q", entry.Content)
+	}
+
+	if len(entry.Enclosures) != 0 {
+		t.Errorf("The images should not be stored as enclosures, got: %d", len(entry.Enclosures))
+	}
+
+	if entry.Hash == "" {
+		t.Error("Empty hash")
+	}
+}
+
+func TestParseInvalidEmail(t *testing.T) {
+	if _, err := Parse(strings.NewReader("")); err == nil {
+		t.Error("Parse should fail on empty messages")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"miniflux.app/config"
+	"miniflux.app/crypto"
 	"miniflux.app/errors"
 	"miniflux.app/http/client"
 	"miniflux.app/locale"
@@ -18,6 +19,9 @@ import (
 	"miniflux.app/reader/icon"
 	"miniflux.app/reader/parser"
 	"miniflux.app/reader/processor"
+	"miniflux.app/reader/sanitizer"
+	"miniflux.app/reader/scraper"
+	"miniflux.app/reader/watcher"
 	"miniflux.app/storage"
 	"miniflux.app/timer"
 )
@@ -26,14 +30,22 @@ var (
 	errDuplicate        = "This feed already exists (%s)"
 	errNotFound         = "Feed %d not found"
 	errCategoryNotFound = "Category not found for this user"
+	errScrapedFeed      = "Unable to extract the entries of this web page: %v"
+	errWatchPage        = "Unable to read the text of this web page: %v"
 )
 
 // CreateFeed fetch, parse and store a new feed.
 func CreateFeed(store *storage.Storage, userID int64, feedCreationRequest *model.FeedCreationRequest) (*model.Feed, error) {
+	feed, _, err := ImportFeed(store, userID, feedCreationRequest)
+	return feed, err
+}
+
+// ImportFeed creates a new feed like CreateFeed and also returns the import result (created, duplicate, unreachable or parse error).
+func ImportFeed(store *storage.Storage, userID int64, feedCreationRequest *model.FeedCreationRequest) (*model.Feed, string, error) {
 	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[CreateFeed] FeedURL=%s", feedCreationRequest.FeedURL))
 
 	if !store.CategoryIDExists(userID, feedCreationRequest.CategoryID) {
-		return nil, errors.NewLocalizedError(errCategoryNotFound)
+		return nil, model.ImportResultError, errors.NewLocalizedError(errCategoryNotFound)
 	}
 
 	request := client.NewClientWithConfig(feedCreationRequest.FeedURL, config.Opts)
@@ -46,16 +58,16 @@ func CreateFeed(store *storage.Storage, userID int64, feedCreationRequest *model
 
 	response, requestErr := browser.Exec(request)
 	if requestErr != nil {
-		return nil, requestErr
+		return nil, model.ImportResultUnreachable, requestErr
 	}
 
 	if store.FeedURLExists(userID, response.EffectiveURL) {
-		return nil, errors.NewLocalizedError(errDuplicate, response.EffectiveURL)
+		return nil, model.ImportResultDuplicate, errors.NewLocalizedError(errDuplicate, response.EffectiveURL)
 	}
 
 	subscription, parseErr := parser.ParseFeed(response.EffectiveURL, response.BodyAsString())
 	if parseErr != nil {
-		return nil, parseErr
+		return nil, model.ImportResultParseError, parseErr
 	}
 
 	subscription.UserID = userID
@@ -77,15 +89,187 @@ func CreateFeed(store *storage.Storage, userID int64, feedCreationRequest *model
 	processor.ProcessFeedEntries(store, subscription)
 
 	if storeErr := store.CreateFeed(subscription); storeErr != nil {
-		return nil, storeErr
+		return nil, model.ImportResultError, storeErr
 	}
 
 	logger.Debug("[CreateFeed] Feed saved with ID: %d", subscription.ID)
 
 	checkFeedIcon(store, subscription.ID, subscription.SiteURL, feedCreationRequest.FetchViaProxy)
+	return subscription, model.ImportResultCreated, nil
+}
+
+// CreateNewsletterFeed creates a feed that receives its entries by email.
+func CreateNewsletterFeed(store *storage.Storage, userID int64, newsletterCreationRequest *model.NewsletterCreationRequest) (*model.Feed, error) {
+	if !store.CategoryIDExists(userID, newsletterCreationRequest.CategoryID) {
+		return nil, errors.NewLocalizedError(errCategoryNotFound)
+	}
+
+	token := crypto.GenerateRandomStringHex(10)
+	address := fmt.Sprintf("mailto:%s@%s", token, config.Opts.NewsletterDomain())
+
+	feed := &model.Feed{
+		UserID:          userID,
+		Kind:            model.FeedKindNewsletter,
+		NewsletterToken: token,
+		Title:           newsletterCreationRequest.Title,
+		FeedURL:         address,
+		SiteURL:         address,
+	}
+	feed.WithCategoryID(newsletterCreationRequest.CategoryID)
+	feed.CheckedNow()
+
+	if storeErr := store.CreateFeed(feed); storeErr != nil {
+		return nil, storeErr
+	}
+
+	logger.Debug("[CreateNewsletterFeed] Feed saved with ID: %d", feed.ID)
+	return feed, nil
+}
+
+// CreateScrapedFeed creates a feed whose entries are extracted from a web page with CSS selectors.
+func CreateScrapedFeed(store *storage.Storage, userID int64, scrapedFeedCreationRequest *model.ScrapedFeedCreationRequest) (*model.Feed, error) {
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[CreateScrapedFeed] PageURL=%s", scrapedFeedCreationRequest.PageURL))
+
+	if !store.CategoryIDExists(userID, scrapedFeedCreationRequest.CategoryID) {
+		return nil, errors.NewLocalizedError(errCategoryNotFound)
+	}
+
+	subscription, response, err := fetchScrapedFeed(scrapedFeedCreationRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	if store.FeedURLExists(userID, response.EffectiveURL) {
+		return nil, errors.NewLocalizedError(errDuplicate, response.EffectiveURL)
+	}
+
+	if scrapedFeedCreationRequest.Title != "" {
+		subscription.Title = scrapedFeedCreationRequest.Title
+	}
+
+	subscription.UserID = userID
+	subscription.Kind = model.FeedKindScraped
+	subscription.Selectors = &scrapedFeedCreationRequest.Selectors
+	subscription.UserAgent = scrapedFeedCreationRequest.UserAgent
+	subscription.Crawler = scrapedFeedCreationRequest.Crawler
+	subscription.FetchViaProxy = scrapedFeedCreationRequest.FetchViaProxy
+	subscription.WithCategoryID(scrapedFeedCreationRequest.CategoryID)
+	subscription.WithClientResponse(response)
+	subscription.CheckedNow()
+
+	processor.ProcessFeedEntries(store, subscription)
+
+	if storeErr := store.CreateFeed(subscription); storeErr != nil {
+		return nil, storeErr
+	}
+
+	logger.Debug("[CreateScrapedFeed] Feed saved with ID: %d", subscription.ID)
+
+	checkFeedIcon(store, subscription.ID, subscription.SiteURL, scrapedFeedCreationRequest.FetchViaProxy)
+	return subscription, nil
+}
+
+// CreateWatchPageFeed creates a feed reporting the changes of a web page.
+func CreateWatchPageFeed(store *storage.Storage, userID int64, watchPageCreationRequest *model.WatchPageCreationRequest) (*model.Feed, error) {
+	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[CreateWatchPageFeed] PageURL=%s", watchPageCreationRequest.PageURL))
+
+	if !store.CategoryIDExists(userID, watchPageCreationRequest.CategoryID) {
+		return nil, errors.NewLocalizedError(errCategoryNotFound)
+	}
+
+	request := client.NewClientWithConfig(watchPageCreationRequest.PageURL, config.Opts)
+	request.WithUserAgent(watchPageCreationRequest.UserAgent)
+
+	if watchPageCreationRequest.FetchViaProxy {
+		request.WithProxy()
+	}
+
+	response, requestErr := browser.Exec(request)
+	if requestErr != nil {
+		return nil, requestErr
+	}
+
+	if store.FeedURLExists(userID, response.EffectiveURL) {
+		return nil, errors.NewLocalizedError(errDuplicate, response.EffectiveURL)
+	}
+
+	title, snapshot, err := watcher.Snapshot(response.Body, watchPageCreationRequest.Selector)
+	if err != nil {
+		return nil, errors.NewLocalizedError(errWatchPage, err)
+	}
+
+	subscription := &model.Feed{
+		UserID:        userID,
+		Title:         title,
+		Kind:          model.FeedKindWatchPage,
+		WatchSelector: watchPageCreationRequest.Selector,
+		UserAgent:     watchPageCreationRequest.UserAgent,
+		FetchViaProxy: watchPageCreationRequest.FetchViaProxy,
+	}
+
+	if watchPageCreationRequest.Title != "" {
+		subscription.Title = watchPageCreationRequest.Title
+	}
+
+	subscription.WithCategoryID(watchPageCreationRequest.CategoryID)
+	subscription.WithClientResponse(response)
+	subscription.SiteURL = response.EffectiveURL
+	subscription.CheckedNow()
+
+	if subscription.Title == "" {
+		subscription.Title = subscription.FeedURL
+	}
+
+	if storeErr := store.CreateFeed(subscription); storeErr != nil {
+		return nil, storeErr
+	}
+
+	if storeErr := store.UpdateFeedWatchSnapshot(subscription.ID, snapshot); storeErr != nil {
+		return nil, storeErr
+	}
+
+	logger.Debug("[CreateWatchPageFeed] Feed saved with ID: %d", subscription.ID)
+
+	checkFeedIcon(store, subscription.ID, subscription.SiteURL, watchPageCreationRequest.FetchViaProxy)
 	return subscription, nil
 }
 
+// PreviewScrapedFeed returns the entries extracted from the web page without saving anything.
+func PreviewScrapedFeed(scrapedFeedCreationRequest *model.ScrapedFeedCreationRequest) (*model.Feed, error) {
+	feed, _, err := fetchScrapedFeed(scrapedFeedCreationRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// The entries are not processed, but their content is displayed as is.
+	for _, entry := range feed.Entries {
+		entry.Content = sanitizer.Sanitize(entry.URL, entry.Content)
+	}
+
+	return feed, nil
+}
+
+func fetchScrapedFeed(scrapedFeedCreationRequest *model.ScrapedFeedCreationRequest) (*model.Feed, *client.Response, error) {
+	request := client.NewClientWithConfig(scrapedFeedCreationRequest.PageURL, config.Opts)
+	request.WithUserAgent(scrapedFeedCreationRequest.UserAgent)
+
+	if scrapedFeedCreationRequest.FetchViaProxy {
+		request.WithProxy()
+	}
+
+	response, requestErr := browser.Exec(request)
+	if requestErr != nil {
+		return nil, nil, requestErr
+	}
+
+	feed, parseErr := scraper.ParseFeed(response.EffectiveURL, response.Body, &scrapedFeedCreationRequest.Selectors)
+	if parseErr != nil {
+		return nil, nil, errors.NewLocalizedError(errScrapedFeed, parseErr)
+	}
+
+	return feed, response, nil
+}
+
 // RefreshFeed refreshes a feed.
 func RefreshFeed(store *storage.Storage, userID, feedID int64) error {
 	defer timer.ExecutionTime(time.Now(), fmt.Sprintf("[RefreshFeed] feedID=%d", feedID))
@@ -101,6 +285,14 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64) error {
 		return errors.NewLocalizedError(errNotFound, feedID)
 	}
 
+	// Newsletter feeds are only updated when an email is received, the next check is scheduled to keep them out of the batches.
+	if originalFeed.IsNewsletter() {
+		originalFeed.CheckedNow()
+		originalFeed.ScheduleNextCheck(0)
+		originalFeed.ResetErrorCounter()
+		return store.UpdateFeed(originalFeed)
+	}
+
 	weeklyEntryCount := 0
 	if config.Opts.PollingScheduler() == model.SchedulerEntryFrequency {
 		var weeklyCountErr error
@@ -142,14 +334,28 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64) error {
 	if originalFeed.IgnoreHTTPCache || response.IsModified(originalFeed.EtagHeader, originalFeed.LastModifiedHeader) {
 		logger.Debug("[RefreshFeed] Feed #%d has been modified", feedID)
 
-		updatedFeed, parseErr := parser.ParseFeed(response.EffectiveURL, response.BodyAsString())
+		var entries model.Entries
+		var snapshot string
+		var parseErr *errors.LocalizedError
+
+		if originalFeed.IsWatchPage() {
+			previousSnapshot, storeErr := store.FeedWatchSnapshot(originalFeed.ID)
+			if storeErr != nil {
+				return storeErr
+			}
+
+			entries, snapshot, parseErr = watchPageEntries(originalFeed, previousSnapshot, response, printer)
+		} else {
+			entries, parseErr = parseFeed(originalFeed, response)
+		}
+
 		if parseErr != nil {
 			originalFeed.WithError(parseErr.Localize(printer))
 			store.UpdateFeedError(originalFeed)
 			return parseErr
 		}
 
-		originalFeed.Entries = updatedFeed.Entries
+		originalFeed.Entries = entries
 		processor.ProcessFeedEntries(store, originalFeed)
 
 		// We don't update existing entries when the crawler is enabled (we crawl only inexisting entries).
@@ -159,6 +365,15 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64) error {
 			return storeErr
 		}
 
+		// The snapshot is replaced only once the changes are saved.
+		if originalFeed.IsWatchPage() {
+			if storeErr := store.UpdateFeedWatchSnapshot(originalFeed.ID, snapshot); storeErr != nil {
+				originalFeed.WithError(storeErr.Error())
+				store.UpdateFeedError(originalFeed)
+				return storeErr
+			}
+		}
+
 		// We update caching headers only if the feed has been modified,
 		// because some websites don't return the same headers when replying with a 304.
 		originalFeed.WithClientResponse(response)
@@ -178,6 +393,56 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64) error {
 	return nil
 }
 
+// parseFeed returns the entries of the response according to the kind of feed,
+// the metadata of the feed are refreshed at the same time.
+func parseFeed(feed *model.Feed, response *client.Response) (model.Entries, *errors.LocalizedError) {
+	if feed.IsScraped() {
+		updatedFeed, err := scraper.ParseFeed(response.EffectiveURL, response.Body, feed.Selectors)
+		if err != nil {
+			return nil, errors.NewLocalizedError(errScrapedFeed, err)
+		}
+		return updatedFeed.Entries, nil
+	}
+
+	updatedFeed, parseErr := parser.ParseFeed(response.EffectiveURL, response.BodyAsString())
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	feed.WithMetadata(updatedFeed)
+	return updatedFeed.Entries, nil
+}
+
+// watchPageEntries compares the web page with the snapshot of the previous check,
+// an entry showing the differences is returned when the text has changed.
+func watchPageEntries(feed *model.Feed, previousSnapshot string, response *client.Response, printer *locale.Printer) (model.Entries, string, *errors.LocalizedError) {
+	_, snapshot, err := watcher.Snapshot(response.Body, feed.WatchSelector)
+	if err != nil {
+		return nil, "", errors.NewLocalizedError(errWatchPage, err)
+	}
+
+	// The first snapshot, or the first one after a change of selector, is only a reference.
+	if previousSnapshot == "" || snapshot == previousSnapshot {
+		return nil, snapshot, nil
+	}
+
+	// The watched pages imported from OPML files may not have a site URL.
+	entryURL := feed.SiteURL
+	if entryURL == "" {
+		entryURL = feed.FeedURL
+	}
+
+	entry := &model.Entry{
+		URL:     entryURL,
+		Title:   printer.Printf("entry.watch_page.title", feed.Title),
+		Date:    feed.CheckedAt,
+		Content: watcher.Diff(previousSnapshot, snapshot),
+		Hash:    crypto.Hash(fmt.Sprintf("%s:%d", snapshot, feed.CheckedAt.UnixNano())),
+	}
+
+	return model.Entries{entry}, snapshot, nil
+}
+
 func checkFeedIcon(store *storage.Storage, feedID int64, websiteURL string, fetchViaProxy bool) {
 	if !store.HasIcon(feedID) {
 		icon, err := icon.FindIcon(websiteURL, fetchViaProxy)
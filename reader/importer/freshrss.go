@@ -0,0 +1,108 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package importer // import "miniflux.app/reader/importer"
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"miniflux.app/reader/opml"
+)
+
+const (
+	// maxArchiveFileSize is the maximum uncompressed size of a file in an archive.
+	maxArchiveFileSize = 100 * 1024 * 1024
+
+	// maxArchiveSize is the maximum uncompressed size of all the files of an archive.
+	maxArchiveSize = 500 * 1024 * 1024
+)
+
+// errArchiveTooLarge is returned when the uncompressed files exceed the size limits.
+var errArchiveTooLarge = errors.New("importer: the archive is too large")
+
+// archiveReader reads the files of an archive within the remaining size budget of the archive.
+type archiveReader struct {
+	reader    io.Reader
+	fileSize  int64
+	remaining *int64
+}
+
+func (r *archiveReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.fileSize += int64(n)
+	*r.remaining -= int64(n)
+	if r.exceeded() {
+		return n, errArchiveTooLarge
+	}
+	return n, err
+}
+
+// exceeded returns true when the file or the archive is larger than allowed.
+// The parsers may hide the error returned by Read, so it is checked again after parsing.
+func (r *archiveReader) exceeded() bool {
+	return r.fileSize > maxArchiveFileSize || *r.remaining < 0
+}
+
+// parseFreshRSSArchive parses the ZIP archive exported by FreshRSS.
+// The archive contains the subscriptions as OPML file and the articles as JSON files in the Google Reader format.
+func parseFreshRSSArchive(data []byte) (opml.SubcriptionList, []*item, error) {
+	return parseArchive(data, maxArchiveSize)
+}
+
+func parseArchive(data []byte, maxSize int64) (opml.SubcriptionList, []*item, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("importer: unable to open the archive: %v", err)
+	}
+
+	var subscriptions opml.SubcriptionList
+	var items []*item
+	remaining := maxSize
+
+	for _, file := range archive.File {
+		extension := strings.ToLower(path.Ext(file.Name))
+		if extension != ".opml" && extension != ".json" {
+			continue
+		}
+
+		f, err := file.Open()
+		if err != nil {
+			return nil, nil, fmt.Errorf("importer: unable to open %q: %v", file.Name, err)
+		}
+
+		reader := &archiveReader{reader: f, remaining: &remaining}
+		if extension == ".opml" {
+			list, parseErr := opml.Parse(reader)
+			if reader.exceeded() {
+				f.Close()
+				return nil, nil, errArchiveTooLarge
+			}
+			if parseErr != nil {
+				f.Close()
+				return nil, nil, parseErr
+			}
+			subscriptions = append(subscriptions, list...)
+		} else {
+			list, err := parseGoogleReader(reader)
+			if reader.exceeded() {
+				f.Close()
+				return nil, nil, errArchiveTooLarge
+			}
+			if err != nil {
+				f.Close()
+				return nil, nil, fmt.Errorf("importer: %q: %v", file.Name, err)
+			}
+			items = append(items, list...)
+		}
+		f.Close()
+	}
+
+	return subscriptions, items, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package importer // import "miniflux.app/reader/importer"
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+)
+
+func createArchive(t *testing.T, files map[string]string) []byte {
+	var buffer bytes.Buffer
+	archive := zip.NewWriter(&buffer)
+	for name, content := range files {
+		f, err := archive.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Write([]byte(content))
+	}
+
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buffer.Bytes()
+}
+
+func TestParseFreshRSSArchive(t *testing.T) {
+	data := createArchive(t, map[string]string{
+		"feeds_2020-05-09.opml.xml": `ignored`,
+		"feeds_2020-05-09.opml": `<?xml version="1.0" encoding="UTF-8"?>
+			<opml version="2.0" xmlns:frss="https://freshrss.org/opml">
+				<body>
+					<outline text="News">
+						<outline text="Example" type="rss" xmlUrl="https://example.org/feed.xml" htmlUrl="https://example.org/" frss:cssFullContent="article"/>
+					</outline>
+				</body>
+			</opml>`,
+		"starred_2020-05-09.json": `{
+			"id": "user/-/state/com.google/starred",
+			"items": [{"id": "1", "title": "Starred", "alternate": [{"href": "https://example.org/1"}], "origin": {"streamId": "feed/1", "feedUrl": "https://example.org/feed.xml"}}]
+		}`,
+		"feed_example.json": `{
+			"items": [{"id": "2", "title": "Unread", "alternate": [{"href": "https://example.org/2"}], "categories": ["user/-/state/com.google/reading-list"], "origin": {"feedUrl": "https://example.org/feed.xml"}}]
+		}`,
+	})
+
+	if format := DetectFormat(data); format != FormatFreshRSS {
+		t.Fatalf(`Unexpected format, got %q`, format)
+	}
+
+	subscriptions, items, err := parseFreshRSSArchive(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(subscriptions) != 1 {
+		t.Fatalf(`Unexpected number of subscriptions, got %d`, len(subscriptions))
+	}
+
+	if subscriptions[0].FeedURL != "https://example.org/feed.xml" || subscriptions[0].CategoryName != "News" {
+		t.Errorf(`Unexpected subscription: %+v`, subscriptions[0])
+	}
+
+	if len(items) != 2 {
+		t.Fatalf(`Unexpected number of items, got %d`, len(items))
+	}
+
+	for _, item := range items {
+		switch item.Title {
+		case "Starred":
+			if !item.Starred || !item.Read {
+				t.Errorf(`The starred item should be starred and read`)
+			}
+		case "Unread":
+			if item.Starred || item.Read {
+				t.Errorf(`The unread item should be unread and not starred`)
+			}
+		default:
+			t.Errorf(`Unexpected item %q`, item.Title)
+		}
+	}
+}
+
+func TestParseInvalidFreshRSSArchive(t *testing.T) {
+	if _, _, err := parseFreshRSSArchive([]byte("PK\x03\x04invalid")); err == nil {
+		t.Error(`Invalid archives should return an error`)
+	}
+}
+
+func TestParseFreshRSSArchiveSizeLimit(t *testing.T) {
+	data := createArchive(t, map[string]string{
+		"feed_1.json": `{"items": [{"id": "1", "title": "First", "alternate": [{"href": "https://example.org/1"}], "origin": {"feedUrl": "https://example.org/feed.xml"}}]}`,
+		"feed_2.json": `{"items": [{"id": "2", "title": "Second", "alternate": [{"href": "https://example.org/2"}], "origin": {"feedUrl": "https://example.org/feed.xml"}}]}`,
+	})
+
+	if _, items, err := parseArchive(data, 1024); err != nil || len(items) != 2 {
+		t.Fatalf(`The archive should be parsed, got %d items and %v`, len(items), err)
+	}
+
+	// Each file is smaller than the limit, but not both files.
+	if _, _, err := parseArchive(data, 200); err != errArchiveTooLarge {
+		t.Errorf(`The archive should be too large, got %v`, err)
+	}
+}
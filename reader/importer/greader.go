@@ -0,0 +1,153 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package importer // import "miniflux.app/reader/importer"
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Google Reader stream and state identifiers, they are also used by Feedly, Inoreader, FreshRSS and The Old Reader.
+const (
+	googleReaderStarredState    = "/state/com.google/starred"
+	googleReaderReadState       = "/state/com.google/read"
+	googleReaderKeptUnreadState = "/state/com.google/kept-unread"
+	googleReaderReadingList     = "/state/com.google/reading-list"
+	googleReaderLabelPrefix     = "/label/"
+)
+
+type googleReaderExport struct {
+	ID    string              `json:"id"`
+	Items []*googleReaderItem `json:"items"`
+}
+
+type googleReaderItem struct {
+	ID            string             `json:"id"`
+	Title         string             `json:"title"`
+	Author        string             `json:"author"`
+	Published     int64              `json:"published"`
+	TimestampUsec string             `json:"timestampUsec"`
+	Canonical     []googleReaderLink `json:"canonical"`
+	Alternate     []googleReaderLink `json:"alternate"`
+	Categories    []string           `json:"categories"`
+	Summary       googleReaderText   `json:"summary"`
+	Content       googleReaderText   `json:"content"`
+	Origin        struct {
+		StreamID string `json:"streamId"`
+		Title    string `json:"title"`
+		HTMLURL  string `json:"htmlUrl"`
+		FeedURL  string `json:"feedUrl"`
+	} `json:"origin"`
+}
+
+type googleReaderLink struct {
+	Href string `json:"href"`
+}
+
+type googleReaderText struct {
+	Content string `json:"content"`
+}
+
+// parseGoogleReader parses the JSON exports based on the Google Reader API, like starred.json.
+func parseGoogleReader(data io.Reader) ([]*item, error) {
+	var export googleReaderExport
+	if err := json.NewDecoder(data).Decode(&export); err != nil {
+		return nil, fmt.Errorf("importer: unable to parse JSON export: %v", err)
+	}
+
+	// All the items of a starred export are starred, even if the items don't have the state.
+	starredExport := strings.HasSuffix(export.ID, googleReaderStarredState)
+
+	var items []*item
+	for _, entry := range export.Items {
+		i := &item{
+			ID:        entry.ID,
+			Title:     entry.Title,
+			Author:    entry.Author,
+			URL:       entry.link(),
+			Content:   entry.Content.Content,
+			Date:      entry.date(),
+			Starred:   starredExport || entry.hasCategory(googleReaderStarredState),
+			FeedURL:   entry.feedURL(),
+			FeedTitle: entry.Origin.Title,
+			SiteURL:   entry.Origin.HTMLURL,
+			Category:  entry.label(),
+		}
+
+		if i.Content == "" {
+			i.Content = entry.Summary.Content
+		}
+
+		// Historical items are imported as read, unless the export says otherwise.
+		unread := entry.hasCategory(googleReaderKeptUnreadState) ||
+			(entry.hasCategory(googleReaderReadingList) && !entry.hasCategory(googleReaderReadState))
+		i.Read = !unread
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+func (g *googleReaderItem) link() string {
+	for _, links := range [][]googleReaderLink{g.Canonical, g.Alternate} {
+		for _, link := range links {
+			if link.Href != "" {
+				return link.Href
+			}
+		}
+	}
+	return ""
+}
+
+func (g *googleReaderItem) date() time.Time {
+	if g.Published > 0 {
+		return time.Unix(g.Published, 0)
+	}
+
+	if usec, err := strconv.ParseInt(g.TimestampUsec, 10, 64); err == nil && usec > 0 {
+		return time.Unix(0, usec*int64(time.Microsecond))
+	}
+
+	return time.Time{}
+}
+
+func (g *googleReaderItem) feedURL() string {
+	if g.Origin.FeedURL != "" {
+		return g.Origin.FeedURL
+	}
+
+	// The stream ID of a feed is the feed URL prefixed by "feed/".
+	feedURL := strings.TrimPrefix(g.Origin.StreamID, "feed/")
+	if strings.HasPrefix(feedURL, "http://") || strings.HasPrefix(feedURL, "https://") {
+		return feedURL
+	}
+
+	return ""
+}
+
+func (g *googleReaderItem) label() string {
+	for _, category := range g.Categories {
+		if strings.HasPrefix(category, "user/") {
+			if index := strings.Index(category, googleReaderLabelPrefix); index > 0 {
+				return category[index+len(googleReaderLabelPrefix):]
+			}
+		}
+	}
+	return ""
+}
+
+func (g *googleReaderItem) hasCategory(state string) bool {
+	for _, category := range g.Categories {
+		if strings.HasPrefix(category, "user/") && strings.HasSuffix(category, state) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package importer // import "miniflux.app/reader/importer"
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseGoogleReaderStarredExport(t *testing.T) {
+	data := `{
+		"id": "user/01234567890123456789/state/com.google/starred",
+		"title": "Starred items",
+		"items": [
+			{
+				"id": "tag:google.com,2005:reader/item/0000000000000001",
+				"title": "First item",
+				"author": "John",
+				"published": 1589000000,
+				"canonical": [{"href": "https://example.org/first"}],
+				"alternate": [{"href": "https://example.org/first?utm=1", "type": "text/html"}],
+				"categories": ["user/01234567890123456789/label/Tech"],
+				"summary": {"content": "Summary"},
+				"content": {"content": "<p>Content</p>"},
+				"origin": {"streamId": "feed/https://example.org/feed.xml", "title": "Example", "htmlUrl": "https://example.org/"}
+			},
+			{
+				"id": "tag:google.com,2005:reader/item/0000000000000002",
+				"title": "Second item",
+				"timestampUsec": "1589000000000000",
+				"alternate": [{"href": "https://example.org/second"}],
+				"summary": {"content": "Summary"},
+				"origin": {"streamId": "feed/42", "feedUrl": "https://example.org/other.xml"}
+			}
+		]
+	}`
+
+	items, err := parseGoogleReader(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf(`Unexpected number of items, got %d`, len(items))
+	}
+
+	first := items[0]
+	if first.URL != "https://example.org/first" {
+		t.Errorf(`The canonical link should be used, got %q`, first.URL)
+	}
+
+	if first.Content != "<p>Content</p>" || first.Author != "John" || first.Title != "First item" {
+		t.Errorf(`Unexpected item: %+v`, first)
+	}
+
+	if !first.Starred || !first.Read {
+		t.Errorf(`The items of a starred export should be starred and read`)
+	}
+
+	if first.FeedURL != "https://example.org/feed.xml" || first.FeedTitle != "Example" || first.SiteURL != "https://example.org/" {
+		t.Errorf(`Unexpected feed: %q %q %q`, first.FeedURL, first.FeedTitle, first.SiteURL)
+	}
+
+	if first.Category != "Tech" {
+		t.Errorf(`Unexpected category, got %q`, first.Category)
+	}
+
+	if first.Date.Unix() != 1589000000 {
+		t.Errorf(`Unexpected date, got %v`, first.Date)
+	}
+
+	second := items[1]
+	if second.Content != "Summary" {
+		t.Errorf(`The summary should be used when there is no content, got %q`, second.Content)
+	}
+
+	if second.FeedURL != "https://example.org/other.xml" {
+		t.Errorf(`The feed URL of the origin should be used, got %q`, second.FeedURL)
+	}
+
+	if second.Date.Unix() != 1589000000 {
+		t.Errorf(`Unexpected date, got %v`, second.Date)
+	}
+}
+
+func TestParseGoogleReaderReadState(t *testing.T) {
+	data := `{
+		"id": "feed/https://example.org/feed.xml",
+		"items": [
+			{"id": "1", "alternate": [{"href": "https://example.org/1"}], "categories": ["user/-/state/com.google/reading-list", "user/-/state/com.google/read"]},
+			{"id": "2", "alternate": [{"href": "https://example.org/2"}], "categories": ["user/-/state/com.google/reading-list"]},
+			{"id": "3", "alternate": [{"href": "https://example.org/3"}], "categories": ["user/-/state/com.google/kept-unread", "user/-/state/com.google/starred"]}
+		]
+	}`
+
+	items, err := parseGoogleReader(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !items[0].Read || items[0].Starred {
+		t.Errorf(`The first item should be read and not starred`)
+	}
+
+	if items[1].Read {
+		t.Errorf(`The second item should be unread`)
+	}
+
+	if items[2].Read || !items[2].Starred {
+		t.Errorf(`The third item should be unread and starred`)
+	}
+
+	if items[0].FeedURL != "" {
+		t.Errorf(`The feed URL should be empty without origin, got %q`, items[0].FeedURL)
+	}
+}
+
+func TestParseInvalidGoogleReaderExport(t *testing.T) {
+	if _, err := parseGoogleReader(strings.NewReader(`{"items": [`)); err == nil {
+		t.Error(`Invalid JSON documents should return an error`)
+	}
+}
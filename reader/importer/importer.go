@@ -0,0 +1,417 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package importer // import "miniflux.app/reader/importer"
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/opml"
+	"miniflux.app/reader/sanitizer"
+	"miniflux.app/storage"
+)
+
+// Supported export formats.
+const (
+	FormatOPML         = "opml"
+	FormatGoogleReader = "greader"
+	FormatTinyTinyRSS  = "ttrss"
+	FormatFreshRSS     = "freshrss"
+)
+
+// ErrUnknownFormat is returned when the file is not a supported export.
+var ErrUnknownFormat = errors.New("importer: unsupported file format")
+
+// Report summarizes the result of an import.
+type Report = model.ImportReport
+
+// importBatchSize is the number of entries created in the same transaction.
+const importBatchSize = 100
+
+// maxJobErrors is the maximum number of errors stored in the report of an import job.
+const maxJobErrors = 100
+
+// item is an article exported by another feed reader.
+type item struct {
+	ID        string
+	URL       string
+	Title     string
+	Author    string
+	Content   string
+	Date      time.Time
+	Starred   bool
+	Read      bool
+	FeedURL   string
+	FeedTitle string
+	SiteURL   string
+	Category  string
+}
+
+// Importer creates the feeds, categories and entries of an export.
+type Importer struct {
+	store      *storage.Storage
+	userID     int64
+	report     *Report
+	job        *model.ImportJob
+	categories map[string]*model.Category
+	feeds      map[string]int64
+}
+
+// NewImporter returns an importer for the given user.
+func NewImporter(store *storage.Storage, userID int64) *Importer {
+	return &Importer{
+		store:      store,
+		userID:     userID,
+		categories: make(map[string]*model.Category),
+		feeds:      make(map[string]int64),
+	}
+}
+
+// DetectFormat returns the format of the export, an empty string is returned when the format is not supported.
+func DetectFormat(data []byte) string {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if bytes.HasPrefix(data, []byte("PK\x03\x04")) {
+		return FormatFreshRSS
+	}
+
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(data, []byte("{")):
+		return FormatGoogleReader
+	case bytes.HasPrefix(data, []byte("<")):
+		decoder := xml.NewDecoder(bytes.NewReader(data))
+		decoder.Strict = false
+		for {
+			token, err := decoder.Token()
+			if err != nil {
+				return ""
+			}
+
+			if element, ok := token.(xml.StartElement); ok {
+				switch strings.ToLower(element.Name.Local) {
+				case "opml":
+					return FormatOPML
+				case "articles":
+					return FormatTinyTinyRSS
+				default:
+					return ""
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// Import creates the subscriptions and the entries of the export.
+func (i *Importer) Import(data []byte) (*Report, error) {
+	format := DetectFormat(data)
+	subscriptions, items, err := parse(format, data)
+	if err != nil {
+		return nil, err
+	}
+
+	i.report = &Report{Format: format}
+	i.importAll(subscriptions, items)
+
+	logger.Info("[Importer] User #%d: %s", i.userID, i.report)
+	return i.report, nil
+}
+
+// Start parses the export and imports it in the background.
+// The returned job is updated with the progress and the report of the import.
+func (i *Importer) Start(data []byte) (*model.ImportJob, error) {
+	format := DetectFormat(data)
+	subscriptions, items, err := parse(format, data)
+	if err != nil {
+		return nil, err
+	}
+
+	i.report = &Report{Format: format}
+	i.job = &model.ImportJob{
+		UserID: i.userID,
+		Status: model.ImportJobStatusRunning,
+		Total:  len(subscriptions) + len(items),
+	}
+
+	if err := i.store.CreateImportJob(i.job); err != nil {
+		return nil, err
+	}
+
+	go i.run(subscriptions, items)
+	return i.job, nil
+}
+
+func (i *Importer) run(subscriptions opml.SubcriptionList, items []*item) {
+	job := i.job
+	logger.Debug("[Importer] Starting job #%d for user #%d (%d items)", job.ID, job.UserID, job.Total)
+
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("[Importer] Job #%d: %v", job.ID, r)
+			job.Status = model.ImportJobStatusFailed
+			job.ErrorMsg = fmt.Sprintf("%v", r)
+		}
+
+		if len(i.report.Errors) > maxJobErrors {
+			i.report.Errors = i.report.Errors[:maxJobErrors]
+		}
+
+		job.Report = i.report
+		if err := i.store.FinishImportJob(job); err != nil {
+			logger.Error("[Importer] %v", err)
+		}
+
+		logger.Info("[Importer] Job #%d for user #%d is %s: %s", job.ID, job.UserID, job.Status, i.report)
+	}()
+
+	job.Status = model.ImportJobStatusFinished
+	i.importAll(subscriptions, items)
+}
+
+// parse returns the subscriptions and the articles of the export.
+func parse(format string, data []byte) (opml.SubcriptionList, []*item, error) {
+	switch format {
+	case FormatOPML:
+		subscriptions, err := opml.Parse(bytes.NewReader(data))
+		if err != nil {
+			return nil, nil, err
+		}
+		return subscriptions, nil, nil
+	case FormatGoogleReader:
+		items, err := parseGoogleReader(bytes.NewReader(data))
+		return nil, items, err
+	case FormatTinyTinyRSS:
+		items, err := parseTinyTinyRSS(bytes.NewReader(data))
+		return nil, items, err
+	case FormatFreshRSS:
+		return parseFreshRSSArchive(data)
+	default:
+		return nil, nil, ErrUnknownFormat
+	}
+}
+
+// importAll creates the subscriptions, then the entries by batches.
+func (i *Importer) importAll(subscriptions opml.SubcriptionList, items []*item) {
+	for _, subscription := range subscriptions {
+		if subscription.FeedURL != "" {
+			if _, err := i.feed(subscription); err != nil {
+				i.addError("%v", err)
+			}
+		}
+	}
+	i.progress(len(subscriptions))
+
+	batch := make(model.Entries, 0, importBatchSize)
+	for index, item := range items {
+		entry, err := i.entry(item)
+		if err != nil {
+			i.report.EntriesSkipped++
+			i.addError("%v", err)
+		} else {
+			batch = append(batch, entry)
+		}
+
+		if len(batch) == importBatchSize || index == len(items)-1 {
+			i.importEntries(batch)
+			batch = batch[:0]
+			i.progress(len(subscriptions) + index + 1)
+		}
+	}
+}
+
+func (i *Importer) importEntries(entries model.Entries) {
+	if len(entries) == 0 {
+		return
+	}
+
+	created, err := i.store.ImportEntries(entries)
+	if err != nil {
+		i.report.EntriesSkipped += len(entries)
+		i.addError("%v", err)
+		return
+	}
+
+	i.report.EntriesCreated += len(created)
+	i.report.EntriesSkipped += len(entries) - len(created)
+	for _, entry := range created {
+		if entry.Starred {
+			i.report.EntriesStarred++
+		}
+	}
+}
+
+// progress stores the number of processed items when the import runs in the background.
+func (i *Importer) progress(processed int) {
+	if i.job == nil {
+		return
+	}
+
+	i.job.Processed = processed
+	if err := i.store.UpdateImportJobProgress(i.job); err != nil {
+		logger.Error("[Importer] %v", err)
+	}
+}
+
+func (i *Importer) addError(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	logger.Debug("[Importer] %s", message)
+	i.report.Errors = append(i.report.Errors, message)
+}
+
+// entry returns the entry of the article, the feed is created when it doesn't exist.
+func (i *Importer) entry(item *item) (*model.Entry, error) {
+	if item.FeedURL == "" {
+		return nil, fmt.Errorf("importer: the item %q has no feed", item.Title)
+	}
+
+	if item.URL == "" && item.ID == "" {
+		return nil, fmt.Errorf("importer: the item %q has no link", item.Title)
+	}
+
+	feedID, err := i.feed(&opml.Subcription{
+		Title:        item.FeedTitle,
+		FeedURL:      item.FeedURL,
+		SiteURL:      item.SiteURL,
+		CategoryName: item.Category,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &model.Entry{
+		UserID:  i.userID,
+		FeedID:  feedID,
+		Title:   item.Title,
+		URL:     item.URL,
+		Author:  item.Author,
+		Date:    item.Date,
+		Content: sanitizer.Sanitize(item.URL, item.Content),
+		Starred: item.Starred,
+		Status:  model.EntryStatusUnread,
+	}
+
+	// Most feeds use the link of the article as identifier, the hash matches the one computed when the feed is refreshed.
+	if item.URL != "" {
+		entry.Hash = crypto.Hash(item.URL)
+	} else {
+		entry.Hash = crypto.Hash(item.ID)
+	}
+
+	if entry.Title == "" {
+		entry.Title = entry.URL
+	}
+
+	if entry.Date.IsZero() {
+		entry.Date = time.Now()
+	}
+
+	if item.Read {
+		entry.Status = model.EntryStatusRead
+	}
+
+	return entry, nil
+}
+
+// feed returns the ID of the feed, the feed and its category are created when they don't exist.
+// The scraped feeds and the watched pages of Miniflux OPML files keep their kind and selectors.
+func (i *Importer) feed(subscription *opml.Subcription) (int64, error) {
+	feedURL := subscription.FeedURL
+	if feedID, found := i.feeds[feedURL]; found {
+		return feedID, nil
+	}
+
+	if feedID := i.store.FeedIDByURL(i.userID, feedURL); feedID > 0 {
+		i.feeds[feedURL] = feedID
+		return feedID, nil
+	}
+
+	if err := subscription.ValidateKind(); err != nil {
+		return 0, fmt.Errorf("importer: %s: %v", feedURL, err)
+	}
+
+	category, err := i.category(subscription.CategoryName)
+	if err != nil {
+		return 0, err
+	}
+
+	title := subscription.Title
+	if title == "" {
+		title = feedURL
+	}
+
+	// The feed is refreshed later by the scheduler, like feeds imported from OPML files.
+	feed := &model.Feed{
+		UserID:   i.userID,
+		Title:    title,
+		FeedURL:  feedURL,
+		SiteURL:  subscription.SiteURL,
+		Category: category,
+	}
+
+	if subscription.HasKind() {
+		feed.Kind = subscription.Kind
+		feed.Selectors = subscription.Selectors
+		feed.WatchSelector = subscription.WatchSelector
+	}
+
+	if err := i.store.CreateFeed(feed); err != nil {
+		return 0, err
+	}
+
+	i.report.FeedsCreated++
+	i.feeds[feedURL] = feed.ID
+	return feed.ID, nil
+}
+
+func (i *Importer) category(title string) (*model.Category, error) {
+	if category, found := i.categories[title]; found {
+		return category, nil
+	}
+
+	var category *model.Category
+	var err error
+
+	if title == "" {
+		category, err = i.store.FirstCategory(i.userID)
+	} else {
+		category, err = i.store.CategoryByTitle(i.userID, title)
+		if err == nil && category == nil {
+			category, err = i.store.CreateCategory(i.userID, &model.CategoryRequest{Title: title})
+			if err == nil {
+				i.report.CategoriesCreated++
+			}
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if category == nil {
+		return nil, errors.New("importer: unable to find a category")
+	}
+
+	i.categories[title] = category
+	return category, nil
+}
+
+// Import detects the format of the export and imports it for the given user.
+func Import(store *storage.Storage, userID int64, data io.Reader) (*Report, error) {
+	var buffer bytes.Buffer
+	if _, err := buffer.ReadFrom(data); err != nil {
+		return nil, fmt.Errorf("importer: unable to read the file: %v", err)
+	}
+
+	return NewImporter(store, userID).Import(buffer.Bytes())
+}
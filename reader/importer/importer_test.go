@@ -0,0 +1,33 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package importer // import "miniflux.app/reader/importer"
+
+import (
+	"testing"
+)
+
+func TestDetectFormat(t *testing.T) {
+	scenarios := map[string]string{
+		`<?xml version="1.0"?><opml version="2.0"><body></body></opml>`:                  FormatOPML,
+		"\xef\xbb\xbf<opml version=\"1.0\"></opml>":                                      FormatOPML,
+		`<?xml version="1.0"?><!-- export --><articles schema-version="137"></articles>`: FormatTinyTinyRSS,
+		`  {"id": "user/-/state/com.google/starred", "items": []}`:                       FormatGoogleReader,
+		"PK\x03\x04archive": FormatFreshRSS,
+		`<?xml version="1.0"?><rss version="2.0"></rss>`: "",
+		`not an export`: "",
+	}
+
+	for input, expected := range scenarios {
+		if result := DetectFormat([]byte(input)); result != expected {
+			t.Errorf(`Unexpected format for %q, got %q instead of %q`, input, result, expected)
+		}
+	}
+}
+
+func TestImportUnknownFormat(t *testing.T) {
+	if _, err := NewImporter(nil, 1).Import([]byte("plain text")); err != ErrUnknownFormat {
+		t.Errorf(`Unknown formats should be rejected, got %v`, err)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package importer // import "miniflux.app/reader/importer"
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"miniflux.app/reader/date"
+	"miniflux.app/reader/encoding"
+)
+
+// Tiny Tiny RSS exports the starred, published and archived articles with the "Import/Export" plugin.
+type ttrssExport struct {
+	XMLName  xml.Name        `xml:"articles"`
+	Articles []*ttrssArticle `xml:"article"`
+}
+
+type ttrssArticle struct {
+	GUID      string `xml:"guid"`
+	Title     string `xml:"title"`
+	Content   string `xml:"content"`
+	Marked    string `xml:"marked"`
+	Unread    string `xml:"unread"`
+	Link      string `xml:"link"`
+	Author    string `xml:"author"`
+	FeedTitle string `xml:"feed_title"`
+	FeedURL   string `xml:"feed_url"`
+	Updated   string `xml:"updated"`
+}
+
+// parseTinyTinyRSS parses the XML export of Tiny Tiny RSS.
+func parseTinyTinyRSS(data io.Reader) ([]*item, error) {
+	var export ttrssExport
+	decoder := xml.NewDecoder(data)
+	decoder.Entity = xml.HTMLEntity
+	decoder.Strict = false
+	decoder.CharsetReader = encoding.CharsetReader
+
+	if err := decoder.Decode(&export); err != nil {
+		return nil, fmt.Errorf("importer: unable to parse Tiny Tiny RSS export: %v", err)
+	}
+
+	var items []*item
+	for _, article := range export.Articles {
+		i := &item{
+			ID:        strings.TrimSpace(article.GUID),
+			URL:       strings.TrimSpace(article.Link),
+			Title:     strings.TrimSpace(article.Title),
+			Author:    strings.TrimSpace(article.Author),
+			Content:   article.Content,
+			Starred:   isTrue(article.Marked),
+			Read:      !isTrue(article.Unread),
+			FeedURL:   strings.TrimSpace(article.FeedURL),
+			FeedTitle: strings.TrimSpace(article.FeedTitle),
+		}
+
+		if updated := strings.TrimSpace(article.Updated); updated != "" {
+			if t, err := date.Parse(updated); err == nil {
+				i.Date = t
+			}
+		}
+
+		items = append(items, i)
+	}
+
+	return items, nil
+}
+
+func isTrue(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "1", "t", "true":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package importer // import "miniflux.app/reader/importer"
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseTinyTinyRSSExport(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<articles schema-version="137">
+		<article>
+			<guid><![CDATA[{"ver":2,"uid":"1","hash":"SHA1:abc"}]]></guid>
+			<title><![CDATA[Starred article]]></title>
+			<content><![CDATA[<p>Content &amp; more</p>]]></content>
+			<marked><![CDATA[1]]></marked>
+			<published><![CDATA[0]]></published>
+			<score><![CDATA[0]]></score>
+			<note><![CDATA[]]></note>
+			<link><![CDATA[https://example.org/article]]></link>
+			<tag_cache><![CDATA[]]></tag_cache>
+			<label_cache><![CDATA[]]></label_cache>
+			<feed_title><![CDATA[Example]]></feed_title>
+			<feed_url><![CDATA[https://example.org/feed.xml]]></feed_url>
+			<updated><![CDATA[2020-05-09 10:00:00]]></updated>
+		</article>
+		<article>
+			<guid><![CDATA[2]]></guid>
+			<title><![CDATA[Published article]]></title>
+			<marked><![CDATA[0]]></marked>
+			<unread><![CDATA[1]]></unread>
+			<link><![CDATA[https://example.org/other]]></link>
+			<feed_url><![CDATA[https://example.org/feed.xml]]></feed_url>
+		</article>
+	</articles>`
+
+	items, err := parseTinyTinyRSS(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf(`Unexpected number of items, got %d`, len(items))
+	}
+
+	first := items[0]
+	if first.Title != "Starred article" || first.URL != "https://example.org/article" || first.Content != "<p>Content &amp; more</p>" {
+		t.Errorf(`Unexpected item: %+v`, first)
+	}
+
+	if !first.Starred || !first.Read {
+		t.Errorf(`The first item should be starred and read`)
+	}
+
+	if first.FeedURL != "https://example.org/feed.xml" || first.FeedTitle != "Example" {
+		t.Errorf(`Unexpected feed: %q %q`, first.FeedURL, first.FeedTitle)
+	}
+
+	if first.Date.Format("2006-01-02 15:04") != "2020-05-09 10:00" {
+		t.Errorf(`Unexpected date, got %v`, first.Date)
+	}
+
+	second := items[1]
+	if second.Starred || second.Read {
+		t.Errorf(`The second item should be unread and not starred`)
+	}
+
+	if !second.Date.IsZero() {
+		t.Errorf(`The date should be empty, got %v`, second.Date)
+	}
+}
+
+func TestParseInvalidTinyTinyRSSExport(t *testing.T) {
+	if _, err := parseTinyTinyRSS(strings.NewReader(`<opml></opml>`)); err == nil {
+		t.Error(`Documents without articles should return an error`)
+	}
+}
@@ -16,12 +16,17 @@ import (
 )
 
 type jsonFeed struct {
-	Version string     `json:"version"`
-	Title   string     `json:"title"`
-	SiteURL string     `json:"home_page_url"`
-	FeedURL string     `json:"feed_url"`
-	Author  jsonAuthor `json:"author"`
-	Items   []jsonItem `json:"items"`
+	Version     string       `json:"version"`
+	Title       string       `json:"title"`
+	SiteURL     string       `json:"home_page_url"`
+	FeedURL     string       `json:"feed_url"`
+	Description string       `json:"description"`
+	Icon        string       `json:"icon"`
+	Favicon     string       `json:"favicon"`
+	Language    string       `json:"language"`
+	Author      jsonAuthor   `json:"author"`
+	Authors     []jsonAuthor `json:"authors"`
+	Items       []jsonItem   `json:"items"`
 }
 
 type jsonAuthor struct {
@@ -32,13 +37,18 @@ type jsonAuthor struct {
 type jsonItem struct {
 	ID            string           `json:"id"`
 	URL           string           `json:"url"`
+	ExternalURL   string           `json:"external_url"`
 	Title         string           `json:"title"`
 	Summary       string           `json:"summary"`
 	Text          string           `json:"content_text"`
 	HTML          string           `json:"content_html"`
+	Image         string           `json:"image"`
+	BannerImage   string           `json:"banner_image"`
 	DatePublished string           `json:"date_published"`
 	DateModified  string           `json:"date_modified"`
 	Author        jsonAuthor       `json:"author"`
+	Authors       []jsonAuthor     `json:"authors"`
+	Tags          []string         `json:"tags"`
 	Attachments   []jsonAttachment `json:"attachments"`
 }
 
@@ -51,7 +61,7 @@ type jsonAttachment struct {
 }
 
 func (j *jsonFeed) GetAuthor() string {
-	return getAuthor(j.Author)
+	return getAuthor(j.Authors, j.Author)
 }
 
 func (j *jsonFeed) Transform(baseURL string) *model.Feed {
@@ -74,11 +84,21 @@ func (j *jsonFeed) Transform(baseURL string) *model.Feed {
 		feed.Title = feed.SiteURL
 	}
 
+	feed.Description = strings.TrimSpace(j.Description)
+	feed.Language = strings.TrimSpace(j.Language)
+
+	for _, value := range []string{j.Icon, j.Favicon} {
+		if value != "" {
+			feed.ImageURL = absoluteURL(feed.FeedURL, value)
+			break
+		}
+	}
+
 	for _, item := range j.Items {
 		entry := item.Transform()
-		entryURL, err := url.AbsoluteURL(feed.SiteURL, entry.URL)
-		if err == nil {
-			entry.URL = entryURL
+		entry.URL = absoluteURL(feed.SiteURL, entry.URL)
+		if entry.ImageURL != "" {
+			entry.ImageURL = absoluteURL(feed.SiteURL, entry.ImageURL)
 		}
 
 		if entry.Author == "" {
@@ -108,11 +128,44 @@ func (j *jsonItem) GetDate() time.Time {
 }
 
 func (j *jsonItem) GetAuthor() string {
-	return getAuthor(j.Author)
+	return getAuthor(j.Authors, j.Author)
+}
+
+// GetURL returns the permalink of the item, link blogs may only provide the URL of the article they talk about.
+func (j *jsonItem) GetURL() string {
+	if j.URL != "" {
+		return j.URL
+	}
+
+	return j.ExternalURL
+}
+
+// GetImage returns the main image of the item, the banner image is used as a fallback.
+func (j *jsonItem) GetImage() string {
+	if j.Image != "" {
+		return j.Image
+	}
+
+	return j.BannerImage
+}
+
+func (j *jsonItem) GetTags() []string {
+	var tags []string
+	seen := make(map[string]bool)
+
+	for _, value := range j.Tags {
+		tag := strings.TrimSpace(value)
+		if tag != "" && !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
 }
 
 func (j *jsonItem) GetHash() string {
-	for _, value := range []string{j.ID, j.URL, j.Text + j.HTML + j.Summary} {
+	for _, value := range []string{j.ID, j.GetURL(), j.Text + j.HTML + j.Summary} {
 		if value != "" {
 			return crypto.Hash(value)
 		}
@@ -122,13 +175,13 @@ func (j *jsonItem) GetHash() string {
 }
 
 func (j *jsonItem) GetTitle() string {
-	for _, value := range []string{j.Title, j.Summary, j.Text, j.URL} {
+	for _, value := range []string{j.Title, j.Summary, j.Text, j.GetURL()} {
 		if value != "" {
 			return truncate(value)
 		}
 	}
 
-	return j.URL
+	return j.GetURL()
 }
 
 func (j *jsonItem) GetContent() string {
@@ -161,22 +214,41 @@ func (j *jsonItem) GetEnclosures() model.EnclosureList {
 
 func (j *jsonItem) Transform() *model.Entry {
 	entry := new(model.Entry)
-	entry.URL = j.URL
+	entry.URL = j.GetURL()
 	entry.Date = j.GetDate()
 	entry.Author = j.GetAuthor()
 	entry.Hash = j.GetHash()
 	entry.Content = j.GetContent()
 	entry.Title = strings.TrimSpace(j.GetTitle())
+	entry.Summary = strings.TrimSpace(j.Summary)
+	entry.ImageURL = j.GetImage()
+	entry.Tags = j.GetTags()
 	entry.Enclosures = j.GetEnclosures()
 	return entry
 }
 
-func getAuthor(author jsonAuthor) string {
-	if author.Name != "" {
-		return strings.TrimSpace(author.Name)
+// getAuthor returns the names of the authors, JSON Feed 1.1 replaced the author object by a list.
+func getAuthor(authors []jsonAuthor, author jsonAuthor) string {
+	var names []string
+	for _, author := range authors {
+		if name := strings.TrimSpace(author.Name); name != "" {
+			names = append(names, name)
+		}
 	}
 
-	return ""
+	if len(names) > 0 {
+		return strings.Join(names, ", ")
+	}
+
+	return strings.TrimSpace(author.Name)
+}
+
+func absoluteURL(baseURL, input string) string {
+	if result, err := url.AbsoluteURL(baseURL, input); err == nil {
+		return result
+	}
+
+	return input
 }
 
 func truncate(str string) string {
@@ -272,6 +272,135 @@ func TestParseAuthor(t *testing.T) {
 	}
 }
 
+func TestParseFeedMetadataVersion10(t *testing.T) {
+	data := `{
+		"version": "https://jsonfeed.org/version/1",
+		"title": "Example",
+		"home_page_url": "https://example.org/",
+		"description": " Feed description ",
+		"icon": "/icon.png",
+		"author": {"name": "Feed Author"},
+		"items": [
+			{
+				"id": "1",
+				"summary": "Entry summary",
+				"content_html": "<p>Content</p>",
+				"image": "/images/1.png",
+				"url": "https://example.org/item"
+			}
+		]
+	}`
+
+	feed, err := Parse("https://example.org/feed.json", bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Description != "Feed description" {
+		t.Errorf("Incorrect description, got: %q", feed.Description)
+	}
+
+	if feed.ImageURL != "https://example.org/icon.png" {
+		t.Errorf("Incorrect image URL, got: %q", feed.ImageURL)
+	}
+
+	if feed.Entries[0].Author != "Feed Author" {
+		t.Errorf("Incorrect entry author, got: %q", feed.Entries[0].Author)
+	}
+
+	if feed.Entries[0].Summary != "Entry summary" {
+		t.Errorf("Incorrect entry summary, got: %q", feed.Entries[0].Summary)
+	}
+
+	if feed.Entries[0].Content != "<p>Content</p>" {
+		t.Errorf("Incorrect entry content, got: %q", feed.Entries[0].Content)
+	}
+
+	if feed.Entries[0].ImageURL != "https://example.org/images/1.png" {
+		t.Errorf("Incorrect entry image URL, got: %q", feed.Entries[0].ImageURL)
+	}
+}
+
+func TestParseFeedVersion11(t *testing.T) {
+	data := `{
+		"version": "https://jsonfeed.org/version/1.1",
+		"title": "Example",
+		"home_page_url": "https://example.org/",
+		"description": "Feed description",
+		"language": "en-CA",
+		"favicon": "https://example.org/favicon.ico",
+		"authors": [{"name": "Jane"}, {"name": "John"}],
+		"items": [
+			{
+				"id": "1",
+				"title": "Link post",
+				"external_url": "https://other.example.org/article",
+				"content_text": "Interesting article",
+				"banner_image": "https://example.org/banner.png",
+				"tags": ["golang", "rss"]
+			},
+			{
+				"id": "2",
+				"url": "https://example.org/2",
+				"external_url": "https://other.example.org/article",
+				"content_text": "Note",
+				"image": "https://example.org/image.png",
+				"banner_image": "https://example.org/banner.png",
+				"authors": [{"name": "Alice"}, {"name": ""}]
+			}
+		]
+	}`
+
+	feed, err := Parse("https://example.org/feed.json", bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Language != "en-CA" {
+		t.Errorf("Incorrect language, got: %q", feed.Language)
+	}
+
+	if feed.Description != "Feed description" {
+		t.Errorf("Incorrect description, got: %q", feed.Description)
+	}
+
+	if feed.ImageURL != "https://example.org/favicon.ico" {
+		t.Errorf("Incorrect image URL, got: %q", feed.ImageURL)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf("Incorrect number of entries, got: %d", len(feed.Entries))
+	}
+
+	if feed.Entries[0].URL != "https://other.example.org/article" {
+		t.Errorf("Incorrect entry URL, got: %q", feed.Entries[0].URL)
+	}
+
+	if feed.Entries[0].Author != "Jane, John" {
+		t.Errorf("Incorrect entry author, got: %q", feed.Entries[0].Author)
+	}
+
+	if feed.Entries[0].ImageURL != "https://example.org/banner.png" {
+		t.Errorf("Incorrect entry image URL, got: %q", feed.Entries[0].ImageURL)
+	}
+
+	if len(feed.Entries[0].Tags) != 2 || feed.Entries[0].Tags[0] != "golang" || feed.Entries[0].Tags[1] != "rss" {
+		t.Errorf("Incorrect entry tags, got: %q", feed.Entries[0].Tags)
+	}
+
+	if feed.Entries[1].URL != "https://example.org/2" {
+		t.Errorf("Incorrect entry URL, got: %q", feed.Entries[1].URL)
+	}
+
+	if feed.Entries[1].Author != "Alice" {
+		t.Errorf("Incorrect entry author, got: %q", feed.Entries[1].Author)
+	}
+
+	if feed.Entries[1].ImageURL != "https://example.org/image.png" {
+		t.Errorf("Incorrect entry image URL, got: %q", feed.Entries[1].ImageURL)
+	}
+}
+
 func TestParseFeedWithoutTitle(t *testing.T) {
 	data := `{
 		"version": "https://jsonfeed.org/version/1",
@@ -0,0 +1,209 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package microformats // import "miniflux.app/reader/microformats"
+
+import (
+	"bytes"
+	"strings"
+
+	"miniflux.app/url"
+
+	"golang.org/x/net/html"
+)
+
+func hasClass(node *html.Node, class string) bool {
+	for _, attribute := range node.Attr {
+		if attribute.Key == "class" {
+			for _, value := range strings.Fields(attribute.Val) {
+				if value == class {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// isMicroformat returns true if the element is the root of a microformat, like h-entry or h-card.
+func isMicroformat(node *html.Node) bool {
+	for _, attribute := range node.Attr {
+		if attribute.Key == "class" {
+			for _, value := range strings.Fields(attribute.Val) {
+				if strings.HasPrefix(value, "h-") {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func attribute(node *html.Node, key string) (string, bool) {
+	for _, attribute := range node.Attr {
+		if attribute.Key == key {
+			return attribute.Val, true
+		}
+	}
+
+	return "", false
+}
+
+// findRoots returns the microformats of the given type below the node, without looking inside them.
+func findRoots(root *html.Node, class string) []*html.Node {
+	var nodes []*html.Node
+	var walk func(node *html.Node)
+	walk = func(node *html.Node) {
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			if child.Type != html.ElementNode {
+				continue
+			}
+
+			if hasClass(child, class) {
+				nodes = append(nodes, child)
+			} else {
+				walk(child)
+			}
+		}
+	}
+
+	walk(root)
+	return nodes
+}
+
+// findProperties returns the elements holding the property of the microformat, in document order.
+// Nested microformats are skipped, unless they are the value of the property like "p-author h-card".
+func findProperties(root *html.Node, class string) []*html.Node {
+	var nodes []*html.Node
+	var walk func(node *html.Node)
+	walk = func(node *html.Node) {
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			if child.Type != html.ElementNode {
+				continue
+			}
+
+			if hasClass(child, class) {
+				nodes = append(nodes, child)
+			}
+
+			if !isMicroformat(child) {
+				walk(child)
+			}
+		}
+	}
+
+	walk(root)
+	return nodes
+}
+
+func findProperty(root *html.Node, class string) *html.Node {
+	if nodes := findProperties(root, class); len(nodes) > 0 {
+		return nodes[0]
+	}
+
+	return nil
+}
+
+// textValue returns the value of a "p-*" property.
+func textValue(node *html.Node) string {
+	switch node.Data {
+	case "img", "area":
+		if value, found := attribute(node, "alt"); found {
+			return strings.TrimSpace(value)
+		}
+	case "abbr", "link":
+		if value, found := attribute(node, "title"); found {
+			return strings.TrimSpace(value)
+		}
+	case "data", "input":
+		if value, found := attribute(node, "value"); found {
+			return strings.TrimSpace(value)
+		}
+	}
+
+	return strings.Join(strings.Fields(textContent(node)), " ")
+}
+
+// urlValue returns the absolute value of a "u-*" property.
+func urlValue(baseURL string, node *html.Node) string {
+	var value string
+	switch node.Data {
+	case "a", "area", "link":
+		value, _ = attribute(node, "href")
+	case "img", "audio", "video", "source", "iframe":
+		value, _ = attribute(node, "src")
+	case "object":
+		value, _ = attribute(node, "data")
+	default:
+		value = textValue(node)
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ""
+	}
+
+	absoluteURL, err := url.AbsoluteURL(baseURL, value)
+	if err != nil {
+		return ""
+	}
+
+	return absoluteURL
+}
+
+// dateValue returns the value of a "dt-*" property.
+func dateValue(node *html.Node) string {
+	switch node.Data {
+	case "time", "ins", "del":
+		if value, found := attribute(node, "datetime"); found {
+			return strings.TrimSpace(value)
+		}
+	case "abbr":
+		if value, found := attribute(node, "title"); found {
+			return strings.TrimSpace(value)
+		}
+	case "data", "input":
+		if value, found := attribute(node, "value"); found {
+			return strings.TrimSpace(value)
+		}
+	}
+
+	return strings.TrimSpace(textContent(node))
+}
+
+// htmlValue returns the value of a "e-*" property.
+func htmlValue(node *html.Node) string {
+	var buffer bytes.Buffer
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		html.Render(&buffer, child)
+	}
+
+	return strings.TrimSpace(buffer.String())
+}
+
+func textContent(node *html.Node) string {
+	var buffer strings.Builder
+	var walk func(node *html.Node)
+	walk = func(node *html.Node) {
+		switch {
+		case node.Type == html.TextNode:
+			buffer.WriteString(node.Data)
+		case node.Type == html.ElementNode && (node.Data == "script" || node.Data == "style"):
+			return
+		case node.Type == html.ElementNode && node.Data == "img":
+			if value, found := attribute(node, "alt"); found {
+				buffer.WriteString(value)
+			}
+		}
+
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+
+	walk(node)
+	return buffer.String()
+}
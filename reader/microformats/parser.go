@@ -0,0 +1,217 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package microformats // import "miniflux.app/reader/microformats"
+
+import (
+	"html"
+	"io"
+	"strings"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/errors"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/date"
+	"miniflux.app/url"
+
+	"github.com/PuerkitoBio/goquery"
+	nethtml "golang.org/x/net/html"
+)
+
+// maxTitleLength is the maximum length of the titles generated from the content of an entry.
+const maxTitleLength = 100
+
+// HasEntries returns true if the HTML document contains h-entry markup.
+func HasEntries(data io.Reader) bool {
+	return hasRoot(data, "h-entry")
+}
+
+// HasFeed returns true if the HTML document contains h-feed markup.
+func HasFeed(data io.Reader) bool {
+	return hasRoot(data, "h-feed")
+}
+
+func hasRoot(data io.Reader, class string) bool {
+	document, err := goquery.NewDocumentFromReader(data)
+	if err != nil {
+		return false
+	}
+
+	return len(findRoots(document.Get(0), class)) > 0
+}
+
+// Parse returns a normalized feed struct from a HTML document containing h-feed or h-entry markup.
+//
+// The entries are read from the first h-feed of the document,
+// the document itself is the feed when the h-entry elements are not wrapped in a h-feed.
+func Parse(baseURL string, data io.Reader) (*model.Feed, *errors.LocalizedError) {
+	document, err := goquery.NewDocumentFromReader(data)
+	if err != nil {
+		return nil, errors.NewLocalizedError("Unable to parse microformats: %q", err)
+	}
+
+	// Relative links are resolved against the base element when the page defines one.
+	if href, found := document.Find("head base[href]").First().Attr("href"); found {
+		if absoluteURL, err := url.AbsoluteURL(baseURL, href); err == nil {
+			baseURL = absoluteURL
+		}
+	}
+
+	root := document.Get(0)
+	feed := &model.Feed{FeedURL: baseURL, SiteURL: baseURL}
+
+	var feedAuthor string
+	if feeds := findRoots(root, "h-feed"); len(feeds) > 0 {
+		root = feeds[0]
+		if name := findProperty(root, "p-name"); name != nil {
+			feed.Title = textValue(name)
+		}
+		feedAuthor = authorValue(root)
+	}
+
+	if feed.Title == "" {
+		feed.Title = strings.TrimSpace(document.Find("head title").First().Text())
+	}
+
+	if feed.Title == "" {
+		feed.Title = baseURL
+	}
+
+	items := findRoots(root, "h-entry")
+	if len(items) == 0 {
+		return nil, errors.NewLocalizedError("Unable to parse microformats: %q", "no h-entry found")
+	}
+
+	for _, item := range items {
+		entry := parseEntry(baseURL, item)
+		if entry.Author == "" {
+			entry.Author = feedAuthor
+		}
+
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	return feed, nil
+}
+
+func parseEntry(baseURL string, item *nethtml.Node) *model.Entry {
+	entry := &model.Entry{
+		Date:       entryDate(item),
+		Author:     authorValue(item),
+		Content:    entryContent(item),
+		Enclosures: make(model.EnclosureList, 0),
+	}
+
+	if property := findProperty(item, "u-url"); property != nil {
+		entry.URL = urlValue(baseURL, property)
+	} else if item.Data == "a" {
+		entry.URL = urlValue(baseURL, item)
+	}
+
+	if property := findProperty(item, "p-name"); property != nil {
+		entry.Title = textValue(property)
+	}
+
+	// Notes don't have a name, the beginning of their content is used instead.
+	if entry.Title == "" {
+		if property := findProperty(item, "e-content"); property != nil {
+			entry.Title = truncate(textValue(property))
+		} else if property := findProperty(item, "p-summary"); property != nil {
+			entry.Title = truncate(textValue(property))
+		}
+	}
+
+	for _, property := range findProperties(item, "u-photo") {
+		if photoURL := urlValue(baseURL, property); photoURL != "" {
+			entry.Enclosures = append(entry.Enclosures, &model.Enclosure{URL: photoURL, MimeType: "image/*"})
+		}
+	}
+
+	var uid string
+	if property := findProperty(item, "u-uid"); property != nil {
+		uid = urlValue(baseURL, property)
+	}
+
+	switch {
+	case uid != "":
+		entry.Hash = crypto.Hash(uid)
+	case entry.URL != "":
+		entry.Hash = crypto.Hash(entry.URL)
+	default:
+		entry.Hash = crypto.Hash(entry.Title + entry.Content)
+	}
+
+	if entry.URL == "" {
+		entry.URL = baseURL
+	}
+
+	if entry.Title == "" {
+		entry.Title = entry.URL
+	}
+
+	return entry
+}
+
+func entryContent(item *nethtml.Node) string {
+	if property := findProperty(item, "e-content"); property != nil {
+		return htmlValue(property)
+	}
+
+	if property := findProperty(item, "p-summary"); property != nil {
+		return html.EscapeString(textValue(property))
+	}
+
+	return ""
+}
+
+func entryDate(item *nethtml.Node) time.Time {
+	for _, class := range []string{"dt-published", "dt-updated"} {
+		property := findProperty(item, class)
+		if property == nil {
+			continue
+		}
+
+		value := dateValue(property)
+		if value == "" {
+			continue
+		}
+
+		result, err := date.Parse(value)
+		if err != nil {
+			logger.Debug("[Microformats] %v", err)
+			continue
+		}
+
+		return result
+	}
+
+	return time.Now()
+}
+
+// authorValue returns the name of the author, which can be a h-card or a simple text.
+func authorValue(item *nethtml.Node) string {
+	property := findProperty(item, "p-author")
+	if property == nil {
+		return ""
+	}
+
+	if hasClass(property, "h-card") {
+		if name := findProperty(property, "p-name"); name != nil {
+			return textValue(name)
+		}
+	}
+
+	return textValue(property)
+}
+
+func truncate(str string) string {
+	runes := []rune(str)
+	if len(runes) > maxTitleLength {
+		return string(runes[:maxTitleLength]) + "..."
+	}
+
+	return str
+}
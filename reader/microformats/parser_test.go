@@ -0,0 +1,190 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package microformats // import "miniflux.app/reader/microformats"
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseFeed(t *testing.T) {
+	data := `<!DOCTYPE html>
+	<html>
+		<head><title>Page Title</title></head>
+		<body>
+			<div class="h-feed">
+				<h1 class="p-name">My Notes</h1>
+				<a class="p-author h-card" href="/">Feed Author</a>
+				<article class="h-entry">
+					<h2 class="p-name">First post</h2>
+					<a class="u-url" href="/posts/1">
+						<time class="dt-published" datetime="2021-03-01T10:00:00Z">March 1</time>
+					</a>
+					<div class="p-author h-card"><img class="u-photo" src="/me.jpg" alt=""><span class="p-name">Jane Doe</span></div>
+					<div class="e-content"><p>Hello, <b>world</b>!</p></div>
+					<img class="u-photo" src="/photos/1.jpg" alt="Sunset">
+				</article>
+				<article class="h-entry">
+					<div class="e-content">A short note without name.</div>
+					<a class="u-url" href="https://example.org/notes/2">link</a>
+				</article>
+			</div>
+		</body>
+	</html>`
+
+	feed, err := Parse("https://example.org/", strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != "My Notes" {
+		t.Errorf(`Incorrect title, got: %q`, feed.Title)
+	}
+
+	if feed.FeedURL != "https://example.org/" {
+		t.Errorf(`Incorrect feed URL, got: %q`, feed.FeedURL)
+	}
+
+	if feed.SiteURL != "https://example.org/" {
+		t.Errorf(`Incorrect site URL, got: %q`, feed.SiteURL)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf(`Incorrect number of entries, got: %d`, len(feed.Entries))
+	}
+
+	entry := feed.Entries[0]
+	if entry.Title != "First post" {
+		t.Errorf(`Incorrect entry title, got: %q`, entry.Title)
+	}
+
+	if entry.URL != "https://example.org/posts/1" {
+		t.Errorf(`Incorrect entry URL, got: %q`, entry.URL)
+	}
+
+	if entry.Author != "Jane Doe" {
+		t.Errorf(`Incorrect entry author, got: %q`, entry.Author)
+	}
+
+	if entry.Content != `<p>Hello, <b>world</b>!</p>` {
+		t.Errorf(`Incorrect entry content, got: %q`, entry.Content)
+	}
+
+	expectedDate := time.Date(2021, time.March, 1, 10, 0, 0, 0, time.UTC)
+	if !entry.Date.Equal(expectedDate) {
+		t.Errorf(`Incorrect entry date, got: %v`, entry.Date)
+	}
+
+	// The photo of the author h-card is not a photo of the entry.
+	if len(entry.Enclosures) != 1 {
+		t.Fatalf(`Incorrect number of enclosures, got: %d`, len(entry.Enclosures))
+	}
+
+	if entry.Enclosures[0].URL != "https://example.org/photos/1.jpg" || entry.Enclosures[0].MimeType != "image/*" {
+		t.Errorf(`Incorrect enclosure, got: %v`, entry.Enclosures[0])
+	}
+
+	entry = feed.Entries[1]
+	if entry.Title != "A short note without name." {
+		t.Errorf(`Incorrect entry title, got: %q`, entry.Title)
+	}
+
+	if entry.URL != "https://example.org/notes/2" {
+		t.Errorf(`Incorrect entry URL, got: %q`, entry.URL)
+	}
+
+	if entry.Author != "Feed Author" {
+		t.Errorf(`Incorrect entry author, got: %q`, entry.Author)
+	}
+}
+
+func TestParseImpliedFeed(t *testing.T) {
+	data := `<!DOCTYPE html>
+	<html>
+		<head>
+			<title>My Blog</title>
+			<base href="https://example.org/blog/">
+		</head>
+		<body>
+			<div class="h-entry">
+				<a class="p-name u-url" href="post-1">Post</a>
+				<p class="p-summary">Some &lt;summary&gt; text</p>
+				<span class="p-author">John</span>
+				<div class="h-cite"><a class="u-url p-name" href="https://other.example.org/">Quoted</a></div>
+			</div>
+		</body>
+	</html>`
+
+	feed, err := Parse("https://example.org/", strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != "My Blog" {
+		t.Errorf(`Incorrect title, got: %q`, feed.Title)
+	}
+
+	if len(feed.Entries) != 1 {
+		t.Fatalf(`Incorrect number of entries, got: %d`, len(feed.Entries))
+	}
+
+	entry := feed.Entries[0]
+	if entry.URL != "https://example.org/blog/post-1" {
+		t.Errorf(`Incorrect entry URL, got: %q`, entry.URL)
+	}
+
+	if entry.Title != "Post" {
+		t.Errorf(`Incorrect entry title, got: %q`, entry.Title)
+	}
+
+	if entry.Content != "Some &lt;summary&gt; text" {
+		t.Errorf(`Incorrect entry content, got: %q`, entry.Content)
+	}
+
+	if entry.Author != "John" {
+		t.Errorf(`Incorrect entry author, got: %q`, entry.Author)
+	}
+
+	if entry.Hash == "" {
+		t.Error(`The entry hash must be set`)
+	}
+}
+
+func TestParseDocumentWithoutEntries(t *testing.T) {
+	data := `<!DOCTYPE html><html><head><title>Title</title></head><body><div class="h-card">Me</div></body></html>`
+
+	if _, err := Parse("https://example.org/", strings.NewReader(data)); err == nil {
+		t.Error(`Parse must returns an error`)
+	}
+}
+
+func TestHasEntries(t *testing.T) {
+	scenarios := map[string]bool{
+		`<div class="h-entry"></div>`:                           true,
+		`<div class="h-feed"><div class="h-entry"></div></div>`: true,
+		`<div class="h-entry-like"></div>`:                      false,
+		`<div class="h-card"></div>`:                            false,
+	}
+
+	for data, expected := range scenarios {
+		if result := HasEntries(strings.NewReader(data)); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, data, result, expected)
+		}
+	}
+}
+
+func TestHasFeed(t *testing.T) {
+	scenarios := map[string]bool{
+		`<div class="h-feed"><div class="h-entry"></div></div>`: true,
+		`<div class="h-entry"></div>`:                           false,
+	}
+
+	for data, expected := range scenarios {
+		if result := HasFeed(strings.NewReader(data)); result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, data, result, expected)
+		}
+	}
+}
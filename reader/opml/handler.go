@@ -11,12 +11,27 @@ import (
 
 	"miniflux.app/logger"
 	"miniflux.app/model"
+	"miniflux.app/reader/handler"
 	"miniflux.app/storage"
 )
 
 // Handler handles the logic for OPML import/export.
 type Handler struct {
-	store *storage.Storage
+	store         *storage.Storage
+	applySettings bool
+	validate      bool
+}
+
+// WithFeedSettings applies the Miniflux feed settings of the OPML file to the imported feeds.
+func (h *Handler) WithFeedSettings() *Handler {
+	h.applySettings = true
+	return h
+}
+
+// WithValidation fetches and parses each feed before creating it, the unreachable and invalid feeds are not created.
+func (h *Handler) WithValidation() *Handler {
+	h.validate = true
+	return h
 }
 
 // Export exports user feeds to OPML.
@@ -28,64 +43,200 @@ func (h *Handler) Export(userID int64) (string, error) {
 
 	var subscriptions SubcriptionList
 	for _, feed := range feeds {
+		// The address of a newsletter cannot be fetched by another feed reader.
+		if feed.IsNewsletter() {
+			continue
+		}
+
 		subscriptions = append(subscriptions, &Subcription{
-			Title:        feed.Title,
-			FeedURL:      feed.FeedURL,
-			SiteURL:      feed.SiteURL,
-			CategoryName: feed.Category.Title,
+			Title:         feed.Title,
+			FeedURL:       feed.FeedURL,
+			SiteURL:       feed.SiteURL,
+			CategoryName:  feed.Category.Title,
+			Settings:      NewFeedSettings(feed),
+			Kind:          feed.Kind,
+			Selectors:     feed.Selectors,
+			WatchSelector: feed.WatchSelector,
 		})
 	}
 
 	return Serialize(subscriptions), nil
 }
 
-// Import parses and create feeds from an OPML import.
-func (h *Handler) Import(userID int64, data io.Reader) error {
-	subscriptions, err := Parse(data)
-	if err != nil {
-		return err
+// Import parses the OPML file and creates the feeds in the background.
+// The returned job is updated with the result of each subscription.
+func (h *Handler) Import(userID int64, data io.Reader) (*model.ImportJob, error) {
+	subscriptions, parseErr := Parse(data)
+	if parseErr != nil {
+		return nil, parseErr
 	}
 
+	job := &model.ImportJob{
+		UserID:   userID,
+		Status:   model.ImportJobStatusRunning,
+		Validate: h.validate,
+		Total:    len(subscriptions),
+	}
+
+	if err := h.store.CreateImportJob(job); err != nil {
+		return nil, err
+	}
+
+	go h.run(job, subscriptions)
+	return job, nil
+}
+
+// run imports the subscriptions, the job is always finished, even when the import panics.
+// The jobs interrupted by a restart are marked as failed when the daemon starts.
+func (h *Handler) run(job *model.ImportJob, subscriptions SubcriptionList) {
+	logger.Debug("[OPML:Import] Starting job #%d for user #%d (%d subscriptions)", job.ID, job.UserID, len(subscriptions))
+
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("[OPML:Import] Job #%d: %v", job.ID, r)
+			job.Status = model.ImportJobStatusFailed
+			job.ErrorMsg = fmt.Sprintf("%v", r)
+		}
+
+		if err := h.store.FinishImportJob(job); err != nil {
+			logger.Error("[OPML:Import] %v", err)
+		}
+
+		logger.Debug("[OPML:Import] Job #%d is %s", job.ID, job.Status)
+	}()
+
+	categories := make(map[string]*model.Category)
+	job.Status = model.ImportJobStatusFinished
+
 	for _, subscription := range subscriptions {
-		if !h.store.FeedURLExists(userID, subscription.FeedURL) {
-			var category *model.Category
-			var err error
-
-			if subscription.CategoryName == "" {
-				category, err = h.store.FirstCategory(userID)
-				if err != nil {
-					logger.Error("[OPML:Import] %v", err)
-					return errors.New("unable to find first category")
-				}
-			} else {
-				category, err = h.store.CategoryByTitle(userID, subscription.CategoryName)
-				if err != nil {
-					logger.Error("[OPML:Import] %v", err)
-					return errors.New("unable to search category by title")
-				}
-
-				if category == nil {
-					category, err = h.store.CreateCategory(userID, &model.CategoryRequest{Title: subscription.CategoryName})
-					if err != nil {
-						logger.Error("[OPML:Import] %v", err)
-						return fmt.Errorf(`unable to create this category: %q`, subscription.CategoryName)
-					}
-				}
-			}
-
-			feed := &model.Feed{
-				UserID:   userID,
-				Title:    subscription.Title,
-				FeedURL:  subscription.FeedURL,
-				SiteURL:  subscription.SiteURL,
-				Category: category,
-			}
-
-			h.store.CreateFeed(feed)
+		result := h.importSubscription(job.UserID, categories, subscription)
+		result.JobID = job.ID
+
+		if err := h.store.CreateImportJobResult(result); err != nil {
+			logger.Error("[OPML:Import] %v", err)
+			job.Status = model.ImportJobStatusFailed
+			job.ErrorMsg = err.Error()
+			break
+		}
+	}
+}
+
+// importSubscription creates the feed of the subscription, the errors are reported in the result.
+func (h *Handler) importSubscription(userID int64, categories map[string]*model.Category, subscription *Subcription) *model.ImportJobResult {
+	result := &model.ImportJobResult{
+		Title:        subscription.Title,
+		FeedURL:      subscription.FeedURL,
+		CategoryName: subscription.CategoryName,
+	}
+
+	if h.store.FeedURLExists(userID, subscription.FeedURL) {
+		result.Status = model.ImportResultDuplicate
+		return result
+	}
+
+	if err := subscription.ValidateKind(); err != nil {
+		result.Status = model.ImportResultError
+		result.ErrorMsg = err.Error()
+		return result
+	}
+
+	category, err := h.category(userID, categories, subscription.CategoryName)
+	if err != nil {
+		logger.Error("[OPML:Import] %v", err)
+		result.Status = model.ImportResultError
+		result.ErrorMsg = err.Error()
+		return result
+	}
+
+	// The scraped feeds and the watched pages are not feeds, they are fetched later by the scheduler.
+	if h.validate && !subscription.HasKind() {
+		feedCreationRequest := &model.FeedCreationRequest{
+			FeedURL:    subscription.FeedURL,
+			CategoryID: category.ID,
+		}
+
+		if h.applySettings {
+			settings := subscription.Settings
+			feedCreationRequest.ScraperRules = settings.ScraperRules
+			feedCreationRequest.RewriteRules = settings.RewriteRules
+			feedCreationRequest.BlocklistRules = settings.BlocklistRules
+			feedCreationRequest.KeeplistRules = settings.KeeplistRules
+			feedCreationRequest.UserAgent = settings.UserAgent
+			feedCreationRequest.Crawler = settings.Crawler
+			feedCreationRequest.FetchViaProxy = settings.FetchViaProxy
+			feedCreationRequest.IgnoreHTTPCache = settings.IgnoreHTTPCache
+			feedCreationRequest.Disabled = settings.Disabled
+		}
+
+		feed, status, err := handler.ImportFeed(h.store, userID, feedCreationRequest)
+		result.Status = status
+		if err != nil {
+			result.ErrorMsg = err.Error()
+		} else {
+			result.FeedID = feed.ID
+		}
+
+		return result
+	}
+
+	// Without validation, the feed is fetched later by the scheduler.
+	feed := &model.Feed{
+		UserID:   userID,
+		Title:    subscription.Title,
+		FeedURL:  subscription.FeedURL,
+		SiteURL:  subscription.SiteURL,
+		Category: category,
+	}
+
+	if subscription.HasKind() {
+		feed.Kind = subscription.Kind
+		feed.Selectors = subscription.Selectors
+		feed.WatchSelector = subscription.WatchSelector
+	}
+
+	if h.applySettings {
+		subscription.Settings.Apply(feed)
+	}
+
+	if err := h.store.CreateFeed(feed); err != nil {
+		logger.Error("[OPML:Import] %v", err)
+		result.Status = model.ImportResultError
+		result.ErrorMsg = err.Error()
+		return result
+	}
+
+	result.Status = model.ImportResultCreated
+	result.FeedID = feed.ID
+	return result
+}
+
+func (h *Handler) category(userID int64, categories map[string]*model.Category, title string) (*model.Category, error) {
+	if category, found := categories[title]; found {
+		return category, nil
+	}
+
+	var category *model.Category
+	var err error
+
+	if title == "" {
+		category, err = h.store.FirstCategory(userID)
+	} else {
+		category, err = h.store.CategoryByTitle(userID, title)
+		if err == nil && category == nil {
+			category, err = h.store.CreateCategory(userID, &model.CategoryRequest{Title: title})
 		}
 	}
 
-	return nil
+	if err != nil {
+		return nil, err
+	}
+
+	if category == nil {
+		return nil, errors.New("unable to find first category")
+	}
+
+	categories[title] = category
+	return category, nil
 }
 
 // NewHandler creates a new handler for OPML files.
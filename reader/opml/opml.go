@@ -5,21 +5,34 @@
 package opml // import "miniflux.app/reader/opml"
 
 import (
+	"encoding/json"
 	"encoding/xml"
+	"strconv"
+
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+// The Miniflux feed settings are stored in namespaced attributes, other applications ignore them.
+const (
+	minifluxNamespace = "https://miniflux.app/opml"
+	minifluxPrefix    = "miniflux"
 )
 
 type opml struct {
-	XMLName  xml.Name  `xml:"opml"`
-	Version  string    `xml:"version,attr"`
-	Outlines []outline `xml:"body>outline"`
+	XMLName   xml.Name  `xml:"opml"`
+	Version   string    `xml:"version,attr"`
+	Namespace string    `xml:"xmlns:miniflux,attr,omitempty"`
+	Outlines  []outline `xml:"body>outline"`
 }
 
 type outline struct {
-	Title    string    `xml:"title,attr,omitempty"`
-	Text     string    `xml:"text,attr"`
-	FeedURL  string    `xml:"xmlUrl,attr,omitempty"`
-	SiteURL  string    `xml:"htmlUrl,attr,omitempty"`
-	Outlines []outline `xml:"outline,omitempty"`
+	Title      string     `xml:"title,attr,omitempty"`
+	Text       string     `xml:"text,attr"`
+	FeedURL    string     `xml:"xmlUrl,attr,omitempty"`
+	SiteURL    string     `xml:"htmlUrl,attr,omitempty"`
+	Attributes []xml.Attr `xml:",any,attr"`
+	Outlines   []outline  `xml:"outline,omitempty"`
 }
 
 func (o *outline) GetTitle() string {
@@ -50,31 +63,152 @@ func (o *outline) GetSiteURL() string {
 	return o.FeedURL
 }
 
+// GetSettings returns the Miniflux feed settings stored in the namespaced attributes.
+func (o *outline) GetSettings() FeedSettings {
+	var settings FeedSettings
+	for _, attribute := range o.Attributes {
+		// The prefix is kept as namespace when the document does not declare it.
+		if attribute.Name.Space != minifluxNamespace && attribute.Name.Space != minifluxPrefix {
+			continue
+		}
+
+		switch attribute.Name.Local {
+		case "scraperRules":
+			settings.ScraperRules = attribute.Value
+		case "rewriteRules":
+			settings.RewriteRules = attribute.Value
+		case "blocklistRules":
+			settings.BlocklistRules = attribute.Value
+		case "keeplistRules":
+			settings.KeeplistRules = attribute.Value
+		case "userAgent":
+			settings.UserAgent = attribute.Value
+		case "crawler":
+			settings.Crawler = parseBool(attribute.Value)
+		case "fetchViaProxy":
+			settings.FetchViaProxy = parseBool(attribute.Value)
+		case "ignoreHTTPCache":
+			settings.IgnoreHTTPCache = parseBool(attribute.Value)
+		case "disabled":
+			settings.Disabled = parseBool(attribute.Value)
+		}
+	}
+
+	return settings
+}
+
+// GetKind returns the kind of feed and its selectors stored in the namespaced attributes.
+func (o *outline) GetKind() (kind string, selectors *model.FeedSelectors, watchSelector string) {
+	for _, attribute := range o.Attributes {
+		if attribute.Name.Space != minifluxNamespace && attribute.Name.Space != minifluxPrefix {
+			continue
+		}
+
+		switch attribute.Name.Local {
+		case "kind":
+			kind = attribute.Value
+		case "selectors":
+			selectors = new(model.FeedSelectors)
+			if err := json.Unmarshal([]byte(attribute.Value), selectors); err != nil {
+				logger.Debug("[OPML:Parse] Invalid selectors %q: %v", attribute.Value, err)
+				selectors = nil
+			}
+		case "watchSelector":
+			watchSelector = attribute.Value
+		}
+	}
+
+	return kind, selectors, watchSelector
+}
+
+// SetKind stores the kind of feed and its selectors in namespaced attributes, the regular feeds are left unchanged.
+func (o *outline) SetKind(subscription *Subcription) {
+	if subscription.Kind == "" || subscription.Kind == model.FeedKindDefault {
+		return
+	}
+
+	o.Attributes = append(o.Attributes, xml.Attr{Name: xml.Name{Local: minifluxPrefix + ":kind"}, Value: subscription.Kind})
+
+	if subscription.Selectors != nil {
+		if data, err := json.Marshal(subscription.Selectors); err == nil {
+			o.Attributes = append(o.Attributes, xml.Attr{Name: xml.Name{Local: minifluxPrefix + ":selectors"}, Value: string(data)})
+		}
+	}
+
+	if subscription.WatchSelector != "" {
+		o.Attributes = append(o.Attributes, xml.Attr{Name: xml.Name{Local: minifluxPrefix + ":watchSelector"}, Value: subscription.WatchSelector})
+	}
+}
+
+// SetSettings stores the Miniflux feed settings in namespaced attributes, empty values are omitted.
+func (o *outline) SetSettings(settings FeedSettings) {
+	values := []struct {
+		name  string
+		value string
+	}{
+		{"scraperRules", settings.ScraperRules},
+		{"rewriteRules", settings.RewriteRules},
+		{"blocklistRules", settings.BlocklistRules},
+		{"keeplistRules", settings.KeeplistRules},
+		{"userAgent", settings.UserAgent},
+		{"crawler", formatBool(settings.Crawler)},
+		{"fetchViaProxy", formatBool(settings.FetchViaProxy)},
+		{"ignoreHTTPCache", formatBool(settings.IgnoreHTTPCache)},
+		{"disabled", formatBool(settings.Disabled)},
+	}
+
+	for _, v := range values {
+		if v.value != "" {
+			o.Attributes = append(o.Attributes, xml.Attr{Name: xml.Name{Local: minifluxPrefix + ":" + v.name}, Value: v.value})
+		}
+	}
+}
+
+// Append adds the subscriptions of the outline, outlines without feed URL are categories and can be nested.
 func (o *outline) Append(subscriptions SubcriptionList, category string) SubcriptionList {
 	if o.FeedURL != "" {
-		subscriptions = append(subscriptions, &Subcription{
-			Title:        o.GetTitle(),
-			FeedURL:      o.FeedURL,
-			SiteURL:      o.GetSiteURL(),
-			CategoryName: category,
+		kind, selectors, watchSelector := o.GetKind()
+		return append(subscriptions, &Subcription{
+			Title:         o.GetTitle(),
+			FeedURL:       o.FeedURL,
+			SiteURL:       o.GetSiteURL(),
+			CategoryName:  category,
+			Settings:      o.GetSettings(),
+			Kind:          kind,
+			Selectors:     selectors,
+			WatchSelector: watchSelector,
 		})
 	}
 
+	// outline.Text is only available in OPML v2, the closest category is used for nested categories.
+	if o.Text != "" {
+		category = o.Text
+	}
+
+	for _, element := range o.Outlines {
+		subscriptions = element.Append(subscriptions, category)
+	}
+
 	return subscriptions
 }
 
 func (o *opml) Transform() SubcriptionList {
 	var subscriptions SubcriptionList
 	for _, outline := range o.Outlines {
-		if len(outline.Outlines) > 0 {
-			for _, element := range outline.Outlines {
-				// outline.Text is only available in OPML v2.
-				subscriptions = element.Append(subscriptions, outline.Text)
-			}
-		} else {
-			subscriptions = outline.Append(subscriptions, "")
-		}
+		subscriptions = outline.Append(subscriptions, "")
 	}
 
 	return subscriptions
 }
+
+func parseBool(value string) bool {
+	result, _ := strconv.ParseBool(value)
+	return result
+}
+
+func formatBool(value bool) string {
+	if value {
+		return "true"
+	}
+	return ""
+}
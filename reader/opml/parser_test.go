@@ -234,3 +234,84 @@ func TestParseInvalidXML(t *testing.T) {
 		t.Error("Parse should generate an error")
 	}
 }
+
+func TestParseOpmlWithNestedCategories(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+	<opml version="2.0">
+		<body>
+			<outline text="Technology">
+				<outline text="Feed 1" xmlUrl="http://example.org/feed1/" htmlUrl="http://example.org/1"></outline>
+				<outline text="Programming">
+					<outline text="Feed 2" xmlUrl="http://example.org/feed2/" htmlUrl="http://example.org/2"></outline>
+					<outline title="Untitled folder">
+						<outline text="Feed 3" xmlUrl="http://example.org/feed3/" htmlUrl="http://example.org/3"></outline>
+					</outline>
+				</outline>
+			</outline>
+			<outline text="Feed 4" xmlUrl="http://example.org/feed4/" htmlUrl="http://example.org/4"></outline>
+		</body>
+	</opml>
+	`
+
+	var expected SubcriptionList
+	expected = append(expected, &Subcription{Title: "Feed 1", FeedURL: "http://example.org/feed1/", SiteURL: "http://example.org/1", CategoryName: "Technology"})
+	expected = append(expected, &Subcription{Title: "Feed 2", FeedURL: "http://example.org/feed2/", SiteURL: "http://example.org/2", CategoryName: "Programming"})
+	expected = append(expected, &Subcription{Title: "Feed 3", FeedURL: "http://example.org/feed3/", SiteURL: "http://example.org/3", CategoryName: "Programming"})
+	expected = append(expected, &Subcription{Title: "Feed 4", FeedURL: "http://example.org/feed4/", SiteURL: "http://example.org/4", CategoryName: ""})
+
+	subscriptions, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(subscriptions) != len(expected) {
+		t.Fatalf("Wrong number of subscriptions: %d instead of %d", len(subscriptions), len(expected))
+	}
+
+	for i := 0; i < len(subscriptions); i++ {
+		if !subscriptions[i].Equals(expected[i]) {
+			t.Errorf(`Subscription are different: "%v" vs "%v"`, subscriptions[i], expected[i])
+		}
+	}
+}
+
+func TestParseOpmlWithFeedSettings(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+	<opml version="2.0" xmlns:miniflux="https://miniflux.app/opml">
+		<body>
+			<outline text="Category">
+				<outline text="Feed 1" xmlUrl="http://example.org/feed1/" miniflux:scraperRules="article" miniflux:crawler="true" miniflux:disabled="1" miniflux:fetchViaProxy="false"></outline>
+			</outline>
+		</body>
+	</opml>
+	`
+
+	subscriptions, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := FeedSettings{ScraperRules: "article", Crawler: true, Disabled: true}
+	if subscriptions[0].Settings != expected {
+		t.Errorf(`Unexpected settings: %+v instead of %+v`, subscriptions[0].Settings, expected)
+	}
+}
+
+func TestParseOpmlWithUndeclaredNamespace(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+	<opml version="2.0">
+		<body>
+			<outline text="Feed 1" xmlUrl="http://example.org/feed1/" miniflux:userAgent="Agent" other:userAgent="Other"></outline>
+		</body>
+	</opml>
+	`
+
+	subscriptions, err := Parse(bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if subscriptions[0].Settings.UserAgent != "Agent" {
+		t.Errorf(`Unexpected user agent: %q`, subscriptions[0].Settings.UserAgent)
+	}
+}
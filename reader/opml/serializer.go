@@ -43,6 +43,7 @@ func groupSubscriptionsByFeed(subscriptions SubcriptionList) map[string]Subcript
 func normalizeFeeds(subscriptions SubcriptionList) *opml {
 	feeds := new(opml)
 	feeds.Version = "2.0"
+	feeds.Namespace = minifluxNamespace
 
 	groupedSubs := groupSubscriptionsByFeed(subscriptions)
 	var categories []string
@@ -54,12 +55,15 @@ func normalizeFeeds(subscriptions SubcriptionList) *opml {
 	for _, categoryName := range categories {
 		category := outline{Text: categoryName}
 		for _, subscription := range groupedSubs[categoryName] {
-			category.Outlines = append(category.Outlines, outline{
+			feed := outline{
 				Title:   subscription.Title,
 				Text:    subscription.Title,
 				FeedURL: subscription.FeedURL,
 				SiteURL: subscription.SiteURL,
-			})
+			}
+			feed.SetKind(subscription)
+			feed.SetSettings(subscription.Settings)
+			category.Outlines = append(category.Outlines, feed)
 		}
 
 		feeds.Outlines = append(feeds.Outlines, category)
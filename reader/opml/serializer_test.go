@@ -7,7 +7,10 @@ package opml // import "miniflux.app/reader/opml"
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"testing"
+
+	"miniflux.app/model"
 )
 
 func TestSerialize(t *testing.T) {
@@ -64,3 +67,97 @@ func TestNormalizedCategoriesOrder(t *testing.T) {
 		}
 	}
 }
+
+func TestSerializeWithFeedSettings(t *testing.T) {
+	settings := FeedSettings{
+		ScraperRules:    "article > div.content",
+		RewriteRules:    `add_image_title,replace("a"|"b")`,
+		BlocklistRules:  "(?i)sponsored",
+		KeeplistRules:   "golang",
+		UserAgent:       "Custom Agent/1.0",
+		Crawler:         true,
+		FetchViaProxy:   true,
+		IgnoreHTTPCache: true,
+		Disabled:        true,
+	}
+
+	var subscriptions SubcriptionList
+	subscriptions = append(subscriptions, &Subcription{Title: "Feed 1", FeedURL: "http://example.org/feed/1", SiteURL: "http://example.org/1", CategoryName: "Category 1", Settings: settings})
+	subscriptions = append(subscriptions, &Subcription{Title: "Feed 2", FeedURL: "http://example.org/feed/2", SiteURL: "http://example.org/2", CategoryName: "Category 1"})
+
+	output := Serialize(subscriptions)
+	if !strings.Contains(output, `xmlns:miniflux="https://miniflux.app/opml"`) {
+		t.Errorf(`The namespace is not declared: %s`, output)
+	}
+
+	if !strings.Contains(output, `miniflux:userAgent="Custom Agent/1.0"`) {
+		t.Errorf(`The feed settings are not serialized: %s`, output)
+	}
+
+	feeds, err := Parse(bytes.NewBufferString(output))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feeds) != 2 {
+		t.Fatalf("Wrong number of subscriptions: %d instead of %d", len(feeds), 2)
+	}
+
+	for _, feed := range feeds {
+		switch feed.Title {
+		case "Feed 1":
+			if feed.Settings != settings {
+				t.Errorf(`The feed settings are different: %+v instead of %+v`, feed.Settings, settings)
+			}
+		case "Feed 2":
+			if feed.Settings != (FeedSettings{}) {
+				t.Errorf(`The feed should not have any settings: %+v`, feed.Settings)
+			}
+		}
+	}
+}
+
+func TestSerializeWithoutFeedSettings(t *testing.T) {
+	var subscriptions SubcriptionList
+	subscriptions = append(subscriptions, &Subcription{Title: "Feed 1", FeedURL: "http://example.org/feed/1", SiteURL: "http://example.org/1", CategoryName: "Category 1"})
+
+	if output := Serialize(subscriptions); strings.Contains(output, "miniflux:") {
+		t.Errorf(`The feeds without settings should not have namespaced attributes: %s`, output)
+	}
+}
+
+func TestSerializeWithFeedKinds(t *testing.T) {
+	selectors := &model.FeedSelectors{Item: "article", Title: "h2", Link: "h2 > a"}
+
+	var subscriptions SubcriptionList
+	subscriptions = append(subscriptions, &Subcription{Title: "Scraped", FeedURL: "http://example.org/news", CategoryName: "Category 1", Kind: model.FeedKindScraped, Selectors: selectors})
+	subscriptions = append(subscriptions, &Subcription{Title: "Watched", FeedURL: "http://example.org/prices", CategoryName: "Category 1", Kind: model.FeedKindWatchPage, WatchSelector: "#price"})
+	subscriptions = append(subscriptions, &Subcription{Title: "Feed", FeedURL: "http://example.org/feed", CategoryName: "Category 1", Kind: model.FeedKindDefault})
+
+	output := Serialize(subscriptions)
+	if !strings.Contains(output, `miniflux:kind="scraped"`) || !strings.Contains(output, `miniflux:watchSelector="#price"`) {
+		t.Errorf(`The kinds of feed are not serialized: %s`, output)
+	}
+
+	feeds, err := Parse(bytes.NewBufferString(output))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, feed := range feeds {
+		switch feed.Title {
+		case "Scraped":
+			if feed.Kind != model.FeedKindScraped || feed.Selectors == nil || *feed.Selectors != *selectors {
+				t.Errorf(`Unexpected scraped feed: %+v`, feed)
+			}
+		case "Watched":
+			if feed.Kind != model.FeedKindWatchPage || feed.WatchSelector != "#price" || feed.Selectors != nil {
+				t.Errorf(`Unexpected watched page: %+v`, feed)
+			}
+		case "Feed":
+			if feed.Kind != "" || feed.Selectors != nil || feed.WatchSelector != "" {
+				t.Errorf(`The regular feed should not have a kind: %+v`, feed)
+			}
+		}
+	}
+}
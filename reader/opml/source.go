@@ -0,0 +1,149 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package opml // import "miniflux.app/reader/opml"
+
+import (
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/http/client"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/browser"
+)
+
+// SyncSource fetches the remote OPML list and applies its changes to the subscriptions of the user.
+//
+// The new outlines are added to the category of the source. The feeds removed from the list are disabled,
+// and enabled again when they come back, if the source is configured to do so. Feeds already subscribed
+// by the user, feeds removed by the user since they were added and feeds disabled by the user are left alone.
+//
+// A synchronization is recorded only when the subscriptions have changed or when a new error occurred.
+func (h *Handler) SyncSource(source *model.OPMLSource) (*model.OPMLSourceSync, error) {
+	logger.Debug("[OPML:SyncSource] Synchronizing source #%d (%s)", source.ID, source.URL)
+
+	now := time.Now()
+	previousError := source.ErrorMsg
+	source.CheckedAt = &now
+
+	sync := &model.OPMLSourceSync{SourceID: source.ID}
+	subscriptions, err := h.fetchSource(source)
+	if err != nil {
+		source.ErrorMsg = err.Error()
+		sync.ErrorMsg = source.ErrorMsg
+	} else {
+		source.ErrorMsg = ""
+	}
+
+	var newFeeds model.Feeds
+	if subscriptions != nil {
+		newFeeds, err = h.applySubscriptions(source, sync, subscriptions)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	record := len(sync.Changes) > 0 || len(newFeeds) > 0 || (sync.ErrorMsg != "" && sync.ErrorMsg != previousError)
+	if err := h.store.SyncOPMLSource(source, sync, newFeeds, record); err != nil {
+		return nil, err
+	}
+
+	if !record {
+		return nil, nil
+	}
+
+	return sync, nil
+}
+
+// fetchSource downloads and parses the remote OPML list, no subscriptions are returned if the list has not been modified.
+func (h *Handler) fetchSource(source *model.OPMLSource) (SubcriptionList, error) {
+	request := client.NewClientWithConfig(source.URL, config.Opts)
+	request.WithCacheHeaders(source.EtagHeader, source.LastModifiedHeader)
+
+	response, requestErr := browser.Exec(request)
+	if requestErr != nil {
+		return nil, requestErr
+	}
+
+	if !response.IsModified(source.EtagHeader, source.LastModifiedHeader) {
+		logger.Debug("[OPML:SyncSource] Source #%d not modified", source.ID)
+		return nil, nil
+	}
+
+	subscriptions, parseErr := Parse(response.Body)
+	if parseErr != nil {
+		return nil, parseErr
+	}
+
+	source.EtagHeader = response.ETag
+	source.LastModifiedHeader = response.LastModified
+	return subscriptions, nil
+}
+
+// applySubscriptions records the feeds to enable or disable in the synchronization and returns the feeds to create.
+// Nothing is written, the changes are applied with the synchronization record in the same transaction.
+func (h *Handler) applySubscriptions(source *model.OPMLSource, sync *model.OPMLSourceSync, subscriptions SubcriptionList) (model.Feeds, error) {
+	managedFeeds, err := h.store.OPMLSourceFeeds(source.UserID, source.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	addedURLs, err := h.store.OPMLSourceAddedURLs(source.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	disabledFeedIDs, err := h.store.OPMLSourceDisabledFeedIDs(source.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	feedsByURL := make(map[string]*model.Feed)
+	for _, feed := range managedFeeds {
+		feedsByURL[feed.FeedURL] = feed
+	}
+
+	var newFeeds model.Feeds
+	listedURLs := make(map[string]bool)
+	for _, subscription := range subscriptions {
+		if listedURLs[subscription.FeedURL] {
+			continue
+		}
+		listedURLs[subscription.FeedURL] = true
+
+		if feed, found := feedsByURL[subscription.FeedURL]; found {
+			// The feeds disabled by the user stay disabled, only the synchronization undoes its own changes.
+			if feed.Disabled && disabledFeedIDs[feed.ID] {
+				sync.AddChange(model.OPMLSourceChangeEnabled, feed)
+			}
+			continue
+		}
+
+		if addedURLs[subscription.FeedURL] || h.store.FeedURLExists(source.UserID, subscription.FeedURL) {
+			continue
+		}
+
+		// The feed is fetched later by the scheduler, like the imported feeds without validation.
+		newFeeds = append(newFeeds, &model.Feed{
+			UserID:   source.UserID,
+			Title:    subscription.Title,
+			FeedURL:  subscription.FeedURL,
+			SiteURL:  subscription.SiteURL,
+			Category: source.Category,
+		})
+	}
+
+	if !source.DisableRemoved {
+		return newFeeds, nil
+	}
+
+	for _, feed := range managedFeeds {
+		if !feed.Disabled && !listedURLs[feed.FeedURL] {
+			sync.AddChange(model.OPMLSourceChangeDisabled, feed)
+		}
+	}
+
+	return newFeeds, nil
+}
@@ -4,12 +4,65 @@
 
 package opml // import "miniflux.app/reader/opml"
 
+import (
+	"errors"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
 // Subcription represents a feed that will be imported or exported.
+// The scraped feeds and the watched pages keep their kind and selectors, the newsletters are never exported.
 type Subcription struct {
-	Title        string
-	SiteURL      string
-	FeedURL      string
-	CategoryName string
+	Title         string
+	SiteURL       string
+	FeedURL       string
+	CategoryName  string
+	Settings      FeedSettings
+	Kind          string
+	Selectors     *model.FeedSelectors
+	WatchSelector string
+}
+
+// FeedSettings contains the Miniflux specific settings of a feed, the credentials are never exported.
+type FeedSettings struct {
+	ScraperRules    string
+	RewriteRules    string
+	BlocklistRules  string
+	KeeplistRules   string
+	UserAgent       string
+	Crawler         bool
+	FetchViaProxy   bool
+	IgnoreHTTPCache bool
+	Disabled        bool
+}
+
+// NewFeedSettings returns the settings of the given feed.
+func NewFeedSettings(feed *model.Feed) FeedSettings {
+	return FeedSettings{
+		ScraperRules:    feed.ScraperRules,
+		RewriteRules:    feed.RewriteRules,
+		BlocklistRules:  feed.BlocklistRules,
+		KeeplistRules:   feed.KeeplistRules,
+		UserAgent:       feed.UserAgent,
+		Crawler:         feed.Crawler,
+		FetchViaProxy:   feed.FetchViaProxy,
+		IgnoreHTTPCache: feed.IgnoreHTTPCache,
+		Disabled:        feed.Disabled,
+	}
+}
+
+// Apply copies the settings to the given feed.
+func (s FeedSettings) Apply(feed *model.Feed) {
+	feed.ScraperRules = s.ScraperRules
+	feed.RewriteRules = s.RewriteRules
+	feed.BlocklistRules = s.BlocklistRules
+	feed.KeeplistRules = s.KeeplistRules
+	feed.UserAgent = s.UserAgent
+	feed.Crawler = s.Crawler
+	feed.FetchViaProxy = s.FetchViaProxy
+	feed.IgnoreHTTPCache = s.IgnoreHTTPCache
+	feed.Disabled = s.Disabled
 }
 
 // Equals compare two subscriptions.
@@ -18,5 +71,25 @@ func (s Subcription) Equals(subscription *Subcription) bool {
 		s.FeedURL == subscription.FeedURL && s.CategoryName == subscription.CategoryName
 }
 
+// HasKind returns true when the subscription is not a regular feed.
+func (s Subcription) HasKind() bool {
+	return s.Kind != "" && s.Kind != model.FeedKindDefault
+}
+
+// ValidateKind returns an error when the kind of feed cannot be imported.
+func (s Subcription) ValidateKind() error {
+	switch s.Kind {
+	case "", model.FeedKindDefault, model.FeedKindWatchPage:
+		return nil
+	case model.FeedKindScraped:
+		if s.Selectors == nil || s.Selectors.Item == "" {
+			return errors.New("the selectors of the scraped feed are missing")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported kind of feed: %s", s.Kind)
+	}
+}
+
 // SubcriptionList is a list of subscriptions.
 type SubcriptionList []*Subcription
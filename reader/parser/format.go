@@ -8,16 +8,18 @@ import (
 	"encoding/xml"
 	"strings"
 
+	"miniflux.app/reader/microformats"
 	rxml "miniflux.app/reader/xml"
 )
 
 // List of feed formats.
 const (
-	FormatRDF     = "rdf"
-	FormatRSS     = "rss"
-	FormatAtom    = "atom"
-	FormatJSON    = "json"
-	FormatUnknown = "unknown"
+	FormatRDF          = "rdf"
+	FormatRSS          = "rss"
+	FormatAtom         = "atom"
+	FormatJSON         = "json"
+	FormatMicroformats = "microformats"
+	FormatUnknown      = "unknown"
 )
 
 // DetectFeedFormat tries to guess the feed format from input data.
@@ -46,5 +48,10 @@ func DetectFeedFormat(data string) string {
 		}
 	}
 
+	// IndieWeb sites publish their entries directly in the HTML page.
+	if strings.Contains(data, "h-entry") && microformats.HasEntries(strings.NewReader(data)) {
+		return FormatMicroformats
+	}
+
 	return FormatUnknown
 }
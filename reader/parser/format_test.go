@@ -67,6 +67,24 @@ func TestDetectJSON(t *testing.T) {
 	}
 }
 
+func TestDetectMicroformats(t *testing.T) {
+	data := `
+	<!DOCTYPE html>
+	<html>
+		<body>
+			<div class="h-feed">
+				<article class="h-entry"><p class="p-name">Example</p></article>
+			</div>
+		</body>
+	</html>
+	`
+	format := DetectFeedFormat(data)
+
+	if format != FormatMicroformats {
+		t.Errorf(`Wrong format detected: %q instead of %q`, format, FormatMicroformats)
+	}
+}
+
 func TestDetectUnknown(t *testing.T) {
 	data := `
 	<!DOCTYPE html> <html> </html>
@@ -11,6 +11,7 @@ import (
 	"miniflux.app/model"
 	"miniflux.app/reader/atom"
 	"miniflux.app/reader/json"
+	"miniflux.app/reader/microformats"
 	"miniflux.app/reader/rdf"
 	"miniflux.app/reader/rss"
 )
@@ -26,6 +27,8 @@ func ParseFeed(baseURL, data string) (*model.Feed, *errors.LocalizedError) {
 		return json.Parse(baseURL, strings.NewReader(data))
 	case FormatRDF:
 		return rdf.Parse(baseURL, strings.NewReader(data))
+	case FormatMicroformats:
+		return microformats.Parse(baseURL, strings.NewReader(data))
 	default:
 		return nil, errors.NewLocalizedError("Unsupported feed format")
 	}
@@ -277,6 +277,35 @@ func TestParseJsonFeedWithRelativeURL(t *testing.T) {
 	}
 }
 
+func TestParseMicroformats(t *testing.T) {
+	data := `
+		<!DOCTYPE html>
+		<html>
+			<body>
+				<div class="h-feed">
+					<h1 class="p-name">My Notes</h1>
+					<article class="h-entry">
+						<a class="p-name u-url" href="/notes/1">First note</a>
+					</article>
+				</div>
+			</body>
+		</html>
+	`
+
+	feed, err := ParseFeed("https://example.org/", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != "My Notes" {
+		t.Errorf("Incorrect title, got: %s", feed.Title)
+	}
+
+	if len(feed.Entries) != 1 || feed.Entries[0].URL != "https://example.org/notes/1" {
+		t.Errorf("Incorrect entries, got: %v", feed.Entries)
+	}
+}
+
 func TestParseUnknownFeed(t *testing.T) {
 	data := `
 		<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.0 Transitional//EN" "http://www.w3.org/TR/xhtml1/DTD/xhtml1-transitional.dtd">
@@ -0,0 +1,77 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package podcast // import "miniflux.app/reader/podcast"
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"miniflux.app/model"
+	"miniflux.app/url"
+)
+
+// Specs: https://github.com/Podcastindex-org/podcast-namespace/blob/main/chapters/jsonChapters.md
+type jsonChapters struct {
+	Chapters []jsonChapter `json:"chapters"`
+}
+
+type jsonChapter struct {
+	StartTime float64 `json:"startTime"`
+	Title     string  `json:"title"`
+	Image     string  `json:"img"`
+	URL       string  `json:"url"`
+	TOC       *bool   `json:"toc"`
+}
+
+// FetchChapters downloads the chapters file of an episode.
+func FetchChapters(chaptersURL, userAgent string, fetchViaProxy bool) (model.PodcastChapters, error) {
+	response, err := fetch(chaptersURL, userAgent, fetchViaProxy)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseChapters(response.EffectiveURL, response.Body)
+}
+
+// ParseChapters returns the chapters of a JSON chapters document.
+// The chapters hidden from the table of contents are ignored.
+func ParseChapters(baseURL string, data io.Reader) (model.PodcastChapters, error) {
+	var document jsonChapters
+	if err := json.NewDecoder(data).Decode(&document); err != nil {
+		return nil, fmt.Errorf("podcast: unable to parse chapters: %v", err)
+	}
+
+	chapters := make(model.PodcastChapters, 0)
+	for _, chapter := range document.Chapters {
+		title := strings.TrimSpace(chapter.Title)
+		if title == "" || (chapter.TOC != nil && !*chapter.TOC) {
+			continue
+		}
+
+		chapters = append(chapters, &model.PodcastChapter{
+			StartTime: chapter.StartTime,
+			Title:     title,
+			URL:       absoluteURL(baseURL, chapter.URL),
+			ImageURL:  absoluteURL(baseURL, chapter.Image),
+		})
+	}
+
+	return chapters, nil
+}
+
+func absoluteURL(baseURL, input string) string {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return ""
+	}
+
+	if result, err := url.AbsoluteURL(baseURL, input); err == nil {
+		return result
+	}
+
+	return input
+}
@@ -0,0 +1,70 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package podcast // import "miniflux.app/reader/podcast"
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseChapters(t *testing.T) {
+	data := `{
+		"version": "1.2.0",
+		"chapters": [
+			{"startTime": 0, "title": "Intro"},
+			{"startTime": 95.5, "title": " Interview ", "img": "/images/guest.jpg", "url": "https://example.org/guest"},
+			{"startTime": 120, "title": "Hidden", "toc": false},
+			{"startTime": 130, "img": "/images/untitled.jpg"},
+			{"startTime": 1800, "title": "Outro", "toc": true}
+		]
+	}`
+
+	chapters, err := ParseChapters("https://example.org/episodes/1/chapters.json", strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(chapters) != 3 {
+		t.Fatalf(`Incorrect number of chapters, got: %d`, len(chapters))
+	}
+
+	if chapters[0].StartTime != 0 || chapters[0].Title != "Intro" {
+		t.Errorf(`Incorrect first chapter, got: %+v`, chapters[0])
+	}
+
+	if chapters[1].StartTime != 95.5 || chapters[1].Title != "Interview" {
+		t.Errorf(`Incorrect second chapter, got: %+v`, chapters[1])
+	}
+
+	if chapters[1].ImageURL != "https://example.org/images/guest.jpg" {
+		t.Errorf(`Incorrect chapter image, got: %q`, chapters[1].ImageURL)
+	}
+
+	if chapters[1].URL != "https://example.org/guest" {
+		t.Errorf(`Incorrect chapter URL, got: %q`, chapters[1].URL)
+	}
+
+	if chapters[2].Title != "Outro" {
+		t.Errorf(`Incorrect last chapter, got: %+v`, chapters[2])
+	}
+}
+
+func TestParseChaptersWithoutChapters(t *testing.T) {
+	chapters, err := ParseChapters("https://example.org/", strings.NewReader(`{"version": "1.2.0"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An empty list is returned to avoid downloading the document again.
+	if chapters == nil || len(chapters) != 0 {
+		t.Errorf(`Unexpected chapters, got: %v`, chapters)
+	}
+}
+
+func TestParseInvalidChapters(t *testing.T) {
+	if _, err := ParseChapters("https://example.org/", strings.NewReader(`garbage`)); err == nil {
+		t.Error(`ParseChapters must returns an error`)
+	}
+}
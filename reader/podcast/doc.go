@@ -0,0 +1,10 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+
+Package podcast handles the documents linked from the Podcasting 2.0 namespace, like the chapters and the transcripts of an episode.
+
+*/
+package podcast // import "miniflux.app/reader/podcast"
@@ -0,0 +1,172 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package podcast // import "miniflux.app/reader/podcast"
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"miniflux.app/config"
+	"miniflux.app/http/client"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/storage"
+)
+
+const (
+	// maxQueuedEpisodes is the number of episodes waiting for their downloads,
+	// the episodes displayed when the queue is full are requested again on the next view.
+	maxQueuedEpisodes = 100
+
+	// nbDownloaders is the number of episodes downloaded at the same time.
+	nbDownloaders = 2
+
+	// retryDelay is the time to wait before downloading again the documents of an episode after a failure.
+	retryDelay = 6 * time.Hour
+)
+
+// episodeJob contains a copy of what is needed to download the documents of an episode,
+// the entry itself is still used by the request that queued it.
+type episodeJob struct {
+	userID        int64
+	entryID       int64
+	entryURL      string
+	userAgent     string
+	fetchViaProxy bool
+	chaptersURL   string
+	transcript    *model.PodcastTranscript
+}
+
+var (
+	startDownloaders sync.Once
+	queue            = make(chan *episodeJob, maxQueuedEpisodes)
+
+	pendingMutex sync.Mutex
+	pending      = make(map[int64]bool)
+)
+
+// QueueEpisode downloads the chapters and the transcript of an episode in the background the first time the entry is displayed.
+// They are not downloaded when the feed is refreshed because a podcast can have hundreds of episodes.
+// When a download fails, it is retried when the entry is displayed after a delay.
+func QueueEpisode(store *storage.Storage, entry *model.Entry) {
+	job := newEpisodeJob(entry, time.Now())
+	if job == nil {
+		return
+	}
+
+	startDownloaders.Do(func() {
+		for i := 0; i < nbDownloaders; i++ {
+			go download(store)
+		}
+	})
+
+	pendingMutex.Lock()
+	defer pendingMutex.Unlock()
+
+	if pending[job.entryID] {
+		return
+	}
+
+	select {
+	case queue <- job:
+		pending[job.entryID] = true
+	default:
+		logger.Debug(`[Podcast] Too many episodes to download, skipping entry #%d`, job.entryID)
+	}
+}
+
+// newEpisodeJob returns nil when there is nothing to download for the entry.
+func newEpisodeJob(entry *model.Entry, now time.Time) *episodeJob {
+	if entry.Podcast == nil {
+		return nil
+	}
+
+	if entry.Podcast.RetryAt != nil && now.Before(*entry.Podcast.RetryAt) {
+		return nil
+	}
+
+	job := &episodeJob{userID: entry.UserID, entryID: entry.ID, entryURL: entry.URL}
+	if entry.Feed != nil {
+		job.userAgent = entry.Feed.UserAgent
+		job.fetchViaProxy = entry.Feed.FetchViaProxy
+	}
+
+	if entry.Podcast.ChaptersURL != "" && entry.Podcast.Chapters == nil {
+		job.chaptersURL = entry.Podcast.ChaptersURL
+	}
+
+	if transcript := SelectTranscript(entry.Podcast.Transcripts); transcript != nil && entry.Podcast.Transcript == nil {
+		job.transcript = transcript
+	}
+
+	if job.chaptersURL == "" && job.transcript == nil {
+		return nil
+	}
+
+	return job
+}
+
+func download(store *storage.Storage) {
+	for job := range queue {
+		loadEpisode(store, job)
+
+		pendingMutex.Lock()
+		delete(pending, job.entryID)
+		pendingMutex.Unlock()
+	}
+}
+
+func loadEpisode(store *storage.Storage, job *episodeJob) {
+	failed := false
+
+	if job.chaptersURL != "" {
+		chapters, err := FetchChapters(job.chaptersURL, job.userAgent, job.fetchViaProxy)
+		if err != nil {
+			logger.Error(`[Podcast] Unable to fetch the chapters of this entry: %q => %v`, job.entryURL, err)
+			failed = true
+		} else if err := store.UpdatePodcastChapters(job.userID, job.entryID, chapters); err != nil {
+			logger.Error("[Podcast] %v", err)
+		}
+	}
+
+	if job.transcript != nil {
+		cues, err := FetchTranscript(job.transcript, job.userAgent, job.fetchViaProxy)
+		if err != nil {
+			logger.Error(`[Podcast] Unable to fetch the transcript of this entry: %q => %v`, job.entryURL, err)
+			failed = true
+		} else if err := store.UpdatePodcastTranscript(job.userID, job.entryID, cues); err != nil {
+			logger.Error("[Podcast] %v", err)
+		}
+	}
+
+	if failed {
+		if err := store.DelayPodcastDownload(job.userID, job.entryID, time.Now().Add(retryDelay)); err != nil {
+			logger.Error("[Podcast] %v", err)
+		}
+	}
+}
+
+func fetch(documentURL, userAgent string, fetchViaProxy bool) (*client.Response, error) {
+	clt := client.NewClientWithConfig(documentURL, config.Opts)
+	if userAgent != "" {
+		clt.WithUserAgent(userAgent)
+	}
+
+	if fetchViaProxy {
+		clt.WithProxy()
+	}
+
+	response, err := clt.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	if response.HasServerFailure() {
+		return nil, fmt.Errorf("podcast: unable to download %q, status code %d", documentURL, response.StatusCode)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package podcast // import "miniflux.app/reader/podcast"
+
+import (
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+func TestNewEpisodeJobWithoutDownload(t *testing.T) {
+	now := time.Now()
+	retryAt := now.Add(time.Hour)
+
+	entries := []*model.Entry{
+		{},
+		{Podcast: &model.PodcastEpisode{Episode: "1"}},
+		{Podcast: &model.PodcastEpisode{ChaptersURL: "https://example.org/chapters.json", Chapters: make(model.PodcastChapters, 0)}},
+		{Podcast: &model.PodcastEpisode{Transcripts: []*model.PodcastTranscript{{URL: "https://example.org/episode.pdf", MimeType: "application/pdf"}}}},
+		{Podcast: &model.PodcastEpisode{
+			Transcripts: []*model.PodcastTranscript{{URL: "https://example.org/episode.vtt", MimeType: "text/vtt"}},
+			Transcript:  make(model.PodcastCues, 0),
+		}},
+		{Podcast: &model.PodcastEpisode{ChaptersURL: "https://example.org/chapters.json", RetryAt: &retryAt}},
+	}
+
+	for i, entry := range entries {
+		if job := newEpisodeJob(entry, now); job != nil {
+			t.Errorf(`Entry #%d should not be downloaded, got %+v`, i, job)
+		}
+	}
+}
+
+func TestNewEpisodeJob(t *testing.T) {
+	now := time.Now()
+	retryAt := now.Add(-time.Minute)
+
+	entry := &model.Entry{
+		ID:     42,
+		UserID: 1,
+		Feed:   &model.Feed{UserAgent: "Podcast Player"},
+		Podcast: &model.PodcastEpisode{
+			ChaptersURL: "https://example.org/chapters.json",
+			Transcripts: []*model.PodcastTranscript{{URL: "https://example.org/episode.vtt", MimeType: "text/vtt"}},
+			RetryAt:     &retryAt,
+		},
+	}
+
+	job := newEpisodeJob(entry, now)
+	if job == nil {
+		t.Fatal(`The episode should be downloaded once the retry delay is over`)
+	}
+
+	if job.entryID != 42 || job.userID != 1 || job.userAgent != "Podcast Player" {
+		t.Errorf(`Unexpected job: %+v`, job)
+	}
+
+	if job.chaptersURL != "https://example.org/chapters.json" {
+		t.Errorf(`Unexpected chapters URL: %q`, job.chaptersURL)
+	}
+
+	if job.transcript == nil || job.transcript.URL != "https://example.org/episode.vtt" {
+		t.Errorf(`Unexpected transcript: %+v`, job.transcript)
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package podcast // import "miniflux.app/reader/podcast"
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"miniflux.app/model"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// mergeDelay is the longest time covered by a paragraph of the transcript,
+// the short cues of captions are merged to make the text readable.
+const mergeDelay = 30
+
+var (
+	tagRegex     = regexp.MustCompile(`<[^>]*>`)
+	voiceRegex   = regexp.MustCompile(`^<v(?:\.[^\s>]*)?\s+([^>]+)>`)
+	errNoCues    = errors.New("podcast: the transcript is empty")
+	errUnhandled = errors.New("podcast: unsupported transcript format")
+)
+
+// Transcript formats ordered by preference, the timestamps of captions are less precise than the JSON format.
+var transcriptFormats = []string{
+	"application/json",
+	"text/vtt",
+	"application/x-subrip",
+	"application/srt",
+	"text/srt",
+	"text/html",
+}
+
+// Specs: https://github.com/Podcastindex-org/podcast-namespace/blob/main/transcripts/transcripts.md
+type jsonTranscript struct {
+	Segments []jsonSegment `json:"segments"`
+}
+
+type jsonSegment struct {
+	Speaker   string  `json:"speaker"`
+	StartTime float64 `json:"startTime"`
+	Body      string  `json:"body"`
+}
+
+// SelectTranscript returns the transcript that can be displayed, nil is returned when none of the formats is supported.
+func SelectTranscript(transcripts []*model.PodcastTranscript) *model.PodcastTranscript {
+	for _, format := range transcriptFormats {
+		for _, transcript := range transcripts {
+			if transcriptFormat(transcript.MimeType) == format {
+				return transcript
+			}
+		}
+	}
+
+	return nil
+}
+
+// FetchTranscript downloads the transcript of an episode.
+func FetchTranscript(transcript *model.PodcastTranscript, userAgent string, fetchViaProxy bool) (model.PodcastCues, error) {
+	response, err := fetch(transcript.URL, userAgent, fetchViaProxy)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseTranscript(transcript.MimeType, response.Body)
+}
+
+// ParseTranscript returns the text of a JSON, WebVTT, SRT or HTML transcript.
+// The markup is removed, the text is escaped when it is rendered.
+func ParseTranscript(mimeType string, data io.Reader) (model.PodcastCues, error) {
+	var cues model.PodcastCues
+	var err error
+
+	switch transcriptFormat(mimeType) {
+	case "application/json":
+		cues, err = parseJSONTranscript(data)
+	case "text/vtt", "application/x-subrip", "application/srt", "text/srt":
+		cues, err = parseTimedText(data)
+	case "text/html":
+		cues, err = parseHTMLTranscript(data)
+	default:
+		return nil, errUnhandled
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("podcast: unable to parse transcript: %v", err)
+	}
+
+	cues = mergeCues(cues)
+	if len(cues) == 0 {
+		return nil, errNoCues
+	}
+
+	return cues, nil
+}
+
+func transcriptFormat(mimeType string) string {
+	mimeType = strings.ToLower(strings.TrimSpace(mimeType))
+	if index := strings.Index(mimeType, ";"); index != -1 {
+		mimeType = strings.TrimSpace(mimeType[:index])
+	}
+	return mimeType
+}
+
+func parseJSONTranscript(data io.Reader) (model.PodcastCues, error) {
+	var document jsonTranscript
+	if err := json.NewDecoder(data).Decode(&document); err != nil {
+		return nil, err
+	}
+
+	var cues model.PodcastCues
+	for _, segment := range document.Segments {
+		cues = append(cues, &model.PodcastCue{
+			StartTime: segment.StartTime,
+			Speaker:   strings.TrimSpace(segment.Speaker),
+			Text:      cleanText(segment.Body),
+		})
+	}
+
+	return cues, nil
+}
+
+// parseTimedText handles WebVTT and SRT documents, a cue starts with the timing line
+// and ends with a blank line. The other blocks, like the WebVTT header and notes, are ignored.
+func parseTimedText(data io.Reader) (model.PodcastCues, error) {
+	var cues model.PodcastCues
+	var cue *model.PodcastCue
+	var lines []string
+
+	addCue := func() {
+		if cue != nil {
+			text := strings.Join(lines, " ")
+			if matches := voiceRegex.FindStringSubmatch(text); matches != nil {
+				cue.Speaker = strings.TrimSpace(matches[1])
+			}
+			cue.Text = cleanText(text)
+			cues = append(cues, cue)
+		}
+		cue, lines = nil, nil
+	}
+
+	scanner := bufio.NewScanner(data)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			addCue()
+		case strings.Contains(line, "-->"):
+			addCue()
+			if startTime, err := parseTimestamp(strings.SplitN(line, "-->", 2)[0]); err == nil {
+				cue = &model.PodcastCue{StartTime: startTime}
+			}
+		case cue != nil:
+			lines = append(lines, line)
+		}
+	}
+	addCue()
+
+	return cues, scanner.Err()
+}
+
+// parseHTMLTranscript handles the HTML format of the Podcasting 2.0 specs,
+// each paragraph is preceded by the name of the speaker and its start time.
+func parseHTMLTranscript(data io.Reader) (model.PodcastCues, error) {
+	doc, err := goquery.NewDocumentFromReader(data)
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Find("script, style").Remove()
+
+	var cues model.PodcastCues
+	doc.Find("p").Each(func(i int, s *goquery.Selection) {
+		cue := &model.PodcastCue{
+			Speaker: strings.TrimSuffix(strings.TrimSpace(s.PrevAllFiltered("cite").First().Text()), ":"),
+			Text:    cleanText(s.Text()),
+		}
+
+		if startTime, err := parseTimestamp(s.PrevAllFiltered("time").First().Text()); err == nil {
+			cue.StartTime = startTime
+		}
+
+		cues = append(cues, cue)
+	})
+
+	return cues, nil
+}
+
+// parseTimestamp returns the number of seconds of a timestamp formatted as HH:MM:SS.mmm or MM:SS.mmm,
+// SRT documents use a comma before the milliseconds.
+func parseTimestamp(value string) (float64, error) {
+	value = strings.Replace(strings.TrimSpace(value), ",", ".", 1)
+	if value == "" {
+		return 0, errors.New("podcast: empty timestamp")
+	}
+
+	var seconds float64
+	for _, part := range strings.Split(value, ":") {
+		number, err := strconv.ParseFloat(part, 64)
+		if err != nil || number < 0 {
+			return 0, fmt.Errorf("podcast: invalid timestamp %q", value)
+		}
+		seconds = seconds*60 + number
+	}
+
+	return seconds, nil
+}
+
+func cleanText(text string) string {
+	text = html.UnescapeString(tagRegex.ReplaceAllString(text, ""))
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// mergeCues removes the empty cues and merges the consecutive cues of the same speaker into paragraphs.
+func mergeCues(cues model.PodcastCues) model.PodcastCues {
+	result := make(model.PodcastCues, 0)
+	for _, cue := range cues {
+		if cue.Text == "" {
+			continue
+		}
+
+		if len(result) > 0 {
+			previous := result[len(result)-1]
+			if cue.Speaker == previous.Speaker && cue.StartTime-previous.StartTime < mergeDelay {
+				previous.Text += " " + cue.Text
+				continue
+			}
+		}
+
+		result = append(result, cue)
+	}
+
+	return result
+}
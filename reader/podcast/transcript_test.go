@@ -0,0 +1,137 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package podcast // import "miniflux.app/reader/podcast"
+
+import (
+	"strings"
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func checkCues(t *testing.T, cues model.PodcastCues, expected model.PodcastCues) {
+	if len(cues) != len(expected) {
+		t.Fatalf(`Unexpected number of cues, got %d instead of %d`, len(cues), len(expected))
+	}
+
+	for i := range expected {
+		if *cues[i] != *expected[i] {
+			t.Errorf(`Unexpected cue #%d, got %+v instead of %+v`, i, *cues[i], *expected[i])
+		}
+	}
+}
+
+func TestSelectTranscript(t *testing.T) {
+	transcripts := []*model.PodcastTranscript{
+		{URL: "https://example.org/episode.pdf", MimeType: "application/pdf"},
+		{URL: "https://example.org/episode.html", MimeType: "text/html"},
+		{URL: "https://example.org/episode.srt", MimeType: "application/x-subrip"},
+		{URL: "https://example.org/episode.json", MimeType: "application/json; charset=utf-8"},
+	}
+
+	if transcript := SelectTranscript(transcripts); transcript == nil || transcript.URL != "https://example.org/episode.json" {
+		t.Errorf(`The JSON transcript should be selected, got %+v`, transcript)
+	}
+
+	if transcript := SelectTranscript(transcripts[:1]); transcript != nil {
+		t.Errorf(`Unsupported transcripts should not be selected, got %+v`, transcript)
+	}
+}
+
+func TestParseJSONTranscript(t *testing.T) {
+	data := `{
+		"version": "1.0.0",
+		"segments": [
+			{"speaker": "Jane", "startTime": 0.5, "endTime": 2, "body": "Hello"},
+			{"speaker": "Jane", "startTime": 2, "endTime": 4, "body": "and welcome."},
+			{"speaker": "John", "startTime": 4.2, "endTime": 6, "body": "<b>Thanks</b> &amp; hi!"},
+			{"speaker": "John", "startTime": 40, "endTime": 42, "body": "Later."},
+			{"speaker": "John", "startTime": 50, "endTime": 52, "body": "  "}
+		]
+	}`
+
+	cues, err := ParseTranscript("application/json", strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkCues(t, cues, model.PodcastCues{
+		{StartTime: 0.5, Speaker: "Jane", Text: "Hello and welcome."},
+		{StartTime: 4.2, Speaker: "John", Text: "Thanks & hi!"},
+		{StartTime: 40, Speaker: "John", Text: "Later."},
+	})
+}
+
+func TestParseWebVTTTranscript(t *testing.T) {
+	data := `WEBVTT
+
+NOTE This is a comment
+that spans two lines
+
+1
+00:00:00.000 --> 00:00:02.000
+<v Jane>Hello</v>
+
+00:00:02.000 --> 00:00:04.000 align:start
+<v Jane>and <i>welcome</i>.</v>
+
+01:00.500 --> 01:02.000
+<v.loud John>Hi &lt;everyone&gt;!
+Second line.</v>
+`
+
+	cues, err := ParseTranscript("text/vtt", strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkCues(t, cues, model.PodcastCues{
+		{StartTime: 0, Speaker: "Jane", Text: "Hello and welcome."},
+		{StartTime: 60.5, Speaker: "John", Text: "Hi <everyone>! Second line."},
+	})
+}
+
+func TestParseSRTTranscript(t *testing.T) {
+	data := "1\r\n00:00:01,000 --> 00:00:03,000\r\nHello\r\n\r\n2\r\n00:01:05,250 --> 00:01:07,000\r\n<i>World</i>\r\n"
+
+	cues, err := ParseTranscript("application/x-subrip", strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkCues(t, cues, model.PodcastCues{
+		{StartTime: 1, Text: "Hello"},
+		{StartTime: 65.25, Text: "World"},
+	})
+}
+
+func TestParseHTMLTranscript(t *testing.T) {
+	data := `<cite>Jane:</cite>
+		<time>0:00</time>
+		<p>Hello <script>alert(1)</script>everyone.</p>
+		<cite>John:</cite>
+		<time>1:10:05</time>
+		<p>Hi!</p>`
+
+	cues, err := ParseTranscript("text/html", strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkCues(t, cues, model.PodcastCues{
+		{StartTime: 0, Speaker: "Jane", Text: "Hello everyone."},
+		{StartTime: 4205, Speaker: "John", Text: "Hi!"},
+	})
+}
+
+func TestParseEmptyTranscript(t *testing.T) {
+	if _, err := ParseTranscript("text/vtt", strings.NewReader("WEBVTT\n")); err == nil {
+		t.Error(`An empty transcript should return an error`)
+	}
+
+	if _, err := ParseTranscript("application/pdf", strings.NewReader("")); err == nil {
+		t.Error(`An unsupported transcript should return an error`)
+	}
+}
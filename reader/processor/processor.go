@@ -72,8 +72,7 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed) {
 
 func isBlockedEntry(feed *model.Feed, entry *model.Entry) bool {
 	if feed.BlocklistRules != "" {
-		match, _ := regexp.MatchString(feed.BlocklistRules, entry.Title)
-		if match {
+		if matchesRule(feed.BlocklistRules, entry) {
 			logger.Debug("[Processor] Blocking entry %q from feed %q based on rule %q", entry.Title, feed.FeedURL, feed.BlocklistRules)
 			return true
 		}
@@ -83,8 +82,7 @@ func isBlockedEntry(feed *model.Feed, entry *model.Entry) bool {
 
 func isAllowedEntry(feed *model.Feed, entry *model.Entry) bool {
 	if feed.KeeplistRules != "" {
-		match, _ := regexp.MatchString(feed.KeeplistRules, entry.Title)
-		if match {
+		if matchesRule(feed.KeeplistRules, entry) {
 			logger.Debug("[Processor] Allow entry %q from feed %q based on rule %q", entry.Title, feed.FeedURL, feed.KeeplistRules)
 			return true
 		}
@@ -93,6 +91,26 @@ func isAllowedEntry(feed *model.Feed, entry *model.Entry) bool {
 	return true
 }
 
+// matchesRule returns true if the title or one of the categories of the entry matches the rule.
+func matchesRule(rule string, entry *model.Entry) bool {
+	re, err := regexp.Compile(rule)
+	if err != nil {
+		return false
+	}
+
+	if re.MatchString(entry.Title) {
+		return true
+	}
+
+	for _, tag := range entry.Tags {
+		if re.MatchString(tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ProcessEntryWebPage downloads the entry web page and apply rewrite rules.
 func ProcessEntryWebPage(entry *model.Entry) error {
 	startTime := time.Now()
@@ -19,6 +19,9 @@ func TestBlockingEntries(t *testing.T) {
 		{&model.Feed{ID: 1, BlocklistRules: "(?i)example"}, &model.Entry{Title: "Some Example"}, true},
 		{&model.Feed{ID: 1, BlocklistRules: "(?i)example"}, &model.Entry{Title: "Something different"}, false},
 		{&model.Feed{ID: 1}, &model.Entry{Title: "No rule defined"}, false},
+		{&model.Feed{ID: 1, BlocklistRules: "(?i)^sponsored$"}, &model.Entry{Title: "Some Title", Tags: []string{"News", "Sponsored"}}, true},
+		{&model.Feed{ID: 1, BlocklistRules: "(?i)^sponsored$"}, &model.Entry{Title: "Some Title", Tags: []string{"News"}}, false},
+		{&model.Feed{ID: 1, BlocklistRules: "[invalid"}, &model.Entry{Title: "[invalid"}, false},
 	}
 
 	for _, tc := range scenarios {
@@ -38,6 +41,8 @@ func TestAllowEntries(t *testing.T) {
 		{&model.Feed{ID: 1, KeeplistRules: "(?i)example"}, &model.Entry{Title: "Some Example"}, true},
 		{&model.Feed{ID: 1, KeeplistRules: "(?i)example"}, &model.Entry{Title: "Something different"}, false},
 		{&model.Feed{ID: 1}, &model.Entry{Title: "No rule defined"}, true},
+		{&model.Feed{ID: 1, KeeplistRules: "^golang$"}, &model.Entry{Title: "Some Title", Tags: []string{"rust", "golang"}}, true},
+		{&model.Feed{ID: 1, KeeplistRules: "^golang$"}, &model.Entry{Title: "Some Title", Tags: []string{"rust"}}, false},
 	}
 
 	for _, tc := range scenarios {
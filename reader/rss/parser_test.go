@@ -1277,3 +1277,161 @@ func TestEntryDescriptionFromGooglePlayDescription(t *testing.T) {
 		t.Errorf(`Unexpected podcast content, got %q instead of %q`, result, expected)
 	}
 }
+
+func TestParseEntryCategories(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<item>
+				<title>Item 1</title>
+				<link>https://example.org/item1</link>
+				<category>golang</category>
+				<category domain="https://example.org/tags"> Programming </category>
+				<category>golang</category>
+				<category></category>
+				<media:category>https://example.org/media</media:category>
+			</item>
+		</channel>
+	</rss>`
+
+	feed, err := Parse("https://example.org/", bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"golang", "Programming"}
+	result := feed.Entries[0].Tags
+	if len(result) != len(expected) {
+		t.Fatalf(`Unexpected categories, got %q instead of %q`, result, expected)
+	}
+
+	for i := range expected {
+		if result[i] != expected[i] {
+			t.Errorf(`Unexpected category, got %q instead of %q`, result[i], expected[i])
+		}
+	}
+}
+
+func TestParsePodcastNamespace(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd" xmlns:podcast="https://podcastindex.org/namespace/1.0">
+		<channel>
+			<title>Podcast Example</title>
+			<link>https://example.org/</link>
+			<podcast:funding url="https://example.org/donate">Support the show</podcast:funding>
+			<podcast:person role="host" href="https://example.org/jane">Jane Doe</podcast:person>
+			<item>
+				<title>Episode 12</title>
+				<link>https://example.org/episode12</link>
+				<enclosure url="https://example.org/episode12.mp3" length="2048" type="audio/mpeg"/>
+				<itunes:duration>01:02:05</itunes:duration>
+				<itunes:image href="https://example.org/episode12.jpg"/>
+				<itunes:season>1</itunes:season>
+				<itunes:episode>11</itunes:episode>
+				<itunes:episodeType>full</itunes:episodeType>
+				<podcast:season name="Second Season">2</podcast:season>
+				<podcast:episode display="Twelve">12</podcast:episode>
+				<podcast:chapters url="https://example.org/episode12.json" type="application/json+chapters"/>
+				<podcast:transcript url="https://example.org/episode12.vtt" type="text/vtt" language="en" rel="captions"/>
+				<podcast:transcript url="" type="text/html"/>
+				<podcast:person role="guest" group="cast" img="https://example.org/john.jpg">John Doe</podcast:person>
+			</item>
+			<item>
+				<title>Episode 13</title>
+				<link>https://example.org/episode13</link>
+				<itunes:episode>13</itunes:episode>
+			</item>
+			<item>
+				<title>Blog post</title>
+				<link>https://example.org/post</link>
+			</item>
+		</channel>
+	</rss>`
+
+	feed, err := Parse("https://example.org/", bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := feed.Entries[0]
+	if entry.ImageURL != "https://example.org/episode12.jpg" {
+		t.Errorf(`Unexpected image URL, got %q`, entry.ImageURL)
+	}
+
+	if len(entry.Enclosures) != 1 || entry.Enclosures[0].Duration != 3725 {
+		t.Fatalf(`Unexpected enclosures, got %+v`, entry.Enclosures)
+	}
+
+	podcast := entry.Podcast
+	if podcast == nil {
+		t.Fatal(`The entry should have podcast metadata`)
+	}
+
+	if podcast.Season != 2 || podcast.SeasonName != "Second Season" {
+		t.Errorf(`Unexpected season, got %d %q`, podcast.Season, podcast.SeasonName)
+	}
+
+	if podcast.Episode != "Twelve" || podcast.EpisodeType != "full" {
+		t.Errorf(`Unexpected episode, got %q %q`, podcast.Episode, podcast.EpisodeType)
+	}
+
+	if podcast.ChaptersURL != "https://example.org/episode12.json" {
+		t.Errorf(`Unexpected chapters URL, got %q`, podcast.ChaptersURL)
+	}
+
+	if len(podcast.Transcripts) != 1 {
+		t.Fatalf(`Unexpected transcripts, got %d`, len(podcast.Transcripts))
+	}
+
+	transcript := podcast.Transcripts[0]
+	if transcript.URL != "https://example.org/episode12.vtt" || transcript.MimeType != "text/vtt" || transcript.Language != "en" || transcript.Rel != "captions" {
+		t.Errorf(`Unexpected transcript, got %+v`, transcript)
+	}
+
+	if len(podcast.Persons) != 1 {
+		t.Fatalf(`Unexpected persons, got %d`, len(podcast.Persons))
+	}
+
+	person := podcast.Persons[0]
+	if person.Name != "John Doe" || person.Role != "guest" || person.Group != "cast" || person.ImageURL != "https://example.org/john.jpg" {
+		t.Errorf(`Unexpected person, got %+v`, person)
+	}
+
+	if len(podcast.Funding) != 1 || podcast.Funding[0].URL != "https://example.org/donate" || podcast.Funding[0].Title != "Support the show" {
+		t.Errorf(`The channel funding should be used, got %+v`, podcast.Funding)
+	}
+
+	podcast = feed.Entries[1].Podcast
+	if podcast == nil || podcast.Episode != "13" {
+		t.Fatalf(`Unexpected podcast metadata, got %+v`, podcast)
+	}
+
+	if len(podcast.Persons) != 1 || podcast.Persons[0].Name != "Jane Doe" || podcast.Persons[0].URL != "https://example.org/jane" {
+		t.Errorf(`The channel persons should be used, got %+v`, podcast.Persons)
+	}
+
+	if feed.Entries[2].Podcast != nil {
+		t.Errorf(`The entry should not have podcast metadata`)
+	}
+}
+
+func TestParsePodcastDuration(t *testing.T) {
+	scenarios := map[string]int{
+		"":         0,
+		"3725":     3725,
+		"62:05":    3725,
+		"01:02:05": 3725,
+		"1:02:05":  3725,
+		"invalid":  0,
+		"-10":      0,
+	}
+
+	for input, expected := range scenarios {
+		element := &PodcastEntryElement{ItunesDuration: input}
+		if result := element.PodcastDuration(); result != expected {
+			t.Errorf(`Unexpected duration for %q, got %d instead of %d`, input, result, expected)
+		}
+	}
+}
@@ -4,25 +4,85 @@
 
 package rss // import "miniflux.app/reader/rss"
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+
+	"miniflux.app/model"
+)
 
 // PodcastFeedElement represents iTunes and GooglePlay feed XML elements.
 // Specs:
 // - https://github.com/simplepie/simplepie-ng/wiki/Spec:-iTunes-Podcast-RSS
 // - https://developers.google.com/search/reference/podcast/rss-feed
 type PodcastFeedElement struct {
-	ItunesAuthor     string       `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>author"`
-	Subtitle         string       `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>subtitle"`
-	Summary          string       `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>summary"`
-	PodcastOwner     PodcastOwner `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>owner"`
-	GooglePlayAuthor string       `xml:"http://www.google.com/schemas/play-podcasts/1.0 channel>author"`
+	ItunesAuthor     string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>author"`
+	Subtitle         string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>subtitle"`
+	Summary          string           `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>summary"`
+	PodcastOwner     PodcastOwner     `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd channel>owner"`
+	GooglePlayAuthor string           `xml:"http://www.google.com/schemas/play-podcasts/1.0 channel>author"`
+	PodcastFunding   []PodcastFunding `xml:"https://podcastindex.org/namespace/1.0 channel>funding"`
+	PodcastPersons   []PodcastPerson  `xml:"https://podcastindex.org/namespace/1.0 channel>person"`
 }
 
-// PodcastEntryElement represents iTunes and GooglePlay entry XML elements.
+// PodcastEntryElement represents iTunes, GooglePlay and Podcasting 2.0 entry XML elements.
+// Podcasting 2.0 specs: https://github.com/Podcastindex-org/podcast-namespace
 type PodcastEntryElement struct {
-	Subtitle              string `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd subtitle"`
-	Summary               string `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd summary"`
-	GooglePlayDescription string `xml:"http://www.google.com/schemas/play-podcasts/1.0 description"`
+	Subtitle              string               `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd subtitle"`
+	Summary               string               `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd summary"`
+	ItunesDuration        string               `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd duration"`
+	ItunesImage           Image                `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd image"`
+	ItunesSeason          string               `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd season"`
+	ItunesEpisode         string               `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd episode"`
+	ItunesEpisodeType     string               `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd episodeType"`
+	GooglePlayDescription string               `xml:"http://www.google.com/schemas/play-podcasts/1.0 description"`
+	PodcastChapters       PodcastChapters      `xml:"https://podcastindex.org/namespace/1.0 chapters"`
+	PodcastTranscripts    []PodcastTranscript  `xml:"https://podcastindex.org/namespace/1.0 transcript"`
+	PodcastPersons        []PodcastPerson      `xml:"https://podcastindex.org/namespace/1.0 person"`
+	PodcastFunding        []PodcastFunding     `xml:"https://podcastindex.org/namespace/1.0 funding"`
+	PodcastSeason         PodcastSeason        `xml:"https://podcastindex.org/namespace/1.0 season"`
+	PodcastEpisodeNumber  PodcastEpisodeNumber `xml:"https://podcastindex.org/namespace/1.0 episode"`
+}
+
+// PodcastChapters represents the link to the chapters file of an episode.
+type PodcastChapters struct {
+	URL  string `xml:"url,attr"`
+	Type string `xml:"type,attr"`
+}
+
+// PodcastTranscript represents the link to a transcript of an episode.
+type PodcastTranscript struct {
+	URL      string `xml:"url,attr"`
+	Type     string `xml:"type,attr"`
+	Language string `xml:"language,attr"`
+	Rel      string `xml:"rel,attr"`
+}
+
+// PodcastPerson represents a person of interest to the podcast or to an episode.
+type PodcastPerson struct {
+	Name  string `xml:",chardata"`
+	Role  string `xml:"role,attr"`
+	Group string `xml:"group,attr"`
+	Image string `xml:"img,attr"`
+	Href  string `xml:"href,attr"`
+}
+
+// PodcastFunding represents a donation link.
+type PodcastFunding struct {
+	URL   string `xml:"url,attr"`
+	Title string `xml:",chardata"`
+}
+
+// PodcastSeason represents the season of an episode.
+type PodcastSeason struct {
+	Number string `xml:",chardata"`
+	Name   string `xml:"name,attr"`
+}
+
+// PodcastEpisodeNumber represents the episode number, the display attribute replaces the number when it is defined.
+type PodcastEpisodeNumber struct {
+	Number  string `xml:",chardata"`
+	Display string `xml:"display,attr"`
 }
 
 // PodcastOwner represents contact information for the podcast owner.
@@ -68,3 +128,106 @@ func (e *PodcastEntryElement) PodcastDescription() string {
 	}
 	return strings.TrimSpace(description)
 }
+
+// PodcastDuration returns the duration of the episode in seconds.
+// The iTunes duration can be formatted as seconds, MM:SS or HH:MM:SS.
+func (e *PodcastEntryElement) PodcastDuration() int {
+	value := strings.TrimSpace(e.ItunesDuration)
+	if value == "" {
+		return 0
+	}
+
+	duration := 0
+	for _, part := range strings.Split(value, ":") {
+		number, err := strconv.ParseFloat(part, 64)
+		if err != nil || number < 0 {
+			return 0
+		}
+		duration = duration*60 + int(number)
+	}
+
+	return duration
+}
+
+// PodcastImage returns the artwork of the episode.
+func (e *PodcastEntryElement) PodcastImage() string {
+	return strings.TrimSpace(e.ItunesImage.URL)
+}
+
+// PodcastEpisode returns the podcast metadata of the entry, nil is returned when the entry doesn't have any.
+func (e *PodcastEntryElement) PodcastEpisode() *model.PodcastEpisode {
+	episode := &model.PodcastEpisode{
+		EpisodeType: strings.TrimSpace(e.ItunesEpisodeType),
+		SeasonName:  strings.TrimSpace(e.PodcastSeason.Name),
+		Persons:     podcastPersons(e.PodcastPersons),
+		Funding:     podcastFunding(e.PodcastFunding),
+	}
+
+	for _, value := range []string{e.PodcastSeason.Number, e.ItunesSeason} {
+		if season, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && season > 0 {
+			episode.Season = season
+			break
+		}
+	}
+
+	for _, value := range []string{e.PodcastEpisodeNumber.Display, e.PodcastEpisodeNumber.Number, e.ItunesEpisode} {
+		if value = strings.TrimSpace(value); value != "" {
+			episode.Episode = value
+			break
+		}
+	}
+
+	if chaptersURL := strings.TrimSpace(e.PodcastChapters.URL); chaptersURL != "" {
+		episode.ChaptersURL = chaptersURL
+	}
+
+	for _, transcript := range e.PodcastTranscripts {
+		if transcriptURL := strings.TrimSpace(transcript.URL); transcriptURL != "" {
+			episode.Transcripts = append(episode.Transcripts, &model.PodcastTranscript{
+				URL:      transcriptURL,
+				MimeType: strings.TrimSpace(transcript.Type),
+				Language: strings.TrimSpace(transcript.Language),
+				Rel:      strings.TrimSpace(transcript.Rel),
+			})
+		}
+	}
+
+	if episode.Season == 0 && episode.SeasonName == "" && episode.Episode == "" && episode.EpisodeType == "" &&
+		episode.ChaptersURL == "" && len(episode.Transcripts) == 0 && len(episode.Persons) == 0 && len(episode.Funding) == 0 {
+		return nil
+	}
+
+	return episode
+}
+
+func podcastPersons(persons []PodcastPerson) []*model.PodcastPerson {
+	var result []*model.PodcastPerson
+	for _, person := range persons {
+		if name := strings.TrimSpace(person.Name); name != "" {
+			result = append(result, &model.PodcastPerson{
+				Name:     name,
+				Role:     strings.TrimSpace(person.Role),
+				Group:    strings.TrimSpace(person.Group),
+				URL:      strings.TrimSpace(person.Href),
+				ImageURL: strings.TrimSpace(person.Image),
+			})
+		}
+	}
+
+	return result
+}
+
+func podcastFunding(funding []PodcastFunding) []*model.PodcastFunding {
+	var result []*model.PodcastFunding
+	for _, link := range funding {
+		if fundingURL := strings.TrimSpace(link.URL); fundingURL != "" {
+			title := strings.TrimSpace(link.Title)
+			if title == "" {
+				title = fundingURL
+			}
+			result = append(result, &model.PodcastFunding{URL: fundingURL, Title: title})
+		}
+	}
+
+	return result
+}
@@ -63,6 +63,17 @@ func (r *rssFeed) Transform(baseURL string) *model.Feed {
 			entry.Author = r.feedAuthor()
 		}
 
+		// Persons and donation links are usually defined once for the whole podcast.
+		if entry.Podcast != nil {
+			if len(entry.Podcast.Persons) == 0 {
+				entry.Podcast.Persons = podcastPersons(r.PodcastPersons)
+			}
+
+			if len(entry.Podcast.Funding) == 0 {
+				entry.Podcast.Funding = podcastFunding(r.PodcastFunding)
+			}
+		}
+
 		if entry.URL == "" {
 			entry.URL = feed.SiteURL
 		} else {
@@ -139,6 +150,11 @@ type rssTitle struct {
 	Inner   string `xml:",innerxml"`
 }
 
+type rssCategory struct {
+	XMLName xml.Name
+	Data    string `xml:",chardata"`
+}
+
 type rssEnclosure struct {
 	URL    string `xml:"url,attr"`
 	Type   string `xml:"type,attr"`
@@ -162,6 +178,7 @@ type rssItem struct {
 	Authors        []rssAuthor      `xml:"author"`
 	CommentLinks   []rssCommentLink `xml:"comments"`
 	EnclosureLinks []rssEnclosure   `xml:"enclosure"`
+	Categories     []rssCategory    `xml:"category"`
 	DublinCoreElement
 	FeedBurnerElement
 	PodcastEntryElement
@@ -178,6 +195,9 @@ func (r *rssItem) Transform() *model.Entry {
 	entry.Content = r.entryContent()
 	entry.Title = r.entryTitle()
 	entry.Enclosures = r.entryEnclosures()
+	entry.Tags = r.entryTags()
+	entry.ImageURL = r.PodcastImage()
+	entry.Podcast = r.PodcastEpisode()
 	return entry
 }
 
@@ -239,6 +259,26 @@ func (r *rssItem) entryHash() string {
 	return ""
 }
 
+func (r *rssItem) entryTags() []string {
+	var tags []string
+	seen := make(map[string]bool)
+
+	for _, category := range r.Categories {
+		// Categories of other namespaces, like the media RSS one, are not labels.
+		if category.XMLName.Space != "" {
+			continue
+		}
+
+		tag := strings.TrimSpace(category.Data)
+		if tag != "" && !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
 func (r *rssItem) entryTitle() string {
 	var title string
 
@@ -323,6 +363,7 @@ func (r *rssItem) entryEnclosures() model.EnclosureList {
 				URL:      enclosureURL,
 				MimeType: enclosure.Type,
 				Size:     enclosure.Size(),
+				Duration: r.PodcastDuration(),
 			})
 		}
 	}
@@ -0,0 +1,203 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package scraper // import "miniflux.app/reader/scraper"
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"miniflux.app/crypto"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/date"
+	"miniflux.app/url"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// maxTitleLength is the maximum length of the titles generated from the content of an item.
+const maxTitleLength = 100
+
+// ErrNoItems is returned when the item selector doesn't match anything in the web page.
+var ErrNoItems = errors.New("scraper: no item found with this selector")
+
+// ParseFeed extracts the entries of a web page with the given CSS selectors.
+//
+// Each element matched by the item selector becomes an entry. The other selectors are applied
+// inside the item; when they are empty, the first link of the item is used for the URL and
+// the title, the whole item is used for the content and the entry is dated from now.
+func ParseFeed(pageURL string, page io.Reader, selectors *model.FeedSelectors) (*model.Feed, error) {
+	if selectors == nil || strings.TrimSpace(selectors.Item) == "" {
+		return nil, errors.New("scraper: the item selector is mandatory")
+	}
+
+	document, err := goquery.NewDocumentFromReader(page)
+	if err != nil {
+		return nil, fmt.Errorf("scraper: unable to parse web page: %v", err)
+	}
+
+	feed := &model.Feed{
+		FeedURL: pageURL,
+		SiteURL: pageURL,
+		Title:   strings.TrimSpace(document.Find("head title").First().Text()),
+	}
+
+	if feed.Title == "" {
+		feed.Title = pageURL
+	}
+
+	// Relative links are resolved against the base element when the page defines one.
+	baseURL := pageURL
+	if href, found := document.Find("head base[href]").First().Attr("href"); found {
+		if absoluteURL, err := url.AbsoluteURL(pageURL, href); err == nil {
+			baseURL = absoluteURL
+		}
+	}
+
+	document.Find(selectors.Item).Each(func(i int, item *goquery.Selection) {
+		if entry := parseItem(baseURL, item, selectors); entry != nil {
+			if entry.URL == "" {
+				entry.URL = pageURL
+			}
+			feed.Entries = append(feed.Entries, entry)
+		}
+	})
+
+	if len(feed.Entries) == 0 {
+		return nil, ErrNoItems
+	}
+
+	return feed, nil
+}
+
+func parseItem(baseURL string, item *goquery.Selection, selectors *model.FeedSelectors) *model.Entry {
+	entryURL, link := itemLink(baseURL, item, selectors.Link)
+	entryDate, dated := itemDate(item, selectors.Date)
+	entry := &model.Entry{
+		URL:     entryURL,
+		Title:   itemText(item, selectors.Title),
+		Content: itemContent(item, selectors.Content),
+		Date:    entryDate,
+	}
+
+	if entry.Title == "" && selectors.Title == "" {
+		entry.Title = truncate(link.Text())
+	}
+
+	if entry.Title == "" {
+		entry.Title = truncate(item.Text())
+	}
+
+	if entry.URL == "" && entry.Title == "" {
+		return nil
+	}
+
+	if entry.Title == "" {
+		entry.Title = entry.URL
+	}
+
+	// The link identifies the entry. Items without link are identified by their title and their date,
+	// the content is left out because it often contains counters or relative dates that change on each visit.
+	switch {
+	case entry.URL != "":
+		entry.Hash = crypto.Hash(entry.URL)
+	case dated:
+		entry.Hash = crypto.Hash(strings.ToLower(entry.Title) + entry.Date.UTC().Format(time.RFC3339))
+	default:
+		entry.Hash = crypto.Hash(strings.ToLower(entry.Title))
+	}
+
+	return entry
+}
+
+func itemLink(baseURL string, item *goquery.Selection, selector string) (string, *goquery.Selection) {
+	var link *goquery.Selection
+	switch {
+	case selector != "":
+		link = item.Find(selector).First()
+	case goquery.NodeName(item) == "a":
+		link = item
+	default:
+		link = item.Find("a[href]").First()
+	}
+
+	// The selector can also point to a parent of the link.
+	if _, found := link.Attr("href"); !found {
+		link = link.Find("a[href]").First()
+	}
+
+	href := strings.TrimSpace(link.AttrOr("href", ""))
+	if href == "" || strings.HasPrefix(href, "#") || strings.HasPrefix(strings.ToLower(href), "javascript:") {
+		return "", link
+	}
+
+	absoluteURL, err := url.AbsoluteURL(baseURL, href)
+	if err != nil {
+		return "", link
+	}
+
+	return absoluteURL, link
+}
+
+func itemText(item *goquery.Selection, selector string) string {
+	if selector == "" {
+		return ""
+	}
+
+	return strings.Join(strings.Fields(item.Find(selector).First().Text()), " ")
+}
+
+func itemContent(item *goquery.Selection, selector string) string {
+	content := item
+	if selector != "" {
+		content = item.Find(selector)
+	}
+
+	var contents string
+	content.Each(func(i int, s *goquery.Selection) {
+		html, _ := s.Html()
+		contents += html
+	})
+
+	return strings.TrimSpace(contents)
+}
+
+// itemDate returns the date of the item, the current time is returned when the page doesn't provide a valid date.
+func itemDate(item *goquery.Selection, selector string) (time.Time, bool) {
+	if selector == "" {
+		return time.Now(), false
+	}
+
+	element := item.Find(selector).First()
+	value, found := element.Attr("datetime")
+	if !found {
+		value = element.Text()
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Now(), false
+	}
+
+	result, err := date.Parse(value)
+	if err != nil {
+		logger.Debug("[Scraper] %v", err)
+		return time.Now(), false
+	}
+
+	return result, true
+}
+
+func truncate(str string) string {
+	runes := []rune(strings.Join(strings.Fields(str), " "))
+	if len(runes) > maxTitleLength {
+		return string(runes[:maxTitleLength]) + "..."
+	}
+
+	return string(runes)
+}
@@ -0,0 +1,192 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package scraper // import "miniflux.app/reader/scraper"
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/model"
+)
+
+const scrapedPage = `<!DOCTYPE html>
+<html>
+<head><title>Example News</title></head>
+<body>
+	<div class="post">
+		<h2><a href="/posts/1">First   post</a></h2>
+		<time datetime="2021-01-02T10:00:00Z">January 2</time>
+		<div class="summary"><p>Summary of the <b>first</b> post.</p></div>
+	</div>
+	<div class="post">
+		<h2><a href="https://example.org/posts/2">Second post</a></h2>
+		<time>Sun, 03 Jan 2021 10:00:00 GMT</time>
+		<div class="summary"><p>Summary of the second post.</p></div>
+	</div>
+	<div class="post"></div>
+</body>
+</html>`
+
+func TestParseFeedWithSelectors(t *testing.T) {
+	selectors := &model.FeedSelectors{
+		Item:    "div.post",
+		Title:   "h2",
+		Link:    "h2 a",
+		Date:    "time",
+		Content: ".summary",
+	}
+
+	feed, err := ParseFeed("https://example.org/news", strings.NewReader(scrapedPage), selectors)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != "Example News" {
+		t.Errorf(`Unexpected feed title, got %q`, feed.Title)
+	}
+
+	if feed.SiteURL != "https://example.org/news" {
+		t.Errorf(`Unexpected site URL, got %q`, feed.SiteURL)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf(`Unexpected number of entries, got %d`, len(feed.Entries))
+	}
+
+	entry := feed.Entries[0]
+	if entry.Title != "First post" {
+		t.Errorf(`Unexpected entry title, got %q`, entry.Title)
+	}
+
+	if entry.URL != "https://example.org/posts/1" {
+		t.Errorf(`Unexpected entry URL, got %q`, entry.URL)
+	}
+
+	if !entry.Date.Equal(time.Date(2021, 1, 2, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf(`Unexpected entry date, got %v`, entry.Date)
+	}
+
+	if entry.Content != "<p>Summary of the <b>first</b> post.</p>" {
+		t.Errorf(`Unexpected entry content, got %q`, entry.Content)
+	}
+
+	if entry.Hash == "" || entry.Hash == feed.Entries[1].Hash {
+		t.Errorf(`Unexpected entry hash, got %q`, entry.Hash)
+	}
+
+	if !feed.Entries[1].Date.Equal(time.Date(2021, 1, 3, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf(`Unexpected entry date, got %v`, feed.Entries[1].Date)
+	}
+}
+
+func TestParseFeedWithItemSelectorOnly(t *testing.T) {
+	feed, err := ParseFeed("https://example.org/news", strings.NewReader(scrapedPage), &model.FeedSelectors{Item: "div.post"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf(`Unexpected number of entries, got %d`, len(feed.Entries))
+	}
+
+	entry := feed.Entries[1]
+	if entry.Title != "Second post" {
+		t.Errorf(`The title should be the text of the first link, got %q`, entry.Title)
+	}
+
+	if entry.URL != "https://example.org/posts/2" {
+		t.Errorf(`Unexpected entry URL, got %q`, entry.URL)
+	}
+
+	if !strings.Contains(entry.Content, `<div class="summary">`) {
+		t.Errorf(`The content should be the whole item, got %q`, entry.Content)
+	}
+}
+
+func TestParseFeedWithLinkItems(t *testing.T) {
+	page := `<html><head><base href="https://cdn.example.org/blog/"></head><body>
+		<ul><li><a href="a.html">A</a></li><li><a href="#top">Top</a></li><li><a href="javascript:void(0)"></a></li></ul>
+	</body></html>`
+
+	feed, err := ParseFeed("https://example.org/", strings.NewReader(page), &model.FeedSelectors{Item: "li a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf(`Unexpected number of entries, got %d`, len(feed.Entries))
+	}
+
+	if feed.Entries[0].URL != "https://cdn.example.org/blog/a.html" {
+		t.Errorf(`Relative links should be resolved with the base element, got %q`, feed.Entries[0].URL)
+	}
+
+	if feed.Entries[1].URL != "https://example.org/" || feed.Entries[1].Title != "Top" {
+		t.Errorf(`Items without a valid link should use the page URL, got %q`, feed.Entries[1].URL)
+	}
+
+	if feed.Title != "https://example.org/" {
+		t.Errorf(`The page URL should be used when there is no title, got %q`, feed.Title)
+	}
+}
+
+func TestParseFeedHashOfItemsWithoutLink(t *testing.T) {
+	selectors := &model.FeedSelectors{Item: "div.post", Title: "h2", Date: "time", Content: "p"}
+	parse := func(page string) *model.Entry {
+		feed, err := ParseFeed("https://example.org/news", strings.NewReader(page), selectors)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return feed.Entries[0]
+	}
+
+	entry := parse(`<div class="post"><h2>Release</h2><time>2021-01-02</time><p>Viewed 10 times</p></div>`)
+	if entry.URL != "https://example.org/news" {
+		t.Errorf(`Unexpected entry URL, got %q`, entry.URL)
+	}
+
+	if hash := parse(`<div class="other"></div><div class="post"><h2>Release</h2><time>2021-01-02</time><p>Viewed 11 times</p></div>`).Hash; hash != entry.Hash {
+		t.Errorf(`The hash should not depend on the content or the position of the item`)
+	}
+
+	if hash := parse(`<div class="post"><h2>Release</h2><time>2021-02-03</time><p>Viewed 10 times</p></div>`).Hash; hash == entry.Hash {
+		t.Errorf(`The hash should depend on the date of the item`)
+	}
+
+	undated := parse(`<div class="post"><h2>Release</h2><p>Viewed 10 times</p></div>`)
+	if hash := parse(`<div class="post"><h2>Release</h2><p>Viewed 12 times</p></div>`).Hash; hash != undated.Hash {
+		t.Errorf(`The hash of items without date should depend on the title only`)
+	}
+}
+
+func TestParseFeedWithoutItems(t *testing.T) {
+	_, err := ParseFeed("https://example.org/news", strings.NewReader(scrapedPage), &model.FeedSelectors{Item: "article"})
+	if err != ErrNoItems {
+		t.Errorf(`Parsing a page without items should fail, got %v`, err)
+	}
+
+	_, err = ParseFeed("https://example.org/news", strings.NewReader(scrapedPage), &model.FeedSelectors{Item: "div[["})
+	if err != ErrNoItems {
+		t.Errorf(`Parsing a page with an invalid selector should fail, got %v`, err)
+	}
+}
+
+func TestParseFeedWithoutItemSelector(t *testing.T) {
+	if _, err := ParseFeed("https://example.org/news", strings.NewReader(scrapedPage), &model.FeedSelectors{}); err == nil {
+		t.Error(`Parsing a page without item selector should fail`)
+	}
+
+	if _, err := ParseFeed("https://example.org/news", strings.NewReader(scrapedPage), nil); err == nil {
+		t.Error(`Parsing a page without selectors should fail`)
+	}
+}
+
+func TestTruncateTitle(t *testing.T) {
+	title := truncate(strings.Repeat("é", 150))
+	if title != strings.Repeat("é", 100)+"..." {
+		t.Errorf(`Unexpected title, got %q`, title)
+	}
+}
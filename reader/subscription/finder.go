@@ -14,6 +14,7 @@ import (
 	"miniflux.app/errors"
 	"miniflux.app/http/client"
 	"miniflux.app/reader/browser"
+	"miniflux.app/reader/microformats"
 	"miniflux.app/reader/parser"
 	"miniflux.app/url"
 
@@ -45,7 +46,8 @@ func FindSubscriptions(websiteURL, userAgent, username, password string, fetchVi
 	}
 
 	body := response.BodyAsString()
-	if format := parser.DetectFeedFormat(body); format != parser.FormatUnknown {
+	format := parser.DetectFeedFormat(body)
+	if format != parser.FormatUnknown && format != parser.FormatMicroformats {
 		var subscriptions Subscriptions
 		subscriptions = append(subscriptions, &Subscription{
 			Title: response.EffectiveURL,
@@ -57,8 +59,21 @@ func FindSubscriptions(websiteURL, userAgent, username, password string, fetchVi
 	}
 
 	subscriptions, err := parseWebPage(response.EffectiveURL, strings.NewReader(body))
-	if err != nil || subscriptions != nil {
-		return subscriptions, err
+	if err != nil {
+		return nil, err
+	}
+
+	// The page itself can be followed when it publishes a h-feed.
+	if format == parser.FormatMicroformats && microformats.HasFeed(strings.NewReader(body)) {
+		subscriptions = append(subscriptions, &Subscription{
+			Title: response.EffectiveURL,
+			URL:   response.EffectiveURL,
+			Type:  format,
+		})
+	}
+
+	if subscriptions != nil {
+		return subscriptions, nil
 	}
 
 	return tryWellKnownUrls(websiteURL, userAgent, username, password)
@@ -0,0 +1,173 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package watcher // import "miniflux.app/reader/watcher"
+
+import (
+	"html"
+	"strings"
+)
+
+const (
+	// contextLines is the number of unchanged lines displayed around each change.
+	contextLines = 2
+
+	// maxDiffCells limits the memory used to compare the lines, larger changes are displayed as a whole replacement.
+	maxDiffCells = 4 * 1024 * 1024
+)
+
+type operation int
+
+const (
+	opEqual operation = iota
+	opDelete
+	opInsert
+)
+
+type diffLine struct {
+	op   operation
+	text string
+}
+
+// Diff returns an HTML document showing the lines removed and added between two snapshots,
+// surrounded by a few unchanged lines. An empty string is returned when the snapshots are identical.
+func Diff(previous, current string) string {
+	if previous == current {
+		return ""
+	}
+
+	lines := diffLines(splitLines(previous), splitLines(current))
+
+	// Only the changes and their context are displayed.
+	visible := make([]bool, len(lines))
+	for i, line := range lines {
+		if line.op == opEqual {
+			continue
+		}
+
+		for j := i - contextLines; j <= i+contextLines; j++ {
+			if j >= 0 && j < len(lines) {
+				visible[j] = true
+			}
+		}
+	}
+
+	var buffer strings.Builder
+	skipped := false
+	for i, line := range lines {
+		if !visible[i] {
+			skipped = true
+			continue
+		}
+
+		if skipped && buffer.Len() > 0 {
+			buffer.WriteString("<hr>")
+		}
+		skipped = false
+
+		text := html.EscapeString(line.text)
+		switch line.op {
+		case opDelete:
+			buffer.WriteString("<p><del>" + text + "</del></p>")
+		case opInsert:
+			buffer.WriteString("<p><ins>" + text + "</ins></p>")
+		default:
+			buffer.WriteString("<p>" + text + "</p>")
+		}
+	}
+
+	return buffer.String()
+}
+
+func splitLines(snapshot string) []string {
+	if snapshot == "" {
+		return nil
+	}
+
+	return strings.Split(snapshot, "\n")
+}
+
+// diffLines compares the lines with the longest common subsequence, after removing the common prefix and suffix.
+func diffLines(a, b []string) []diffLine {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var result []diffLine
+	for _, text := range a[:prefix] {
+		result = append(result, diffLine{opEqual, text})
+	}
+
+	result = append(result, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+
+	for _, text := range a[len(a)-suffix:] {
+		result = append(result, diffLine{opEqual, text})
+	}
+
+	return result
+}
+
+func diffMiddle(a, b []string) []diffLine {
+	var result []diffLine
+
+	if (len(a)+1)*(len(b)+1) > maxDiffCells {
+		for _, text := range a {
+			result = append(result, diffLine{opDelete, text})
+		}
+		for _, text := range b {
+			result = append(result, diffLine{opInsert, text})
+		}
+		return result
+	}
+
+	// lengths[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lengths := make([][]int32, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int32, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			result = append(result, diffLine{opEqual, a[i]})
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			result = append(result, diffLine{opDelete, a[i]})
+			i++
+		default:
+			result = append(result, diffLine{opInsert, b[j]})
+			j++
+		}
+	}
+
+	for ; i < len(a); i++ {
+		result = append(result, diffLine{opDelete, a[i]})
+	}
+
+	for ; j < len(b); j++ {
+		result = append(result, diffLine{opInsert, b[j]})
+	}
+
+	return result
+}
@@ -0,0 +1,44 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package watcher // import "miniflux.app/reader/watcher"
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiffWithIdenticalSnapshots(t *testing.T) {
+	if result := Diff("a\nb", "a\nb"); result != "" {
+		t.Errorf(`Identical snapshots should not have a diff, got %q`, result)
+	}
+}
+
+func TestDiffWithChangedLine(t *testing.T) {
+	result := Diff("Title\nStarter: 10$\nPro: 20$", "Title\nStarter: 12$\nPro: 20$")
+	expected := "<p>Title</p><p><del>Starter: 10$</del></p><p><ins>Starter: 12$</ins></p><p>Pro: 20$</p>"
+	if result != expected {
+		t.Errorf(`Unexpected diff, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDiffOnlyShowsContext(t *testing.T) {
+	previous := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12"
+	current := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13"
+	current = strings.Replace(current, "2\n", "two\n", 1)
+
+	result := Diff(previous, current)
+	expected := "<p>1</p><p><del>2</del></p><p><ins>two</ins></p><p>3</p><p>4</p><hr><p>11</p><p>12</p><p><ins>13</ins></p>"
+	if result != expected {
+		t.Errorf(`Unexpected diff, got %q instead of %q`, result, expected)
+	}
+}
+
+func TestDiffEscapesText(t *testing.T) {
+	result := Diff("", "<script>alert(1)</script>")
+	expected := "<p><ins>&lt;script&gt;alert(1)&lt;/script&gt;</ins></p>"
+	if result != expected {
+		t.Errorf(`Unexpected diff, got %q instead of %q`, result, expected)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package watcher // import "miniflux.app/reader/watcher"
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+)
+
+// ErrSelectorNotFound is returned when the selector doesn't match anything in the web page.
+var ErrSelectorNotFound = errors.New("watcher: no element found with this selector")
+
+// Elements starting a new line of text.
+var blockElements = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true, "br": true, "dd": true,
+	"details": true, "div": true, "dl": true, "dt": true, "fieldset": true, "figcaption": true,
+	"figure": true, "footer": true, "form": true, "h1": true, "h2": true, "h3": true,
+	"h4": true, "h5": true, "h6": true, "header": true, "hr": true, "li": true,
+	"main": true, "nav": true, "ol": true, "p": true, "pre": true, "section": true,
+	"summary": true, "table": true, "tr": true, "ul": true,
+}
+
+// Snapshot returns the title of the web page and its normalized text, one line per block of text.
+//
+// Only the elements matched by the selector are kept when it is not empty, otherwise the whole body is used.
+// Scripts and styles are ignored and the whitespaces are collapsed, so the snapshot only changes with the visible text.
+func Snapshot(page io.Reader, selector string) (title, text string, err error) {
+	document, err := goquery.NewDocumentFromReader(page)
+	if err != nil {
+		return "", "", fmt.Errorf("watcher: unable to parse web page: %v", err)
+	}
+
+	title = strings.TrimSpace(document.Find("head title").First().Text())
+	document.Find("script, style, noscript, template").Remove()
+
+	selection := document.Find("body")
+	if selector != "" {
+		selection = document.Find(selector)
+		if selection.Length() == 0 {
+			return "", "", ErrSelectorNotFound
+		}
+	}
+
+	var lines []string
+	var line strings.Builder
+	flush := func() {
+		if text := strings.Join(strings.Fields(line.String()), " "); text != "" {
+			lines = append(lines, text)
+		}
+		line.Reset()
+	}
+
+	var walk func(node *html.Node)
+	walk = func(node *html.Node) {
+		switch node.Type {
+		case html.TextNode:
+			line.WriteString(node.Data)
+		case html.ElementNode:
+			isBlock := blockElements[node.Data]
+			if isBlock {
+				flush()
+			}
+
+			for child := node.FirstChild; child != nil; child = child.NextSibling {
+				walk(child)
+			}
+
+			if isBlock {
+				flush()
+			} else if node.Data == "td" || node.Data == "th" {
+				line.WriteString(" ")
+			}
+		}
+	}
+
+	for _, node := range selection.Nodes {
+		walk(node)
+		flush()
+	}
+
+	return title, strings.Join(lines, "\n"), nil
+}
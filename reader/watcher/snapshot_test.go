@@ -0,0 +1,63 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package watcher // import "miniflux.app/reader/watcher"
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSnapshotNormalizesText(t *testing.T) {
+	page := `<html>
+		<head><title>Pricing</title><style>body { color: red; }</style></head>
+		<body>
+			<h1>  Pricing
+				plans </h1>
+			<script>var price = 42;</script>
+			<p>Starter: <b>10</b>$ per month<br>Billed yearly</p>
+			<ul><li>One</li><li>Two</li></ul>
+			<table><tr><td>Pro</td><td>20$</td></tr></table>
+		</body>
+	</html>`
+
+	title, snapshot, err := Snapshot(strings.NewReader(page), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if title != "Pricing" {
+		t.Errorf(`Unexpected title, got %q`, title)
+	}
+
+	expected := "Pricing plans\nStarter: 10$ per month\nBilled yearly\nOne\nTwo\nPro 20$"
+	if snapshot != expected {
+		t.Errorf(`Unexpected snapshot, got %q instead of %q`, snapshot, expected)
+	}
+}
+
+func TestSnapshotWithSelector(t *testing.T) {
+	page := `<html><body>
+		<nav>Home - Blog</nav>
+		<div class="changelog"><p>v2.0</p></div>
+		<footer>Updated 5 minutes ago</footer>
+	</body></html>`
+
+	_, snapshot, err := Snapshot(strings.NewReader(page), ".changelog")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if snapshot != "v2.0" {
+		t.Errorf(`Unexpected snapshot, got %q`, snapshot)
+	}
+}
+
+func TestSnapshotWithUnknownSelector(t *testing.T) {
+	page := `<html><body><p>Text</p></body></html>`
+
+	if _, _, err := Snapshot(strings.NewReader(page), ".missing"); err != ErrSelectorNotFound {
+		t.Errorf(`Unexpected error, got %v`, err)
+	}
+}
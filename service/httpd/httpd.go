@@ -18,6 +18,7 @@ import (
 	"miniflux.app/fever"
 	"miniflux.app/http/request"
 	"miniflux.app/logger"
+	"miniflux.app/newsletter"
 	"miniflux.app/storage"
 	"miniflux.app/ui"
 	"miniflux.app/version"
@@ -182,6 +183,11 @@ func setupHandler(store *storage.Storage, pool *worker.Pool) *mux.Router {
 
 	fever.Serve(router, store)
 	api.Serve(router, store, pool)
+
+	if config.Opts.HasNewsletterWebhook() {
+		newsletter.Serve(router, store)
+	}
+
 	ui.Serve(router, store, pool)
 
 	router.HandleFunc("/healthcheck", func(w http.ResponseWriter, r *http.Request) {
@@ -15,9 +15,10 @@ import (
 
 func middleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		clientIP := request.FindClientIP(r)
+		clientIP := request.FindClientIPWithTrustedProxies(r, config.Opts.TrustedProxies())
 		ctx := r.Context()
 		ctx = context.WithValue(ctx, request.ClientIPContextKey, clientIP)
+		ctx = context.WithValue(ctx, request.TrustedClientIPContextKey, request.FindTrustedClientIP(r, config.Opts.TrustedProxies()))
 
 		if r.Header.Get("X-Forwarded-Proto") == "https" {
 			config.Opts.HTTPS = true
@@ -11,6 +11,8 @@ import (
 	"miniflux.app/logger"
 	"miniflux.app/metric"
 	"miniflux.app/model"
+	"miniflux.app/proxy"
+	"miniflux.app/reader/opml"
 	"miniflux.app/storage"
 	"miniflux.app/worker"
 )
@@ -26,12 +28,18 @@ func Serve(store *storage.Storage, pool *worker.Pool) {
 		config.Opts.BatchSize(),
 	)
 
+	go opmlSourceScheduler(
+		store,
+		config.Opts.OPMLSourceFrequency(),
+	)
+
 	go cleanupScheduler(
 		store,
 		config.Opts.CleanupFrequencyHours(),
 		config.Opts.CleanupArchiveReadDays(),
 		config.Opts.CleanupArchiveUnreadDays(),
 		config.Opts.CleanupRemoveSessionsDays(),
+		config.Opts.CleanupRemoveAuditEventsDays(),
 	)
 }
 
@@ -47,11 +55,45 @@ func feedScheduler(store *storage.Storage, pool *worker.Pool, frequency, batchSi
 	}
 }
 
-func cleanupScheduler(store *storage.Storage, frequency, archiveReadDays, archiveUnreadDays, sessionsDays int) {
+func opmlSourceScheduler(store *storage.Storage, frequency int) {
+	for range time.Tick(time.Duration(frequency) * time.Minute) {
+		sources, err := store.AllOPMLSources()
+		if err != nil {
+			logger.Error("[Scheduler:OPMLSource] %v", err)
+			continue
+		}
+
+		logger.Debug("[Scheduler:OPMLSource] Synchronizing %d sources", len(sources))
+		for _, source := range sources {
+			if _, err := opml.NewHandler(store).SyncSource(source); err != nil {
+				logger.Error("[Scheduler:OPMLSource] %v", err)
+			}
+		}
+	}
+}
+
+func cleanupScheduler(store *storage.Storage, frequency, archiveReadDays, archiveUnreadDays, sessionsDays, auditEventsDays int) {
 	for range time.Tick(time.Duration(frequency) * time.Hour) {
 		nbSessions := store.CleanOldSessions(sessionsDays)
 		nbUserSessions := store.CleanOldUserSessions(sessionsDays)
-		logger.Info("[Scheduler:Cleanup] Cleaned %d sessions and %d user sessions", nbSessions, nbUserSessions)
+		nbLoginFailures := store.CleanOldLoginFailures(1)
+		logger.Info("[Scheduler:Cleanup] Cleaned %d sessions, %d user sessions and %d login failures", nbSessions, nbUserSessions, nbLoginFailures)
+
+		// The audit log is never emptied, 0 keeps all events like -1.
+		if auditEventsDays > 0 {
+			nbAuditEvents := store.CleanOldAuditEvents(auditEventsDays)
+			logger.Info("[Scheduler:Cleanup] Cleaned %d audit events", nbAuditEvents)
+		}
+
+		if config.Opts.HasProxyCache() {
+			cache := proxy.NewCache(config.Opts.ProxyCacheDir())
+			maxAge := time.Duration(config.Opts.ProxyCacheMaxAge()) * time.Hour
+			if nbFiles, err := cache.Clean(maxAge, config.Opts.ProxyCacheMaxSize()); err != nil {
+				logger.Error("[Scheduler:ProxyCache] %v", err)
+			} else {
+				logger.Info("[Scheduler:ProxyCache] Removed %d cached files", nbFiles)
+			}
+		}
 
 		startTime := time.Now()
 		if rowsAffected, err := store.ArchiveEntries(model.EntryStatusRead, archiveReadDays); err != nil {
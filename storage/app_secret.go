@@ -0,0 +1,27 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/crypto"
+)
+
+// AppSecret returns the secret stored under the given name, it is generated the first time.
+// The secret is stored in the database to be stable across restarts and shared by all instances.
+func (s *Storage) AppSecret(name string) (string, error) {
+	query := `INSERT INTO app_secrets (name, value) VALUES ($1, $2) ON CONFLICT (name) DO NOTHING`
+	if _, err := s.db.Exec(query, name, crypto.GenerateRandomStringHex(32)); err != nil {
+		return "", fmt.Errorf(`store: unable to create secret %q: %v`, name, err)
+	}
+
+	var value string
+	if err := s.db.QueryRow(`SELECT value FROM app_secrets WHERE name=$1`, name).Scan(&value); err != nil {
+		return "", fmt.Errorf(`store: unable to fetch secret %q: %v`, name, err)
+	}
+
+	return value, nil
+}
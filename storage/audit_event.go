@@ -0,0 +1,207 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"miniflux.app/model"
+)
+
+// CreateAuditEvent appends an event to the audit log.
+// The actor name is taken from the users table when only the actor ID is given.
+func (s *Storage) CreateAuditEvent(event *model.AuditEvent) error {
+	query := `
+		INSERT INTO audit_events
+			(actor_id, actor, user_id, client_ip, user_agent, action, target)
+		VALUES
+			($1, COALESCE(NULLIF($2, ''), (SELECT username FROM users WHERE id=$1), ''), $3, $4, $5, $6, $7)
+		RETURNING
+			id, actor, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		nullableID(event.ActorID),
+		event.Actor,
+		nullableID(event.UserID),
+		event.ClientIP,
+		event.UserAgent,
+		event.Action,
+		event.Target,
+	).Scan(&event.ID, &event.Actor, &event.CreatedAt)
+	if err != nil {
+		return fmt.Errorf(`store: unable to create audit event: %v`, err)
+	}
+
+	return nil
+}
+
+// NewAuditEventQueryBuilder returns a new AuditEventQueryBuilder.
+func (s *Storage) NewAuditEventQueryBuilder() *AuditEventQueryBuilder {
+	return NewAuditEventQueryBuilder(s)
+}
+
+// CleanOldAuditEvents removes audit events older than specified days.
+func (s *Storage) CleanOldAuditEvents(days int) int64 {
+	query := `DELETE FROM audit_events WHERE created_at < now() - interval '%d days'`
+	result, err := s.db.Exec(fmt.Sprintf(query, days))
+	if err != nil {
+		return 0
+	}
+
+	n, _ := result.RowsAffected()
+	return n
+}
+
+// AuditEventQueryBuilder builds a SQL query to fetch audit events.
+type AuditEventQueryBuilder struct {
+	store      *Storage
+	args       []interface{}
+	conditions []string
+	limit      int
+	offset     int
+}
+
+// WithUserID adds a condition on the actor or the account concerned by the events.
+func (a *AuditEventQueryBuilder) WithUserID(userID int64) *AuditEventQueryBuilder {
+	if userID != 0 {
+		a.conditions = append(a.conditions, fmt.Sprintf("(actor_id = $%d OR user_id = $%d)", len(a.args)+1, len(a.args)+1))
+		a.args = append(a.args, userID)
+	}
+	return a
+}
+
+// WithAction adds an action filter.
+func (a *AuditEventQueryBuilder) WithAction(action string) *AuditEventQueryBuilder {
+	if action != "" {
+		a.conditions = append(a.conditions, fmt.Sprintf("action = $%d", len(a.args)+1))
+		a.args = append(a.args, action)
+	}
+	return a
+}
+
+// WithClientIP adds a client IP address filter.
+func (a *AuditEventQueryBuilder) WithClientIP(clientIP string) *AuditEventQueryBuilder {
+	if clientIP != "" {
+		a.conditions = append(a.conditions, fmt.Sprintf("client_ip = $%d", len(a.args)+1))
+		a.args = append(a.args, clientIP)
+	}
+	return a
+}
+
+// BeforeDate adds a condition < created_at.
+func (a *AuditEventQueryBuilder) BeforeDate(date time.Time) *AuditEventQueryBuilder {
+	a.conditions = append(a.conditions, fmt.Sprintf("created_at < $%d", len(a.args)+1))
+	a.args = append(a.args, date)
+	return a
+}
+
+// AfterDate adds a condition > created_at.
+func (a *AuditEventQueryBuilder) AfterDate(date time.Time) *AuditEventQueryBuilder {
+	a.conditions = append(a.conditions, fmt.Sprintf("created_at > $%d", len(a.args)+1))
+	a.args = append(a.args, date)
+	return a
+}
+
+// WithLimit sets the limit.
+func (a *AuditEventQueryBuilder) WithLimit(limit int) *AuditEventQueryBuilder {
+	a.limit = limit
+	return a
+}
+
+// WithOffset sets the offset.
+func (a *AuditEventQueryBuilder) WithOffset(offset int) *AuditEventQueryBuilder {
+	a.offset = offset
+	return a
+}
+
+// CountEvents returns the number of events matching the conditions.
+func (a *AuditEventQueryBuilder) CountEvents() (count int, err error) {
+	query := `SELECT count(*) FROM audit_events WHERE %s`
+	err = a.store.db.QueryRow(fmt.Sprintf(query, a.buildCondition()), a.args...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to count audit events: %v`, err)
+	}
+
+	return count, nil
+}
+
+// GetEvents returns the events matching the conditions, the most recent first.
+func (a *AuditEventQueryBuilder) GetEvents() (model.AuditEvents, error) {
+	query := `
+		SELECT
+			id,
+			COALESCE(actor_id, 0),
+			actor,
+			COALESCE(user_id, 0),
+			client_ip,
+			user_agent,
+			action,
+			target,
+			created_at
+		FROM
+			audit_events
+		WHERE %s
+		ORDER BY created_at DESC, id DESC
+	`
+	query = fmt.Sprintf(query, a.buildCondition())
+
+	if a.limit > 0 {
+		query += fmt.Sprintf(` LIMIT %d`, a.limit)
+	}
+
+	if a.offset > 0 {
+		query += fmt.Sprintf(` OFFSET %d`, a.offset)
+	}
+
+	rows, err := a.store.db.Query(query, a.args...)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch audit events: %v`, err)
+	}
+	defer rows.Close()
+
+	events := make(model.AuditEvents, 0)
+	for rows.Next() {
+		var event model.AuditEvent
+		if err := rows.Scan(
+			&event.ID,
+			&event.ActorID,
+			&event.Actor,
+			&event.UserID,
+			&event.ClientIP,
+			&event.UserAgent,
+			&event.Action,
+			&event.Target,
+			&event.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch audit event row: %v`, err)
+		}
+
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
+
+func (a *AuditEventQueryBuilder) buildCondition() string {
+	if len(a.conditions) == 0 {
+		return "true"
+	}
+	return strings.Join(a.conditions, " AND ")
+}
+
+// NewAuditEventQueryBuilder returns a new AuditEventQueryBuilder.
+func NewAuditEventQueryBuilder(store *Storage) *AuditEventQueryBuilder {
+	return &AuditEventQueryBuilder{store: store}
+}
+
+func nullableID(id int64) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 
 	"miniflux.app/model"
+
+	"github.com/lib/pq"
 )
 
 // GetEnclosures returns all attachments for the given entry.
@@ -20,7 +22,9 @@ func (s *Storage) GetEnclosures(entryID int64) (model.EnclosureList, error) {
 			entry_id,
 			url,
 			size,
-			mime_type
+			mime_type,
+			duration,
+			media_progression
 		FROM
 			enclosures
 		WHERE
@@ -44,6 +48,8 @@ func (s *Storage) GetEnclosures(entryID int64) (model.EnclosureList, error) {
 			&enclosure.URL,
 			&enclosure.Size,
 			&enclosure.MimeType,
+			&enclosure.Duration,
+			&enclosure.MediaProgression,
 		)
 
 		if err != nil {
@@ -56,6 +62,59 @@ func (s *Storage) GetEnclosures(entryID int64) (model.EnclosureList, error) {
 	return enclosures, nil
 }
 
+// GetEnclosure returns an attachment of the given user, nil is returned when it doesn't exist.
+func (s *Storage) GetEnclosure(userID, enclosureID int64) (*model.Enclosure, error) {
+	query := `
+		SELECT
+			id,
+			user_id,
+			entry_id,
+			url,
+			size,
+			mime_type,
+			duration,
+			media_progression
+		FROM
+			enclosures
+		WHERE
+			id = $1 AND user_id = $2
+	`
+
+	var enclosure model.Enclosure
+	err := s.db.QueryRow(query, enclosureID, userID).Scan(
+		&enclosure.ID,
+		&enclosure.UserID,
+		&enclosure.EntryID,
+		&enclosure.URL,
+		&enclosure.Size,
+		&enclosure.MimeType,
+		&enclosure.Duration,
+		&enclosure.MediaProgression,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch enclosure #%d: %v`, enclosureID, err)
+	}
+
+	return &enclosure, nil
+}
+
+// UpdateEnclosure saves the playback position and the duration of an attachment.
+func (s *Storage) UpdateEnclosure(enclosure *model.Enclosure) error {
+	query := `UPDATE enclosures SET media_progression=$1, duration=$2 WHERE id=$3 AND user_id=$4`
+	_, err := s.db.Exec(query, enclosure.MediaProgression, enclosure.Duration, enclosure.ID, enclosure.UserID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to update enclosure #%d: %v`, enclosure.ID, err)
+	}
+
+	return nil
+}
+
+// createEnclosure inserts an attachment or refreshes the existing one with the same URL,
+// the playback position of the user is preserved.
 func (s *Storage) createEnclosure(tx *sql.Tx, enclosure *model.Enclosure) error {
 	if enclosure.URL == "" {
 		return nil
@@ -63,11 +122,15 @@ func (s *Storage) createEnclosure(tx *sql.Tx, enclosure *model.Enclosure) error
 
 	query := `
 		INSERT INTO enclosures
-			(url, size, mime_type, entry_id, user_id)
+			(url, size, mime_type, entry_id, user_id, duration)
 		VALUES
-			($1, $2, $3, $4, $5)
+			($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id, entry_id, md5(url)) DO UPDATE SET
+			size=EXCLUDED.size,
+			mime_type=EXCLUDED.mime_type,
+			duration=CASE WHEN EXCLUDED.duration > 0 THEN EXCLUDED.duration ELSE enclosures.duration END
 		RETURNING
-			id
+			id, media_progression
 	`
 	err := tx.QueryRow(
 		query,
@@ -76,7 +139,8 @@ func (s *Storage) createEnclosure(tx *sql.Tx, enclosure *model.Enclosure) error
 		enclosure.MimeType,
 		enclosure.EntryID,
 		enclosure.UserID,
-	).Scan(&enclosure.ID)
+		enclosure.Duration,
+	).Scan(&enclosure.ID, &enclosure.MediaProgression)
 
 	if err != nil {
 		return fmt.Errorf(`store: unable to create enclosure %q: %v`, enclosure.URL, err)
@@ -86,15 +150,20 @@ func (s *Storage) createEnclosure(tx *sql.Tx, enclosure *model.Enclosure) error
 }
 
 func (s *Storage) updateEnclosures(tx *sql.Tx, userID, entryID int64, enclosures model.EnclosureList) error {
-	// We delete all attachments in the transaction to keep only the ones visible in the feeds.
-	if _, err := tx.Exec(`DELETE FROM enclosures WHERE user_id=$1 AND entry_id=$2`, userID, entryID); err != nil {
-		return err
-	}
-
+	urls := make([]string, 0, len(enclosures))
 	for _, enclosure := range enclosures {
 		if err := s.createEnclosure(tx, enclosure); err != nil {
 			return err
 		}
+
+		urls = append(urls, enclosure.URL)
+	}
+
+	// We delete the attachments that are not visible in the feeds anymore.
+	// The other ones are updated in place to keep their playback position.
+	query := `DELETE FROM enclosures WHERE user_id=$1 AND entry_id=$2 AND url <> ALL($3)`
+	if _, err := tx.Exec(query, userID, entryID, pq.Array(urls)); err != nil {
+		return fmt.Errorf(`store: unable to delete old enclosures of entry #%d: %v`, entryID, err)
 	}
 
 	return nil
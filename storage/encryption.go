@@ -0,0 +1,397 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"miniflux.app/crypto"
+)
+
+// encryptedValuePrefix marks the values encrypted with a data key,
+// the format is "enc:v1:<data key ID>:<base64 nonce and ciphertext>".
+const encryptedValuePrefix = "enc:v1:"
+
+// encryptionLockID is the PostgreSQL advisory lock shared by the running instances,
+// the data keys cannot be rotated while an instance holds it.
+const encryptionLockID = 7263540712
+
+// encryptedColumns lists the columns holding third-party credentials and other secrets.
+var encryptedColumns = []struct {
+	table  string
+	key    string
+	column string
+}{
+	{"feeds", "id", "password"},
+	{"integrations", "user_id", "pinboard_token"},
+	{"integrations", "user_id", "instapaper_password"},
+	{"integrations", "user_id", "wallabag_client_secret"},
+	{"integrations", "user_id", "wallabag_password"},
+	{"integrations", "user_id", "nunux_keeper_api_key"},
+	{"integrations", "user_id", "pocket_access_token"},
+	{"integrations", "user_id", "pocket_consumer_key"},
+	{"users", "id", "totp_secret"},
+}
+
+// keyring holds the data keys unwrapped with the master keys.
+// Values are always encrypted with the current data key, older ones are kept to decrypt existing rows.
+type keyring struct {
+	mu          sync.RWMutex
+	masterKeyID string
+	masterKeys  map[string][]byte
+	currentID   string
+	dataKeys    map[string][]byte
+
+	// lock is the connection holding the advisory lock until the process exits.
+	lock *sql.Conn
+}
+
+// EnableEncryption turns on the transparent encryption of sensitive values.
+// The previous master keys are only used to unwrap the data keys created before a master key rotation.
+func (s *Storage) EnableEncryption(masterKey []byte, previousMasterKeys [][]byte) error {
+	ring := &keyring{
+		masterKeyID: crypto.KeyFingerprint(masterKey),
+		masterKeys:  make(map[string][]byte),
+	}
+
+	ring.masterKeys[ring.masterKeyID] = masterKey
+	for _, key := range previousMasterKeys {
+		ring.masterKeys[crypto.KeyFingerprint(key)] = key
+	}
+
+	if err := s.loadDataKeys(ring); err != nil {
+		return err
+	}
+
+	if ring.currentID == "" {
+		// Only one data key is created per master key, even if several instances start at the same time.
+		query := `
+			INSERT INTO encryption_keys (id, master_key_id, wrapped_key)
+			SELECT $1, $2, $3
+			WHERE NOT EXISTS (SELECT 1 FROM encryption_keys WHERE master_key_id=$2)
+		`
+		if err := s.createDataKey(query, masterKey, ring.masterKeyID); err != nil {
+			return err
+		}
+
+		if err := s.loadDataKeys(ring); err != nil {
+			return err
+		}
+	}
+
+	s.keyring = ring
+	return nil
+}
+
+// LockEncryptionKeys prevents the rotation of the data keys while the application is running.
+// The current data key is only loaded once at startup, a rotation would remove it while it is still used to encrypt new values.
+// If a rotation is in progress, this function waits for the end of the rotation and loads the new data key.
+func (s *Storage) LockEncryptionKeys() error {
+	if s.keyring == nil {
+		return errors.New(`store: encryption is not enabled`)
+	}
+
+	ctx := context.Background()
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf(`store: unable to get a database connection: %v`, err)
+	}
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock_shared($1)`, encryptionLockID); err != nil {
+		conn.Close()
+		return fmt.Errorf(`store: unable to lock the encryption keys: %v`, err)
+	}
+
+	s.keyring.lock = conn
+	return s.loadDataKeys(s.keyring)
+}
+
+// EncryptSecrets encrypts with the current data key the values stored in plaintext or with an older data key.
+func (s *Storage) EncryptSecrets() (int, error) {
+	if s.keyring == nil {
+		return 0, errors.New(`store: encryption is not enabled`)
+	}
+
+	s.keyring.mu.RLock()
+	currentID := s.keyring.currentID
+	s.keyring.mu.RUnlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	count := 0
+	for _, c := range encryptedColumns {
+		rows, err := tx.Query(fmt.Sprintf(`SELECT %s, %s FROM %s WHERE %s <> ''`, c.key, c.column, c.table, c.column))
+		if err != nil {
+			tx.Rollback()
+			return 0, fmt.Errorf(`store: unable to fetch %s.%s: %v`, c.table, c.column, err)
+		}
+
+		values := make(map[int64]string)
+		for rows.Next() {
+			var id int64
+			var value string
+			if err := rows.Scan(&id, &value); err != nil {
+				rows.Close()
+				tx.Rollback()
+				return 0, fmt.Errorf(`store: unable to fetch %s.%s: %v`, c.table, c.column, err)
+			}
+
+			if dataKeyID(value) != currentID {
+				values[id] = value
+			}
+		}
+		rows.Close()
+
+		for id, value := range values {
+			plaintext, err := s.decryptValue(value)
+			if err != nil {
+				tx.Rollback()
+				return 0, fmt.Errorf(`store: unable to decrypt %s.%s for %s=%d: %v`, c.table, c.column, c.key, id, err)
+			}
+
+			ciphertext, err := s.encryptValue(plaintext)
+			if err != nil {
+				tx.Rollback()
+				return 0, err
+			}
+
+			query := fmt.Sprintf(`UPDATE %s SET %s=$1 WHERE %s=$2`, c.table, c.column, c.key)
+			if _, err := tx.Exec(query, ciphertext, id); err != nil {
+				tx.Rollback()
+				return 0, fmt.Errorf(`store: unable to update %s.%s: %v`, c.table, c.column, err)
+			}
+
+			count++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return count, nil
+}
+
+// RotateEncryptionKey creates a new data key wrapped with the current master key,
+// re-encrypts all sensitive values and removes the previous data keys.
+// The rotation is refused while an instance of the application is running, see LockEncryptionKeys.
+func (s *Storage) RotateEncryptionKey() (int, error) {
+	if s.keyring == nil {
+		return 0, errors.New(`store: encryption is not enabled`)
+	}
+
+	ctx := context.Background()
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to get a database connection: %v`, err)
+	}
+	defer conn.Close()
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, encryptionLockID).Scan(&locked); err != nil {
+		return 0, fmt.Errorf(`store: unable to lock the encryption keys: %v`, err)
+	}
+
+	if !locked {
+		return 0, errors.New(`store: the encryption keys are used by a running instance, stop the application before rotating the key`)
+	}
+
+	// The lock belongs to the database session, it must be released before returning the connection to the pool.
+	defer conn.ExecContext(ctx, `SELECT pg_advisory_unlock($1)`, encryptionLockID)
+
+	query := `INSERT INTO encryption_keys (id, master_key_id, wrapped_key) VALUES ($1, $2, $3)`
+	if err := s.createDataKey(query, s.keyring.masterKeys[s.keyring.masterKeyID], s.keyring.masterKeyID); err != nil {
+		return 0, err
+	}
+
+	if err := s.loadDataKeys(s.keyring); err != nil {
+		return 0, err
+	}
+
+	count, err := s.EncryptSecrets()
+	if err != nil {
+		return 0, err
+	}
+
+	s.keyring.mu.Lock()
+	defer s.keyring.mu.Unlock()
+
+	if _, err := s.db.Exec(`DELETE FROM encryption_keys WHERE id <> $1`, s.keyring.currentID); err != nil {
+		return count, fmt.Errorf(`store: unable to remove old encryption keys: %v`, err)
+	}
+
+	for id := range s.keyring.dataKeys {
+		if id != s.keyring.currentID {
+			delete(s.keyring.dataKeys, id)
+		}
+	}
+
+	return count, nil
+}
+
+func (s *Storage) createDataKey(query string, masterKey []byte, masterKeyID string) error {
+	wrappedKey, err := crypto.Encrypt(masterKey, crypto.GenerateEncryptionKey())
+	if err != nil {
+		return fmt.Errorf(`store: unable to wrap data key: %v`, err)
+	}
+
+	if _, err := s.db.Exec(query, crypto.GenerateRandomStringHex(8), masterKeyID, wrappedKey); err != nil {
+		return fmt.Errorf(`store: unable to create data key: %v`, err)
+	}
+
+	return nil
+}
+
+// loadDataKeys unwraps all data keys, the newest one wrapped by the current master key becomes the current data key.
+func (s *Storage) loadDataKeys(ring *keyring) error {
+	rows, err := s.db.Query(`SELECT id, master_key_id, wrapped_key FROM encryption_keys ORDER BY created_at ASC, id ASC`)
+	if err != nil {
+		return fmt.Errorf(`store: unable to fetch data keys: %v`, err)
+	}
+	defer rows.Close()
+
+	dataKeys := make(map[string][]byte)
+	currentID := ""
+	for rows.Next() {
+		var id, masterKeyID string
+		var wrappedKey []byte
+		if err := rows.Scan(&id, &masterKeyID, &wrappedKey); err != nil {
+			return fmt.Errorf(`store: unable to fetch data key row: %v`, err)
+		}
+
+		masterKey, found := ring.masterKeys[masterKeyID]
+		if !found {
+			return fmt.Errorf(`store: the data key %q is wrapped by an unknown master key %q`, id, masterKeyID)
+		}
+
+		dataKey, err := crypto.Decrypt(masterKey, wrappedKey)
+		if err != nil {
+			return fmt.Errorf(`store: unable to unwrap data key %q: %v`, id, err)
+		}
+
+		dataKeys[id] = dataKey
+		if masterKeyID == ring.masterKeyID {
+			currentID = id
+		}
+	}
+
+	ring.mu.Lock()
+	ring.dataKeys = dataKeys
+	ring.currentID = currentID
+	ring.mu.Unlock()
+
+	return nil
+}
+
+// dataKey returns the given data key, the keys are reloaded when another instance created a new one.
+func (s *Storage) dataKey(id string) ([]byte, error) {
+	s.keyring.mu.RLock()
+	key, found := s.keyring.dataKeys[id]
+	s.keyring.mu.RUnlock()
+	if found {
+		return key, nil
+	}
+
+	if err := s.loadDataKeys(s.keyring); err != nil {
+		return nil, err
+	}
+
+	s.keyring.mu.RLock()
+	defer s.keyring.mu.RUnlock()
+	if key, found := s.keyring.dataKeys[id]; found {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf(`store: unknown data key %q`, id)
+}
+
+// encryptValue returns the encrypted form of the value, values are stored unchanged when encryption is disabled.
+func (s *Storage) encryptValue(value string) (string, error) {
+	if value == "" || s.keyring == nil {
+		return value, nil
+	}
+
+	s.keyring.mu.RLock()
+	id := s.keyring.currentID
+	key := s.keyring.dataKeys[id]
+	s.keyring.mu.RUnlock()
+
+	ciphertext, err := crypto.Encrypt(key, []byte(value))
+	if err != nil {
+		return "", fmt.Errorf(`store: unable to encrypt value: %v`, err)
+	}
+
+	return encryptedValuePrefix + id + ":" + base64.RawStdEncoding.EncodeToString(ciphertext), nil
+}
+
+// decryptValue returns the plaintext of an encrypted value, plaintext values are returned unchanged.
+func (s *Storage) decryptValue(value string) (string, error) {
+	if !strings.HasPrefix(value, encryptedValuePrefix) {
+		return value, nil
+	}
+
+	if s.keyring == nil {
+		return "", errors.New(`store: an encrypted value has been found but no encryption key is configured`)
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(value, encryptedValuePrefix), ":", 2)
+	if len(parts) != 2 {
+		return "", errors.New(`store: invalid encrypted value`)
+	}
+
+	key, err := s.dataKey(parts[0])
+	if err != nil {
+		return "", err
+	}
+
+	ciphertext, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf(`store: invalid encrypted value: %v`, err)
+	}
+
+	plaintext, err := crypto.Decrypt(key, ciphertext)
+	if err != nil {
+		return "", fmt.Errorf(`store: %v`, err)
+	}
+
+	return string(plaintext), nil
+}
+
+// encryptValues encrypts the given values in place.
+func (s *Storage) encryptValues(values ...*string) (err error) {
+	for _, value := range values {
+		if *value, err = s.encryptValue(*value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decryptValues decrypts the given values in place.
+func (s *Storage) decryptValues(values ...*string) (err error) {
+	for _, value := range values {
+		if *value, err = s.decryptValue(*value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dataKeyID returns the ID of the data key used to encrypt the value, or an empty string for plaintext values.
+func dataKeyID(value string) string {
+	if !strings.HasPrefix(value, encryptedValuePrefix) {
+		return ""
+	}
+	return strings.SplitN(strings.TrimPrefix(value, encryptedValuePrefix), ":", 2)[0]
+}
@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"miniflux.app/crypto"
@@ -117,6 +118,10 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 				user_id,
 				feed_id,
 				reading_time,
+				summary,
+				image_url,
+				tags,
+				podcast,
 				changed_at,
 				document_vectors
 			)
@@ -132,6 +137,10 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 				$8,
 				$9,
 				$10,
+				$11,
+				$12,
+				coalesce($13, '{}'::text[]),
+				$14,
 				now(),
 				setweight(to_tsvector(substring(coalesce($1, '') for 1000000)), 'A') || setweight(to_tsvector(substring(coalesce($6, '') for 1000000)), 'B')
 			)
@@ -150,6 +159,10 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 		entry.UserID,
 		entry.FeedID,
 		entry.ReadingTime,
+		entry.Summary,
+		entry.ImageURL,
+		pq.Array(normalizeTags(entry.Tags)),
+		entry.Podcast,
 	).Scan(&entry.ID, &entry.Status)
 
 	if err != nil {
@@ -168,6 +181,25 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 	return nil
 }
 
+// normalizeTags lowercases the categories provided by the feed to match them case-insensitively with the tags index.
+func normalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return tags
+	}
+
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(tag)
+		if !seen[tag] {
+			seen[tag] = true
+			normalized = append(normalized, tag)
+		}
+	}
+
+	return normalized
+}
+
 // updateEntry updates an entry when a feed is refreshed.
 // Note: we do not update the published date because some feeds do not contains any date,
 // it default to time.Now() which could change the order of items on the history page.
@@ -182,9 +214,16 @@ func (s *Storage) updateEntry(tx *sql.Tx, entry *model.Entry) error {
 			content=$4,
 			author=$5,
 			reading_time=$6,
+			summary=$7,
+			image_url=$8,
+			tags=coalesce($9, '{}'::text[]),
+			podcast=$10,
+			podcast_chapters=CASE WHEN $10::jsonb->>'chapters_url' IS DISTINCT FROM podcast->>'chapters_url' THEN NULL ELSE podcast_chapters END,
+			podcast_transcript=CASE WHEN $10::jsonb->'transcripts' IS DISTINCT FROM podcast->'transcripts' THEN NULL ELSE podcast_transcript END,
+			podcast_retry_at=CASE WHEN $10::jsonb->>'chapters_url' IS DISTINCT FROM podcast->>'chapters_url' OR $10::jsonb->'transcripts' IS DISTINCT FROM podcast->'transcripts' THEN NULL ELSE podcast_retry_at END,
 			document_vectors = setweight(to_tsvector(substring(coalesce($1, '') for 1000000)), 'A') || setweight(to_tsvector(substring(coalesce($4, '') for 1000000)), 'B')
 		WHERE
-			user_id=$7 AND feed_id=$8 AND hash=$9
+			user_id=$11 AND feed_id=$12 AND hash=$13
 		RETURNING
 			id
 	`
@@ -196,6 +235,10 @@ func (s *Storage) updateEntry(tx *sql.Tx, entry *model.Entry) error {
 		entry.Content,
 		entry.Author,
 		entry.ReadingTime,
+		entry.Summary,
+		entry.ImageURL,
+		pq.Array(normalizeTags(entry.Tags)),
+		entry.Podcast,
 		entry.UserID,
 		entry.FeedID,
 		entry.Hash,
@@ -225,6 +268,36 @@ func (s *Storage) entryExists(tx *sql.Tx, entry *model.Entry) bool {
 	return result
 }
 
+// UpdatePodcastChapters stores the chapters downloaded for an episode.
+func (s *Storage) UpdatePodcastChapters(userID, entryID int64, chapters model.PodcastChapters) error {
+	query := `UPDATE entries SET podcast_chapters=$1 WHERE user_id=$2 AND id=$3`
+	if _, err := s.db.Exec(query, chapters, userID, entryID); err != nil {
+		return fmt.Errorf(`store: unable to update the podcast chapters of entry #%d: %v`, entryID, err)
+	}
+
+	return nil
+}
+
+// UpdatePodcastTranscript stores the transcript downloaded for an episode.
+func (s *Storage) UpdatePodcastTranscript(userID, entryID int64, transcript model.PodcastCues) error {
+	query := `UPDATE entries SET podcast_transcript=$1 WHERE user_id=$2 AND id=$3`
+	if _, err := s.db.Exec(query, transcript, userID, entryID); err != nil {
+		return fmt.Errorf(`store: unable to update the podcast transcript of entry #%d: %v`, entryID, err)
+	}
+
+	return nil
+}
+
+// DelayPodcastDownload postpones the next download of the chapters and the transcript of an episode after a failure.
+func (s *Storage) DelayPodcastDownload(userID, entryID int64, retryAt time.Time) error {
+	query := `UPDATE entries SET podcast_retry_at=$1 WHERE user_id=$2 AND id=$3`
+	if _, err := s.db.Exec(query, retryAt, userID, entryID); err != nil {
+		return fmt.Errorf(`store: unable to delay the podcast download of entry #%d: %v`, entryID, err)
+	}
+
+	return nil
+}
+
 // cleanupEntries deletes from the database entries marked as "removed" and not visible anymore in the feed.
 func (s *Storage) cleanupEntries(feedID int64, entryHashes []string) error {
 	query := `
@@ -284,6 +357,51 @@ func (s *Storage) RefreshFeedEntries(userID, feedID int64, entries model.Entries
 	return nil
 }
 
+// ImportEntries creates the entries imported from another feed reader in a single transaction.
+// The imported status and bookmark are also applied to the existing entries, the created entries are returned.
+func (s *Storage) ImportEntries(entries model.Entries) (model.Entries, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	var created model.Entries
+	for _, entry := range entries {
+		// The status of the new entry is replaced by the default status when the entry is inserted.
+		status := entry.Status
+		if !s.entryExists(tx, entry) {
+			if err := s.createEntry(tx, entry); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+			created = append(created, entry)
+		}
+
+		// The removed entries are not restored.
+		query := `
+			UPDATE
+				entries
+			SET
+				status=CASE WHEN status=$1 THEN status ELSE $2 END,
+				starred=starred OR $3
+			WHERE
+				user_id=$4 AND feed_id=$5 AND hash=$6
+		`
+		_, err = tx.Exec(query, model.EntryStatusRemoved, status, entry.Starred, entry.UserID, entry.FeedID, entry.Hash)
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf(`store: unable to update imported entry %q: %v`, entry.URL, err)
+		}
+		entry.Status = status
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return created, nil
+}
+
 // ArchiveEntries changes the status of entries to "removed" after the given number of days.
 func (s *Storage) ArchiveEntries(status string, days int) (int64, error) {
 	if days < 0 {
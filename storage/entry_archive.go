@@ -0,0 +1,96 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// SaveEntryArchive stores the snapshot of an entry, the previous snapshot is replaced.
+func (s *Storage) SaveEntryArchive(archive *model.EntryArchive) error {
+	query := `
+		INSERT INTO entry_archives
+			(entry_id, user_id, url, title, content)
+		VALUES
+			($1, $2, $3, $4, $5)
+		ON CONFLICT (entry_id) DO UPDATE SET
+			url=EXCLUDED.url,
+			title=EXCLUDED.title,
+			content=EXCLUDED.content,
+			created_at=now()
+		RETURNING
+			created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		archive.EntryID,
+		archive.UserID,
+		archive.URL,
+		archive.Title,
+		archive.Content,
+	).Scan(&archive.CreatedAt)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to save archive of entry #%d: %v`, archive.EntryID, err)
+	}
+
+	return nil
+}
+
+// EntryArchive returns the snapshot of the given entry.
+func (s *Storage) EntryArchive(userID, entryID int64) (*model.EntryArchive, error) {
+	query := `
+		SELECT
+			entry_id,
+			user_id,
+			url,
+			title,
+			content,
+			created_at
+		FROM
+			entry_archives
+		WHERE
+			user_id=$1 AND entry_id=$2
+	`
+
+	var archive model.EntryArchive
+	err := s.db.QueryRow(query, userID, entryID).Scan(
+		&archive.EntryID,
+		&archive.UserID,
+		&archive.URL,
+		&archive.Title,
+		&archive.Content,
+		&archive.CreatedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch archive of entry #%d: %v`, entryID, err)
+	}
+
+	return &archive, nil
+}
+
+// HasEntryArchive returns true if a snapshot exists for the given entry.
+func (s *Storage) HasEntryArchive(userID, entryID int64) bool {
+	var result bool
+	s.db.QueryRow(`SELECT true FROM entry_archives WHERE user_id=$1 AND entry_id=$2`, userID, entryID).Scan(&result)
+	return result
+}
+
+// RemoveEntryArchive deletes the snapshot of the given entry.
+func (s *Storage) RemoveEntryArchive(userID, entryID int64) error {
+	_, err := s.db.Exec(`DELETE FROM entry_archives WHERE user_id=$1 AND entry_id=$2`, userID, entryID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove archive of entry #%d: %v`, entryID, err)
+	}
+
+	return nil
+}
@@ -113,6 +113,15 @@ func (e *EntryQueryBuilder) WithCategoryID(categoryID int64) *EntryQueryBuilder
 	return e
 }
 
+// WithTag filter by a category provided by the feed, the comparison is case-insensitive.
+func (e *EntryQueryBuilder) WithTag(tag string) *EntryQueryBuilder {
+	if tag != "" {
+		e.conditions = append(e.conditions, fmt.Sprintf("e.tags @> $%d", len(e.args)+1))
+		e.args = append(e.args, pq.StringArray{strings.ToLower(tag)})
+	}
+	return e
+}
+
 // WithStatus filter by entry status.
 func (e *EntryQueryBuilder) WithStatus(status string) *EntryQueryBuilder {
 	if status != "" {
@@ -229,9 +238,16 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			e.author,
 			e.share_code,
 			e.content,
+			e.summary,
+			e.image_url,
 			e.status,
 			e.starred,
 			e.reading_time,
+			e.tags,
+			e.podcast,
+			e.podcast_chapters,
+			e.podcast_transcript,
+			e.podcast_retry_at,
 			e.created_at,
 			f.title as feed_title,
 			f.feed_url,
@@ -242,6 +258,7 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			f.rewrite_rules,
 			f.crawler,
 			f.user_agent,
+			f.fetch_via_proxy,
 			fi.icon_id,
 			u.timezone
 		FROM
@@ -272,6 +289,9 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 		var entry model.Entry
 		var iconID sql.NullInt64
 		var tz string
+		var chapters model.PodcastChapters
+		var transcript model.PodcastCues
+		var podcastRetryAt *time.Time
 
 		entry.Feed = &model.Feed{}
 		entry.Feed.Category = &model.Category{}
@@ -289,9 +309,16 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			&entry.Author,
 			&entry.ShareCode,
 			&entry.Content,
+			&entry.Summary,
+			&entry.ImageURL,
 			&entry.Status,
 			&entry.Starred,
 			&entry.ReadingTime,
+			pq.Array(&entry.Tags),
+			&entry.Podcast,
+			&chapters,
+			&transcript,
+			&podcastRetryAt,
 			&entry.CreatedAt,
 			&entry.Feed.Title,
 			&entry.Feed.FeedURL,
@@ -303,6 +330,7 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			&entry.Feed.RewriteRules,
 			&entry.Feed.Crawler,
 			&entry.Feed.UserAgent,
+			&entry.Feed.FetchViaProxy,
 			&iconID,
 			&tz,
 		)
@@ -311,6 +339,12 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			return nil, fmt.Errorf("unable to fetch entry row: %v", err)
 		}
 
+		if entry.Podcast != nil {
+			entry.Podcast.Chapters = chapters
+			entry.Podcast.Transcript = transcript
+			entry.Podcast.RetryAt = podcastRetryAt
+		}
+
 		if iconID.Valid {
 			entry.Feed.Icon.IconID = iconID.Int64
 		} else {
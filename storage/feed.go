@@ -28,6 +28,14 @@ func (s *Storage) FeedURLExists(userID int64, feedURL string) bool {
 	return result
 }
 
+// FeedIDByURL returns the ID of the feed with the given URL, 0 is returned when the feed does not exist.
+func (s *Storage) FeedIDByURL(userID int64, feedURL string) int64 {
+	var feedID int64
+	query := `SELECT id FROM feeds WHERE user_id=$1 AND feed_url=$2`
+	s.db.QueryRow(query, userID, feedURL).Scan(&feedID)
+	return feedID
+}
+
 // AnotherFeedURLExists checks if the user a duplicated feed.
 func (s *Storage) AnotherFeedURLExists(userID, feedID int64, feedURL string) bool {
 	var result bool
@@ -171,6 +179,22 @@ func (s *Storage) FeedByID(userID, feedID int64) (*model.Feed, error) {
 	return feed, nil
 }
 
+// FeedByNewsletterToken returns the newsletter feed associated to the given token.
+func (s *Storage) FeedByNewsletterToken(token string) (*model.Feed, error) {
+	var userID, feedID int64
+	query := `SELECT user_id, id FROM feeds WHERE kind=$1 AND newsletter_token=$2`
+	err := s.db.QueryRow(query, model.FeedKindNewsletter, token).Scan(&userID, &feedID)
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch newsletter feed: %v`, err)
+	}
+
+	return s.FeedByID(userID, feedID)
+}
+
 // CreateFeed creates a new feed.
 func (s *Storage) CreateFeed(feed *model.Feed) error {
 	sql := `
@@ -192,14 +216,31 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 			blocklist_rules,
 			keeplist_rules,
 			ignore_http_cache,
-			fetch_via_proxy
+			fetch_via_proxy,
+			kind,
+			newsletter_token,
+			selectors,
+			watch_selector,
+			description,
+			language,
+			image_url
 		)
 		VALUES
-			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25)
 		RETURNING
 			id
 	`
-	err := s.db.QueryRow(
+
+	if feed.Kind == "" {
+		feed.Kind = model.FeedKindDefault
+	}
+
+	password, err := s.encryptValue(feed.Password)
+	if err != nil {
+		return err
+	}
+
+	err = s.db.QueryRow(
 		sql,
 		feed.FeedURL,
 		feed.SiteURL,
@@ -211,7 +252,7 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 		feed.Crawler,
 		feed.UserAgent,
 		feed.Username,
-		feed.Password,
+		password,
 		feed.Disabled,
 		feed.ScraperRules,
 		feed.RewriteRules,
@@ -219,6 +260,13 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 		feed.KeeplistRules,
 		feed.IgnoreHTTPCache,
 		feed.FetchViaProxy,
+		feed.Kind,
+		feed.NewsletterToken,
+		feed.Selectors,
+		feed.WatchSelector,
+		feed.Description,
+		feed.Language,
+		feed.ImageURL,
 	).Scan(&feed.ID)
 	if err != nil {
 		return fmt.Errorf(`store: unable to create feed %q: %v`, feed.FeedURL, err)
@@ -274,10 +322,21 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 			disabled=$18,
 			next_check_at=$19,
 			ignore_http_cache=$20,
-			fetch_via_proxy=$21
+			fetch_via_proxy=$21,
+			selectors=$22,
+			watch_selector=$23,
+			watch_snapshot=CASE WHEN watch_selector=$23 THEN watch_snapshot ELSE '' END,
+			description=$24,
+			language=$25,
+			image_url=$26
 		WHERE
-			id=$22 AND user_id=$23
+			id=$27 AND user_id=$28
 	`
+	password, err := s.encryptValue(feed.Password)
+	if err != nil {
+		return err
+	}
+
 	_, err = s.db.Exec(query,
 		feed.FeedURL,
 		feed.SiteURL,
@@ -295,11 +354,16 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 		feed.Crawler,
 		feed.UserAgent,
 		feed.Username,
-		feed.Password,
+		password,
 		feed.Disabled,
 		feed.NextCheckAt,
 		feed.IgnoreHTTPCache,
 		feed.FetchViaProxy,
+		feed.Selectors,
+		feed.WatchSelector,
+		feed.Description,
+		feed.Language,
+		feed.ImageURL,
 		feed.ID,
 		feed.UserID,
 	)
@@ -311,6 +375,27 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 	return nil
 }
 
+// FeedWatchSnapshot returns the text of the watched web page at the last check.
+func (s *Storage) FeedWatchSnapshot(feedID int64) (string, error) {
+	var snapshot string
+	query := `SELECT watch_snapshot FROM feeds WHERE id=$1`
+	if err := s.db.QueryRow(query, feedID).Scan(&snapshot); err != nil {
+		return "", fmt.Errorf(`store: unable to fetch snapshot of feed #%d: %v`, feedID, err)
+	}
+
+	return snapshot, nil
+}
+
+// UpdateFeedWatchSnapshot stores the text of the watched web page.
+func (s *Storage) UpdateFeedWatchSnapshot(feedID int64, snapshot string) error {
+	query := `UPDATE feeds SET watch_snapshot=$1 WHERE id=$2`
+	if _, err := s.db.Exec(query, snapshot, feedID); err != nil {
+		return fmt.Errorf(`store: unable to update snapshot of feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
+
 // UpdateFeedError updates feed errors.
 func (s *Storage) UpdateFeedError(feed *model.Feed) (err error) {
 	query := `
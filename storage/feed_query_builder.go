@@ -147,6 +147,9 @@ func (f *FeedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			f.feed_url,
 			f.site_url,
 			f.title,
+			f.description,
+			f.language,
+			f.image_url,
 			f.etag_header,
 			f.last_modified_header,
 			f.user_id,
@@ -164,6 +167,10 @@ func (f *FeedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			f.ignore_http_cache,
 			f.fetch_via_proxy,
 			f.disabled,
+			f.kind,
+			f.newsletter_token,
+			f.selectors,
+			f.watch_selector,
 			f.category_id,
 			c.title as category_title,
 			fi.icon_id,
@@ -205,6 +212,9 @@ func (f *FeedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			&feed.FeedURL,
 			&feed.SiteURL,
 			&feed.Title,
+			&feed.Description,
+			&feed.Language,
+			&feed.ImageURL,
 			&feed.EtagHeader,
 			&feed.LastModifiedHeader,
 			&feed.UserID,
@@ -222,6 +232,10 @@ func (f *FeedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			&feed.IgnoreHTTPCache,
 			&feed.FetchViaProxy,
 			&feed.Disabled,
+			&feed.Kind,
+			&feed.NewsletterToken,
+			&feed.Selectors,
+			&feed.WatchSelector,
 			&feed.Category.ID,
 			&feed.Category.Title,
 			&iconID,
@@ -232,6 +246,10 @@ func (f *FeedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			return nil, fmt.Errorf(`store: unable to fetch feeds row: %w`, err)
 		}
 
+		if err := f.store.decryptValues(&feed.Password); err != nil {
+			return nil, err
+		}
+
 		if iconID.Valid {
 			feed.Icon = &model.FeedIcon{FeedID: feed.ID, IconID: iconID.Int64}
 		} else {
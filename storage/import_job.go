@@ -0,0 +1,188 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// CreateImportJob creates a new import job.
+func (s *Storage) CreateImportJob(job *model.ImportJob) error {
+	query := `
+		INSERT INTO import_jobs
+			(user_id, status, validate, total)
+		VALUES
+			($1, $2, $3, $4)
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(query, job.UserID, job.Status, job.Validate, job.Total).Scan(&job.ID, &job.CreatedAt)
+	if err != nil {
+		return fmt.Errorf(`store: unable to create import job: %v`, err)
+	}
+
+	return nil
+}
+
+// UpdateImportJobProgress stores the number of items processed by the import job.
+func (s *Storage) UpdateImportJobProgress(job *model.ImportJob) error {
+	query := `UPDATE import_jobs SET processed=$1 WHERE id=$2`
+	if _, err := s.db.Exec(query, job.Processed, job.ID); err != nil {
+		return fmt.Errorf(`store: unable to update import job #%d: %v`, job.ID, err)
+	}
+
+	return nil
+}
+
+// FinishImportJob stores the final status and the report of the import job.
+func (s *Storage) FinishImportJob(job *model.ImportJob) error {
+	query := `UPDATE import_jobs SET status=$1, error_msg=$2, report=$3, finished_at=now() WHERE id=$4 RETURNING finished_at`
+	if err := s.db.QueryRow(query, job.Status, job.ErrorMsg, job.Report, job.ID).Scan(&job.FinishedAt); err != nil {
+		return fmt.Errorf(`store: unable to update import job #%d: %v`, job.ID, err)
+	}
+
+	return nil
+}
+
+// InterruptImportJobs marks the jobs interrupted by a restart of the application as failed.
+func (s *Storage) InterruptImportJobs() error {
+	query := `UPDATE import_jobs SET status=$1, error_msg=$2, finished_at=now() WHERE status=$3`
+	if _, err := s.db.Exec(query, model.ImportJobStatusFailed, "Interrupted", model.ImportJobStatusRunning); err != nil {
+		return fmt.Errorf(`store: unable to update import jobs: %v`, err)
+	}
+
+	return nil
+}
+
+// CreateImportJobResult stores the result of the import of one subscription.
+func (s *Storage) CreateImportJobResult(result *model.ImportJobResult) error {
+	query := `
+		INSERT INTO import_job_results
+			(job_id, title, feed_url, category, status, error_msg, feed_id)
+		VALUES
+			($1, $2, $3, $4, $5, $6, NULLIF($7, 0))
+		RETURNING
+			id
+	`
+	err := s.db.QueryRow(
+		query,
+		result.JobID,
+		result.Title,
+		result.FeedURL,
+		result.CategoryName,
+		result.Status,
+		result.ErrorMsg,
+		result.FeedID,
+	).Scan(&result.ID)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to create import result for %q: %v`, result.FeedURL, err)
+	}
+
+	return nil
+}
+
+// ImportJob returns the import job and its results.
+func (s *Storage) ImportJob(userID, jobID int64) (*model.ImportJob, error) {
+	query := `
+		SELECT
+			id,
+			user_id,
+			status,
+			validate,
+			total,
+			processed,
+			error_msg,
+			report,
+			created_at,
+			finished_at
+		FROM
+			import_jobs
+		WHERE
+			user_id=$1 AND id=$2
+	`
+
+	var job model.ImportJob
+	err := s.db.QueryRow(query, userID, jobID).Scan(
+		&job.ID,
+		&job.UserID,
+		&job.Status,
+		&job.Validate,
+		&job.Total,
+		&job.Processed,
+		&job.ErrorMsg,
+		&job.Report,
+		&job.CreatedAt,
+		&job.FinishedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch import job #%d: %v`, jobID, err)
+	}
+
+	results, err := s.importJobResults(job.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The OPML imports store one result per subscription, the other imports only update the progress.
+	job.Results = results
+	job.Processed += len(results)
+	return &job, nil
+}
+
+func (s *Storage) importJobResults(jobID int64) ([]*model.ImportJobResult, error) {
+	query := `
+		SELECT
+			id,
+			job_id,
+			title,
+			feed_url,
+			category,
+			status,
+			error_msg,
+			coalesce(feed_id, 0)
+		FROM
+			import_job_results
+		WHERE
+			job_id=$1
+		ORDER BY
+			id ASC
+	`
+
+	rows, err := s.db.Query(query, jobID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch results of import job #%d: %v`, jobID, err)
+	}
+	defer rows.Close()
+
+	results := make([]*model.ImportJobResult, 0)
+	for rows.Next() {
+		var result model.ImportJobResult
+		err := rows.Scan(
+			&result.ID,
+			&result.JobID,
+			&result.Title,
+			&result.FeedURL,
+			&result.CategoryName,
+			&result.Status,
+			&result.ErrorMsg,
+			&result.FeedID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch import result row: %v`, err)
+		}
+
+		results = append(results, &result)
+	}
+
+	return results, nil
+}
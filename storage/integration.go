@@ -105,9 +105,19 @@ func (s *Storage) Integration(userID int64) (*model.Integration, error) {
 		return &integration, nil
 	case err != nil:
 		return &integration, fmt.Errorf(`store: unable to fetch integration row: %v`, err)
-	default:
-		return &integration, nil
 	}
+
+	err = s.decryptValues(
+		&integration.PinboardToken,
+		&integration.InstapaperPassword,
+		&integration.WallabagClientSecret,
+		&integration.WallabagPassword,
+		&integration.NunuxKeeperAPIKey,
+		&integration.PocketAccessToken,
+		&integration.PocketConsumerKey,
+	)
+
+	return &integration, err
 }
 
 // UpdateIntegration saves user integration settings.
@@ -141,30 +151,45 @@ func (s *Storage) UpdateIntegration(integration *model.Integration) error {
 		WHERE
 			user_id=$23
 	`
-	_, err := s.db.Exec(
+	// The secrets are encrypted on a copy to keep the caller's values in plaintext.
+	secrets := *integration
+	err := s.encryptValues(
+		&secrets.PinboardToken,
+		&secrets.InstapaperPassword,
+		&secrets.WallabagClientSecret,
+		&secrets.WallabagPassword,
+		&secrets.NunuxKeeperAPIKey,
+		&secrets.PocketAccessToken,
+		&secrets.PocketConsumerKey,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Exec(
 		query,
 		integration.PinboardEnabled,
-		integration.PinboardToken,
+		secrets.PinboardToken,
 		integration.PinboardTags,
 		integration.PinboardMarkAsUnread,
 		integration.InstapaperEnabled,
 		integration.InstapaperUsername,
-		integration.InstapaperPassword,
+		secrets.InstapaperPassword,
 		integration.FeverEnabled,
 		integration.FeverUsername,
 		integration.FeverToken,
 		integration.WallabagEnabled,
 		integration.WallabagURL,
 		integration.WallabagClientID,
-		integration.WallabagClientSecret,
+		secrets.WallabagClientSecret,
 		integration.WallabagUsername,
-		integration.WallabagPassword,
+		secrets.WallabagPassword,
 		integration.NunuxKeeperEnabled,
 		integration.NunuxKeeperURL,
-		integration.NunuxKeeperAPIKey,
+		secrets.NunuxKeeperAPIKey,
 		integration.PocketEnabled,
-		integration.PocketAccessToken,
-		integration.PocketConsumerKey,
+		secrets.PocketAccessToken,
+		secrets.PocketConsumerKey,
 		integration.UserID,
 	)
 
@@ -0,0 +1,171 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"miniflux.app/model"
+)
+
+// LoginFailure returns the failed logins of a username or a client IP address.
+func (s *Storage) LoginFailure(kind, value string) (*model.LoginFailure, error) {
+	query := `
+		SELECT
+			kind, value, failures, last_failure_at, last_attempt_at, locked_until
+		FROM
+			login_failures
+		WHERE
+			kind=$1 AND value=$2
+	`
+	var failure model.LoginFailure
+	err := s.db.QueryRow(query, kind, value).Scan(
+		&failure.Kind,
+		&failure.Value,
+		&failure.Failures,
+		&failure.LastFailureAt,
+		&failure.LastAttemptAt,
+		&failure.LockedUntil,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch login failures: %v`, err)
+	}
+
+	return &failure, nil
+}
+
+// ReserveLoginAttempt records a login attempt of a username or a client IP address.
+// It returns false when the login is locked or when the previous attempt is more recent than the delay
+// after the failures, the delay doubles after each failure up to maxDelay.
+// The check and the update are a single statement, so concurrent attempts cannot all be accepted.
+func (s *Storage) ReserveLoginAttempt(kind, value string, maxDelay time.Duration) (bool, error) {
+	query := `
+		UPDATE
+			login_failures
+		SET
+			last_attempt_at = now()
+		WHERE
+			kind=$1 AND value=$2 AND
+			(locked_until IS NULL OR locked_until <= now()) AND
+			last_attempt_at + least(power(2, greatest(failures - 1, 0)), $3) * interval '1 second' <= now()
+	`
+	result, err := s.db.Exec(query, kind, value, int(maxDelay.Seconds()))
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to reserve login attempt: %v`, err)
+	}
+
+	if count, _ := result.RowsAffected(); count > 0 {
+		return true, nil
+	}
+
+	var exists bool
+	err = s.db.QueryRow(`SELECT true FROM login_failures WHERE kind=$1 AND value=$2`, kind, value).Scan(&exists)
+	switch {
+	case err == sql.ErrNoRows:
+		return true, nil
+	case err != nil:
+		return false, fmt.Errorf(`store: unable to reserve login attempt: %v`, err)
+	}
+
+	return false, nil
+}
+
+// AddLoginFailure increments the number of failed logins.
+// The counter starts again when the previous failure happened before resetBefore.
+func (s *Storage) AddLoginFailure(kind, value string, resetBefore time.Time) (*model.LoginFailure, error) {
+	query := `
+		INSERT INTO login_failures
+			(kind, value, failures, last_failure_at)
+		VALUES
+			($1, $2, 1, now())
+		ON CONFLICT (kind, value) DO UPDATE SET
+			failures = CASE WHEN login_failures.last_failure_at < $3 THEN 1 ELSE login_failures.failures + 1 END,
+			locked_until = CASE WHEN login_failures.last_failure_at < $3 THEN NULL ELSE login_failures.locked_until END,
+			last_failure_at = now(),
+			last_attempt_at = now()
+		RETURNING
+			kind, value, failures, last_failure_at, last_attempt_at, locked_until
+	`
+	var failure model.LoginFailure
+	err := s.db.QueryRow(query, kind, value, resetBefore).Scan(
+		&failure.Kind,
+		&failure.Value,
+		&failure.Failures,
+		&failure.LastFailureAt,
+		&failure.LastAttemptAt,
+		&failure.LockedUntil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to record login failure: %v`, err)
+	}
+
+	return &failure, nil
+}
+
+// LockLogin refuses all logins of a username or a client IP address until the given time.
+func (s *Storage) LockLogin(kind, value string, until time.Time) error {
+	query := `UPDATE login_failures SET locked_until=$1 WHERE kind=$2 AND value=$3`
+	if _, err := s.db.Exec(query, until, kind, value); err != nil {
+		return fmt.Errorf(`store: unable to lock login: %v`, err)
+	}
+
+	return nil
+}
+
+// RemoveLoginFailures forgets the failed logins and unlocks a username or a client IP address.
+func (s *Storage) RemoveLoginFailures(kind, value string) error {
+	query := `DELETE FROM login_failures WHERE kind=$1 AND value=$2`
+	if _, err := s.db.Exec(query, kind, value); err != nil {
+		return fmt.Errorf(`store: unable to remove login failures: %v`, err)
+	}
+
+	return nil
+}
+
+// LockedLogins returns the values of the given kind that are currently locked with their lockout expiration.
+func (s *Storage) LockedLogins(kind string) (map[string]time.Time, error) {
+	query := `SELECT value, locked_until FROM login_failures WHERE kind=$1 AND locked_until > now()`
+	rows, err := s.db.Query(query, kind)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch locked logins: %v`, err)
+	}
+	defer rows.Close()
+
+	lockedLogins := make(map[string]time.Time)
+	for rows.Next() {
+		var value string
+		var lockedUntil time.Time
+		if err := rows.Scan(&value, &lockedUntil); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch locked login row: %v`, err)
+		}
+
+		lockedLogins[value] = lockedUntil
+	}
+
+	return lockedLogins, nil
+}
+
+// CleanOldLoginFailures removes failed logins older than specified days when they are not locked anymore.
+func (s *Storage) CleanOldLoginFailures(days int) int64 {
+	query := `
+		DELETE FROM
+			login_failures
+		WHERE
+			last_failure_at < now() - interval '%d days' AND (locked_until IS NULL OR locked_until < now())
+	`
+	result, err := s.db.Exec(fmt.Sprintf(query, days))
+	if err != nil {
+		return 0
+	}
+
+	n, _ := result.RowsAffected()
+	return n
+}
@@ -0,0 +1,447 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"miniflux.app/model"
+)
+
+// OPMLSourceURLExists checks if the user is already subscribed to the remote OPML list.
+func (s *Storage) OPMLSourceURLExists(userID int64, url string) bool {
+	var result bool
+	query := `SELECT true FROM opml_sources WHERE user_id=$1 AND url=$2`
+	s.db.QueryRow(query, userID, url).Scan(&result)
+	return result
+}
+
+// CreateOPMLSource creates a new remote OPML list.
+func (s *Storage) CreateOPMLSource(source *model.OPMLSource) error {
+	query := `
+		INSERT INTO opml_sources
+			(user_id, category_id, url, disable_removed)
+		VALUES
+			($1, $2, $3, $4)
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		source.UserID,
+		source.Category.ID,
+		source.URL,
+		source.DisableRemoved,
+	).Scan(&source.ID, &source.CreatedAt)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to create OPML source %q: %v`, source.URL, err)
+	}
+
+	return nil
+}
+
+// RemoveOPMLSource removes a remote OPML list, the subscriptions are kept.
+func (s *Storage) RemoveOPMLSource(userID, sourceID int64) error {
+	query := `DELETE FROM opml_sources WHERE id=$1 AND user_id=$2`
+	result, err := s.db.Exec(query, sourceID, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove OPML source #%d: %v`, sourceID, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`store: unable to remove OPML source #%d: %v`, sourceID, err)
+	}
+
+	if count == 0 {
+		return errors.New(`store: no OPML source has been removed`)
+	}
+
+	return nil
+}
+
+// OPMLSource returns a remote OPML list of the given user.
+func (s *Storage) OPMLSource(userID, sourceID int64) (*model.OPMLSource, error) {
+	sources, err := s.fetchOPMLSources(`s.user_id=$1 AND s.id=$2`, userID, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sources) == 0 {
+		return nil, nil
+	}
+
+	return sources[0], nil
+}
+
+// OPMLSources returns the remote OPML lists of the given user.
+func (s *Storage) OPMLSources(userID int64) (model.OPMLSources, error) {
+	return s.fetchOPMLSources(`s.user_id=$1`, userID)
+}
+
+// AllOPMLSources returns the remote OPML lists of all users.
+func (s *Storage) AllOPMLSources() (model.OPMLSources, error) {
+	return s.fetchOPMLSources(`true`)
+}
+
+func (s *Storage) fetchOPMLSources(condition string, args ...interface{}) (model.OPMLSources, error) {
+	query := `
+		SELECT
+			s.id,
+			s.user_id,
+			s.url,
+			s.disable_removed,
+			s.etag_header,
+			s.last_modified_header,
+			s.checked_at,
+			s.error_msg,
+			s.created_at,
+			s.category_id,
+			c.title,
+			(SELECT count(*) FROM feeds f WHERE f.opml_source_id=s.id)
+		FROM
+			opml_sources s
+		LEFT JOIN
+			categories c ON c.id=s.category_id
+		WHERE
+			%s
+		ORDER BY
+			s.url ASC
+	`
+
+	rows, err := s.db.Query(fmt.Sprintf(query, condition), args...)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch OPML sources: %v`, err)
+	}
+	defer rows.Close()
+
+	sources := make(model.OPMLSources, 0)
+	for rows.Next() {
+		source := model.OPMLSource{Category: &model.Category{}}
+		err := rows.Scan(
+			&source.ID,
+			&source.UserID,
+			&source.URL,
+			&source.DisableRemoved,
+			&source.EtagHeader,
+			&source.LastModifiedHeader,
+			&source.CheckedAt,
+			&source.ErrorMsg,
+			&source.CreatedAt,
+			&source.Category.ID,
+			&source.Category.Title,
+			&source.CountFeeds,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch OPML source row: %v`, err)
+		}
+
+		source.Category.UserID = source.UserID
+		sources = append(sources, &source)
+	}
+
+	return sources, nil
+}
+
+// OPMLSourceFeeds returns the feeds created by the synchronization of the remote OPML list.
+// Only the attributes required by the synchronization are returned.
+func (s *Storage) OPMLSourceFeeds(userID, sourceID int64) (model.Feeds, error) {
+	query := `SELECT id, feed_url, title, disabled FROM feeds WHERE user_id=$1 AND opml_source_id=$2`
+	rows, err := s.db.Query(query, userID, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch feeds of OPML source #%d: %v`, sourceID, err)
+	}
+	defer rows.Close()
+
+	feeds := make(model.Feeds, 0)
+	for rows.Next() {
+		feed := model.Feed{UserID: userID}
+		if err := rows.Scan(&feed.ID, &feed.FeedURL, &feed.Title, &feed.Disabled); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch feed row: %v`, err)
+		}
+
+		feeds = append(feeds, &feed)
+	}
+
+	return feeds, nil
+}
+
+// OPMLSourceAddedURLs returns the URL of all feeds added by the synchronizations of the remote OPML list,
+// including the feeds removed since then by the user.
+func (s *Storage) OPMLSourceAddedURLs(sourceID int64) (map[string]bool, error) {
+	query := `
+		SELECT DISTINCT
+			c.feed_url
+		FROM
+			opml_source_changes c
+		JOIN
+			opml_source_syncs s ON s.id=c.sync_id
+		WHERE
+			s.source_id=$1 AND c.action=$2
+	`
+	rows, err := s.db.Query(query, sourceID, model.OPMLSourceChangeAdded)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch changes of OPML source #%d: %v`, sourceID, err)
+	}
+	defer rows.Close()
+
+	urls := make(map[string]bool)
+	for rows.Next() {
+		var url string
+		if err := rows.Scan(&url); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch change row: %v`, err)
+		}
+
+		urls[url] = true
+	}
+
+	return urls, nil
+}
+
+// OPMLSourceDisabledFeedIDs returns the ID of the feeds disabled by the last synchronization that changed them,
+// the feeds disabled by the user are not included.
+func (s *Storage) OPMLSourceDisabledFeedIDs(sourceID int64) (map[int64]bool, error) {
+	query := `
+		SELECT DISTINCT ON (c.feed_id)
+			c.feed_id,
+			c.action
+		FROM
+			opml_source_changes c
+		JOIN
+			opml_source_syncs s ON s.id=c.sync_id
+		WHERE
+			s.source_id=$1 AND c.feed_id IS NOT NULL AND c.action IN ($2, $3)
+		ORDER BY
+			c.feed_id, c.id DESC
+	`
+	rows, err := s.db.Query(query, sourceID, model.OPMLSourceChangeDisabled, model.OPMLSourceChangeEnabled)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch changes of OPML source #%d: %v`, sourceID, err)
+	}
+	defer rows.Close()
+
+	feedIDs := make(map[int64]bool)
+	for rows.Next() {
+		var feedID int64
+		var action string
+		if err := rows.Scan(&feedID, &action); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch change row: %v`, err)
+		}
+
+		if action == model.OPMLSourceChangeDisabled {
+			feedIDs[feedID] = true
+		}
+	}
+
+	return feedIDs, nil
+}
+
+// SyncOPMLSource applies the changes of a synchronization to the subscriptions and stores the result of the
+// synchronization of the remote OPML list in the same transaction. The new feeds are created in the category of the list.
+// The synchronization and its changes are recorded only when record is true.
+func (s *Storage) SyncOPMLSource(source *model.OPMLSource, sync *model.OPMLSourceSync, newFeeds model.Feeds, record bool) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	for _, change := range sync.Changes {
+		if err := s.setFeedDisabled(tx, source.UserID, change.FeedID, change.Action == model.OPMLSourceChangeDisabled); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	for _, feed := range newFeeds {
+		if err := s.createOPMLSourceFeed(tx, source, feed); err != nil {
+			tx.Rollback()
+			return err
+		}
+		sync.AddChange(model.OPMLSourceChangeAdded, feed)
+	}
+
+	if err := s.updateOPMLSource(tx, source); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if record {
+		if err := s.createOPMLSourceSync(tx, sync); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
+
+// createOPMLSourceFeed creates a feed linked to the remote OPML list, the feed is fetched later by the scheduler.
+func (s *Storage) createOPMLSourceFeed(tx *sql.Tx, source *model.OPMLSource, feed *model.Feed) error {
+	query := `
+		INSERT INTO feeds
+			(feed_url, site_url, title, category_id, user_id, opml_source_id)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		RETURNING
+			id
+	`
+	err := tx.QueryRow(
+		query,
+		feed.FeedURL,
+		feed.SiteURL,
+		feed.Title,
+		source.Category.ID,
+		source.UserID,
+		source.ID,
+	).Scan(&feed.ID)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to create feed %q: %v`, feed.FeedURL, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) setFeedDisabled(tx *sql.Tx, userID, feedID int64, disabled bool) error {
+	query := `UPDATE feeds SET disabled=$1 WHERE id=$2 AND user_id=$3`
+	if _, err := tx.Exec(query, disabled, feedID, userID); err != nil {
+		return fmt.Errorf(`store: unable to update feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
+
+// updateOPMLSource stores the result of the last synchronization of the remote OPML list.
+func (s *Storage) updateOPMLSource(tx *sql.Tx, source *model.OPMLSource) error {
+	query := `
+		UPDATE
+			opml_sources
+		SET
+			etag_header=$1,
+			last_modified_header=$2,
+			checked_at=$3,
+			error_msg=$4
+		WHERE
+			id=$5 AND user_id=$6
+	`
+	_, err := tx.Exec(
+		query,
+		source.EtagHeader,
+		source.LastModifiedHeader,
+		source.CheckedAt,
+		source.ErrorMsg,
+		source.ID,
+		source.UserID,
+	)
+
+	if err != nil {
+		return fmt.Errorf(`store: unable to update OPML source #%d: %v`, source.ID, err)
+	}
+
+	return nil
+}
+
+// createOPMLSourceSync stores a synchronization of a remote OPML list and its changes.
+func (s *Storage) createOPMLSourceSync(tx *sql.Tx, sync *model.OPMLSourceSync) error {
+	query := `INSERT INTO opml_source_syncs (source_id, error_msg) VALUES ($1, $2) RETURNING id, created_at`
+	if err := tx.QueryRow(query, sync.SourceID, sync.ErrorMsg).Scan(&sync.ID, &sync.CreatedAt); err != nil {
+		return fmt.Errorf(`store: unable to create synchronization of OPML source #%d: %v`, sync.SourceID, err)
+	}
+
+	query = `
+		INSERT INTO opml_source_changes
+			(sync_id, action, title, feed_url, feed_id)
+		VALUES
+			($1, $2, $3, $4, NULLIF($5, 0))
+		RETURNING
+			id
+	`
+	for _, change := range sync.Changes {
+		change.SyncID = sync.ID
+		err := tx.QueryRow(query, change.SyncID, change.Action, change.Title, change.FeedURL, change.FeedID).Scan(&change.ID)
+		if err != nil {
+			return fmt.Errorf(`store: unable to create change of OPML source #%d: %v`, sync.SourceID, err)
+		}
+	}
+
+	return nil
+}
+
+// OPMLSourceSyncs returns the most recent synchronizations of a remote OPML list.
+func (s *Storage) OPMLSourceSyncs(sourceID int64, limit int) ([]*model.OPMLSourceSync, error) {
+	query := `
+		SELECT
+			id, source_id, error_msg, created_at
+		FROM
+			opml_source_syncs
+		WHERE
+			source_id=$1
+		ORDER BY
+			created_at DESC, id DESC
+		LIMIT $2
+	`
+	rows, err := s.db.Query(query, sourceID, limit)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch synchronizations of OPML source #%d: %v`, sourceID, err)
+	}
+	defer rows.Close()
+
+	syncs := make([]*model.OPMLSourceSync, 0)
+	syncIDs := make([]int64, 0)
+	syncsByID := make(map[int64]*model.OPMLSourceSync)
+	for rows.Next() {
+		var sync model.OPMLSourceSync
+		if err := rows.Scan(&sync.ID, &sync.SourceID, &sync.ErrorMsg, &sync.CreatedAt); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch synchronization row: %v`, err)
+		}
+
+		syncs = append(syncs, &sync)
+		syncIDs = append(syncIDs, sync.ID)
+		syncsByID[sync.ID] = &sync
+	}
+
+	if len(syncs) == 0 {
+		return syncs, nil
+	}
+
+	query = `
+		SELECT
+			id, sync_id, action, title, feed_url, coalesce(feed_id, 0)
+		FROM
+			opml_source_changes
+		WHERE
+			sync_id=ANY($1)
+		ORDER BY
+			id ASC
+	`
+	changeRows, err := s.db.Query(query, pq.Array(syncIDs))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch changes of OPML source #%d: %v`, sourceID, err)
+	}
+	defer changeRows.Close()
+
+	for changeRows.Next() {
+		var change model.OPMLSourceChange
+		err := changeRows.Scan(&change.ID, &change.SyncID, &change.Action, &change.Title, &change.FeedURL, &change.FeedID)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch change row: %v`, err)
+		}
+
+		if sync, found := syncsByID[change.SyncID]; found {
+			sync.Changes = append(sync.Changes, &change)
+		}
+	}
+
+	return syncs, nil
+}
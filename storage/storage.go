@@ -10,10 +10,11 @@ import (
 
 // Storage handles all operations related to the database.
 type Storage struct {
-	db *sql.DB
+	db      *sql.DB
+	keyring *keyring
 }
 
 // NewStorage returns a new Storage.
 func NewStorage(db *sql.DB) *Storage {
-	return &Storage{db}
+	return &Storage{db: db}
 }
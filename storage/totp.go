@@ -0,0 +1,148 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/crypto"
+	"miniflux.app/totp"
+)
+
+// HasTOTP returns true if the given user has enabled two-factor authentication.
+func (s *Storage) HasTOTP(userID int64) bool {
+	var result bool
+	query := `SELECT true FROM users WHERE id=$1 AND totp_secret <> ''`
+	s.db.QueryRow(query, userID).Scan(&result)
+	return result
+}
+
+// HasTOTPByUsername returns true if the given user has enabled two-factor authentication.
+func (s *Storage) HasTOTPByUsername(username string) bool {
+	var result bool
+	query := `SELECT true FROM users WHERE username=LOWER($1) AND totp_secret <> ''`
+	s.db.QueryRow(query, username).Scan(&result)
+	return result
+}
+
+// TOTPSecret returns the two-factor authentication secret and the last time step used by the given user.
+func (s *Storage) TOTPSecret(userID int64) (secret string, lastCounter int64, err error) {
+	query := `SELECT totp_secret, totp_last_counter FROM users WHERE id=$1`
+	if err = s.db.QueryRow(query, userID).Scan(&secret, &lastCounter); err != nil {
+		return "", 0, fmt.Errorf(`store: unable to fetch TOTP secret: %v`, err)
+	}
+
+	if secret, err = s.decryptValue(secret); err != nil {
+		return "", 0, err
+	}
+	return secret, lastCounter, nil
+}
+
+// UseTOTPCounter records the time step of a one-time password so the code cannot be replayed.
+// It returns false when the same or a later time step has already been used.
+func (s *Storage) UseTOTPCounter(userID, counter int64) (bool, error) {
+	query := `UPDATE users SET totp_last_counter=$1 WHERE id=$2 AND totp_last_counter < $1`
+	result, err := s.db.Exec(query, counter, userID)
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to update TOTP counter: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to update TOTP counter: %v`, err)
+	}
+
+	return count == 1, nil
+}
+
+// EnableTOTP saves the two-factor authentication secret and replaces the recovery codes.
+func (s *Storage) EnableTOTP(userID int64, secret string, counter int64, recoveryCodes []string) error {
+	secret, err := s.encryptValue(secret)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	if _, err := tx.Exec(`UPDATE users SET totp_secret=$1, totp_last_counter=$2 WHERE id=$3`, secret, counter, userID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to enable TOTP: %v`, err)
+	}
+
+	if err := replaceRecoveryCodes(tx, userID, recoveryCodes); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
+
+// DisableTOTP removes the two-factor authentication secret and the recovery codes of the given user.
+func (s *Storage) DisableTOTP(userID int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	if _, err := tx.Exec(`UPDATE users SET totp_secret='', totp_last_counter=0 WHERE id=$1`, userID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to disable TOTP: %v`, err)
+	}
+
+	if err := replaceRecoveryCodes(tx, userID, nil); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
+
+// CountTOTPRecoveryCodes returns the number of unused recovery codes.
+func (s *Storage) CountTOTPRecoveryCodes(userID int64) int {
+	var result int
+	s.db.QueryRow(`SELECT count(*) FROM totp_recovery_codes WHERE user_id=$1`, userID).Scan(&result)
+	return result
+}
+
+// UseTOTPRecoveryCode consumes a recovery code and returns true if it was valid.
+func (s *Storage) UseTOTPRecoveryCode(userID int64, code string) (bool, error) {
+	result, err := s.db.Exec(`DELETE FROM totp_recovery_codes WHERE user_id=$1 AND code_hash=$2`, userID, crypto.Hash(totp.NormalizeRecoveryCode(code)))
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to use recovery code: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to use recovery code: %v`, err)
+	}
+
+	return count == 1, nil
+}
+
+func replaceRecoveryCodes(tx *sql.Tx, userID int64, recoveryCodes []string) error {
+	if _, err := tx.Exec(`DELETE FROM totp_recovery_codes WHERE user_id=$1`, userID); err != nil {
+		return fmt.Errorf(`store: unable to remove recovery codes: %v`, err)
+	}
+
+	for _, code := range recoveryCodes {
+		if _, err := tx.Exec(`INSERT INTO totp_recovery_codes (user_id, code_hash) VALUES ($1, $2)`, userID, crypto.Hash(totp.NormalizeRecoveryCode(code))); err != nil {
+			return fmt.Errorf(`store: unable to create recovery code: %v`, err)
+		}
+	}
+
+	return nil
+}
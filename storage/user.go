@@ -66,9 +66,9 @@ func (s *Storage) CreateUser(userCreationRequest *model.UserCreationRequest) (*m
 
 	query := `
 		INSERT INTO users
-			(username, password, is_admin, google_id, openid_connect_id)
+			(username, password, is_admin, google_id, openid_connect_id, github_id, gitlab_id, ldap_dn)
 		VALUES
-			(LOWER($1), $2, $3, $4, $5)
+			(LOWER($1), $2, $3, $4, $5, $6, $7, $8)
 		RETURNING
 			id,
 			username,
@@ -81,9 +81,13 @@ func (s *Storage) CreateUser(userCreationRequest *model.UserCreationRequest) (*m
 			keyboard_shortcuts,
 			show_reading_time,
 			entry_swipe,
+			archive_starred,
 			stylesheet,
 			google_id,
-			openid_connect_id
+			openid_connect_id,
+			github_id,
+			gitlab_id,
+			ldap_dn
 	`
 
 	tx, err := s.db.Begin()
@@ -99,6 +103,9 @@ func (s *Storage) CreateUser(userCreationRequest *model.UserCreationRequest) (*m
 		userCreationRequest.IsAdmin,
 		userCreationRequest.GoogleID,
 		userCreationRequest.OpenIDConnectID,
+		userCreationRequest.GitHubID,
+		userCreationRequest.GitLabID,
+		userCreationRequest.LDAPDN,
 	).Scan(
 		&user.ID,
 		&user.Username,
@@ -111,9 +118,13 @@ func (s *Storage) CreateUser(userCreationRequest *model.UserCreationRequest) (*m
 		&user.KeyboardShortcuts,
 		&user.ShowReadingTime,
 		&user.EntrySwipe,
+		&user.ArchiveStarred,
 		&user.Stylesheet,
 		&user.GoogleID,
 		&user.OpenIDConnectID,
+		&user.GitHubID,
+		&user.GitLabID,
+		&user.LDAPDN,
 	)
 	if err != nil {
 		tx.Rollback()
@@ -160,11 +171,14 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				keyboard_shortcuts=$9,
 				show_reading_time=$10,
 				entry_swipe=$11,
-				stylesheet=$12,
-				google_id=$13,
-				openid_connect_id=$14
+				archive_starred=$12,
+				stylesheet=$13,
+				google_id=$14,
+				openid_connect_id=$15,
+				github_id=$16,
+				gitlab_id=$17
 			WHERE
-				id=$15
+				id=$18
 		`
 
 		_, err = s.db.Exec(
@@ -180,9 +194,12 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.KeyboardShortcuts,
 			user.ShowReadingTime,
 			user.EntrySwipe,
+			user.ArchiveStarred,
 			user.Stylesheet,
 			user.GoogleID,
 			user.OpenIDConnectID,
+			user.GitHubID,
+			user.GitLabID,
 			user.ID,
 		)
 		if err != nil {
@@ -201,11 +218,14 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				keyboard_shortcuts=$8,
 				show_reading_time=$9,
 				entry_swipe=$10,
-				stylesheet=$11,
-				google_id=$12,
-				openid_connect_id=$13
+				archive_starred=$11,
+				stylesheet=$12,
+				google_id=$13,
+				openid_connect_id=$14,
+				github_id=$15,
+				gitlab_id=$16
 			WHERE
-				id=$14
+				id=$17
 		`
 
 		_, err := s.db.Exec(
@@ -220,9 +240,12 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.KeyboardShortcuts,
 			user.ShowReadingTime,
 			user.EntrySwipe,
+			user.ArchiveStarred,
 			user.Stylesheet,
 			user.GoogleID,
 			user.OpenIDConnectID,
+			user.GitHubID,
+			user.GitLabID,
 			user.ID,
 		)
 
@@ -234,6 +257,17 @@ func (s *Storage) UpdateUser(user *model.User) error {
 	return nil
 }
 
+// SetUserLDAPDN updates the directory entry linked to a user.
+func (s *Storage) SetUserLDAPDN(userID int64, dn string) error {
+	query := `UPDATE users SET ldap_dn=$1 WHERE id=$2`
+	_, err := s.db.Exec(query, dn, userID)
+	if err != nil {
+		return fmt.Errorf(`store: unable to update LDAP DN: %v`, err)
+	}
+
+	return nil
+}
+
 // UserLanguage returns the language of the given user.
 func (s *Storage) UserLanguage(userID int64) (language string) {
 	err := s.db.QueryRow(`SELECT language FROM users WHERE id = $1`, userID).Scan(&language)
@@ -259,10 +293,14 @@ func (s *Storage) UserByID(userID int64) (*model.User, error) {
 			keyboard_shortcuts,
 			show_reading_time,
 			entry_swipe,
+			archive_starred,
 			last_login_at,
 			stylesheet,
 			google_id,
-			openid_connect_id
+			openid_connect_id,
+			github_id,
+			gitlab_id,
+			ldap_dn
 		FROM
 			users
 		WHERE
@@ -286,10 +324,14 @@ func (s *Storage) UserByUsername(username string) (*model.User, error) {
 			keyboard_shortcuts,
 			show_reading_time,
 			entry_swipe,
+			archive_starred,
 			last_login_at,
 			stylesheet,
 			google_id,
-			openid_connect_id
+			openid_connect_id,
+			github_id,
+			gitlab_id,
+			ldap_dn
 		FROM
 			users
 		WHERE
@@ -313,10 +355,14 @@ func (s *Storage) UserByField(field, value string) (*model.User, error) {
 			keyboard_shortcuts,
 			show_reading_time,
 			entry_swipe,
+			archive_starred,
 			last_login_at,
 			stylesheet,
 			google_id,
-			openid_connect_id
+			openid_connect_id,
+			github_id,
+			gitlab_id,
+			ldap_dn
 		FROM
 			users
 		WHERE
@@ -347,10 +393,14 @@ func (s *Storage) UserByAPIKey(token string) (*model.User, error) {
 			u.keyboard_shortcuts,
 			u.show_reading_time,
 			u.entry_swipe,
+			u.archive_starred,
 			u.last_login_at,
 			u.stylesheet,
 			u.google_id,
-			u.openid_connect_id
+			u.openid_connect_id,
+			u.github_id,
+			u.gitlab_id,
+			u.ldap_dn
 		FROM
 			users u
 		LEFT JOIN
@@ -375,10 +425,14 @@ func (s *Storage) fetchUser(query string, args ...interface{}) (*model.User, err
 		&user.KeyboardShortcuts,
 		&user.ShowReadingTime,
 		&user.EntrySwipe,
+		&user.ArchiveStarred,
 		&user.LastLoginAt,
 		&user.Stylesheet,
 		&user.GoogleID,
 		&user.OpenIDConnectID,
+		&user.GitHubID,
+		&user.GitLabID,
+		&user.LDAPDN,
 	)
 
 	if err == sql.ErrNoRows {
@@ -439,10 +493,14 @@ func (s *Storage) Users() (model.Users, error) {
 			keyboard_shortcuts,
 			show_reading_time,
 			entry_swipe,
+			archive_starred,
 			last_login_at,
 			stylesheet,
 			google_id,
-			openid_connect_id
+			openid_connect_id,
+			github_id,
+			gitlab_id,
+			ldap_dn
 		FROM
 			users
 		ORDER BY username ASC
@@ -468,10 +526,14 @@ func (s *Storage) Users() (model.Users, error) {
 			&user.KeyboardShortcuts,
 			&user.ShowReadingTime,
 			&user.EntrySwipe,
+			&user.ArchiveStarred,
 			&user.LastLoginAt,
 			&user.Stylesheet,
 			&user.GoogleID,
 			&user.OpenIDConnectID,
+			&user.GitHubID,
+			&user.GitLabID,
+			&user.LDAPDN,
 		)
 
 		if err != nil {
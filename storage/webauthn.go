@@ -0,0 +1,145 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package storage // import "miniflux.app/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/model"
+)
+
+// WebAuthnCredentials returns all security keys and passkeys registered by the given user.
+func (s *Storage) WebAuthnCredentials(userID int64) (model.WebAuthnCredentials, error) {
+	query := `
+		SELECT
+			id, user_id, credential_id, public_key, sign_count, name, last_used_at, created_at
+		FROM
+			webauthn_credentials
+		WHERE
+			user_id=$1
+		ORDER BY created_at ASC
+	`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch WebAuthn credentials: %v`, err)
+	}
+	defer rows.Close()
+
+	credentials := make(model.WebAuthnCredentials, 0)
+	for rows.Next() {
+		var credential model.WebAuthnCredential
+		if err := rows.Scan(
+			&credential.ID,
+			&credential.UserID,
+			&credential.CredentialID,
+			&credential.PublicKey,
+			&credential.SignCount,
+			&credential.Name,
+			&credential.LastUsedAt,
+			&credential.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch WebAuthn credential row: %v`, err)
+		}
+
+		credentials = append(credentials, &credential)
+	}
+
+	return credentials, nil
+}
+
+// WebAuthnCredentialByCredentialID finds a credential by the identifier given by the authenticator.
+func (s *Storage) WebAuthnCredentialByCredentialID(credentialID string) (*model.WebAuthnCredential, error) {
+	query := `
+		SELECT
+			id, user_id, credential_id, public_key, sign_count, name, last_used_at, created_at
+		FROM
+			webauthn_credentials
+		WHERE
+			credential_id=$1
+	`
+
+	var credential model.WebAuthnCredential
+	err := s.db.QueryRow(query, credentialID).Scan(
+		&credential.ID,
+		&credential.UserID,
+		&credential.CredentialID,
+		&credential.PublicKey,
+		&credential.SignCount,
+		&credential.Name,
+		&credential.LastUsedAt,
+		&credential.CreatedAt,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch WebAuthn credential: %v`, err)
+	}
+
+	return &credential, nil
+}
+
+// CreateWebAuthnCredential saves a new security key or passkey.
+func (s *Storage) CreateWebAuthnCredential(credential *model.WebAuthnCredential) error {
+	query := `
+		INSERT INTO webauthn_credentials
+			(user_id, credential_id, public_key, sign_count, name)
+		VALUES
+			($1, $2, $3, $4, $5)
+		RETURNING
+			id, created_at
+	`
+	err := s.db.QueryRow(
+		query,
+		credential.UserID,
+		credential.CredentialID,
+		credential.PublicKey,
+		credential.SignCount,
+		credential.Name,
+	).Scan(
+		&credential.ID,
+		&credential.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf(`store: unable to create WebAuthn credential: %v`, err)
+	}
+
+	return nil
+}
+
+// UseWebAuthnCredential records a successful assertion and its signature counter.
+// It returns false when a concurrent login already stored the same or a higher counter.
+func (s *Storage) UseWebAuthnCredential(credentialID int64, signCount uint32) (bool, error) {
+	query := `
+		UPDATE
+			webauthn_credentials
+		SET
+			sign_count=$1, last_used_at=now()
+		WHERE
+			id=$2 AND (sign_count < $1 OR ($1 = 0 AND sign_count = 0))
+	`
+	result, err := s.db.Exec(query, signCount, credentialID)
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to update WebAuthn credential: %v`, err)
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf(`store: unable to update WebAuthn credential: %v`, err)
+	}
+
+	return count == 1, nil
+}
+
+// RemoveWebAuthnCredential deletes a security key or passkey.
+func (s *Storage) RemoveWebAuthnCredential(userID, credentialID int64) error {
+	query := `DELETE FROM webauthn_credentials WHERE id=$1 AND user_id=$2`
+	if _, err := s.db.Exec(query, credentialID, userID); err != nil {
+		return fmt.Errorf(`store: unable to remove WebAuthn credential: %v`, err)
+	}
+	return nil
+}
@@ -3,6 +3,29 @@
 package template // import "miniflux.app/template"
 
 var templateCommonMap = map[string]string{
+	"audit_events": `{{ define "audit_events" }}
+<table>
+    <tr>
+        <th>{{ t "page.audit.date" }}</th>
+        <th>{{ t "page.audit.action" }}</th>
+        <th>{{ t "page.audit.actor" }}</th>
+        <th>{{ t "page.audit.target" }}</th>
+        <th>{{ t "page.audit.client_ip" }}</th>
+    </tr>
+    {{ range .events }}
+    <tr>
+        <td class="column-20">
+            <time datetime="{{ isodate .CreatedAt }}" title="{{ isodate .CreatedAt }}">{{ elapsed $.user.Timezone .CreatedAt }}</time>
+        </td>
+        <td class="column-25">{{ t (printf "audit.action.%s" .Action) }}</td>
+        <td class="column-20">{{ .Actor }}</td>
+        <td>{{ .Target }}</td>
+        <td class="column-20" title="{{ .UserAgent }}">{{ .ClientIP }}</td>
+    </tr>
+    {{ end }}
+</table>
+{{ end }}
+`,
 	"entry_pagination": `{{ define "entry_pagination" }}
 <div class="pagination">
     <div class="pagination-prev">
@@ -99,11 +122,37 @@ var templateCommonMap = map[string]string{
     <li>
         <a href="{{ route "import" }}">{{ t "menu.import" }}</a>
     </li>
+    <li>
+        <a href="{{ route "opmlSources" }}">{{ t "menu.opml_sources" }}</a>
+    </li>
     <li>
         <a href="{{ route "refreshAllFeeds" }}">{{ t "menu.refresh_all_feeds" }}</a>
     </li>
 </ul>
 {{ end }}`,
+	"feed_selectors": `{{ define "feed_selectors" }}
+<fieldset>
+    <legend>{{ t "form.scraped_feed.legend.selectors" }}</legend>
+
+    <label for="form-selector-item">{{ t "form.scraped_feed.label.item" }}</label>
+    <input type="text" name="selector_item" id="form-selector-item" placeholder="article" value="{{ .Item }}" spellcheck="false" required>
+
+    <label for="form-selector-title">{{ t "form.scraped_feed.label.title" }}</label>
+    <input type="text" name="selector_title" id="form-selector-title" placeholder="h2" value="{{ .Title }}" spellcheck="false">
+
+    <label for="form-selector-link">{{ t "form.scraped_feed.label.link" }}</label>
+    <input type="text" name="selector_link" id="form-selector-link" placeholder="a" value="{{ .Link }}" spellcheck="false">
+
+    <label for="form-selector-date">{{ t "form.scraped_feed.label.date" }}</label>
+    <input type="text" name="selector_date" id="form-selector-date" placeholder="time" value="{{ .Date }}" spellcheck="false">
+
+    <label for="form-selector-content">{{ t "form.scraped_feed.label.content" }}</label>
+    <input type="text" name="selector_content" id="form-selector-content" placeholder=".summary" value="{{ .Content }}" spellcheck="false">
+
+    <p class="form-help">{{ t "form.scraped_feed.help" }}</p>
+</fieldset>
+{{ end }}
+`,
 	"icons": `<!--
 
 MIT License
@@ -232,6 +281,14 @@ SOFTWARE.
     <line x1="8" y1="16" x2="12" y2="16" />
 </svg>
 {{ end }}
+{{ define "icon_archive" }}
+<svg xmlns="http://www.w3.org/2000/svg" class="icon icon-tabler icon-tabler-archive" width="24" height="24" viewBox="0 0 24 24" stroke-width="2" stroke="currentColor" fill="none" stroke-linecap="round" stroke-linejoin="round">
+    <path stroke="none" d="M0 0h24v24H0z"/>
+    <rect x="3" y="4" width="18" height="4" rx="2" />
+    <path d="M5 8v10a2 2 0 0 0 2 2h10a2 2 0 0 0 2 -2v-10" />
+    <line x1="10" y1="12" x2="14" y2="12" />
+</svg>
+{{ end }}
 {{ define "icon_refresh" }}
 <svg xmlns="http://www.w3.org/2000/svg" class="icon icon-tabler icon-tabler-refresh" width="24" height="24" viewBox="0 0 24 24" stroke-width="2" stroke="currentColor" fill="none" stroke-linecap="round" stroke-linejoin="round">
     <path stroke="none" d="M0 0h24v24H0z"/>
@@ -494,7 +551,7 @@ SOFTWARE.
 <div class="pagination">
     <div class="pagination-prev">
         {{ if .ShowPrev }}
-            <a href="{{ .Route }}{{ if gt .PrevOffset 0 }}?offset={{ .PrevOffset }}{{ if .SearchQuery }}&amp;q={{ .SearchQuery }}{{ end }}{{ else }}{{ if .SearchQuery }}?q={{ .SearchQuery }}{{ end }}{{ end }}" data-page="previous" rel="prev">{{ t "pagination.previous" }}</a>
+            <a href="{{ .Route }}{{ if gt .PrevOffset 0 }}?offset={{ .PrevOffset }}{{ if .SearchQuery }}&amp;q={{ .SearchQuery }}{{ end }}{{ if .Tag }}&amp;tag={{ .Tag }}{{ end }}{{ else }}{{ if .SearchQuery }}?q={{ .SearchQuery }}{{ end }}{{ if .Tag }}?tag={{ .Tag }}{{ end }}{{ end }}" data-page="previous" rel="prev">{{ t "pagination.previous" }}</a>
         {{ else }}
             {{ t "pagination.previous" }}
         {{ end }}
@@ -502,7 +559,7 @@ SOFTWARE.
 
     <div class="pagination-next">
         {{ if .ShowNext }}
-            <a href="{{ .Route }}?offset={{ .NextOffset }}{{ if .SearchQuery }}&amp;q={{ .SearchQuery }}{{ end }}" data-page="next" rel="next">{{ t "pagination.next" }}</a>
+            <a href="{{ .Route }}?offset={{ .NextOffset }}{{ if .SearchQuery }}&amp;q={{ .SearchQuery }}{{ end }}{{ if .Tag }}&amp;tag={{ .Tag }}{{ end }}" data-page="next" rel="next">{{ t "pagination.next" }}</a>
         {{ else }}
             {{ t "pagination.next" }}
         {{ end }}
@@ -524,10 +581,22 @@ SOFTWARE.
     <li>
         <a href="{{ route "sessions" }}">{{ t "menu.sessions" }}</a>
     </li>
+    <li>
+        <a href="{{ route "totp" }}">{{ t "menu.totp" }}</a>
+    </li>
+    <li>
+        <a href="{{ route "webAuthnCredentials" }}">{{ t "menu.webauthn" }}</a>
+    </li>
+    <li>
+        <a href="{{ route "securityActivity" }}">{{ t "menu.security_activity" }}</a>
+    </li>
     {{ if .user.IsAdmin }}
         <li>
             <a href="{{ route "users" }}">{{ t "menu.users" }}</a>
         </li>
+        <li>
+            <a href="{{ route "auditEvents" }}">{{ t "menu.audit" }}</a>
+        </li>
     {{ end }}
     <li>
         <a href="{{ route "about" }}">{{ t "menu.about" }}</a>
@@ -537,12 +606,14 @@ SOFTWARE.
 }
 
 var templateCommonMapChecksums = map[string]string{
+	"audit_events":     "afd2bc5698b5dfde0a73d2a8803cd24a650daf0980e33455833f4766d1f14449",
 	"entry_pagination": "cdca9cf12586e41e5355190b06d9168f57f77b85924d1e63b13524bc15abcbf6",
 	"feed_list":        "931e43d328a116318c510de5658c688cd940b934c86b6ec82a472e1f81e020ae",
-	"feed_menu":        "318d8662dda5ca9dfc75b909c8461e79c86fb5082df1428f67aaf856f19f4b50",
-	"icons":            "7161afa4cce46245a99cb1e49a605d3ff30e907c3f568ef9c17218718d20e042",
+	"feed_menu":        "e803df68978ee8307903f569430c5ae549ed40da9dfbb4e3c58de2b864aacca7",
+	"feed_selectors":   "35d4ec968299609a2aa57f6ce4473c0974c7d28c16872c75a38df3968ea1b9ca",
+	"icons":            "1f9c59d1b2f36fad92ac12e6691c47c4bcf4664e438f657c77c9b1211a53f4b3",
 	"item_meta":        "fefa219c8296f0370632336ed59a2c8b0c2146ee77f3b10de1d9b87982219dc5",
 	"layout":           "03c77ed0163b790c0622ecec173119537087c66f6a3925a931ae83a9a94d32cf",
-	"pagination":       "7b61288e86283c4cf0dc83bcbf8bf1c00c7cb29e60201c8c0b633b2450d2911f",
-	"settings_menu":    "e2b777630c0efdbc529800303c01d6744ed3af80ec505ac5a5b3f99c9b989156",
+	"pagination":       "9513467fd310e06420f7dd2ed2baa7119f26e59dc2449ce77cf658ba5b7b5b09",
+	"settings_menu":    "1c90b5f2101b9e16e44fd8a5dfe0a3e5b6b407da846af48f1d6f656c3618b5fc",
 }
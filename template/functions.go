@@ -31,6 +31,7 @@ type funcMap struct {
 func (f *funcMap) Map() template.FuncMap {
 	return template.FuncMap{
 		"formatFileSize": formatFileSize,
+		"formatDuration": formatDuration,
 		"dict":           dict,
 		"hasKey":         hasKey,
 		"truncate":       truncate,
@@ -57,9 +58,15 @@ func (f *funcMap) Map() template.FuncMap {
 			return proxy.ImageProxyRewriter(f.router, data)
 		},
 		"proxyURL": func(link string) string {
-			proxyImages := config.Opts.ProxyImages()
+			if proxy.ShouldProxify(proxy.MediaTypeImage, link) {
+				return proxy.ProxifyURL(f.router, link)
+			}
 
-			if proxyImages == "all" || (proxyImages != "none" && !url.IsHTTPS(link)) {
+			return link
+		},
+		"proxyMediaURL": func(mimeType, link string) string {
+			mediaType := strings.SplitN(mimeType, "/", 2)[0]
+			if proxy.ShouldProxify(mediaType, link) {
 				return proxy.ProxifyURL(f.router, link)
 			}
 
@@ -176,6 +183,19 @@ func elapsedTime(printer *locale.Printer, tz string, t time.Time) string {
 	}
 }
 
+// formatDuration formats a duration in seconds as H:MM:SS or M:SS.
+func formatDuration(seconds int) string {
+	if seconds < 0 {
+		seconds = 0
+	}
+
+	hours, minutes := seconds/3600, seconds%3600/60
+	if hours > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", hours, minutes, seconds%60)
+	}
+	return fmt.Sprintf("%d:%02d", minutes, seconds%60)
+}
+
 func formatFileSize(b int64) string {
 	const unit = 1024
 	if b < unit {
@@ -144,3 +144,23 @@ func TestFormatFileSize(t *testing.T) {
 		}
 	}
 }
+
+func TestFormatDuration(t *testing.T) {
+	scenarios := []struct {
+		input    int
+		expected string
+	}{
+		{-1, "0:00"},
+		{0, "0:00"},
+		{95, "1:35"},
+		{3600, "1:00:00"},
+		{3725, "1:02:05"},
+	}
+
+	for _, scenario := range scenarios {
+		result := formatDuration(scenario.input)
+		if result != scenario.expected {
+			t.Errorf(`Unexpected result, got %q instead of %q for %d`, result, scenario.expected, scenario.input)
+		}
+	}
+}
@@ -111,6 +111,13 @@ var templateViewsMap = map[string]string{
             <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.loading" }}">{{ t "page.add_feed.submit" }}</button>
         </div>
     </form>
+
+    <p><a href="{{ route "createScrapedFeed" }}{{ if .form.URL }}?url={{ .form.URL }}{{ end }}">{{ t "page.add_feed.scraped_feed" }}</a></p>
+    <p><a href="{{ route "createWatchPage" }}{{ if .form.URL }}?url={{ .form.URL }}{{ end }}">{{ t "page.add_feed.watch_page" }}</a></p>
+
+    {{ if .hasNewsletter }}
+    <p><a href="{{ route "createNewsletter" }}">{{ t "page.add_feed.newsletter" }}</a></p>
+    {{ end }}
 {{ end }}
 
 {{ end }}
@@ -186,6 +193,65 @@ var templateViewsMap = map[string]string{
     <a href="{{ route "createAPIKey" }}" class="button button-primary">{{ t "menu.create_api_key" }}</a>
 </p>
 
+{{ end }}
+`,
+	"audit": `{{ define "title"}}{{ t "page.audit.title" }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.audit.title" }} ({{ .total }})</h1>
+    {{ template "settings_menu" dict "user" .user }}
+</section>
+
+<form method="get" action="{{ route "auditEvents" }}">
+    <label for="form-user-id">{{ t "page.audit.user" }}</label>
+    <select id="form-user-id" name="user_id">
+        <option value="">{{ t "page.audit.all_users" }}</option>
+    {{ range .users }}
+        <option value="{{ .ID }}" {{ if eq .ID $.filterUserID }}selected="selected"{{ end }}>{{ .Username }}</option>
+    {{ end }}
+    </select>
+
+    <label for="form-action">{{ t "page.audit.action" }}</label>
+    <select id="form-action" name="action">
+        <option value="">{{ t "page.audit.all_actions" }}</option>
+    {{ range .actions }}
+        <option value="{{ . }}" {{ if eq . $.filterAction }}selected="selected"{{ end }}>{{ t (printf "audit.action.%s" .) }}</option>
+    {{ end }}
+    </select>
+
+    <label for="form-client-ip">{{ t "page.audit.client_ip" }}</label>
+    <input type="text" name="client_ip" id="form-client-ip" value="{{ .filterClientIP }}">
+
+    <div class="buttons">
+        <button type="submit" class="button button-primary">{{ t "action.filter" }}</button>
+    </div>
+</form>
+
+{{ if not .events }}
+    <p class="alert">{{ t "alert.no_audit_event" }}</p>
+{{ else }}
+    {{ template "audit_events" dict "events" .events "user" .user }}
+
+    <div class="pagination">
+        <div class="pagination-prev">
+            {{ if .pagination.ShowPrev }}
+                <a href="{{ .prevURL }}" data-page="previous" rel="prev">{{ t "pagination.previous" }}</a>
+            {{ else }}
+                {{ t "pagination.previous" }}
+            {{ end }}
+        </div>
+
+        <div class="pagination-next">
+            {{ if .pagination.ShowNext }}
+                <a href="{{ .nextURL }}" data-page="next" rel="next">{{ t "pagination.next" }}</a>
+            {{ else }}
+                {{ t "pagination.next" }}
+            {{ end }}
+        </div>
+    </div>
+{{ end }}
+
 {{ end }}
 `,
 	"bookmark_entries": `{{ define "title"}}{{ t "page.starred.title" }} ({{ .total }}){{ end }}
@@ -193,6 +259,13 @@ var templateViewsMap = map[string]string{
 {{ define "content"}}
 <section class="page-header">
     <h1>{{ t "page.starred.title" }} ({{ .total }})</h1>
+    {{ if .entries }}
+    <ul>
+        <li>
+            <a href="{{ route "exportStarredEntries" }}">{{ t "menu.export_epub" }}</a>
+        </li>
+    </ul>
+    {{ end }}
 </section>
 
 {{ if not .entries }}
@@ -308,6 +381,11 @@ var templateViewsMap = map[string]string{
         <li>
             <a href="{{ route "categoryFeeds" "categoryID" .category.ID }}">{{ t "menu.feeds" }}</a>
         </li>
+    {{ if and .entries .showOnlyUnreadEntries }}
+        <li>
+            <a href="{{ route "exportCategoryEntries" "categoryID" .category.ID }}">{{ t "menu.export_epub" }}</a>
+        </li>
+    {{ end }}
     </ul>
 </section>
 
@@ -473,6 +551,130 @@ var templateViewsMap = map[string]string{
         <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.save" }}</button> {{ t "action.or" }} <a href="{{ route "categories" }}">{{ t "action.cancel" }}</a>
     </div>
 </form>
+{{ end }}
+`,
+	"create_newsletter": `{{ define "title"}}{{ t "page.new_newsletter.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.new_newsletter.title" }}</h1>
+    {{ template "feed_menu" }}
+</section>
+
+{{ if not .categories }}
+    <p class="alert alert-error">{{ t "page.add_feed.no_category" }}</p>
+{{ else }}
+    <p class="form-help">{{ t "page.new_newsletter.help" }}</p>
+
+    <form action="{{ route "saveNewsletter" }}" method="post" autocomplete="off">
+        <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+        {{ if .errorMessage }}
+            <div class="alert alert-error">{{ t .errorMessage }}</div>
+        {{ end }}
+
+        <label for="form-title">{{ t "form.feed.label.title" }}</label>
+        <input type="text" name="title" id="form-title" value="{{ .form.Title }}" required autofocus>
+
+        <label for="form-category">{{ t "form.feed.label.category" }}</label>
+        <select id="form-category" name="category_id">
+            {{ range .categories }}
+                <option value="{{ .ID }}" {{ if eq $.form.CategoryID .ID }}selected="selected"{{ end }}>{{ .Title }}</option>
+            {{ end }}
+        </select>
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.save" }}</button> {{ t "action.or" }} <a href="{{ route "addSubscription" }}">{{ t "action.cancel" }}</a>
+        </div>
+    </form>
+{{ end }}
+
+{{ end }}
+`,
+	"create_scraped_feed": `{{ define "title"}}{{ t "page.new_scraped_feed.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.new_scraped_feed.title" }}</h1>
+    {{ template "feed_menu" }}
+</section>
+
+{{ if not .categories }}
+    <p class="alert alert-error">{{ t "page.add_feed.no_category" }}</p>
+{{ else }}
+    <p class="form-help">{{ t "page.new_scraped_feed.help" }}</p>
+
+    <form action="{{ route "saveScrapedFeed" }}" method="post" autocomplete="off">
+        <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+        {{ if .errorMessage }}
+            <div class="alert alert-error">{{ t .errorMessage }}</div>
+        {{ end }}
+
+        <label for="form-page-url">{{ t "form.scraped_feed.label.page_url" }}</label>
+        <input type="url" name="page_url" id="form-page-url" placeholder="https://domain.tld/news" value="{{ .form.PageURL }}" spellcheck="false" required autofocus>
+
+        <label for="form-category">{{ t "form.feed.label.category" }}</label>
+        <select id="form-category" name="category_id">
+            {{ range .categories }}
+                <option value="{{ .ID }}" {{ if eq $.form.CategoryID .ID }}selected="selected"{{ end }}>{{ .Title }}</option>
+            {{ end }}
+        </select>
+
+        {{ template "feed_selectors" .form.Selectors }}
+
+        <details>
+            <summary>{{ t "page.add_feed.legend.advanced_options" }}</summary>
+            <div class="details-content">
+                <label for="form-title">{{ t "form.feed.label.title" }}</label>
+                <input type="text" name="title" id="form-title" value="{{ .form.Title }}">
+
+                <label><input type="checkbox" name="crawler" value="1" {{ if .form.Crawler }}checked{{ end }}> {{ t "form.feed.label.crawler" }}</label>
+                {{ if .hasProxyConfigured }}
+                <label><input type="checkbox" name="fetch_via_proxy" value="1" {{ if .form.FetchViaProxy }}checked{{ end }}> {{ t "form.feed.label.fetch_via_proxy" }}</label>
+                {{ end }}
+
+                <label for="form-user-agent">{{ t "form.feed.label.user_agent" }}</label>
+                <input type="text" name="user_agent" id="form-user-agent" placeholder="{{ .defaultUserAgent }}" value="{{ .form.UserAgent }}" spellcheck="false" autocomplete="off">
+            </div>
+        </details>
+
+        <div class="buttons">
+            <button type="submit" class="button" data-label-loading="{{ t "form.submit.loading" }}">{{ t "action.preview" }}</button>
+            <button type="submit" name="action" value="save" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.save" }}</button> {{ t "action.or" }} <a href="{{ route "addSubscription" }}">{{ t "action.cancel" }}</a>
+        </div>
+    </form>
+
+    {{ if .preview }}
+    <h3>{{ plural "page.new_scraped_feed.preview" (len .preview.Entries) (len .preview.Entries) }}</h3>
+    <div class="items">
+        {{ range .preview.Entries }}
+        <article class="item">
+            <div class="item-header">
+                <span class="item-title">
+                    <a href="{{ .URL | safeURL }}" rel="noopener noreferrer" referrerpolicy="no-referrer" target="_blank">{{ .Title }}</a>
+                </span>
+            </div>
+            <div class="item-meta">
+                <ul class="item-meta-info">
+                    <li class="item-meta-info-timestamp">
+                        <time datetime="{{ isodate .Date }}" title="{{ isodate .Date }}">{{ elapsed $.user.Timezone .Date }}</time>
+                    </li>
+                    <li>{{ domain .URL }}</li>
+                </ul>
+            </div>
+            {{ if .Content }}
+            <details>
+                <summary>{{ t "page.new_scraped_feed.content" }}</summary>
+                <div class="entry-content">{{ noescape .Content }}</div>
+            </details>
+            {{ end }}
+        </article>
+        {{ end }}
+    </div>
+    {{ end }}
+{{ end }}
+
 {{ end }}
 `,
 	"create_user": `{{ define "title"}}{{ t "page.new_user.title" }}{{ end }}
@@ -505,6 +707,63 @@ var templateViewsMap = map[string]string{
         <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.save" }}</button> {{ t "action.or" }} <a href="{{ route "users" }}">{{ t "action.cancel" }}</a>
     </div>
 </form>
+{{ end }}
+`,
+	"create_watch_page": `{{ define "title"}}{{ t "page.new_watch_page.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.new_watch_page.title" }}</h1>
+    {{ template "feed_menu" }}
+</section>
+
+{{ if not .categories }}
+    <p class="alert alert-error">{{ t "page.add_feed.no_category" }}</p>
+{{ else }}
+    <p class="form-help">{{ t "page.new_watch_page.help" }}</p>
+
+    <form action="{{ route "saveWatchPage" }}" method="post" autocomplete="off">
+        <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+        {{ if .errorMessage }}
+            <div class="alert alert-error">{{ t .errorMessage }}</div>
+        {{ end }}
+
+        <label for="form-page-url">{{ t "form.scraped_feed.label.page_url" }}</label>
+        <input type="url" name="page_url" id="form-page-url" placeholder="https://domain.tld/pricing" value="{{ .form.PageURL }}" spellcheck="false" required autofocus>
+
+        <label for="form-category">{{ t "form.feed.label.category" }}</label>
+        <select id="form-category" name="category_id">
+            {{ range .categories }}
+                <option value="{{ .ID }}" {{ if eq $.form.CategoryID .ID }}selected="selected"{{ end }}>{{ .Title }}</option>
+            {{ end }}
+        </select>
+
+        <label for="form-watch-selector">{{ t "form.feed.label.watch_selector" }}</label>
+        <input type="text" name="watch_selector" id="form-watch-selector" placeholder="main" value="{{ .form.Selector }}" spellcheck="false">
+        <p class="form-help">{{ t "form.feed.help.watch_selector" }}</p>
+
+        <details>
+            <summary>{{ t "page.add_feed.legend.advanced_options" }}</summary>
+            <div class="details-content">
+                <label for="form-title">{{ t "form.feed.label.title" }}</label>
+                <input type="text" name="title" id="form-title" value="{{ .form.Title }}">
+
+                {{ if .hasProxyConfigured }}
+                <label><input type="checkbox" name="fetch_via_proxy" value="1" {{ if .form.FetchViaProxy }}checked{{ end }}> {{ t "form.feed.label.fetch_via_proxy" }}</label>
+                {{ end }}
+
+                <label for="form-user-agent">{{ t "form.feed.label.user_agent" }}</label>
+                <input type="text" name="user_agent" id="form-user-agent" placeholder="{{ .defaultUserAgent }}" value="{{ .form.UserAgent }}" spellcheck="false" autocomplete="off">
+            </div>
+        </details>
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.save" }}</button> {{ t "action.or" }} <a href="{{ route "addSubscription" }}">{{ t "action.cancel" }}</a>
+        </div>
+    </form>
+{{ end }}
+
 {{ end }}
 `,
 	"edit_category": `{{ define "title"}}{{ t "page.edit_category.title" .category.Title }}{{ end }}
@@ -553,9 +812,11 @@ var templateViewsMap = map[string]string{
         <li>
             <a href="{{ route "feedEntries" "feedID" .feed.ID }}">{{ t "menu.feed_entries" }}</a>
         </li>
+        {{ if not .feed.IsNewsletter }}
         <li>
             <a href="{{ route "refreshFeed" "feedID" .feed.ID }}">{{ t "menu.refresh_feed" }}</a>
         </li>
+        {{ end }}
     </ul>
 </section>
 
@@ -579,6 +840,12 @@ var templateViewsMap = map[string]string{
         <label for="form-title">{{ t "form.feed.label.title" }}</label>
         <input type="text" name="title" id="form-title" value="{{ .form.Title }}" spellcheck="false" required autofocus>
 
+        {{ if .feed.IsNewsletter }}
+        <label for="form-newsletter-address">{{ t "form.newsletter.label.address" }}</label>
+        <input type="text" id="form-newsletter-address" value="{{ .feed.NewsletterAddress }}" spellcheck="false" readonly>
+        <input type="hidden" name="site_url" value="{{ .form.SiteURL }}">
+        <input type="hidden" name="feed_url" value="{{ .form.FeedURL }}">
+        {{ else }}
         <label for="form-site-url">{{ t "form.feed.label.site_url" }}</label>
         <input type="url" name="site_url" id="form-site-url" placeholder="https://domain.tld/" value="{{ .form.SiteURL }}" spellcheck="false" required>
 
@@ -603,6 +870,17 @@ var templateViewsMap = map[string]string{
 
         <label for="form-scraper-rules">{{ t "form.feed.label.scraper_rules" }}</label>
         <input type="text" name="scraper_rules" id="form-scraper-rules" value="{{ .form.ScraperRules }}" spellcheck="false">
+        {{ end }}
+
+        {{ if .feed.IsScraped }}
+        {{ template "feed_selectors" .form.Selectors }}
+        {{ end }}
+
+        {{ if .feed.IsWatchPage }}
+        <label for="form-watch-selector">{{ t "form.feed.label.watch_selector" }}</label>
+        <input type="text" name="watch_selector" id="form-watch-selector" value="{{ .form.WatchSelector }}" spellcheck="false">
+        <p class="form-help">{{ t "form.feed.help.watch_selector" }}</p>
+        {{ end }}
 
         <label for="form-rewrite-rules">{{ t "form.feed.label.rewrite_rules" }}</label>
         <input type="text" name="rewrite_rules" id="form-rewrite-rules" value="{{ .form.RewriteRules }}" spellcheck="false">
@@ -620,11 +898,15 @@ var templateViewsMap = map[string]string{
         {{ end }}
         </select>
 
+        {{ if not .feed.IsNewsletter }}
+        {{ if not .feed.IsWatchPage }}
         <label><input type="checkbox" name="crawler" value="1" {{ if .form.Crawler }}checked{{ end }}> {{ t "form.feed.label.crawler" }}</label>
+        {{ end }}
         <label><input type="checkbox" name="ignore_http_cache" value="1" {{ if .form.IgnoreHTTPCache }}checked{{ end }}> {{ t "form.feed.label.ignore_http_cache" }}</label>
         {{ if .hasProxyConfigured }}
         <label><input type="checkbox" name="fetch_via_proxy" value="1" {{ if .form.FetchViaProxy }}checked{{ end }}> {{ t "form.feed.label.fetch_via_proxy" }}</label>
         {{ end }}
+        {{ end }}
         <label><input type="checkbox" name="disabled" value="1" {{ if .form.Disabled }}checked{{ end }}> {{ t "form.feed.label.disabled" }}</label>
 
         <div class="buttons">
@@ -635,8 +917,10 @@ var templateViewsMap = map[string]string{
     <div class="panel">
         <ul>
             <li><strong>{{ t "page.edit_feed.last_check" }} </strong><time datetime="{{ isodate .feed.CheckedAt }}" title="{{ isodate .feed.CheckedAt }}">{{ elapsed $.user.Timezone .feed.CheckedAt }}</time></li>
+            {{ if not .feed.IsNewsletter }}
             <li><strong>{{ t "page.edit_feed.etag_header" }} </strong>{{ if .feed.EtagHeader }}{{ .feed.EtagHeader }}{{ else }}{{ t "page.edit_feed.no_header" }}{{ end }}</li>
             <li><strong>{{ t "page.edit_feed.last_modified_header" }} </strong>{{ if .feed.LastModifiedHeader }}{{ .feed.LastModifiedHeader }}{{ else }}{{ t "page.edit_feed.no_header" }}{{ end }}</li>
+            {{ end }}
         </ul>
     </div>
 
@@ -759,6 +1043,10 @@ var templateViewsMap = map[string]string{
                         data-label-loading="{{ t "entry.state.loading" }}"
                         >{{ template "icon_scraper" }}<span class="icon-label">{{ t "entry.scraper.label" }}</span></a>
                 </li>
+                <li>
+                    <a href="{{ route "entryArchive" "entryID" .entry.ID }}"
+                        title="{{ t "entry.archive.title" }}">{{ template "icon_archive" }}<span class="icon-label">{{ t "entry.archive.label" }}</span></a>
+                </li>
                 {{ if .entry.CommentsURL }}
                     <li>
                         <a href="{{ .entry.CommentsURL | safeURL }}"
@@ -806,6 +1094,17 @@ var templateViewsMap = map[string]string{
                 <time datetime="{{ isodate .entry.Date }}" title="{{ isodate .entry.Date }}">{{ elapsed "UTC" .entry.Date }}</time>
             {{ end }}
         </div>
+        {{ if .entry.Tags }}
+        <div class="entry-tags" dir="auto">
+            {{ range .entry.Tags }}
+                {{ if $.user }}
+                    <span class="category"><a href="{{ route "tagEntries" }}?tag={{ . }}">{{ . }}</a></span>
+                {{ else }}
+                    <span class="category">{{ . }}</span>
+                {{ end }}
+            {{ end }}
+        </div>
+        {{ end }}
     </header>
     {{ if gt (len .entry.Content) 120 }}
     {{ if .user }}
@@ -815,6 +1114,9 @@ var templateViewsMap = map[string]string{
     {{ end }}
     {{ end }}
     <article class="entry-content" dir="auto">
+        {{ if and .entry.ImageURL (not (contains .entry.Content .entry.ImageURL)) }}
+        <p class="entry-image"><img src="{{ if .user }}{{ proxyURL .entry.ImageURL }}{{ else }}{{ .entry.ImageURL }}{{ end }}" alt="" loading="lazy"></p>
+        {{ end }}
         {{ if .user }}
             {{ noescape (proxyFilter .entry.Content) }}
         {{ else }}
@@ -829,14 +1131,22 @@ var templateViewsMap = map[string]string{
             <div class="entry-enclosure">
                 {{ if hasPrefix .MimeType "audio/" }}
                     <div class="enclosure-audio">
-                        <audio controls preload="metadata">
-                            <source src="{{ .URL | safeURL }}" type="{{ .MimeType }}">
+                        <audio controls preload="metadata"{{ if $.user }} data-save-url="{{ route "saveEnclosureProgression" "enclosureID" .ID }}" data-media-progression="{{ .MediaProgression }}"{{ end }}>
+                            {{ if $.user }}
+                                <source src="{{ proxyMediaURL .MimeType .URL }}" type="{{ .MimeType }}">
+                            {{ else }}
+                                <source src="{{ .URL | safeURL }}" type="{{ .MimeType }}">
+                            {{ end }}
                         </audio>
                     </div>
                 {{ else if hasPrefix .MimeType "video/" }}
                     <div class="enclosure-video">
-                        <video controls preload="metadata">
-                            <source src="{{ .URL | safeURL }}" type="{{ .MimeType }}">
+                        <video controls preload="metadata"{{ if $.user }} data-save-url="{{ route "saveEnclosureProgression" "enclosureID" .ID }}" data-media-progression="{{ .MediaProgression }}"{{ end }}>
+                            {{ if $.user }}
+                                <source src="{{ proxyMediaURL .MimeType .URL }}" type="{{ .MimeType }}">
+                            {{ else }}
+                                <source src="{{ .URL | safeURL }}" type="{{ .MimeType }}">
+                            {{ end }}
                         </video>
                     </div>
                 {{ else if hasPrefix .MimeType "image/" }}
@@ -851,13 +1161,93 @@ var templateViewsMap = map[string]string{
 
                 <div class="entry-enclosure-download">
                     <a href="{{ .URL | safeURL }}" title="{{ t "action.download" }}{{ if gt .Size 0 }} - {{ formatFileSize .Size }}{{ end }} ({{ .MimeType }})" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .URL | safeURL  }}</a>
-                    <small>{{ if gt .Size 0 }} - <strong>{{ formatFileSize .Size }}</strong>{{ end }}</small>
+                    <small>{{ if gt .Size 0 }} - <strong>{{ formatFileSize .Size }}</strong>{{ end }}{{ if gt .Duration 0 }} - {{ formatDuration .Duration }}{{ end }}</small>
                 </div>
             </div>
             {{ end }}
         {{ end }}
         </details>
     {{ end }}
+    {{ with .entry.Podcast }}
+    <div class="entry-podcast">
+        {{ if or .Season .SeasonName .Episode }}
+        <p class="entry-podcast-episode">
+            {{ if .SeasonName }}{{ .SeasonName }}{{ else if .Season }}{{ t "page.entry.podcast.season" .Season }}{{ end }}
+            {{ if and (or .Season .SeasonName) .Episode }} - {{ end }}
+            {{ if .Episode }}{{ t "page.entry.podcast.episode" .Episode }}{{ end }}
+        </p>
+        {{ end }}
+        {{ if .Chapters }}
+        <details class="entry-podcast-chapters" open>
+            <summary>{{ t "page.entry.podcast.chapters" }} ({{ len .Chapters }})</summary>
+            <ol>
+            {{ range .Chapters }}
+                <li>
+                    <a href="#" data-seek="{{ .StartTime }}"><time>{{ formatDuration .StartSeconds }}</time></a>
+                    {{ if .URL }}
+                        <a href="{{ .URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .Title }}</a>
+                    {{ else }}
+                        {{ .Title }}
+                    {{ end }}
+                </li>
+            {{ end }}
+            </ol>
+        </details>
+        {{ end }}
+        {{ if .Transcript }}
+        <details class="entry-podcast-transcript">
+            <summary>{{ t "page.entry.podcast.transcript" }}</summary>
+            <ol>
+            {{ range .Transcript }}
+                <li>
+                    <a href="#" data-seek="{{ .StartTime }}"><time>{{ formatDuration .StartSeconds }}</time></a>
+                    {{ if .Speaker }}<strong>{{ .Speaker }}</strong>{{ end }}
+                    <p>{{ .Text }}</p>
+                </li>
+            {{ end }}
+            </ol>
+        </details>
+        {{ end }}
+        {{ if .Transcripts }}
+        <details class="entry-podcast-transcripts">
+            <summary>{{ t "page.entry.podcast.transcripts" }} ({{ len .Transcripts }})</summary>
+            <ul>
+            {{ range .Transcripts }}
+                <li>
+                    <a href="{{ .URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ if .Language }}{{ .Language }}{{ else }}{{ .URL }}{{ end }}</a>
+                    <small>({{ .MimeType }}{{ if eq .Rel "captions" }}, {{ t "page.entry.podcast.captions" }}{{ end }})</small>
+                </li>
+            {{ end }}
+            </ul>
+        </details>
+        {{ end }}
+        {{ if .Persons }}
+        <details class="entry-podcast-persons">
+            <summary>{{ t "page.entry.podcast.persons" }} ({{ len .Persons }})</summary>
+            <ul>
+            {{ range .Persons }}
+                <li>
+                    {{ if .URL }}
+                        <a href="{{ .URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .Name }}</a>
+                    {{ else }}
+                        {{ .Name }}
+                    {{ end }}
+                    {{ if .Role }}<small>({{ .Role }})</small>{{ end }}
+                </li>
+            {{ end }}
+            </ul>
+        </details>
+        {{ end }}
+        {{ if .Funding }}
+        <p class="entry-podcast-funding">
+            {{ t "page.entry.podcast.funding" }}
+            {{ range .Funding }}
+                <a href="{{ .URL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .Title }}</a>
+            {{ end }}
+        </p>
+        {{ end }}
+    </div>
+    {{ end }}
 </section>
 
 {{ if .user }}
@@ -867,32 +1257,77 @@ var templateViewsMap = map[string]string{
 {{ end }}
 {{ end }}
 `,
-	"feed_entries": `{{ define "title"}}{{ .feed.Title }} ({{ .total }}){{ end }}
+	"entry_archive": `{{ define "title"}}{{ if .archive }}{{ .archive.Title }}{{ else }}{{ .entry.Title }}{{ end }}{{ end }}
 
 {{ define "content"}}
-<section class="page-header">
-    <h1 dir="auto">
-        <a href="{{ .feed.SiteURL | safeURL  }}" title="{{ .feed.SiteURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true">{{ .feed.Title }}</a>
-        ({{ .total }})
-    </h1>
-    <ul>
-        {{ if .entries }}
-        <li>
-            <a href="#"
-                data-action="markPageAsRead"
-                data-label-question="{{ t "confirm.question" }}"
-                data-label-yes="{{ t "confirm.yes" }}"
-                data-label-no="{{ t "confirm.no" }}"
-                data-label-loading="{{ t "confirm.loading" }}"
-                data-show-only-unread="{{ if .showOnlyUnreadEntries }}1{{ end }}">{{ t "menu.mark_page_as_read" }}</a>
-        </li>
-        {{ end }}
-        {{ if .showOnlyUnreadEntries }}
-        <li>
-            <a href="{{ route "feedEntriesAll" "feedID" .feed.ID }}">{{ t "menu.show_all_entries" }}</a>
-        </li>
-        {{ else }}
-        <li>
+<section class="entry" data-id="{{ .entry.ID }}">
+    <header class="entry-header">
+        <h1 dir="auto">
+            {{ if .archive }}
+            <a href="{{ .archive.URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .archive.Title }}</a>
+            {{ else }}
+            <a href="{{ .entry.URL | safeURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer">{{ .entry.Title }}</a>
+            {{ end }}
+        </h1>
+        <div class="entry-meta" dir="auto">
+            <span class="entry-website">
+                <a href="{{ route "feedEntries" "feedID" .entry.Feed.ID }}">{{ .entry.Feed.Title }}</a>
+            </span>
+        </div>
+        {{ if .archive }}
+        <div class="entry-date">
+            {{ t "page.entry_archive.created_at" }}
+            <time datetime="{{ isodate .archive.CreatedAt }}" title="{{ isodate .archive.CreatedAt }}">{{ elapsed $.user.Timezone .archive.CreatedAt }}</time>
+        </div>
+        {{ end }}
+        {{ if .pending }}
+        <p class="alert alert-info">{{ t "page.entry_archive.pending" }}</p>
+        {{ else }}
+        {{ if not .archive }}
+        <p class="alert alert-error">{{ t "page.entry_archive.unavailable" }}</p>
+        {{ end }}
+        <form method="post" action="{{ route "archiveEntry" "entryID" .entry.ID }}" autocomplete="off">
+            <input type="hidden" name="csrf" value="{{ .csrf }}">
+            <div class="buttons">
+                <button type="submit" class="button" data-label-loading="{{ t "form.submit.loading" }}">{{ t "action.refresh_archive" }}</button>
+            </div>
+        </form>
+        {{ end }}
+    </header>
+    {{ if .archive }}
+    <article class="entry-content" dir="auto">
+        {{ noescape .archive.Content }}
+    </article>
+    {{ end }}
+</section>
+{{ end }}
+`,
+	"feed_entries": `{{ define "title"}}{{ .feed.Title }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1 dir="auto">
+        <a href="{{ .feed.SiteURL | safeURL  }}" title="{{ .feed.SiteURL }}" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer" data-original-link="true">{{ .feed.Title }}</a>
+        ({{ .total }})
+    </h1>
+    <ul>
+        {{ if .entries }}
+        <li>
+            <a href="#"
+                data-action="markPageAsRead"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-show-only-unread="{{ if .showOnlyUnreadEntries }}1{{ end }}">{{ t "menu.mark_page_as_read" }}</a>
+        </li>
+        {{ end }}
+        {{ if .showOnlyUnreadEntries }}
+        <li>
+            <a href="{{ route "feedEntriesAll" "feedID" .feed.ID }}">{{ t "menu.show_all_entries" }}</a>
+        </li>
+        {{ else }}
+        <li>
             <a href="{{ route "feedEntries" "feedID" .feed.ID }}">{{ t "menu.show_only_unread_entries" }}</a>
         </li>
         {{ end }}
@@ -916,6 +1351,10 @@ var templateViewsMap = map[string]string{
     </ul>
 </section>
 
+{{ if .feed.Description }}
+<p class="feed-description" dir="auto">{{ .feed.Description }}</p>
+{{ end }}
+
 {{ if ne .feed.ParsingErrorCount 0 }}
 <div class="alert alert-error">
     <h3>{{ t "alert.feed_error" }}</h3>
@@ -1052,6 +1491,10 @@ var templateViewsMap = map[string]string{
 
     <label for="form-file">{{ t "form.import.label.file" }}</label>
     <input type="file" name="file" id="form-file">
+    <div class="form-help">{{ t "form.import.help.file" }}</div>
+
+    <label><input type="checkbox" name="apply_settings" value="1"> {{ t "form.import.label.apply_settings" }}</label>
+    <label><input type="checkbox" name="validate" value="1"> {{ t "form.import.label.validate" }}</label>
 
     <div class="buttons">
         <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.import" }}</button>
@@ -1064,11 +1507,80 @@ var templateViewsMap = map[string]string{
     <label for="form-url">{{ t "form.import.label.url" }}</label>
     <input type="url" name="url" id="form-url" required>
 
+    <label><input type="checkbox" name="apply_settings" value="1"> {{ t "form.import.label.apply_settings" }}</label>
+    <label><input type="checkbox" name="validate" value="1"> {{ t "form.import.label.validate" }}</label>
+
     <div class="buttons">
         <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.import" }}</button>
     </div>
 </form>
 
+{{ end }}
+`,
+	"import_job": `{{ define "title"}}{{ t "page.import_job.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.import_job.title" }}</h1>
+    {{ template "feed_menu" }}
+</section>
+
+{{ if .job.IsRunning }}
+    <p class="alert alert-info">
+        {{ if .job.Results }}
+            {{ t "page.import_job.running" .job.Processed .job.Total }}
+        {{ else }}
+            {{ t "page.import_job.running_items" .job.Processed .job.Total }}
+        {{ end }}
+        <a href="{{ route "importJob" "jobID" .job.ID }}">{{ t "page.import_job.refresh" }}</a>
+    </p>
+{{ else if eq .job.Status "failed" }}
+    <p class="alert alert-error">{{ t "page.import_job.failed" .job.ErrorMsg }}</p>
+{{ else if .job.Report }}
+    <p class="alert alert-success">{{ t "page.import.report" .job.Report.FeedsCreated .job.Report.CategoriesCreated .job.Report.EntriesCreated .job.Report.EntriesStarred .job.Report.EntriesSkipped }}</p>
+{{ else }}
+    <p class="alert alert-success">{{ t "page.import_job.finished" .countCreated .countDuplicates .countUnreachable .countParseErrors .countErrors }}</p>
+{{ end }}
+
+{{ with .job.Report }}
+    {{ if .Errors }}
+    <div class="alert alert-error">
+        <ul>
+        {{ range .Errors }}
+            <li>{{ . }}</li>
+        {{ end }}
+        </ul>
+    </div>
+    {{ end }}
+{{ end }}
+
+{{ if .job.Results }}
+<table>
+    <tr>
+        <th>{{ t "page.import_job.table.feed" }}</th>
+        <th>{{ t "page.import_job.table.category" }}</th>
+        <th>{{ t "page.import_job.table.result" }}</th>
+    </tr>
+    {{ range .job.Results }}
+    <tr>
+        <td>
+            {{ if .FeedID }}
+                <a href="{{ route "feedEntries" "feedID" .FeedID }}">{{ .Title }}</a>
+            {{ else }}
+                {{ .Title }}
+            {{ end }}
+            <br><small>{{ .FeedURL }}</small>
+        </td>
+        <td class="column-20">{{ .CategoryName }}</td>
+        <td class="column-25">
+            {{ t (printf "page.import_job.status.%s" .Status) }}
+            {{ if .ErrorMsg }}<br><small>{{ .ErrorMsg }}</small>{{ end }}
+        </td>
+    </tr>
+    {{ end }}
+</table>
+{{ end }}
+
 {{ end }}
 `,
 	"integrations": `{{ define "title"}}{{ t "page.integrations.title" }}{{ end }}
@@ -1245,6 +1757,13 @@ var templateViewsMap = map[string]string{
             <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.loading" }}">{{ t "action.login" }}</button>
         </div>
     </form>
+    <div class="alert alert-error" id="webauthn-error" hidden></div>
+    <div class="webauthn" data-webauthn-action="login" hidden>
+        <button type="button" class="button"
+            data-begin-url="{{ route "beginWebAuthnLogin" }}"
+            data-finish-url="{{ route "finishWebAuthnLogin" }}"
+            data-label-error="{{ t "error.webauthn_failed" }}">{{ t "page.login.webauthn_signin" }}</button>
+    </div>
     {{ if hasOAuth2Provider "google" }}
     <div class="oauth2">
         <a href="{{ route "oauth2Redirect" "provider" "google" }}">{{ t "page.login.google_signin" }}</a>
@@ -1253,11 +1772,211 @@ var templateViewsMap = map[string]string{
     <div class="oauth2">
         <a href="{{ route "oauth2Redirect" "provider" "oidc" }}">{{ t "page.login.oidc_signin" }}</a>
     </div>
+    {{ else if hasOAuth2Provider "github" }}
+    <div class="oauth2">
+        <a href="{{ route "oauth2Redirect" "provider" "github" }}">{{ t "page.login.github_signin" }}</a>
+    </div>
+    {{ else if hasOAuth2Provider "gitlab" }}
+    <div class="oauth2">
+        <a href="{{ route "oauth2Redirect" "provider" "gitlab" }}">{{ t "page.login.gitlab_signin" }}</a>
+    </div>
     {{ end }}
 </section>
 <footer id="prompt-home-screen">
     <a href="#" id="btn-add-to-home-screen">★ {{ t "action.home_screen" }}</a>
 </footer>
+<script type="text/javascript" src="{{ route "javascript" "name" "webauthn" }}?{{ .webauthn_js_checksum }}" defer></script>
+{{ end }}
+`,
+	"login_totp": `{{ define "title"}}{{ t "page.login_totp.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="login-form">
+    <form action="{{ route "checkLoginTOTP" }}" method="post">
+        <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+        {{ if .errorMessage }}
+            <div class="alert alert-error">{{ t .errorMessage }}</div>
+        {{ end }}
+
+        <label for="form-code">{{ t "form.totp.label.code" }}</label>
+        <input type="text" name="code" id="form-code" inputmode="numeric" autocomplete="one-time-code" spellcheck="false" required autofocus>
+        <p class="form-help">{{ t "page.login_totp.help" }}</p>
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.loading" }}">{{ t "action.login" }}</button>
+        </div>
+    </form>
+</section>
+{{ end }}
+`,
+	"opml_source": `{{ define "title"}}{{ t "page.opml_source.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.opml_source.title" }}</h1>
+    <ul>
+        <li>
+            <a href="{{ route "opmlSources" }}">{{ t "menu.opml_sources" }}</a>
+        </li>
+        <li>
+            <a href="{{ route "refreshOPMLSource" "sourceID" .source.ID }}">{{ t "menu.refresh_feed" }}</a>
+        </li>
+        <li>
+            <a href="#"
+                data-confirm="true"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-url="{{ route "removeOPMLSource" "sourceID" .source.ID }}">{{ t "action.remove" }}</a>
+        </li>
+    </ul>
+</section>
+
+{{ if .source.ErrorMsg }}
+    <div class="alert alert-error">
+        <h3>{{ t "page.opml_source.error" }}</h3>
+        <p>{{ .source.ErrorMsg }}</p>
+    </div>
+{{ end }}
+
+<table>
+    <tr>
+        <th class="column-25">{{ t "page.opml_sources.table.url" }}</th>
+        <td><a href="{{ .source.URL }}" rel="noopener noreferrer" referrerpolicy="no-referrer" target="_blank">{{ .source.URL }}</a></td>
+    </tr>
+    <tr>
+        <th>{{ t "page.opml_sources.table.category" }}</th>
+        <td><a href="{{ route "categoryFeeds" "categoryID" .source.Category.ID }}">{{ .source.Category.Title }}</a></td>
+    </tr>
+    <tr>
+        <th>{{ t "page.opml_sources.table.feeds" }}</th>
+        <td>
+            {{ .source.CountFeeds }}
+            {{ if .source.DisableRemoved }}<br><small>{{ t "page.opml_source.disable_removed" }}</small>{{ end }}
+        </td>
+    </tr>
+    <tr>
+        <th>{{ t "page.opml_sources.table.checked_at" }}</th>
+        <td>
+            {{ if .source.CheckedAt }}
+                <time datetime="{{ isodate .source.CheckedAt }}" title="{{ isodate .source.CheckedAt }}">{{ elapsed $.user.Timezone .source.CheckedAt }}</time>
+            {{ else }}
+                {{ t "page.opml_source.never_checked" }}
+            {{ end }}
+        </td>
+    </tr>
+</table>
+
+<h3>{{ t "page.opml_source.history" }}</h3>
+
+{{ if not .syncs }}
+    <p class="alert">{{ t "page.opml_source.no_history" }}</p>
+{{ else }}
+<table>
+    {{ range .syncs }}
+    <tr>
+        <td class="column-25">
+            <time datetime="{{ isodate .CreatedAt }}" title="{{ isodate .CreatedAt }}">{{ elapsed $.user.Timezone .CreatedAt }}</time>
+        </td>
+        <td>
+            {{ if .ErrorMsg }}
+                <span class="feed-parsing-error">{{ .ErrorMsg }}</span>
+            {{ end }}
+            {{ if .Changes }}
+            <ul>
+                {{ range .Changes }}
+                <li>
+                    {{ t (printf "page.opml_source.change.%s" .Action) }}:
+                    {{ if .FeedID }}
+                        <a href="{{ route "feedEntries" "feedID" .FeedID }}">{{ .Title }}</a>
+                    {{ else }}
+                        {{ .Title }}
+                    {{ end }}
+                    <small>{{ .FeedURL }}</small>
+                </li>
+                {{ end }}
+            </ul>
+            {{ end }}
+        </td>
+    </tr>
+    {{ end }}
+</table>
+{{ end }}
+
+{{ end }}
+`,
+	"opml_sources": `{{ define "title"}}{{ t "page.opml_sources.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.opml_sources.title" }}</h1>
+    {{ template "feed_menu" }}
+</section>
+
+{{ if not .sources }}
+    <p class="alert">{{ t "page.opml_sources.no_source" }}</p>
+{{ else }}
+<table>
+    <tr>
+        <th>{{ t "page.opml_sources.table.url" }}</th>
+        <th class="column-20">{{ t "page.opml_sources.table.category" }}</th>
+        <th class="column-10">{{ t "page.opml_sources.table.feeds" }}</th>
+        <th class="column-20">{{ t "page.opml_sources.table.checked_at" }}</th>
+    </tr>
+    {{ range .sources }}
+    <tr>
+        <td>
+            <a href="{{ route "opmlSource" "sourceID" .ID }}">{{ .URL }}</a>
+            {{ if .ErrorMsg }}<br><small class="feed-parsing-error">{{ .ErrorMsg }}</small>{{ end }}
+        </td>
+        <td><a href="{{ route "categoryFeeds" "categoryID" .Category.ID }}">{{ .Category.Title }}</a></td>
+        <td>{{ .CountFeeds }}</td>
+        <td>
+            {{ if .CheckedAt }}
+                <time datetime="{{ isodate .CheckedAt }}" title="{{ isodate .CheckedAt }}">{{ elapsed $.user.Timezone .CheckedAt }}</time>
+            {{ else }}
+                {{ t "page.opml_source.never_checked" }}
+            {{ end }}
+        </td>
+    </tr>
+    {{ end }}
+</table>
+{{ end }}
+
+<h3>{{ t "page.opml_sources.new" }}</h3>
+
+{{ if not .categories }}
+    <p class="alert alert-error">{{ t "page.add_feed.no_category" }}</p>
+{{ else }}
+    <p class="form-help">{{ t "page.opml_sources.help" }}</p>
+
+    <form action="{{ route "saveOPMLSource" }}" method="post" autocomplete="off">
+        <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+        {{ if .errorMessage }}
+            <div class="alert alert-error">{{ t .errorMessage }}</div>
+        {{ end }}
+
+        <label for="form-url">{{ t "form.opml_source.label.url" }}</label>
+        <input type="url" name="url" id="form-url" placeholder="https://domain.tld/blogroll.opml" value="{{ .form.URL }}" required>
+
+        <label for="form-category">{{ t "form.feed.label.category" }}</label>
+        <select id="form-category" name="category_id">
+            {{ range .categories }}
+                <option value="{{ .ID }}" {{ if eq $.form.CategoryID .ID }}selected="selected"{{ end }}>{{ .Title }}</option>
+            {{ end }}
+        </select>
+
+        <label><input type="checkbox" name="disable_removed" value="1" {{ if .form.DisableRemoved }}checked{{ end }}> {{ t "form.opml_source.label.disable_removed" }}</label>
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.loading" }}">{{ t "action.subscribe" }}</button>
+        </div>
+    </form>
+{{ end }}
+
 {{ end }}
 `,
 	"search_entries": `{{ define "title"}}{{ t "page.search.title" }} ({{ .total }}){{ end }}
@@ -1265,6 +1984,13 @@ var templateViewsMap = map[string]string{
 {{ define "content"}}
 <section class="page-header">
     <h1>{{ t "page.search.title" }} ({{ .total }})</h1>
+    {{ if .entries }}
+    <ul>
+        <li>
+            <a href="{{ route "exportSearchEntries" }}?q={{ .searchQuery }}">{{ t "menu.export_epub" }}</a>
+        </li>
+    </ul>
+    {{ end }}
 </section>
 
 {{ if not .entries }}
@@ -1289,6 +2015,22 @@ var templateViewsMap = map[string]string{
     {{ template "pagination" .pagination }}
 {{ end }}
 
+{{ end }}
+`,
+	"security_activity": `{{ define "title"}}{{ t "page.security_activity.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.security_activity.title" }}</h1>
+    {{ template "settings_menu" dict "user" .user }}
+</section>
+
+{{ if not .events }}
+    <p class="alert">{{ t "alert.no_audit_event" }}</p>
+{{ else }}
+    {{ template "audit_events" dict "events" .events "user" .user }}
+{{ end }}
+
 {{ end }}
 `,
 	"sessions": `{{ define "title"}}{{ t "page.sessions.title" }}{{ end }}
@@ -1390,6 +2132,8 @@ var templateViewsMap = map[string]string{
 
     <label><input type="checkbox" name="entry_swipe" value="1" {{ if .form.EntrySwipe }}checked{{ end }}> {{ t "form.prefs.label.entry_swipe" }}</label>
 
+    <label><input type="checkbox" name="archive_starred" value="1" {{ if .form.ArchiveStarred }}checked{{ end }}> {{ t "form.prefs.label.archive_starred" }}</label>
+
     <label>{{t "form.prefs.label.custom_css" }}</label><textarea name="custom_css" cols="40" rows="8" spellcheck="false">{{ .form.CustomCSS }}</textarea>
     <div class="buttons">
         <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.update" }}</button>
@@ -1412,6 +2156,22 @@ var templateViewsMap = map[string]string{
         <a href="{{ route "oauth2Redirect" "provider" "oidc" }}">{{ t "page.settings.link_oidc_account" }}</a>
     {{ end }}
 </div>
+{{ else if hasOAuth2Provider "github" }}
+<div class="panel">
+    {{ if .user.GitHubID }}
+        <a href="{{ route "oauth2Unlink" "provider" "github" }}">{{ t "page.settings.unlink_github_account" }}</a>
+    {{ else }}
+        <a href="{{ route "oauth2Redirect" "provider" "github" }}">{{ t "page.settings.link_github_account" }}</a>
+    {{ end }}
+</div>
+{{ else if hasOAuth2Provider "gitlab" }}
+<div class="panel">
+    {{ if .user.GitLabID }}
+        <a href="{{ route "oauth2Unlink" "provider" "gitlab" }}">{{ t "page.settings.unlink_gitlab_account" }}</a>
+    {{ else }}
+        <a href="{{ route "oauth2Redirect" "provider" "gitlab" }}">{{ t "page.settings.link_gitlab_account" }}</a>
+    {{ end }}
+</div>
 {{ end }}
 
 {{ end }}
@@ -1486,6 +2246,102 @@ var templateViewsMap = map[string]string{
     </div>
 {{ end }}
 
+{{ end }}
+`,
+	"tag_entries": `{{ define "title"}}{{ t "page.tag_entries.title" .tag }} ({{ .total }}){{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.tag_entries.title" .tag }} ({{ .total }})</h1>
+    {{ if .entries }}
+    <ul>
+        <li>
+            <a href="{{ route "exportTagEntries" }}?tag={{ .tag }}">{{ t "menu.export_epub" }}</a>
+        </li>
+    </ul>
+    {{ end }}
+</section>
+
+{{ if not .entries }}
+    <p class="alert alert-info">{{ t "alert.no_tag_entry" }}</p>
+{{ else }}
+    <div class="items">
+        {{ range .entries }}
+        <article class="item {{ if $.user.EntrySwipe }}touch-item{{ end }} item-status-{{ .Status }}" data-id="{{ .ID }}">
+            <div class="item-header" dir="auto">
+                <span class="item-title">
+                    {{ if ne .Feed.Icon.IconID 0 }}
+                        <img src="{{ route "icon" "iconID" .Feed.Icon.IconID }}" width="16" height="16" loading="lazy" alt="{{ .Feed.Title }}">
+                    {{ end }}
+                    <a href="{{ route "feedEntry" "feedID" .FeedID "entryID" .ID }}">{{ .Title }}</a>
+                </span>
+                <span class="category"><a href="{{ route "categoryEntries" "categoryID" .Feed.Category.ID }}">{{ .Feed.Category.Title }}</a></span>
+            </div>
+            {{ template "item_meta" dict "user" $.user "entry" . "hasSaveEntry" $.hasSaveEntry  }}
+        </article>
+        {{ end }}
+    </div>
+    {{ template "pagination" .pagination }}
+{{ end }}
+
+{{ end }}
+`,
+	"totp": `{{ define "title"}}{{ t "page.totp.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.totp.title" }}</h1>
+    {{ template "settings_menu" dict "user" .user }}
+</section>
+
+{{ if .errorMessage }}
+    <div class="alert alert-error">{{ t .errorMessage }}</div>
+{{ end }}
+
+{{ if .totpEnabled }}
+    {{ if .recoveryCodes }}
+    <div class="panel">
+        <p>{{ t "page.totp.recovery_codes_help" }}</p>
+        <ul class="totp-recovery-codes">
+            {{ range .recoveryCodes }}
+            <li><code>{{ . }}</code></li>
+            {{ end }}
+        </ul>
+    </div>
+    {{ end }}
+
+    <p class="alert alert-success">{{ plural "page.totp.enabled" .countRecoveryCodes .countRecoveryCodes }}</p>
+
+    <form action="{{ route "disableTOTP" }}" method="post" autocomplete="off">
+        <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+        <label for="form-code">{{ t "form.totp.label.code_or_recovery_code" }}</label>
+        <input type="text" name="code" id="form-code" autocomplete="one-time-code" spellcheck="false" required>
+
+        <div class="buttons">
+            <button type="submit" class="button button-danger" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.disable_totp" }}</button>
+        </div>
+    </form>
+{{ else }}
+    <p>{{ t "page.totp.enroll_help" }}</p>
+
+    <div class="panel">
+        <img src="{{ route "totpQRCode" }}" alt="{{ t "page.totp.qr_code" }}" width="256" height="256">
+        <p>{{ t "page.totp.secret" }} <code>{{ .totpSecret }}</code></p>
+    </div>
+
+    <form action="{{ route "enableTOTP" }}" method="post" autocomplete="off">
+        <input type="hidden" name="csrf" value="{{ .csrf }}">
+
+        <label for="form-code">{{ t "form.totp.label.code" }}</label>
+        <input type="text" name="code" id="form-code" inputmode="numeric" autocomplete="one-time-code" spellcheck="false" required autofocus>
+
+        <div class="buttons">
+            <button type="submit" class="button button-primary" data-label-loading="{{ t "form.submit.saving" }}">{{ t "action.enable_totp" }}</button>
+        </div>
+    </form>
+{{ end }}
+
 {{ end }}
 `,
 	"unread_entries": `{{ define "title"}}{{ t "page.unread.title" }} {{ if gt .countUnread 0 }}({{ .countUnread }}){{ end }} {{ end }}
@@ -1576,8 +2432,14 @@ var templateViewsMap = map[string]string{
         </tr>
         {{ range .users }}
             {{ if ne .ID $.user.ID }}
+            {{ $lockedUntil := index $.lockedUsers .Username }}
             <tr>
-                <td>{{ .Username }}</td>
+                <td>
+                    {{ .Username }}
+                    {{ if not $lockedUntil.IsZero }}
+                        <span title="{{ isodate $lockedUntil }}">({{ t "page.users.locked" }})</span>
+                    {{ end }}
+                </td>
                 <td>{{ if eq .IsAdmin true }}{{ t "page.users.admin.yes" }}{{ else }}{{ t "page.users.admin.no" }}{{ end }}</td>
                 <td>
                     {{ if .LastLoginAt }}
@@ -1594,7 +2456,15 @@ var templateViewsMap = map[string]string{
                         data-label-yes="{{ t "confirm.yes" }}"
                         data-label-no="{{ t "confirm.no" }}"
                         data-label-loading="{{ t "confirm.loading" }}"
-                        data-url="{{ route "removeUser" "userID" .ID }}">{{ t "action.remove" }}</a>
+                        data-url="{{ route "removeUser" "userID" .ID }}">{{ t "action.remove" }}</a>{{ if not $lockedUntil.IsZero }},
+                    <a href="#"
+                        data-confirm="true"
+                        data-label-question="{{ t "confirm.question" }}"
+                        data-label-yes="{{ t "confirm.yes" }}"
+                        data-label-no="{{ t "confirm.no" }}"
+                        data-label-loading="{{ t "confirm.loading" }}"
+                        data-url="{{ route "unlockUser" "userID" .ID }}">{{ t "action.unlock" }}</a>
+                    {{ end }}
                 </td>
             </tr>
             {{ end }}
@@ -1607,36 +2477,113 @@ var templateViewsMap = map[string]string{
     <a href="{{ route "createUser" }}" class="button button-primary">{{ t "menu.add_user" }}</a>
 </p>
 
+{{ end }}
+`,
+	"webauthn": `{{ define "title"}}{{ t "page.webauthn.title" }}{{ end }}
+
+{{ define "content"}}
+<section class="page-header">
+    <h1>{{ t "page.webauthn.title" }}</h1>
+    {{ template "settings_menu" dict "user" .user }}
+</section>
+
+{{ if .credentials }}
+<table>
+    <tr>
+        <th>{{ t "page.webauthn.table.name" }}</th>
+        <th>{{ t "page.webauthn.table.last_used_at" }}</th>
+        <th>{{ t "page.webauthn.table.created_at" }}</th>
+        <th>{{ t "page.webauthn.table.actions" }}</th>
+    </tr>
+    {{ range .credentials }}
+    <tr>
+        <td title="{{ .Name }}">{{ .Name }}</td>
+        <td class="column-20">
+            {{ if .LastUsedAt }}
+                <time datetime="{{ isodate .LastUsedAt }}" title="{{ isodate .LastUsedAt }}">{{ elapsed $.user.Timezone .LastUsedAt }}</time>
+            {{ else }}
+                {{ t "page.webauthn.never_used" }}
+            {{ end }}
+        </td>
+        <td class="column-20">
+            <time datetime="{{ isodate .CreatedAt }}" title="{{ isodate .CreatedAt }}">{{ elapsed $.user.Timezone .CreatedAt }}</time>
+        </td>
+        <td class="column-20">
+            <a href="#"
+                data-confirm="true"
+                data-label-question="{{ t "confirm.question" }}"
+                data-label-yes="{{ t "confirm.yes" }}"
+                data-label-no="{{ t "confirm.no" }}"
+                data-label-loading="{{ t "confirm.loading" }}"
+                data-url="{{ route "removeWebAuthnCredential" "credentialID" .ID }}">{{ t "action.remove" }}</a>
+        </td>
+    </tr>
+    {{ end }}
+</table>
+<br>
+{{ end }}
+
+<p>{{ t "page.webauthn.help" }}</p>
+
+<div class="alert alert-error" id="webauthn-error" hidden></div>
+<div class="alert" data-webauthn-unsupported>{{ t "page.webauthn.unsupported" }}</div>
+
+<div data-webauthn-action="register" hidden>
+    <label for="form-webauthn-name">{{ t "form.webauthn.label.name" }}</label>
+    <input type="text" id="form-webauthn-name" maxlength="100" required>
+
+    <div class="buttons">
+        <button type="button" class="button button-primary"
+            data-begin-url="{{ route "beginWebAuthnRegistration" }}"
+            data-finish-url="{{ route "finishWebAuthnRegistration" }}"
+            data-label-error="{{ t "error.webauthn_failed" }}">{{ t "action.register_webauthn" }}</button>
+    </div>
+</div>
+
+<script type="text/javascript" src="{{ route "javascript" "name" "webauthn" }}?{{ .webauthn_js_checksum }}" defer></script>
 {{ end }}
 `,
 }
 
 var templateViewsMapChecksums = map[string]string{
 	"about":               "ed362f506b931186b2273655e3264110225154e7756e29d49ba4ede442caffc9",
-	"add_subscription":    "bc0f878b37692a00d51e834536f211843a59703991d2a743ef204b9d6ae38549",
+	"add_subscription":    "421c9e3cfed8623dd14f29003f9c6852638afc4a6a20ce25cc0a7d8c304727d1",
 	"api_keys":            "27d401b31a72881d5232486ba17eb47edaf5246eaedce81de88698c15ebb2284",
-	"bookmark_entries":    "eacbbdce7fa85ec66c4c12f02879daab562a17ff79f1aac1805617e83e3a3a42",
+	"audit":               "d08d6b84076e6cfd300f2a7634b02aabea080d5c19ae4a9ab2450c1bd180316d",
+	"bookmark_entries":    "5ef351e770e8939617ee4324a579e106193492b25bf831c49ce6b4a8efab4452",
 	"categories":          "9dfc3cb7bb91c7750753fe962ee4540dd1843e5f75f9e0a575ee964f6f9923e9",
-	"category_entries":    "ef3005f8f4c96182587acbf31b979cc26b1ac8f755a74cd5a25681260f4b6d63",
+	"category_entries":    "abac5d675e6d13e9656d8311850baefbe0624f8d166df013bd773a057aa880c5",
 	"category_feeds":      "07154127087f9b127f7290abad6020c35ad9ceb2490b869120b7628bc4413808",
 	"choose_subscription": "22109d760ea8079c491561d0106f773c885efbf66f87d81fcf8700218260d2a0",
 	"create_api_key":      "2fbd74342176b9970d9162a54da99186589621e4c005566a5368fc4a7994ad20",
 	"create_category":     "6b22b5ce51abf4e225e23a79f81be09a7fb90acb265e93a8faf9446dff74018d",
+	"create_newsletter":   "1b60273ebecf9ca428f9eed2c41dc3af0f4052b4662474e9137f1478b368b79f",
+	"create_scraped_feed": "915275536d715266101c2802d739700676ab9e4608368a30fdf61069fad1ef20",
 	"create_user":         "cca0dbdbd846639d5295707de0674e5e75df987dd22b80d75f030f8daa503a85",
+	"create_watch_page":   "a9818320a92d3e4a8ab921c9084aa30c41949a8c70fd62c1ca120f54d0a1c95b",
 	"edit_category":       "b1c0b38f1b714c5d884edcd61e5b5295a5f1c8b71c469b35391e4dcc97cc6d36",
-	"edit_feed":           "3da1edc78a464f33359663028f0b3fd11706b98e0c3851b090a20ccb2f780b02",
+	"edit_feed":           "e126c017cef97cc647d075508d98dd73a42f6c9171d7971424fe4c9032269e92",
 	"edit_user":           "04423f5ea4249a97440ddd892f99ff96c646f6ce26313765ac5293abf257ef3c",
-	"entry":               "07ccdd5b9e99c63872bcab44b70b347cb59424fc8b69fd671b99b832c47277cc",
-	"feed_entries":        "89977ea86b8d43305d587b70e6d9c45c2c88249b3966f2d31051dc7a5f1c48b6",
+	"entry":               "2d6fe4ca0ca1fb3fc8eec4461048a55384e105030cabb31534e28da9dbe21f0f",
+	"entry_archive":       "aee0ad0c6ea4e8908d52151c57353e464e12839200072af03b4f4de1ce67d66c",
+	"feed_entries":        "76a8ca1eecdf77ad4a38cc6d8a05b791ec7771750b1cfc6c51e1d4d575c20c3f",
 	"feeds":               "ec7d3fa96735bd8422ba69ef0927dcccddc1cc51327e0271f0312d3f881c64fd",
 	"history_entries":     "261b47e5f2f699a9cef1b3b690f80d7aabf585d05b77d67645d623f7ff6c0fbb",
-	"import":              "1b59b3bd55c59fcbc6fbb346b414dcdd26d1b4e0c307e437bb58b3f92ef01ad1",
+	"import":              "25cc639fb7034302b7606bd8170b06993e1a802f5ef511f7b6c83c850332bd6e",
+	"import_job":          "f1e4a74c89a8a7c95e9b0bb16a2cb2f8c11fd11ae78b6f7e56a5ebd5079ef998",
 	"integrations":        "92d5ab36361f9a2e2b9b7e2318494123f4976d4410daf117810ebf6eca8250b6",
-	"login":               "9165434b2405e9332de4bebbb54a93dc5692276ea72e7c5e07f655a002dfd290",
-	"search_entries":      "6a3e5876cb7541a2f08f56e30ab46a2d7d64894ec5e170f627b2dd674d8aeefe",
+	"login":               "7da40c4761eb93e6542a6587a92d881985c2b1483a485c15afe2ceeac95dea6a",
+	"login_totp":          "e2415d8d69bccdf288aa3603d22d85a14b0b41a98a301c5c1a29d79026990ac4",
+	"opml_source":         "8b06ef7dbcd7622d9c7837eafd33ad300dac5b7144ae56e3231bffc46518f382",
+	"opml_sources":        "0406892107aeeee6ecfabd590753acee74b4367609ac245b1663a933b416bbd5",
+	"search_entries":      "a22a87414c7c418a3141b92322a66c8e6c9699a4ea5005dfc33033af717f2e93",
+	"security_activity":   "dc926a0d12781df6e363da35e8474a9470f1cbad4ebec5409f3c9961b269f04e",
 	"sessions":            "5d5c677bddbd027e0b0c9f7a0dd95b66d9d95b4e130959f31fb955b926c2201c",
-	"settings":            "8e90e9e48c62990c2aca217054cb4e122e4ed58c377e28d4c150e2d2d22ebe74",
+	"settings":            "a7f730e72282a2ec368353fc7cd8ba6f76e0ebcd5b65bbd9fdb00c05fef7e303",
 	"shared_entries":      "f87a42bf44dc3606c5a44b185263c1b9a612a8ae194f75061253d4dde7b095a2",
+	"tag_entries":         "a32e84d7850ebea98ae84343e1ae7fe0b682740dcf2dcccf1deab68aa27ac4b6",
+	"totp":                "ce07187002e6560ebbac3b0cd22857a3cad688d06f452fa06e8d0dda5948fd5a",
 	"unread_entries":      "21c584da7ca8192655c62f16a7ac92dbbfdf1307588ffe51eb4a8bbf3f9f7526",
-	"users":               "d7ff52efc582bbad10504f4a04fa3adcc12d15890e45dff51cac281e0c446e45",
+	"users":               "a79b218b39895f3f942b73a4ea99c5f89663c1dba5623f2b574543763b2bb21f",
+	"webauthn":            "e721cb6d57198e7454f685ea507c010d79d14c6db7e819117752a180b3e083d5",
 }
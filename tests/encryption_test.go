@@ -0,0 +1,120 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	miniflux "miniflux.app/client"
+
+	_ "github.com/lib/pq"
+)
+
+const encryptedValuePrefix = "enc:v1:"
+
+func openDatabase(t *testing.T) *sql.DB {
+	databaseURL := os.Getenv("DATABASE_URL")
+	if databaseURL == "" {
+		t.Skip(`DATABASE_URL is not defined`)
+	}
+
+	db, err := sql.Open("postgres", databaseURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func checkRawFeedPassword(t *testing.T, db *sql.DB, feedID int64, plaintext string) {
+	var value string
+	if err := db.QueryRow(`SELECT password FROM feeds WHERE id=$1`, feedID).Scan(&value); err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(value, plaintext) {
+		t.Fatalf(`The feed password is stored in plaintext: %q`, value)
+	}
+
+	if !strings.HasPrefix(value, encryptedValuePrefix) {
+		t.Fatalf(`The feed password is not encrypted: %q`, value)
+	}
+}
+
+func TestFeedPasswordIsEncrypted(t *testing.T) {
+	db := openDatabase(t)
+	defer db.Close()
+
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feedID, err := client.CreateFeed(&miniflux.FeedCreationRequest{
+		FeedURL:    testFeedURL,
+		CategoryID: categories[0].ID,
+		Username:   "feed-user",
+		Password:   "feed-secret-password",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkRawFeedPassword(t, db, feedID, "feed-secret-password")
+
+	feed, err := client.Feed(feedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Password != "feed-secret-password" {
+		t.Fatalf(`The feed password should be decrypted, got %q`, feed.Password)
+	}
+
+	password := "another-secret-password"
+	feed, err = client.UpdateFeed(feedID, &miniflux.FeedModificationRequest{Password: &password})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Password != password {
+		t.Fatalf(`The feed password should be decrypted, got %q`, feed.Password)
+	}
+
+	checkRawFeedPassword(t, db, feedID, password)
+}
+
+func TestRawTablesHaveNoPlaintextSecrets(t *testing.T) {
+	db := openDatabase(t)
+	defer db.Close()
+
+	columns := map[string][]string{
+		"feeds":        {"password"},
+		"integrations": {"pinboard_token", "instapaper_password", "wallabag_client_secret", "wallabag_password", "nunux_keeper_api_key", "pocket_access_token", "pocket_consumer_key"},
+		"users":        {"totp_secret"},
+	}
+
+	for table, names := range columns {
+		for _, column := range names {
+			query := fmt.Sprintf(`SELECT count(*) FROM %s WHERE %s <> '' AND %s NOT LIKE '%s%%'`, table, column, column, encryptedValuePrefix)
+
+			var count int
+			if err := db.QueryRow(query).Scan(&count); err != nil {
+				t.Fatal(err)
+			}
+
+			if count != 0 {
+				t.Errorf(`%d plaintext values found in %s.%s`, count, table, column)
+			}
+		}
+	}
+}
@@ -7,6 +7,8 @@
 package tests
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	miniflux "miniflux.app/client"
@@ -131,6 +133,69 @@ func TestGetAllEntries(t *testing.T) {
 	if resultWithStarredEntries.Total != 0 {
 		t.Fatalf(`We are not supposed to have starred entries yet`)
 	}
+
+	resultWithCategoryLabel, err := client.Entries(&miniflux.Filter{CategoryLabel: "label that does not exist"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resultWithCategoryLabel.Total != 0 {
+		t.Fatalf(`We are not supposed to have entries with this category label`)
+	}
+}
+
+func TestFilterEntriesByCategoryLabelIgnoresCase(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/rss+xml")
+		w.Write([]byte(`<?xml version="1.0" encoding="utf-8"?>
+			<rss version="2.0">
+				<channel>
+					<title>Labels</title>
+					<link>https://example.org/</link>
+					<item>
+						<title>Entry with labels</title>
+						<link>https://example.org/labels</link>
+						<category>Golang</category>
+						<category>golang</category>
+						<category>Databases</category>
+					</item>
+					<item>
+						<title>Entry without labels</title>
+						<link>https://example.org/no-labels</link>
+					</item>
+				</channel>
+			</rss>`))
+	}))
+	defer server.Close()
+
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feedID, err := client.CreateFeed(&miniflux.FeedCreationRequest{
+		FeedURL:    server.URL,
+		CategoryID: categories[0].ID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, label := range []string{"golang", "GOLANG", "Golang"} {
+		result, err := client.FeedEntries(feedID, &miniflux.Filter{CategoryLabel: label})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if result.Total != 1 {
+			t.Fatalf(`Filtering by %q should return 1 entry, got %d`, label, result.Total)
+		}
+
+		if tags := result.Entries[0].Tags; len(tags) != 2 || tags[0] != "golang" || tags[1] != "databases" {
+			t.Fatalf(`Invalid category labels, got %v`, tags)
+		}
+	}
 }
 
 func TestFilterEntriesByCategory(t *testing.T) {
@@ -425,6 +490,39 @@ func TestToggleBookmark(t *testing.T) {
 	}
 }
 
+func TestEntryArchive(t *testing.T) {
+	client := createClient(t)
+	createFeed(t, client)
+
+	result, err := client.Entries(&miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entryID := result.Entries[0].ID
+	if _, err := client.EntryArchive(entryID); err != miniflux.ErrNotFound {
+		t.Fatalf(`Fetching a missing snapshot should return a 404, got %v`, err)
+	}
+
+	archive, err := client.ArchiveEntry(entryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if archive.EntryID != entryID || archive.Content == "" {
+		t.Fatalf(`Unexpected snapshot: %+v`, archive)
+	}
+
+	archive, err = client.EntryArchive(entryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if archive.URL != result.Entries[0].URL {
+		t.Fatalf(`Unexpected snapshot URL: got %q instead of %q`, archive.URL, result.Entries[0].URL)
+	}
+}
+
 func TestHistoryOrder(t *testing.T) {
 	client := createClient(t)
 	createFeed(t, client)
@@ -450,3 +548,21 @@ func TestHistoryOrder(t *testing.T) {
 		t.Fatal("The entry that we just read should be at the top of the history")
 	}
 }
+
+func TestEnclosureNotFound(t *testing.T) {
+	client := createClient(t)
+
+	if _, err := client.Enclosure(123456789); err != miniflux.ErrNotFound {
+		t.Fatalf(`Fetching an unknown enclosure should return a not found error, got %v`, err)
+	}
+
+	err := client.UpdateEnclosure(123456789, &miniflux.EnclosureUpdateRequest{MediaProgression: 60})
+	if err != miniflux.ErrNotFound {
+		t.Fatalf(`Updating an unknown enclosure should return a not found error, got %v`, err)
+	}
+
+	err = client.UpdateEnclosure(123456789, &miniflux.EnclosureUpdateRequest{MediaProgression: -1})
+	if err == nil {
+		t.Fatal(`A negative media progression should raise an error`)
+	}
+}
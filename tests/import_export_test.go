@@ -11,6 +11,8 @@ import (
 	"io/ioutil"
 	"strings"
 	"testing"
+
+	miniflux "miniflux.app/client"
 )
 
 func TestExport(t *testing.T) {
@@ -26,6 +28,19 @@ func TestExport(t *testing.T) {
 	}
 }
 
+func TestExportEntries(t *testing.T) {
+	client := createClient(t)
+
+	output, err := client.ExportEntries(&miniflux.Filter{Starred: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.HasPrefix(output, []byte("PK")) || !bytes.Contains(output, []byte("application/epub+zip")) {
+		t.Fatalf(`Invalid EPUB export`)
+	}
+}
+
 func TestImport(t *testing.T) {
 	client := createClient(t)
 
@@ -43,4 +58,50 @@ func TestImport(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+
+	// The import is finished when the method returns.
+	feeds, err := client.Feeds()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, feed := range feeds {
+		if feed.FeedURL == testFeedURL {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Fatalf(`The imported feed is missing`)
+	}
+}
+
+func TestImportJob(t *testing.T) {
+	client := createClient(t)
+
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+	<opml version="2.0">
+		<body>
+			<outline title="Test" text="Test" xmlUrl="` + testFeedURL + `" htmlUrl="` + testWebsiteURL + `"></outline>
+		</body>
+	</opml>`
+
+	jobID, err := client.StartImport(ioutil.NopCloser(bytes.NewReader([]byte(data))))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	job, err := client.ImportJob(jobID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if job.ID != jobID {
+		t.Fatalf(`Invalid import job, got %d instead of %d`, job.ID, jobID)
+	}
+
+	if job.Total != 1 {
+		t.Fatalf(`Invalid number of subscriptions, got %d instead of 1`, job.Total)
+	}
 }
@@ -0,0 +1,131 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestCreateNewsletterFeed(t *testing.T) {
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feedID, err := client.CreateNewsletterFeed(&miniflux.NewsletterCreationRequest{
+		Title:      "Weekly Newsletter",
+		CategoryID: categories[0].ID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed, err := client.Feed(feedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Kind != "newsletter" {
+		t.Errorf(`Invalid feed kind, got %q`, feed.Kind)
+	}
+
+	if feed.Title != "Weekly Newsletter" {
+		t.Errorf(`Invalid feed title, got %q`, feed.Title)
+	}
+
+	if !strings.HasPrefix(feed.FeedURL, "mailto:") {
+		t.Errorf(`Invalid feed URL, got %q`, feed.FeedURL)
+	}
+}
+
+func TestCreateNewsletterFeedWithoutTitle(t *testing.T) {
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.CreateNewsletterFeed(&miniflux.NewsletterCreationRequest{
+		CategoryID: categories[0].ID,
+	})
+	if err == nil {
+		t.Fatal(`Newsletter feeds should not be created without title`)
+	}
+}
+
+func deliverNewsletter(t *testing.T, secret, recipient, message string) int {
+	endpoint := testBaseURL + "newsletter/inbound?recipient=" + url.QueryEscape(recipient)
+	request, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(message))
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Set("Authorization", "Bearer "+secret)
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+
+	return response.StatusCode
+}
+
+func TestReceiveNewsletter(t *testing.T) {
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feedID, err := client.CreateNewsletterFeed(&miniflux.NewsletterCreationRequest{
+		Title:      "Daily Newsletter",
+		CategoryID: categories[0].ID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed, err := client.Feed(feedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recipient := strings.TrimPrefix(feed.FeedURL, "mailto:")
+	message := "From: sender@example.org\r\nTo: " + recipient + "\r\nSubject: First issue\r\nMessage-ID: <first-issue@example.org>\r\nDate: Mon, 02 Nov 2020 10:00:00 +0000\r\n\r\nHello\r\n"
+
+	if status := deliverNewsletter(t, "invalid", recipient, message); status != http.StatusUnauthorized {
+		t.Fatalf(`Emails with an invalid secret should be rejected, got status %d`, status)
+	}
+
+	if status := deliverNewsletter(t, testNewsletterSecret, recipient, message); status != http.StatusNoContent {
+		t.Fatalf(`The email should be accepted, got status %d`, status)
+	}
+
+	if status := deliverNewsletter(t, testNewsletterSecret, "unknown@example.org", message); status != http.StatusNotFound {
+		t.Fatalf(`Emails sent to an unknown address should be rejected, got status %d`, status)
+	}
+
+	results, err := client.FeedEntries(feedID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 1 || results.Entries[0].Title != "First issue" {
+		t.Errorf(`The email should be stored as entry, got %+v`, results)
+	}
+
+	// Refreshing a newsletter does not fetch the address.
+	if err := client.RefreshFeed(feedID); err != nil {
+		t.Fatal(err)
+	}
+}
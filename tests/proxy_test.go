@@ -0,0 +1,67 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func proxifiedURL(key, link string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(link))
+	return testBaseURL + "proxy/" + base64.URLEncoding.EncodeToString(mac.Sum(nil)) + "/" + base64.URLEncoding.EncodeToString([]byte(link))
+}
+
+func fetchWithoutSession(t *testing.T, link string) *http.Response {
+	// The redirections to the login page must not be followed.
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	response, err := client.Get(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+	return response
+}
+
+func TestPublicProxyWithoutSession(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 1, 1))
+	img.Set(0, 0, color.White)
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, img); err != nil {
+		t.Fatal(err)
+	}
+
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(buffer.Bytes())
+	}))
+	defer origin.Close()
+
+	link := origin.URL + "/image.png"
+	response := fetchWithoutSession(t, proxifiedURL(testProxyPrivateKey, link))
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf(`A signed URL should be served without session, got status %d`, response.StatusCode)
+	}
+
+	if contentType := response.Header.Get("Content-Type"); contentType != "image/png" {
+		t.Errorf(`Unexpected content type, got %q`, contentType)
+	}
+
+	response = fetchWithoutSession(t, proxifiedURL("wrong-key", link))
+	if response.StatusCode != http.StatusForbidden {
+		t.Errorf(`A URL with an invalid signature should be refused, got status %d`, response.StatusCode)
+	}
+}
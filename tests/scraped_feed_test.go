@@ -0,0 +1,74 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestCreateScrapedFeed(t *testing.T) {
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feedID, err := client.CreateScrapedFeed(&miniflux.ScrapedFeedCreationRequest{
+		PageURL:    testWebsiteURL,
+		CategoryID: categories[0].ID,
+		Selectors:  miniflux.FeedSelectors{Item: "a[href]"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed, err := client.Feed(feedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Kind != "scraped" {
+		t.Errorf(`Invalid feed kind, got %q`, feed.Kind)
+	}
+
+	if feed.Selectors == nil || feed.Selectors.Item != "a[href]" {
+		t.Errorf(`Invalid feed selectors, got %+v`, feed.Selectors)
+	}
+}
+
+func TestCreateScrapedFeedWithoutItemSelector(t *testing.T) {
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.CreateScrapedFeed(&miniflux.ScrapedFeedCreationRequest{
+		PageURL:    testWebsiteURL,
+		CategoryID: categories[0].ID,
+	})
+	if err == nil {
+		t.Fatal(`Scraped feeds should not be created without item selector`)
+	}
+}
+
+func TestPreviewScrapedFeed(t *testing.T) {
+	client := createClient(t)
+	result, err := client.PreviewScrapedFeed(&miniflux.ScrapedFeedCreationRequest{
+		PageURL:   testWebsiteURL,
+		Selectors: miniflux.FeedSelectors{Item: "a[href]"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Total == 0 || len(result.Entries) != result.Total {
+		t.Errorf(`Invalid preview, got %d entries for a total of %d`, len(result.Entries), result.Total)
+	}
+}
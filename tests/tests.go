@@ -23,6 +23,8 @@ const (
 	testFeedTitle         = "Miniflux"
 	testSubscriptionTitle = "Miniflux Releases"
 	testWebsiteURL        = "https://miniflux.app/"
+	testNewsletterSecret  = "newsletter-secret"
+	testProxyPrivateKey   = "proxy-secret"
 )
 
 func getRandomUsername() string {
@@ -282,10 +282,12 @@ func TestUpdateUserFields(t *testing.T) {
 	stylesheet := "body { color: red }"
 	swipe := false
 	entriesPerPage := 5
+	archiveStarred := true
 	user, err = client.UpdateUser(user.ID, &miniflux.UserModificationRequest{
 		Stylesheet:     &stylesheet,
 		EntrySwipe:     &swipe,
 		EntriesPerPage: &entriesPerPage,
+		ArchiveStarred: &archiveStarred,
 	})
 	if err != nil {
 		t.Fatal(err)
@@ -302,6 +304,10 @@ func TestUpdateUserFields(t *testing.T) {
 	if user.EntriesPerPage != entriesPerPage {
 		t.Fatalf(`Unable to update user EntriesPerPage: got %q instead of %q`, user.EntriesPerPage, entriesPerPage)
 	}
+
+	if user.ArchiveStarred != archiveStarred {
+		t.Fatalf(`Unable to update user ArchiveStarred: got %v instead of %v`, user.ArchiveStarred, archiveStarred)
+	}
 }
 
 func TestUpdateUserThemeWithInvalidValue(t *testing.T) {
@@ -0,0 +1,133 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+// +build integration
+
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	miniflux "miniflux.app/client"
+)
+
+func TestCreateWatchPageFeed(t *testing.T) {
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feedID, err := client.CreateWatchPageFeed(&miniflux.WatchPageCreationRequest{
+		PageURL:    testWebsiteURL,
+		CategoryID: categories[0].ID,
+		Selector:   "body",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed, err := client.Feed(feedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Kind != "watch_page" {
+		t.Errorf(`Invalid feed kind, got %q`, feed.Kind)
+	}
+
+	if feed.WatchSelector != "body" {
+		t.Errorf(`Invalid watch selector, got %q`, feed.WatchSelector)
+	}
+
+	// The page has not changed since the feed has been created.
+	if err := client.RefreshFeed(feedID); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := client.FeedEntries(feedID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 0 {
+		t.Errorf(`No entry should be created when the page has not changed, got %d`, results.Total)
+	}
+}
+
+func TestWatchPageEntryURL(t *testing.T) {
+	var mutex sync.Mutex
+	visits := 0
+	page := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		visits++
+		fmt.Fprintf(w, `<html><head><title>Status</title></head><body><p id="status">Version %d</p></body></html>`, visits)
+		mutex.Unlock()
+	}))
+	defer page.Close()
+
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pageURL := page.URL + "/status"
+	feedID, err := client.CreateWatchPageFeed(&miniflux.WatchPageCreationRequest{
+		PageURL:    pageURL,
+		CategoryID: categories[0].ID,
+		Selector:   "#status",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed, err := client.Feed(feedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.SiteURL != pageURL {
+		t.Errorf(`The site URL should be the watched page, got %q`, feed.SiteURL)
+	}
+
+	// The page changes on each visit.
+	if err := client.RefreshFeed(feedID); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := client.FeedEntries(feedID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if results.Total != 1 {
+		t.Fatalf(`One entry should be created when the page has changed, got %d`, results.Total)
+	}
+
+	if results.Entries[0].URL != pageURL {
+		t.Errorf(`The entry should link to the watched page, got %q`, results.Entries[0].URL)
+	}
+}
+
+func TestCreateWatchPageFeedWithUnknownSelector(t *testing.T) {
+	client := createClient(t)
+	categories, err := client.Categories()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.CreateWatchPageFeed(&miniflux.WatchPageCreationRequest{
+		PageURL:    testWebsiteURL,
+		CategoryID: categories[0].ID,
+		Selector:   "#this-element-does-not-exist",
+	})
+	if err == nil {
+		t.Fatal(`Watch page feeds should not be created with a selector matching nothing`)
+	}
+}
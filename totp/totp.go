@@ -0,0 +1,120 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package totp // import "miniflux.app/totp"
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"miniflux.app/crypto"
+)
+
+const (
+	// Period is the validity of a code in seconds.
+	Period = 30
+
+	// Digits is the number of digits of a code.
+	Digits = 6
+
+	// Skew is the number of periods accepted before and after the current one.
+	Skew = 1
+
+	secretSize = 20
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a new random secret encoded in base32.
+func GenerateSecret() string {
+	return encoding.EncodeToString(crypto.GenerateRandomBytes(secretSize))
+}
+
+// ProvisioningURI returns the otpauth:// URI used by authenticator apps.
+func ProvisioningURI(issuer, account, secret string) string {
+	values := url.Values{}
+	values.Set("secret", secret)
+	values.Set("issuer", issuer)
+	values.Set("algorithm", "SHA1")
+	values.Set("digits", fmt.Sprint(Digits))
+	values.Set("period", fmt.Sprint(Period))
+
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+	return "otpauth://totp/" + label + "?" + values.Encode()
+}
+
+// Counter returns the time step of the given time.
+func Counter(t time.Time) int64 {
+	return t.Unix() / Period
+}
+
+// GenerateCode returns the code of the given time step.
+func GenerateCode(secret string, counter int64) (string, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return "", fmt.Errorf("totp: invalid secret: %v", err)
+	}
+
+	var message [8]byte
+	binary.BigEndian.PutUint64(message[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", Digits, value%1000000), nil
+}
+
+// Validate checks the code against the time steps around the given time.
+// It returns the matching time step, which must be greater than lastCounter
+// so a code cannot be used twice.
+func Validate(secret, code string, t time.Time, lastCounter int64) (int64, bool) {
+	code = strings.Replace(strings.TrimSpace(code), " ", "", -1)
+	if len(code) != Digits {
+		return 0, false
+	}
+
+	current := Counter(t)
+	for counter := current - Skew; counter <= current+Skew; counter++ {
+		if counter <= lastCounter {
+			continue
+		}
+
+		expected, err := GenerateCode(secret, counter)
+		if err != nil {
+			return 0, false
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return counter, true
+		}
+	}
+
+	return 0, false
+}
+
+// GenerateRecoveryCodes returns single-use codes that can replace a one-time password.
+func GenerateRecoveryCodes(count int) []string {
+	codes := make([]string, count)
+	for i := range codes {
+		code := crypto.GenerateRandomStringHex(5)
+		codes[i] = code[:5] + "-" + code[5:]
+	}
+	return codes
+}
+
+// NormalizeRecoveryCode removes the formatting of a recovery code typed by a user.
+func NormalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(code), "-", "", -1))
+}
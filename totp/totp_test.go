@@ -0,0 +1,117 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package totp // import "miniflux.app/totp"
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Secret and test vectors from RFC 6238, appendix B (SHA-1, last 6 digits).
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestGenerateCode(t *testing.T) {
+	scenarios := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+
+	for timestamp, expected := range scenarios {
+		code, err := GenerateCode(rfcSecret, Counter(time.Unix(timestamp, 0)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if code != expected {
+			t.Errorf(`Unexpected code for %d, got %q instead of %q`, timestamp, code, expected)
+		}
+	}
+}
+
+func TestGenerateCodeWithInvalidSecret(t *testing.T) {
+	if _, err := GenerateCode("not base32!", 1); err == nil {
+		t.Error("An invalid secret should return an error")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+
+	counter, valid := Validate(rfcSecret, "081804", now, 0)
+	if !valid {
+		t.Fatal("The code should be valid")
+	}
+
+	if counter != Counter(now) {
+		t.Errorf(`Unexpected counter, got %d`, counter)
+	}
+
+	if _, valid := Validate(rfcSecret, "081 804", now.Add(Period*time.Second), 0); !valid {
+		t.Error("The previous code should be accepted")
+	}
+
+	if _, valid := Validate(rfcSecret, "081804", now.Add(3*Period*time.Second), 0); valid {
+		t.Error("An expired code should be rejected")
+	}
+
+	if _, valid := Validate(rfcSecret, "081804", now, counter); valid {
+		t.Error("A code should not be accepted twice")
+	}
+
+	if _, valid := Validate(rfcSecret, "12345", now, 0); valid {
+		t.Error("A code with the wrong length should be rejected")
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret := GenerateSecret()
+	if len(secret) != 32 {
+		t.Errorf(`Unexpected secret length, got %d`, len(secret))
+	}
+
+	if _, err := GenerateCode(secret, 1); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := ProvisioningURI("Miniflux", "john doe", "JBSWY3DPEHPK3PXP")
+	if !strings.HasPrefix(uri, "otpauth://totp/Miniflux:john%20doe?") {
+		t.Errorf(`Unexpected URI, got %q`, uri)
+	}
+
+	if !strings.Contains(uri, "secret=JBSWY3DPEHPK3PXP") || !strings.Contains(uri, "issuer=Miniflux") {
+		t.Errorf(`Missing parameters in URI, got %q`, uri)
+	}
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes := GenerateRecoveryCodes(10)
+	if len(codes) != 10 {
+		t.Fatalf(`Unexpected number of codes, got %d`, len(codes))
+	}
+
+	for _, code := range codes {
+		if len(code) != 11 || code[5] != '-' {
+			t.Errorf(`Unexpected code format, got %q`, code)
+		}
+	}
+
+	if codes[0] == codes[1] {
+		t.Error("Recovery codes should be different")
+	}
+}
+
+func TestNormalizeRecoveryCode(t *testing.T) {
+	if code := NormalizeRecoveryCode(" 0A1B2-c3d4e "); code != "0a1b2c3d4e" {
+		t.Errorf(`Unexpected code, got %q`, code)
+	}
+}
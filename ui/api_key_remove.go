@@ -5,12 +5,15 @@
 package ui // import "miniflux.app/ui"
 
 import (
+	"fmt"
 	"net/http"
 
+	"miniflux.app/audit"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
 	"miniflux.app/logger"
+	"miniflux.app/model"
 )
 
 func (h *handler) removeAPIKey(w http.ResponseWriter, r *http.Request) {
@@ -18,6 +21,12 @@ func (h *handler) removeAPIKey(w http.ResponseWriter, r *http.Request) {
 	err := h.store.RemoveAPIKey(request.UserID(r), keyID)
 	if err != nil {
 		logger.Error("[UI:RemoveAPIKey] %v", err)
+	} else {
+		audit.Log(h.store, r, &model.AuditEvent{
+			UserID: request.UserID(r),
+			Action: model.AuditActionAPIKeyRemoved,
+			Target: fmt.Sprintf("#%d", keyID),
+		})
 	}
 
 	html.Redirect(w, r, route.Path(h.router, "apiKeys"))
@@ -7,6 +7,7 @@ package ui // import "miniflux.app/ui"
 import (
 	"net/http"
 
+	"miniflux.app/audit"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
@@ -54,5 +55,6 @@ func (h *handler) saveAPIKey(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	audit.Log(h.store, r, &model.AuditEvent{UserID: user.ID, Action: model.AuditActionAPIKeyCreated, Target: apiKey.Description})
 	html.Redirect(w, r, route.Path(h.router, "apiKeys"))
 }
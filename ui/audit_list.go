@@ -0,0 +1,105 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/model"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+const nbAuditEventsPerPage = 50
+
+func (h *handler) showAuditEventsPage(w http.ResponseWriter, r *http.Request) {
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !user.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	offset := request.QueryIntParam(r, "offset", 0)
+	filterUserID := request.QueryInt64Param(r, "user_id", 0)
+	filterAction := request.QueryStringParam(r, "action", "")
+	filterClientIP := request.QueryStringParam(r, "client_ip", "")
+
+	builder := h.store.NewAuditEventQueryBuilder()
+	builder.WithUserID(filterUserID)
+	builder.WithAction(filterAction)
+	builder.WithClientIP(filterClientIP)
+
+	count, err := builder.CountEvents()
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	builder.WithOffset(offset)
+	builder.WithLimit(nbAuditEventsPerPage)
+
+	events, err := builder.GetEvents()
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	events.UseTimezone(user.Timezone)
+
+	users, err := h.store.Users()
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	// The pagination links must keep the current filters.
+	values := url.Values{}
+	if filterUserID != 0 {
+		values.Set("user_id", strconv.FormatInt(filterUserID, 10))
+	}
+	if filterAction != "" {
+		values.Set("action", filterAction)
+	}
+	if filterClientIP != "" {
+		values.Set("client_ip", filterClientIP)
+	}
+
+	pageURL := func(offset int) string {
+		values.Set("offset", strconv.Itoa(offset))
+		return route.Path(h.router, "auditEvents") + "?" + values.Encode()
+	}
+
+	pagination := getPagination(route.Path(h.router, "auditEvents"), count, offset, nbAuditEventsPerPage)
+
+	view.Set("events", events)
+	view.Set("total", count)
+	view.Set("users", users)
+	view.Set("actions", model.AuditActions())
+	view.Set("filterUserID", filterUserID)
+	view.Set("filterAction", filterAction)
+	view.Set("filterClientIP", filterClientIP)
+	view.Set("pagination", pagination)
+	view.Set("prevURL", pageURL(pagination.PrevOffset))
+	view.Set("nextURL", pageURL(pagination.NextOffset))
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	html.OK(w, r, view.Render("audit"))
+}
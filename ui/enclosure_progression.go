@@ -0,0 +1,58 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	json_parser "encoding/json"
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/model"
+	"miniflux.app/validator"
+)
+
+func (h *handler) saveEnclosureProgression(w http.ResponseWriter, r *http.Request) {
+	var enclosureUpdateRequest model.EnclosureUpdateRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&enclosureUpdateRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := validator.ValidateEnclosureUpdateRequest(&enclosureUpdateRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	userID := request.UserID(r)
+	enclosure, err := h.store.GetEnclosure(userID, request.RouteInt64Param(r, "enclosureID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if enclosure == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	wasFinished := enclosure.IsMediaFinished()
+	enclosureUpdateRequest.Patch(enclosure)
+
+	if err := h.store.UpdateEnclosure(enclosure); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	// The entry is marked as read only once, when the end of the episode is reached.
+	if !wasFinished && enclosure.IsMediaFinished() {
+		if err := h.store.SetEntriesStatus(userID, []int64{enclosure.EntryID}, model.EntryStatusRead); err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+	}
+
+	json.OK(w, r, "OK")
+}
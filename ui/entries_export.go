@@ -0,0 +1,103 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/epub"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response"
+	"miniflux.app/http/response/html"
+	"miniflux.app/locale"
+	"miniflux.app/model"
+	"miniflux.app/storage"
+)
+
+func (h *handler) exportStarredEntries(w http.ResponseWriter, r *http.Request) {
+	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
+	builder.WithoutStatus(model.EntryStatusRemoved)
+	builder.WithStarred()
+	builder.WithOrder(model.DefaultSortingOrder)
+	builder.WithDirection(model.DefaultSortingDirection)
+
+	title := locale.NewPrinter(request.UserLanguage(r)).Printf("page.starred.title")
+	h.exportEntries(w, r, builder, title)
+}
+
+func (h *handler) exportCategoryEntries(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	category, err := h.store.Category(userID, request.RouteInt64Param(r, "categoryID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if category == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(userID)
+	builder.WithCategoryID(category.ID)
+	builder.WithStatus(model.EntryStatusUnread)
+	builder.WithOrder(model.DefaultSortingOrder)
+	builder.WithDirection(model.DefaultSortingDirection)
+
+	h.exportEntries(w, r, builder, category.Title)
+}
+
+func (h *handler) exportSearchEntries(w http.ResponseWriter, r *http.Request) {
+	searchQuery := request.QueryStringParam(r, "q", "")
+	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
+	builder.WithSearchQuery(searchQuery)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+
+	title := locale.NewPrinter(request.UserLanguage(r)).Printf("page.search.title")
+	if searchQuery != "" {
+		title += ": " + searchQuery
+	}
+
+	h.exportEntries(w, r, builder, title)
+}
+
+func (h *handler) exportTagEntries(w http.ResponseWriter, r *http.Request) {
+	tag := request.QueryStringParam(r, "tag", "")
+	if tag == "" {
+		html.NotFound(w, r)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(request.UserID(r))
+	builder.WithTag(tag)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+	builder.WithOrder(model.DefaultSortingOrder)
+	builder.WithDirection(model.DefaultSortingDirection)
+
+	title := locale.NewPrinter(request.UserLanguage(r)).Printf("page.tag_entries.title", tag)
+	h.exportEntries(w, r, builder, title)
+}
+
+func (h *handler) exportEntries(w http.ResponseWriter, r *http.Request, builder *storage.EntryQueryBuilder, title string) {
+	builder.WithLimit(epub.MaxEntries)
+	entries, err := builder.GetEntries()
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	book, err := epub.Export(title, request.UserLanguage(r), entries)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	response.New(w, r).
+		WithHeader("Content-Type", "application/epub+zip").
+		WithAttachment("entries.epub").
+		WithBody(book).
+		WithoutCompression().
+		Write()
+}
@@ -11,6 +11,7 @@ import (
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
 	"miniflux.app/model"
+	"miniflux.app/reader/podcast"
 	"miniflux.app/storage"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
@@ -42,6 +43,8 @@ func (h *handler) showCategoryEntryPage(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	podcast.QueueEpisode(h.store, entry)
+
 	if entry.Status == model.EntryStatusUnread {
 		err = h.store.SetEntriesStatus(user.ID, []int64{entry.ID}, model.EntryStatusRead)
 		if err != nil {
@@ -11,6 +11,7 @@ import (
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
 	"miniflux.app/model"
+	"miniflux.app/reader/podcast"
 	"miniflux.app/storage"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
@@ -39,6 +40,8 @@ func (h *handler) showReadEntryPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	podcast.QueueEpisode(h.store, entry)
+
 	entryPaginationBuilder := storage.NewEntryPaginationBuilder(h.store, user.ID, entry.ID, user.EntryDirection)
 	entryPaginationBuilder.WithStatus(model.EntryStatusRead)
 	prevEntry, nextEntry, err := entryPaginationBuilder.Entries()
@@ -9,6 +9,7 @@ import (
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/json"
+	"miniflux.app/reader/archiver"
 )
 
 func (h *handler) toggleBookmark(w http.ResponseWriter, r *http.Request) {
@@ -18,5 +19,7 @@ func (h *handler) toggleBookmark(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	archiver.ArchiveStarredEntry(h.store, request.UserID(r), entryID)
+
 	json.OK(w, r, "OK")
 }
@@ -56,6 +56,11 @@ func (h *handler) showEditFeedPage(w http.ResponseWriter, r *http.Request) {
 		IgnoreHTTPCache: feed.IgnoreHTTPCache,
 		FetchViaProxy:   feed.FetchViaProxy,
 		Disabled:        feed.Disabled,
+		WatchSelector:   feed.WatchSelector,
+	}
+
+	if feed.Selectors != nil {
+		feedForm.Selectors = *feed.Selectors
 	}
 
 	sess := session.New(h.store, request.SessionID(r))
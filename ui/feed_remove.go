@@ -7,17 +7,32 @@ package ui // import "miniflux.app/ui"
 import (
 	"net/http"
 
+	"miniflux.app/audit"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
+	"miniflux.app/model"
 )
 
 func (h *handler) removeFeed(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
+	feed, err := h.store.FeedByID(request.UserID(r), feedID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		html.NotFound(w, r)
+		return
+	}
+
 	if err := h.store.RemoveFeed(request.UserID(r), feedID); err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
 
+	audit.Log(h.store, r, &model.AuditEvent{UserID: feed.UserID, Action: model.AuditActionFeedRemoved, Target: feed.FeedURL})
+
 	html.Redirect(w, r, route.Path(h.router, "feeds"))
 }
@@ -46,6 +46,12 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 
 	feedForm := form.NewFeedForm(r)
 
+	// The address of a newsletter feed is generated and cannot be changed.
+	if feed.IsNewsletter() {
+		feedForm.FeedURL = feed.FeedURL
+		feedForm.SiteURL = feed.SiteURL
+	}
+
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("form", feedForm)
@@ -64,6 +70,15 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		CategoryID: model.OptionalInt64(feedForm.CategoryID),
 	}
 
+	if feed.IsNewsletter() {
+		feedModificationRequest.FeedURL = nil
+		feedModificationRequest.SiteURL = nil
+	}
+
+	if feed.IsScraped() {
+		feedModificationRequest.Selectors = &feedForm.Selectors
+	}
+
 	if validationErr := validator.ValidateFeedModification(h.store, loggedUser.ID, feedModificationRequest); validationErr != nil {
 		view.Set("errorMessage", validationErr.TranslationKey)
 		html.OK(w, r, view.Render("edit_feed"))
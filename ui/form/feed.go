@@ -7,6 +7,7 @@ package form // import "miniflux.app/ui/form"
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"miniflux.app/model"
 )
@@ -28,6 +29,8 @@ type FeedForm struct {
 	IgnoreHTTPCache bool
 	FetchViaProxy   bool
 	Disabled        bool
+	Selectors       model.FeedSelectors
+	WatchSelector   string
 }
 
 // Merge updates the fields of the given feed.
@@ -49,6 +52,16 @@ func (f FeedForm) Merge(feed *model.Feed) *model.Feed {
 	feed.IgnoreHTTPCache = f.IgnoreHTTPCache
 	feed.FetchViaProxy = f.FetchViaProxy
 	feed.Disabled = f.Disabled
+
+	if feed.IsScraped() {
+		selectors := f.Selectors
+		feed.Selectors = &selectors
+	}
+
+	if feed.IsWatchPage() {
+		feed.WatchSelector = f.WatchSelector
+	}
+
 	return feed
 }
 
@@ -74,5 +87,7 @@ func NewFeedForm(r *http.Request) *FeedForm {
 		IgnoreHTTPCache: r.FormValue("ignore_http_cache") == "1",
 		FetchViaProxy:   r.FormValue("fetch_via_proxy") == "1",
 		Disabled:        r.FormValue("disabled") == "1",
+		Selectors:       newFeedSelectors(r),
+		WatchSelector:   strings.TrimSpace(r.FormValue("watch_selector")),
 	}
 }
@@ -0,0 +1,40 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strconv"
+
+	"miniflux.app/errors"
+)
+
+// NewsletterForm represents the newsletter creation form.
+type NewsletterForm struct {
+	Title      string
+	CategoryID int64
+}
+
+// Validate makes sure the form values are valid.
+func (n *NewsletterForm) Validate() error {
+	if n.Title == "" || n.CategoryID == 0 {
+		return errors.NewLocalizedError("error.newsletter_mandatory_fields")
+	}
+
+	return nil
+}
+
+// NewNewsletterForm returns a new NewsletterForm.
+func NewNewsletterForm(r *http.Request) *NewsletterForm {
+	categoryID, err := strconv.Atoi(r.FormValue("category_id"))
+	if err != nil {
+		categoryID = 0
+	}
+
+	return &NewsletterForm{
+		Title:      r.FormValue("title"),
+		CategoryID: int64(categoryID),
+	}
+}
@@ -0,0 +1,40 @@
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestNewsletterFormValid(t *testing.T) {
+	form := &NewsletterForm{Title: "Weekly", CategoryID: 1}
+	if err := form.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNewsletterFormWithoutTitle(t *testing.T) {
+	form := &NewsletterForm{Title: "", CategoryID: 1}
+	if err := form.Validate(); err == nil {
+		t.Error("Validate should fail without title")
+	}
+}
+
+func TestNewsletterFormWithoutCategory(t *testing.T) {
+	form := &NewsletterForm{Title: "Weekly", CategoryID: 0}
+	if err := form.Validate(); err == nil {
+		t.Error("Validate should fail without category")
+	}
+}
+
+func TestNewNewsletterForm(t *testing.T) {
+	values := url.Values{"title": {"Weekly"}, "category_id": {"42"}}
+	r, _ := http.NewRequest(http.MethodPost, "/newsletter/save", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	form := NewNewsletterForm(r)
+	if form.Title != "Weekly" || form.CategoryID != 42 {
+		t.Errorf("Unexpected form values: %+v", form)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strconv"
+
+	"miniflux.app/errors"
+)
+
+// OPMLSourceForm represents the form to subscribe to a remote OPML list.
+type OPMLSourceForm struct {
+	URL            string
+	CategoryID     int64
+	DisableRemoved bool
+}
+
+// Validate makes sure the form values are valid.
+func (o *OPMLSourceForm) Validate() error {
+	if o.URL == "" || o.CategoryID == 0 {
+		return errors.NewLocalizedError("error.feed_mandatory_fields")
+	}
+
+	return nil
+}
+
+// NewOPMLSourceForm returns a new OPMLSourceForm.
+func NewOPMLSourceForm(r *http.Request) *OPMLSourceForm {
+	categoryID, err := strconv.Atoi(r.FormValue("category_id"))
+	if err != nil {
+		categoryID = 0
+	}
+
+	return &OPMLSourceForm{
+		URL:            r.FormValue("url"),
+		CategoryID:     int64(categoryID),
+		DisableRemoved: r.FormValue("disable_removed") == "1",
+	}
+}
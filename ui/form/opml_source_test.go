@@ -0,0 +1,40 @@
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestOPMLSourceFormValid(t *testing.T) {
+	form := &OPMLSourceForm{URL: "https://example.org/blogroll.opml", CategoryID: 1}
+	if err := form.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOPMLSourceFormWithoutURL(t *testing.T) {
+	form := &OPMLSourceForm{URL: "", CategoryID: 1}
+	if err := form.Validate(); err == nil {
+		t.Error("Validate should fail without URL")
+	}
+}
+
+func TestOPMLSourceFormWithoutCategory(t *testing.T) {
+	form := &OPMLSourceForm{URL: "https://example.org/blogroll.opml", CategoryID: 0}
+	if err := form.Validate(); err == nil {
+		t.Error("Validate should fail without category")
+	}
+}
+
+func TestNewOPMLSourceForm(t *testing.T) {
+	values := url.Values{"url": {"https://example.org/blogroll.opml"}, "category_id": {"42"}, "disable_removed": {"1"}}
+	r, _ := http.NewRequest(http.MethodPost, "/opml-sources", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	form := NewOPMLSourceForm(r)
+	if form.URL != "https://example.org/blogroll.opml" || form.CategoryID != 42 || !form.DisableRemoved {
+		t.Errorf("Unexpected form values: %+v", form)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"miniflux.app/errors"
+	"miniflux.app/model"
+)
+
+// ScrapedFeedForm represents the form to create a feed scraped from a web page.
+type ScrapedFeedForm struct {
+	PageURL       string
+	CategoryID    int64
+	Title         string
+	Selectors     model.FeedSelectors
+	UserAgent     string
+	Crawler       bool
+	FetchViaProxy bool
+}
+
+// Validate makes sure the form values are valid.
+func (s *ScrapedFeedForm) Validate() error {
+	if s.PageURL == "" || s.CategoryID == 0 || s.Selectors.Item == "" {
+		return errors.NewLocalizedError("error.scraped_feed_mandatory_fields")
+	}
+
+	return nil
+}
+
+// Request returns the creation request built from the form values.
+func (s *ScrapedFeedForm) Request() *model.ScrapedFeedCreationRequest {
+	return &model.ScrapedFeedCreationRequest{
+		PageURL:       s.PageURL,
+		CategoryID:    s.CategoryID,
+		Title:         s.Title,
+		Selectors:     s.Selectors,
+		UserAgent:     s.UserAgent,
+		Crawler:       s.Crawler,
+		FetchViaProxy: s.FetchViaProxy,
+	}
+}
+
+// NewScrapedFeedForm returns a new ScrapedFeedForm.
+func NewScrapedFeedForm(r *http.Request) *ScrapedFeedForm {
+	categoryID, err := strconv.Atoi(r.FormValue("category_id"))
+	if err != nil {
+		categoryID = 0
+	}
+
+	return &ScrapedFeedForm{
+		PageURL:       strings.TrimSpace(r.FormValue("page_url")),
+		CategoryID:    int64(categoryID),
+		Title:         strings.TrimSpace(r.FormValue("title")),
+		Selectors:     newFeedSelectors(r),
+		UserAgent:     r.FormValue("user_agent"),
+		Crawler:       r.FormValue("crawler") == "1",
+		FetchViaProxy: r.FormValue("fetch_via_proxy") == "1",
+	}
+}
+
+func newFeedSelectors(r *http.Request) model.FeedSelectors {
+	return model.FeedSelectors{
+		Item:    strings.TrimSpace(r.FormValue("selector_item")),
+		Title:   strings.TrimSpace(r.FormValue("selector_title")),
+		Link:    strings.TrimSpace(r.FormValue("selector_link")),
+		Date:    strings.TrimSpace(r.FormValue("selector_date")),
+		Content: strings.TrimSpace(r.FormValue("selector_content")),
+	}
+}
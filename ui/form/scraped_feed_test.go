@@ -0,0 +1,63 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestScrapedFeedFormValid(t *testing.T) {
+	form := &ScrapedFeedForm{PageURL: "https://example.org/news", CategoryID: 1, Selectors: model.FeedSelectors{Item: "article"}}
+	if err := form.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestScrapedFeedFormWithoutItemSelector(t *testing.T) {
+	form := &ScrapedFeedForm{PageURL: "https://example.org/news", CategoryID: 1}
+	if err := form.Validate(); err == nil {
+		t.Error("Validate should fail without item selector")
+	}
+}
+
+func TestScrapedFeedFormWithoutCategory(t *testing.T) {
+	form := &ScrapedFeedForm{PageURL: "https://example.org/news", Selectors: model.FeedSelectors{Item: "article"}}
+	if err := form.Validate(); err == nil {
+		t.Error("Validate should fail without category")
+	}
+}
+
+func TestNewScrapedFeedForm(t *testing.T) {
+	values := url.Values{
+		"page_url":       {" https://example.org/news "},
+		"category_id":    {"42"},
+		"selector_item":  {"article.post"},
+		"selector_title": {"h2"},
+		"selector_date":  {"time"},
+		"crawler":        {"1"},
+	}
+	r, _ := http.NewRequest(http.MethodPost, "/scraped-feed/save", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	form := NewScrapedFeedForm(r)
+	if form.PageURL != "https://example.org/news" || form.CategoryID != 42 || !form.Crawler {
+		t.Errorf("Unexpected form values: %+v", form)
+	}
+
+	expected := model.FeedSelectors{Item: "article.post", Title: "h2", Date: "time"}
+	if form.Selectors != expected {
+		t.Errorf("Unexpected selectors: %+v", form.Selectors)
+	}
+
+	request := form.Request()
+	if request.PageURL != form.PageURL || request.Selectors != expected {
+		t.Errorf("Unexpected creation request: %+v", request)
+	}
+}
@@ -26,6 +26,7 @@ type SettingsForm struct {
 	ShowReadingTime   bool
 	CustomCSS         string
 	EntrySwipe        bool
+	ArchiveStarred    bool
 }
 
 // Merge updates the fields of the given user.
@@ -40,6 +41,7 @@ func (s *SettingsForm) Merge(user *model.User) *model.User {
 	user.ShowReadingTime = s.ShowReadingTime
 	user.Stylesheet = s.CustomCSS
 	user.EntrySwipe = s.EntrySwipe
+	user.ArchiveStarred = s.ArchiveStarred
 
 	if s.Password != "" {
 		user.Password = s.Password
@@ -87,5 +89,6 @@ func NewSettingsForm(r *http.Request) *SettingsForm {
 		ShowReadingTime:   r.FormValue("show_reading_time") == "1",
 		CustomCSS:         r.FormValue("custom_css"),
 		EntrySwipe:        r.FormValue("entry_swipe") == "1",
+		ArchiveStarred:    r.FormValue("archive_starred") == "1",
 	}
 }
@@ -0,0 +1,33 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strings"
+
+	"miniflux.app/errors"
+)
+
+// TOTPForm represents the form used to submit a two-factor authentication code.
+type TOTPForm struct {
+	Code string
+}
+
+// Validate makes sure the form values are valid.
+func (t TOTPForm) Validate() error {
+	if t.Code == "" {
+		return errors.NewLocalizedError("error.totp_code_required")
+	}
+
+	return nil
+}
+
+// NewTOTPForm returns a new TOTPForm.
+func NewTOTPForm(r *http.Request) *TOTPForm {
+	return &TOTPForm{
+		Code: strings.TrimSpace(r.FormValue("code")),
+	}
+}
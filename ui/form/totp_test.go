@@ -0,0 +1,32 @@
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestTOTPFormValid(t *testing.T) {
+	form := &TOTPForm{Code: "123456"}
+	if err := form.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTOTPFormEmpty(t *testing.T) {
+	form := &TOTPForm{Code: ""}
+	if err := form.Validate(); err == nil {
+		t.Error("Validate should fail without code")
+	}
+}
+
+func TestNewTOTPFormTrimsCode(t *testing.T) {
+	values := url.Values{"code": {" 123456 "}}
+	r, _ := http.NewRequest(http.MethodPost, "/login/totp", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if form := NewTOTPForm(r); form.Code != "123456" {
+		t.Errorf("Unexpected code: %q", form.Code)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"miniflux.app/errors"
+	"miniflux.app/model"
+)
+
+// WatchPageForm represents the form to create a feed reporting the changes of a web page.
+type WatchPageForm struct {
+	PageURL       string
+	CategoryID    int64
+	Selector      string
+	Title         string
+	UserAgent     string
+	FetchViaProxy bool
+}
+
+// Validate makes sure the form values are valid.
+func (w *WatchPageForm) Validate() error {
+	if w.PageURL == "" || w.CategoryID == 0 {
+		return errors.NewLocalizedError("error.watch_page_mandatory_fields")
+	}
+
+	return nil
+}
+
+// Request returns the creation request built from the form values.
+func (w *WatchPageForm) Request() *model.WatchPageCreationRequest {
+	return &model.WatchPageCreationRequest{
+		PageURL:       w.PageURL,
+		CategoryID:    w.CategoryID,
+		Selector:      w.Selector,
+		Title:         w.Title,
+		UserAgent:     w.UserAgent,
+		FetchViaProxy: w.FetchViaProxy,
+	}
+}
+
+// NewWatchPageForm returns a new WatchPageForm.
+func NewWatchPageForm(r *http.Request) *WatchPageForm {
+	categoryID, err := strconv.Atoi(r.FormValue("category_id"))
+	if err != nil {
+		categoryID = 0
+	}
+
+	return &WatchPageForm{
+		PageURL:       strings.TrimSpace(r.FormValue("page_url")),
+		CategoryID:    int64(categoryID),
+		Selector:      strings.TrimSpace(r.FormValue("watch_selector")),
+		Title:         strings.TrimSpace(r.FormValue("title")),
+		UserAgent:     r.FormValue("user_agent"),
+		FetchViaProxy: r.FormValue("fetch_via_proxy") == "1",
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package form // import "miniflux.app/ui/form"
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestWatchPageFormValid(t *testing.T) {
+	form := &WatchPageForm{PageURL: "https://example.org/pricing", CategoryID: 1}
+	if err := form.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWatchPageFormWithoutURL(t *testing.T) {
+	form := &WatchPageForm{CategoryID: 1}
+	if err := form.Validate(); err == nil {
+		t.Error("Validate should fail without page URL")
+	}
+}
+
+func TestNewWatchPageForm(t *testing.T) {
+	values := url.Values{
+		"page_url":       {"https://example.org/pricing"},
+		"category_id":    {"3"},
+		"watch_selector": {" #plans "},
+	}
+	r, _ := http.NewRequest(http.MethodPost, "/watch-page/save", strings.NewReader(values.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	form := NewWatchPageForm(r)
+	if form.PageURL != "https://example.org/pricing" || form.CategoryID != 3 || form.Selector != "#plans" {
+		t.Errorf("Unexpected form values: %+v", form)
+	}
+
+	request := form.Request()
+	if request.Selector != "#plans" || request.CategoryID != 3 {
+		t.Errorf("Unexpected creation request: %+v", request)
+	}
+}
@@ -3,11 +3,14 @@ package ui // import "miniflux.app/ui"
 import (
 	"net/http"
 
+	"miniflux.app/audit"
 	"miniflux.app/config"
 	"miniflux.app/http/cookie"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
+	"miniflux.app/ldap"
+	"miniflux.app/lockout"
 	"miniflux.app/logger"
 	"miniflux.app/ui/form"
 	"miniflux.app/ui/session"
@@ -29,12 +32,44 @@ func (h *handler) checkLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.store.CheckPassword(authForm.Username, authForm.Password); err != nil {
-		logger.Error("[UI:CheckLogin] [ClientIP=%s] %v", clientIP, err)
+	lockoutIP := lockout.ClientIP(r)
+	guard := lockout.NewGuard(h.store)
+	if guard.Check("ui", authForm.Username, lockoutIP) > 0 {
+		view.Set("errorMessage", "error.too_many_login_attempts")
 		html.OK(w, r, view.Render("login"))
 		return
 	}
 
+	method := "password"
+	if err := h.store.CheckPassword(authForm.Username, authForm.Password); err != nil {
+		if !config.Opts.HasLDAP() {
+			logger.Error("[UI:CheckLogin] [ClientIP=%s] %v", clientIP, err)
+			guard.Fail("ui", authForm.Username, lockoutIP)
+			audit.LogFailedLogin(h.store, r, authForm.Username, "ui")
+			html.OK(w, r, view.Render("login"))
+			return
+		}
+
+		if _, err := ldap.Login(h.store, r, authForm.Username, authForm.Password); err != nil {
+			logger.Error("[UI:CheckLogin] [ClientIP=%s] %v", clientIP, err)
+			guard.Fail("ui", authForm.Username, lockoutIP)
+			audit.LogFailedLogin(h.store, r, authForm.Username, "ui")
+			html.OK(w, r, view.Render("login"))
+			return
+		}
+
+		method = "ldap"
+	}
+
+	if h.store.HasTOTPByUsername(authForm.Username) {
+		logger.Info("[UI:CheckLogin] [ClientIP=%s] username=%s must complete two-factor authentication", clientIP, authForm.Username)
+		sess.StartTOTPLogin(authForm.Username)
+		html.Redirect(w, r, route.Path(h.router, "loginTOTP"))
+		return
+	}
+
+	guard.Succeed(authForm.Username)
+
 	sessionToken, userID, err := h.store.CreateUserSessionFromUsername(authForm.Username, r.UserAgent(), clientIP)
 	if err != nil {
 		html.ServerError(w, r, err)
@@ -50,6 +85,8 @@ func (h *handler) checkLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	audit.LogLogin(h.store, r, user, method)
+
 	sess.SetLanguage(user.Language)
 	sess.SetTheme(user.Theme)
 
@@ -0,0 +1,118 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"time"
+
+	"miniflux.app/audit"
+	"miniflux.app/config"
+	"miniflux.app/http/cookie"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/lockout"
+	"miniflux.app/logger"
+	"miniflux.app/storage"
+	"miniflux.app/totp"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) checkLoginTOTP(w http.ResponseWriter, r *http.Request) {
+	clientIP := request.ClientIP(r)
+	sess := session.New(h.store, request.SessionID(r))
+
+	appSession, err := h.store.AppSession(request.SessionID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	username := appSession.Data.PendingTOTPUsername(time.Now())
+	if username == "" {
+		logger.Error("[UI:CheckLoginTOTP] [ClientIP=%s] No pending two-factor authentication", clientIP)
+		html.Redirect(w, r, route.Path(h.router, "login"))
+		return
+	}
+
+	// The password must be entered again after a wrong code to prevent brute-force attacks.
+	sess.ClearTOTPLogin()
+
+	user, err := h.store.UserByUsername(username)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if user == nil {
+		html.Redirect(w, r, route.Path(h.router, "login"))
+		return
+	}
+
+	totpForm := form.NewTOTPForm(r)
+	valid := false
+	if totpForm.Validate() == nil {
+		if valid, err = verifyTOTPCode(h.store, user.ID, totpForm.Code); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+	}
+
+	if !valid {
+		logger.Error("[UI:CheckLoginTOTP] [ClientIP=%s] Invalid two-factor authentication code for username=%s", clientIP, user.Username)
+		lockout.NewGuard(h.store).Fail("totp", user.Username, lockout.ClientIP(r))
+		audit.LogFailedLogin(h.store, r, user.Username, "totp")
+		view := view.New(h.tpl, r, sess)
+		view.Set("errorMessage", "error.invalid_totp_code")
+		html.OK(w, r, view.Render("login"))
+		return
+	}
+
+	lockout.NewGuard(h.store).Succeed(user.Username)
+
+	sessionToken, userID, err := h.store.CreateUserSessionFromUsername(user.Username, r.UserAgent(), clientIP)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	logger.Info("[UI:CheckLoginTOTP] username=%s just logged in", user.Username)
+	h.store.SetLastLogin(userID)
+	audit.LogLogin(h.store, r, user, "totp")
+
+	sess.SetLanguage(user.Language)
+	sess.SetTheme(user.Theme)
+
+	http.SetCookie(w, cookie.New(
+		cookie.CookieUserSessionID,
+		sessionToken,
+		config.Opts.HTTPS,
+		config.Opts.BasePath(),
+	))
+
+	html.Redirect(w, r, route.Path(h.router, "unread"))
+}
+
+// verifyTOTPCode accepts either a one-time password or an unused recovery code.
+func verifyTOTPCode(store *storage.Storage, userID int64, code string) (bool, error) {
+	secret, lastCounter, err := store.TOTPSecret(userID)
+	if err != nil {
+		return false, err
+	}
+
+	if secret == "" {
+		return false, nil
+	}
+
+	// The counter is updated only if no concurrent request used the same code.
+	if counter, valid := totp.Validate(secret, code, time.Now(), lastCounter); valid {
+		return store.UseTOTPCounter(userID, counter)
+	}
+
+	return store.UseTOTPRecoveryCode(userID, code)
+}
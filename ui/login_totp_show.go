@@ -0,0 +1,33 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"time"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showLoginTOTPPage(w http.ResponseWriter, r *http.Request) {
+	if request.IsAuthenticated(r) {
+		html.Redirect(w, r, route.Path(h.router, "unread"))
+		return
+	}
+
+	appSession, err := h.store.AppSession(request.SessionID(r))
+	if err != nil || appSession.Data.PendingTOTPUsername(time.Now()) == "" {
+		html.Redirect(w, r, route.Path(h.router, "login"))
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	html.OK(w, r, view.Render("login_totp"))
+}
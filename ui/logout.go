@@ -7,12 +7,14 @@ package ui // import "miniflux.app/ui"
 import (
 	"net/http"
 
+	"miniflux.app/audit"
 	"miniflux.app/config"
 	"miniflux.app/http/cookie"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
 	"miniflux.app/logger"
+	"miniflux.app/model"
 	"miniflux.app/ui/session"
 )
 
@@ -31,6 +33,8 @@ func (h *handler) logout(w http.ResponseWriter, r *http.Request) {
 		logger.Error("[UI:Logout] %v", err)
 	}
 
+	audit.Log(h.store, r, &model.AuditEvent{UserID: user.ID, Action: model.AuditActionLogout})
+
 	http.SetCookie(w, cookie.Expired(
 		cookie.CookieUserSessionID,
 		config.Opts.HTTPS,
@@ -90,7 +90,7 @@ func (m *middleware) handleAppSession(next http.Handler) http.Handler {
 			if session.Data.CSRF != formValue && session.Data.CSRF != headerValue {
 				logger.Error(`[UI:AppSession] Invalid or missing CSRF token: Form="%s", Header="%s"`, formValue, headerValue)
 
-				if mux.CurrentRoute(r).GetName() == "checkLogin" {
+				if routeName := mux.CurrentRoute(r).GetName(); routeName == "checkLogin" || routeName == "checkLoginTOTP" {
 					html.Redirect(w, r, route.Path(m.router, "login"))
 					return
 				}
@@ -133,6 +133,10 @@ func (m *middleware) isPublicRoute(r *http.Request) bool {
 	switch route.GetName() {
 	case "login",
 		"checkLogin",
+		"loginTOTP",
+		"checkLoginTOTP",
+		"beginWebAuthnLogin",
+		"finishWebAuthnLogin",
 		"stylesheet",
 		"javascript",
 		"oauth2Redirect",
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"net/http"
 
+	"miniflux.app/audit"
 	"miniflux.app/config"
 	"miniflux.app/http/cookie"
 	"miniflux.app/http/request"
@@ -86,6 +87,14 @@ func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if allowedGroups := config.Opts.OAuth2AllowedGroups(); len(allowedGroups) > 0 && !profile.IsMemberOf(allowedGroups) {
+		logger.Error("[OAuth2] [ClientIP=%s] User is not a member of the allowed groups: %s", clientIP, profile)
+		html.Forbidden(w, r)
+		return
+	}
+
+	adminGroups := config.Opts.OAuth2AdminGroups()
+
 	user, err := h.store.UserByField(profile.Key, profile.ID)
 	if err != nil {
 		html.ServerError(w, r, err)
@@ -104,6 +113,7 @@ func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 		}
 
 		userCreationRequest := &model.UserCreationRequest{Username: profile.Username}
+		userCreationRequest.IsAdmin = len(adminGroups) > 0 && profile.IsMemberOf(adminGroups)
 		authProvider.PopulateUserCreationWithProfileID(userCreationRequest, profile)
 
 		user, err = h.store.CreateUser(userCreationRequest)
@@ -111,6 +121,25 @@ func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 			html.ServerError(w, r, err)
 			return
 		}
+
+		audit.LogProvisioning(h.store, r, audit.ActorOAuth2+":"+provider, user, true, false)
+	} else if len(adminGroups) > 0 && user.IsAdmin != profile.IsMemberOf(adminGroups) {
+		logger.Info("[OAuth2] [ClientIP=%s] Updating administrator flag of username=%s from groups", clientIP, user.Username)
+		wasAdmin := user.IsAdmin
+		user.IsAdmin = !user.IsAdmin
+		if err := h.store.UpdateUser(user); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		audit.LogProvisioning(h.store, r, audit.ActorOAuth2+":"+provider, user, false, wasAdmin)
+	}
+
+	if h.store.HasTOTP(user.ID) {
+		logger.Info("[OAuth2] [ClientIP=%s] username=%s must complete two-factor authentication", clientIP, user.Username)
+		sess.StartTOTPLogin(user.Username)
+		html.Redirect(w, r, route.Path(h.router, "loginTOTP"))
+		return
 	}
 
 	sessionToken, _, err := h.store.CreateUserSessionFromUsername(user.Username, r.UserAgent(), clientIP)
@@ -122,6 +151,7 @@ func (h *handler) oauth2Callback(w http.ResponseWriter, r *http.Request) {
 	logger.Info("[OAuth2] [ClientIP=%s] username=%s (%s) just logged in", clientIP, user.Username, profile)
 
 	h.store.SetLastLogin(user.ID)
+	audit.LogLogin(h.store, r, user, "oauth2:"+provider)
 	sess.SetLanguage(user.Language)
 	sess.SetTheme(user.Theme)
 
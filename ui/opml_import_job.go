@@ -0,0 +1,56 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/model"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+// maxDisplayedImportErrors is the maximum number of errors displayed after an import.
+const maxDisplayedImportErrors = 20
+
+func (h *handler) showImportJobPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	job, err := h.store.ImportJob(user.ID, request.RouteInt64Param(r, "jobID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if job == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	if job.Report != nil && len(job.Report.Errors) > maxDisplayedImportErrors {
+		job.Report.Errors = job.Report.Errors[:maxDisplayedImportErrors]
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("job", job)
+	view.Set("countCreated", job.CountResults(model.ImportResultCreated))
+	view.Set("countDuplicates", job.CountResults(model.ImportResultDuplicate))
+	view.Set("countUnreachable", job.CountResults(model.ImportResultUnreachable))
+	view.Set("countParseErrors", job.CountResults(model.ImportResultParseError))
+	view.Set("countErrors", job.CountResults(model.ImportResultError))
+	view.Set("menu", "feeds")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	html.OK(w, r, view.Render("import_job"))
+}
@@ -0,0 +1,34 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/reader/opml"
+)
+
+func (h *handler) refreshOPMLSource(w http.ResponseWriter, r *http.Request) {
+	source, err := h.store.OPMLSource(request.UserID(r), request.RouteInt64Param(r, "sourceID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if source == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	if _, err := opml.NewHandler(h.store).SyncSource(source); err != nil {
+		logger.Error("[UI:RefreshOPMLSource] %v", err)
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "opmlSource", "sourceID", source.ID))
+}
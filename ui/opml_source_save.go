@@ -0,0 +1,91 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/reader/opml"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+	"miniflux.app/validator"
+)
+
+func (h *handler) saveOPMLSource(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sources, err := h.store.OPMLSources(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	categories, err := h.store.Categories(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sourceForm := form.NewOPMLSourceForm(r)
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("sources", sources)
+	view.Set("categories", categories)
+	view.Set("form", sourceForm)
+	view.Set("menu", "feeds")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	if err := sourceForm.Validate(); err != nil {
+		view.Set("errorMessage", err.Error())
+		html.OK(w, r, view.Render("opml_sources"))
+		return
+	}
+
+	sourceRequest := &model.OPMLSourceCreationRequest{
+		URL:            sourceForm.URL,
+		CategoryID:     sourceForm.CategoryID,
+		DisableRemoved: sourceForm.DisableRemoved,
+	}
+
+	if validationErr := validator.ValidateOPMLSourceCreation(h.store, user.ID, sourceRequest); validationErr != nil {
+		view.Set("errorMessage", validationErr.TranslationKey)
+		html.OK(w, r, view.Render("opml_sources"))
+		return
+	}
+
+	source := &model.OPMLSource{
+		UserID:         user.ID,
+		URL:            sourceRequest.URL,
+		DisableRemoved: sourceRequest.DisableRemoved,
+		Category:       &model.Category{ID: sourceRequest.CategoryID, UserID: user.ID},
+	}
+
+	if err := h.store.CreateOPMLSource(source); err != nil {
+		logger.Error("[UI:SaveOPMLSource] %v", err)
+		view.Set("errorMessage", err)
+		html.OK(w, r, view.Render("opml_sources"))
+		return
+	}
+
+	// The first synchronization runs immediately, the errors are displayed on the page of the source.
+	if _, err := opml.NewHandler(h.store).SyncSource(source); err != nil {
+		logger.Error("[UI:SaveOPMLSource] %v", err)
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "opmlSource", "sourceID", source.ID))
+}
@@ -0,0 +1,53 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+// maxDisplayedOPMLSourceSyncs is the number of synchronizations displayed in the history of a remote OPML list.
+const maxDisplayedOPMLSourceSyncs = 50
+
+func (h *handler) showOPMLSourcePage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	source, err := h.store.OPMLSource(user.ID, request.RouteInt64Param(r, "sourceID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if source == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	syncs, err := h.store.OPMLSourceSyncs(source.ID, maxDisplayedOPMLSourceSyncs)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("source", source)
+	view.Set("syncs", syncs)
+	view.Set("menu", "feeds")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	html.OK(w, r, view.Render("opml_source"))
+}
@@ -5,6 +5,9 @@
 package ui // import "miniflux.app/ui"
 
 import (
+	"bytes"
+	"io"
+	"io/ioutil"
 	"net/http"
 
 	"miniflux.app/config"
@@ -13,11 +16,15 @@ import (
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
 	"miniflux.app/logger"
+	"miniflux.app/reader/importer"
 	"miniflux.app/reader/opml"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
 )
 
+// maxUploadSize is the maximum size of an imported file, the ZIP archives are limited separately by the importer.
+const maxUploadSize = 50 * 1024 * 1024
+
 func (h *handler) uploadOPML(w http.ResponseWriter, r *http.Request) {
 	user, err := h.store.UserByID(request.UserID(r))
 	if err != nil {
@@ -25,6 +32,21 @@ func (h *handler) uploadOPML(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("menu", "feeds")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	// The form fields are small, the request is rejected before parsing when the file is obviously too large.
+	if r.ContentLength > maxUploadSize {
+		view.Set("errorMessage", "error.file_too_large")
+		html.OK(w, r, view.Render("import"))
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
 	file, fileHeader, err := r.FormFile("file")
 	if err != nil {
 		logger.Error("[UI:UploadOPML] %v", err)
@@ -40,26 +62,51 @@ func (h *handler) uploadOPML(w http.ResponseWriter, r *http.Request) {
 		fileHeader.Size,
 	)
 
-	sess := session.New(h.store, request.SessionID(r))
-	view := view.New(h.tpl, r, sess)
-	view.Set("menu", "feeds")
-	view.Set("user", user)
-	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
-	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
-
 	if fileHeader.Size == 0 {
 		view.Set("errorMessage", "error.empty_file")
 		html.OK(w, r, view.Render("import"))
 		return
 	}
 
-	if impErr := opml.NewHandler(h.store).Import(user.ID, file); impErr != nil {
+	data, err := ioutil.ReadAll(io.LimitReader(file, maxUploadSize+1))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if len(data) > maxUploadSize {
+		view.Set("errorMessage", "error.file_too_large")
+		html.OK(w, r, view.Render("import"))
+		return
+	}
+
+	// Exports of other feed readers also contain starred entries and read state, they are imported in the background.
+	if importer.DetectFormat(data) != importer.FormatOPML {
+		job, impErr := importer.NewImporter(h.store, user.ID).Start(data)
+		if impErr == importer.ErrUnknownFormat {
+			view.Set("errorMessage", "error.unsupported_import_format")
+			html.OK(w, r, view.Render("import"))
+			return
+		}
+
+		if impErr != nil {
+			view.Set("errorMessage", impErr)
+			html.OK(w, r, view.Render("import"))
+			return
+		}
+
+		html.Redirect(w, r, route.Path(h.router, "importJob", "jobID", job.ID))
+		return
+	}
+
+	job, impErr := h.newOPMLHandler(r).Import(user.ID, bytes.NewReader(data))
+	if impErr != nil {
 		view.Set("errorMessage", impErr)
 		html.OK(w, r, view.Render("import"))
 		return
 	}
 
-	html.Redirect(w, r, route.Path(h.router, "feeds"))
+	html.Redirect(w, r, route.Path(h.router, "importJob", "jobID", job.ID))
 }
 
 func (h *handler) fetchOPML(w http.ResponseWriter, r *http.Request) {
@@ -96,11 +143,26 @@ func (h *handler) fetchOPML(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if impErr := opml.NewHandler(h.store).Import(user.ID, resp.Body); impErr != nil {
+	job, impErr := h.newOPMLHandler(r).Import(user.ID, resp.Body)
+	if impErr != nil {
 		view.Set("errorMessage", impErr)
 		html.OK(w, r, view.Render("import"))
 		return
 	}
 
-	html.Redirect(w, r, route.Path(h.router, "feeds"))
+	html.Redirect(w, r, route.Path(h.router, "importJob", "jobID", job.ID))
+}
+
+// newOPMLHandler returns an OPML handler configured with the options of the import form.
+func (h *handler) newOPMLHandler(r *http.Request) *opml.Handler {
+	opmlHandler := opml.NewHandler(h.store)
+	if r.FormValue("apply_settings") == "1" {
+		opmlHandler.WithFeedSettings()
+	}
+
+	if r.FormValue("validate") == "1" {
+		opmlHandler.WithValidation()
+	}
+
+	return opmlHandler
 }
@@ -14,6 +14,7 @@ type pagination struct {
 	NextOffset   int
 	PrevOffset   int
 	SearchQuery  string
+	Tag          string
 }
 
 func getPagination(route string, total, offset, nbItemsPerPage int) pagination {
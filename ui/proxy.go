@@ -5,71 +5,176 @@
 package ui // import "miniflux.app/ui"
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"net/http"
 	"time"
 
 	"miniflux.app/config"
-	"miniflux.app/crypto"
+	"miniflux.app/http/client"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response"
 	"miniflux.app/http/response/html"
 	"miniflux.app/logger"
+	"miniflux.app/proxy"
 )
 
-func (h *handler) imageProxy(w http.ResponseWriter, r *http.Request) {
-	// If we receive a "If-None-Match" header, we assume the image is already stored in browser cache.
+// Response headers forwarded to the browser when streaming audio and video, they are required to seek in the media.
+var forwardedMediaHeaders = []string{"Content-Length", "Content-Range", "Accept-Ranges", "Last-Modified"}
+
+func (h *handler) mediaProxy(w http.ResponseWriter, r *http.Request) {
+	// If we receive a "If-None-Match" header, we assume the media is already stored in browser cache.
 	if r.Header.Get("If-None-Match") != "" {
 		w.WriteHeader(http.StatusNotModified)
 		return
 	}
 
+	encodedDigest := request.RouteStringParam(r, "encodedDigest")
 	encodedURL := request.RouteStringParam(r, "encodedURL")
 	if encodedURL == "" {
 		html.BadRequest(w, r, errors.New("No URL provided"))
 		return
 	}
 
+	decodedDigest, err := base64.URLEncoding.DecodeString(encodedDigest)
+	if err != nil {
+		html.BadRequest(w, r, errors.New("Unable to decode this digest"))
+		return
+	}
+
 	decodedURL, err := base64.URLEncoding.DecodeString(encodedURL)
 	if err != nil {
 		html.BadRequest(w, r, errors.New("Unable to decode this URL"))
 		return
 	}
 
-	imageURL := string(decodedURL)
-	logger.Debug(`[Proxy] Fetching %q`, imageURL)
+	mediaURL := string(decodedURL)
+	if !proxy.IsValidDigest(mediaURL, decodedDigest) {
+		logger.Error(`[Proxy] Invalid signature for %q`, mediaURL)
+		html.Forbidden(w, r)
+		return
+	}
+
+	width := request.QueryIntParam(r, "width", 0)
+	if width != 0 && !isAllowedImageWidth(width) {
+		html.BadRequest(w, r, errors.New("Invalid image width"))
+		return
+	}
 
-	req, err := http.NewRequest("GET", imageURL, nil)
+	// Partial requests are only used to seek in audio and video files, they always go to the origin.
+	rangeHeader := r.Header.Get("Range")
+	cacheKey := proxy.CacheKey(mediaURL, width)
+
+	if rangeHeader == "" {
+		if media := proxy.CachedImage(mediaURL, width); media != nil {
+			writeProxifiedMedia(w, r, cacheKey, media)
+			return
+		}
+	}
+
+	logger.Debug(`[Proxy] Fetching %q`, mediaURL)
+
+	// The request is canceled when the browser goes away or when the timeout expires,
+	// the timeout is stopped once we start streaming audio or video.
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	timer := time.AfterFunc(time.Duration(config.Opts.HTTPClientTimeout())*time.Second, cancel)
+	defer timer.Stop()
+
+	req, err := proxy.NewRequest(ctx, mediaURL)
 	if err != nil {
 		html.ServerError(w, r, err)
 		return
 	}
-	req.Header.Add("User-Agent", config.Opts.HTTPClientUserAgent())
-	req.Header.Add("Connection", "close")
 
-	clt := &http.Client{
-		Timeout: time.Duration(config.Opts.HTTPClientTimeout()) * time.Second,
+	if rangeHeader != "" && width == 0 {
+		req.Header.Set("Range", rangeHeader)
+		if ifRange := r.Header.Get("If-Range"); ifRange != "" {
+			req.Header.Set("If-Range", ifRange)
+		}
 	}
 
-	resp, err := clt.Do(req)
+	resp, err := proxy.NewClient().Do(req)
 	if err != nil {
+		var forbiddenAddressError *client.ForbiddenAddressError
+		if errors.As(err, &forbiddenAddressError) {
+			html.Forbidden(w, r)
+			return
+		}
+
 		html.ServerError(w, r, err)
 		return
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent:
+	case http.StatusRequestedRangeNotSatisfiable:
+		response.New(w, r).WithStatus(resp.StatusCode).WithHeader("Content-Range", resp.Header.Get("Content-Range")).Write()
+		return
+	default:
 		html.NotFound(w, r)
 		return
 	}
 
-	etag := crypto.HashFromBytes(decodedURL)
+	contentType := resp.Header.Get("Content-Type")
+	mediaType := proxy.MediaTypeFromContentType(contentType)
+	if !proxy.IsProxifiedMediaType(mediaType) {
+		logger.Error(`[Proxy] The content type %q of %q is not allowed`, contentType, mediaURL)
+		html.Forbidden(w, r)
+		return
+	}
+
+	if mediaType != proxy.MediaTypeImage || resp.StatusCode == http.StatusPartialContent {
+		timer.Stop()
+		streamProxifiedMedia(w, r, cacheKey, resp)
+		return
+	}
+
+	media, err := proxy.ReadImage(mediaURL, width, contentType, resp.Body)
+	switch {
+	case err == proxy.ErrMediaTooLarge:
+		html.BadRequest(w, r, err)
+		return
+	case err != nil:
+		html.ServerError(w, r, err)
+		return
+	}
+
+	writeProxifiedMedia(w, r, cacheKey, media)
+}
 
+func writeProxifiedMedia(w http.ResponseWriter, r *http.Request, etag string, media *proxy.CachedMedia) {
 	response.New(w, r).WithCaching(etag, 72*time.Hour, func(b *response.Builder) {
+		b.WithHeader("Content-Type", media.ContentType)
+		b.WithBody(media.Body)
+		b.WithoutCompression()
+		b.Write()
+	})
+}
+
+// streamProxifiedMedia forwards the media to the browser as it is downloaded, partial responses are passed through.
+func streamProxifiedMedia(w http.ResponseWriter, r *http.Request, etag string, resp *http.Response) {
+	response.New(w, r).WithCaching(etag, 72*time.Hour, func(b *response.Builder) {
+		b.WithStatus(resp.StatusCode)
 		b.WithHeader("Content-Type", resp.Header.Get("Content-Type"))
+		for _, header := range forwardedMediaHeaders {
+			if value := resp.Header.Get(header); value != "" {
+				b.WithHeader(header, value)
+			}
+		}
 		b.WithBody(resp.Body)
 		b.WithoutCompression()
 		b.Write()
 	})
 }
+
+func isAllowedImageWidth(width int) bool {
+	for _, allowedWidth := range config.Opts.ProxyImageWidths() {
+		if width == allowedWidth {
+			return true
+		}
+	}
+	return false
+}
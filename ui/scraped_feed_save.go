@@ -0,0 +1,92 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/config"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	feedHandler "miniflux.app/reader/handler"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+	"miniflux.app/validator"
+)
+
+func (h *handler) saveScrapedFeed(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	categories, err := h.store.Categories(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	scrapedFeedForm := form.NewScrapedFeedForm(r)
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", scrapedFeedForm)
+	view.Set("categories", categories)
+	view.Set("menu", "feeds")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+	view.Set("defaultUserAgent", config.Opts.HTTPClientUserAgent())
+	view.Set("hasProxyConfigured", config.Opts.HasHTTPClientProxyConfigured())
+
+	if err := scrapedFeedForm.Validate(); err != nil {
+		view.Set("errorMessage", err.Error())
+		html.OK(w, r, view.Render("create_scraped_feed"))
+		return
+	}
+
+	scrapedFeedRequest := scrapedFeedForm.Request()
+
+	// The preview shows the extracted entries so the selectors can be adjusted before saving.
+	// It is the default action, the feed is created only with the save button.
+	if r.FormValue("action") != "save" {
+		if validationErr := validator.ValidateScrapedFeedPreview(scrapedFeedRequest); validationErr != nil {
+			view.Set("errorMessage", validationErr.TranslationKey)
+			html.OK(w, r, view.Render("create_scraped_feed"))
+			return
+		}
+
+		feed, err := feedHandler.PreviewScrapedFeed(scrapedFeedRequest)
+		if err != nil {
+			view.Set("errorMessage", err)
+			html.OK(w, r, view.Render("create_scraped_feed"))
+			return
+		}
+
+		view.Set("preview", feed)
+		html.OK(w, r, view.Render("create_scraped_feed"))
+		return
+	}
+
+	if validationErr := validator.ValidateScrapedFeedCreation(h.store, user.ID, scrapedFeedRequest); validationErr != nil {
+		view.Set("errorMessage", validationErr.TranslationKey)
+		html.OK(w, r, view.Render("create_scraped_feed"))
+		return
+	}
+
+	feed, err := feedHandler.CreateScrapedFeed(h.store, user.ID, scrapedFeedRequest)
+	if err != nil {
+		logger.Error("[UI:SaveScrapedFeed] %v", err)
+		view.Set("errorMessage", err)
+		html.OK(w, r, view.Render("create_scraped_feed"))
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "feedEntries", "feedID", feed.ID))
+}
@@ -0,0 +1,47 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+const nbSecurityActivityEvents = 50
+
+func (h *handler) showSecurityActivityPage(w http.ResponseWriter, r *http.Request) {
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	builder := h.store.NewAuditEventQueryBuilder()
+	builder.WithUserID(user.ID)
+	builder.WithLimit(nbSecurityActivityEvents)
+
+	events, err := builder.GetEvents()
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	events.UseTimezone(user.Timezone)
+
+	view.Set("events", events)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	html.OK(w, r, view.Render("security_activity"))
+}
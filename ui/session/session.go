@@ -5,10 +5,16 @@
 package session // import "miniflux.app/ui/session"
 
 import (
+	"strconv"
+	"time"
+
 	"miniflux.app/crypto"
 	"miniflux.app/storage"
 )
 
+// totpLoginDuration is the time given to the user to complete the second login step.
+const totpLoginDuration = 5 * time.Minute
+
 // Session handles session data.
 type Session struct {
 	store     *storage.Storage
@@ -63,6 +69,28 @@ func (s *Session) SetPocketRequestToken(requestToken string) {
 	s.store.UpdateAppSessionField(s.sessionID, "pocket_request_token", requestToken)
 }
 
+// StartTOTPLogin remembers the user who must complete the two-factor authentication.
+func (s *Session) StartTOTPLogin(username string) {
+	s.store.UpdateAppSessionField(s.sessionID, "totp_username", username)
+	s.store.UpdateAppSessionField(s.sessionID, "totp_expires_at", strconv.FormatInt(time.Now().Add(totpLoginDuration).Unix(), 10))
+}
+
+// ClearTOTPLogin removes the pending two-factor authentication.
+func (s *Session) ClearTOTPLogin() {
+	s.store.UpdateAppSessionField(s.sessionID, "totp_username", "")
+	s.store.UpdateAppSessionField(s.sessionID, "totp_expires_at", "")
+}
+
+// SetTOTPSecret updates the secret being enrolled.
+func (s *Session) SetTOTPSecret(secret string) {
+	s.store.UpdateAppSessionField(s.sessionID, "totp_secret", secret)
+}
+
+// SetWebAuthnChallenge updates the challenge of the pending WebAuthn ceremony.
+func (s *Session) SetWebAuthnChallenge(challenge string) {
+	s.store.UpdateAppSessionField(s.sessionID, "webauthn_challenge", challenge)
+}
+
 // New returns a new session handler.
 func New(store *storage.Storage, sessionID string) *Session {
 	return &Session{store, sessionID}
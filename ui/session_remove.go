@@ -5,12 +5,15 @@
 package ui // import "miniflux.app/ui"
 
 import (
+	"fmt"
 	"net/http"
 
+	"miniflux.app/audit"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
 	"miniflux.app/logger"
+	"miniflux.app/model"
 )
 
 func (h *handler) removeSession(w http.ResponseWriter, r *http.Request) {
@@ -18,6 +21,12 @@ func (h *handler) removeSession(w http.ResponseWriter, r *http.Request) {
 	err := h.store.RemoveUserSessionByID(request.UserID(r), sessionID)
 	if err != nil {
 		logger.Error("[UI:RemoveSession] %v", err)
+	} else {
+		audit.Log(h.store, r, &model.AuditEvent{
+			UserID: request.UserID(r),
+			Action: model.AuditActionSessionRemoved,
+			Target: fmt.Sprintf("#%d", sessionID),
+		})
 	}
 
 	html.Redirect(w, r, route.Path(h.router, "sessions"))
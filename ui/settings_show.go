@@ -37,6 +37,7 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 		ShowReadingTime:   user.ShowReadingTime,
 		CustomCSS:         user.Stylesheet,
 		EntrySwipe:        user.EntrySwipe,
+		ArchiveStarred:    user.ArchiveStarred,
 	}
 
 	timezones, err := h.store.Timezones()
@@ -3,11 +3,13 @@
 package static // import "miniflux.app/ui/static"
 
 var Javascripts = map[string]string{
-	"app":            `(function(){'use strict';class a{static isVisible(a){return a.offsetParent!==null}static openNewTab(b){let a=window.open("");a.opener=null,a.location=b,a.focus()}static scrollPageTo(a,d){let e=window.pageYOffset,b=document.documentElement.clientHeight,c=e+b,f=a.offsetTop+a.offsetHeight;(d||c-f<0||c-a.offsetTop>b)&&window.scrollTo(0,a.offsetTop-10)}static getVisibleElements(c){let a=document.querySelectorAll(c),b=[];for(let c=0;c<a.length;c++)this.isVisible(a[c])&&b.push(a[c]);return b}static findParent(a,b){for(;a&&a!==document;a=a.parentNode)if(a.classList.contains(b))return a;return null}static hasPassiveEventListenerOption(){var b=!1,a;try{a=Object.defineProperty({},"passive",{get:function(){b=!0}}),window.addEventListener("test",a,a),window.removeEventListener("test",a,a)}catch(a){b=!1}return b}}class N{constructor(){this.reset()}reset(){this.touch={start:{x:-1,y:-1},move:{x:-1,y:-1},element:null}}calculateDistance(){if(this.touch.start.x>=-1&&this.touch.move.x>=-1){let a=Math.abs(this.touch.move.x-this.touch.start.x),b=Math.abs(this.touch.move.y-this.touch.start.y);if(a>30&&b<70)return this.touch.move.x-this.touch.start.x}return 0}findElement(b){return b.classList.contains("touch-item")?b:a.findParent(b,"touch-item")}onTouchStart(a){if(a.touches===void 0||a.touches.length!==1)return;this.reset(),this.touch.start.x=a.touches[0].clientX,this.touch.start.y=a.touches[0].clientY,this.touch.element=this.findElement(a.touches[0].target)}onTouchMove(a){if(a.touches===void 0||a.touches.length!==1||this.element===null)return;this.touch.move.x=a.touches[0].clientX,this.touch.move.y=a.touches[0].clientY;let b=this.calculateDistance(),c=Math.abs(b);if(c>0){let d=1-(c>75?.9:c/75*.9),e=b>75?75:b<-75?-75:b;this.touch.element.style.opacity=d,this.touch.element.style.transform="translateX("+e+"px)",a.preventDefault()}}onTouchEnd(a){if(a.touches===void 0)return;if(this.touch.element!==null){let a=Math.abs(this.calculateDistance());a>75&&n(this.touch.element),this.touch.element.style.opacity=1,this.touch.element.style.transform="none"}this.reset()}listen(){let e=document.querySelectorAll(".touch-item"),c=a.hasPassiveEventListenerOption();e.forEach(a=>{a.addEventListener("touchstart",a=>this.onTouchStart(a),!!c&&{passive:!0}),a.addEventListener("touchmove",a=>this.onTouchMove(a),!!c&&{passive:!1}),a.addEventListener("touchend",a=>this.onTouchEnd(a),!!c&&{passive:!0}),a.addEventListener("touchcancel",()=>this.reset(),!!c&&{passive:!0})});let d=document.querySelector(".entry-content");if(d){let a={previous:null,next:null};const e=(c,d)=>{const e=a[c];e===null?a[c]=setTimeout(()=>{a[c]=null},200):(d.preventDefault(),b(c))};d.addEventListener("touchend",a=>{a.changedTouches[0].clientX>=d.offsetWidth/2?e("next",a):e("previous",a)},!!c&&{passive:!1}),d.addEventListener("touchmove",b=>{Object.keys(a).forEach(b=>a[b]=null)})}}}class M{constructor(){this.queue=[],this.shortcuts={},this.triggers=[]}on(a,b){this.shortcuts[a]=b,this.triggers.push(a.split(" ")[0])}listen(){document.onkeydown=a=>{let b=this.getKey(a);if(this.isEventIgnored(a,b)||this.isModifierKeyDown(a))return;a.preventDefault(),this.queue.push(b);for(let c in this.shortcuts){let d=c.split(" ");if(d.every((a,b)=>a===this.queue[b])){this.queue=[],this.shortcuts[c](a);return}if(d.length===1&&b===d[0]){this.queue=[],this.shortcuts[c](a);return}}this.queue.length>=2&&(this.queue=[])}}isEventIgnored(a,b){return a.target.tagName==="INPUT"||a.target.tagName==="TEXTAREA"||this.queue.length<1&&!this.triggers.includes(b)}isModifierKeyDown(a){return a.getModifierState("Control")||a.getModifierState("Alt")||a.getModifierState("Meta")}getKey(b){const a={Esc:'Escape',Up:'ArrowUp',Down:'ArrowDown',Left:'ArrowLeft',Right:'ArrowRight'};for(let c in a)if(a.hasOwnProperty(c)&&c===b.key)return a[c];return b.key}}class d{constructor(a){this.callback=null,this.url=a,this.options={method:"POST",cache:"no-cache",credentials:"include",body:null,headers:new Headers({"Content-Type":"application/json","X-Csrf-Token":this.getCsrfToken()})}}withHttpMethod(a){return this.options.method=a,this}withBody(a){return this.options.body=JSON.stringify(a),this}withCallback(a){return this.callback=a,this}getCsrfToken(){let a=document.querySelector("meta[name=X-CSRF-Token]");return a!==null?a.getAttribute("value"):""}execute(){fetch(new Request(this.url,this.options)).then(a=>{this.callback&&this.callback(a)})}}class g{static exists(){return document.getElementById("modal-container")!==null}static open(c){if(g.exists())return;let a=document.createElement("div");a.id="modal-container",a.appendChild(document.importNode(c,!0)),document.body.appendChild(a);let b=document.querySelector("a.btn-close-modal");b!==null&&(b.onclick=a=>{a.preventDefault(),g.close()})}static close(){let a=document.getElementById("modal-container");a!==null&&a.parentNode.removeChild(a)}}function c(a,b,c){let d=document.querySelectorAll(a);d.forEach(a=>{a.onclick=a=>{c||a.preventDefault(),b(a)}})}function K(){let b=document.querySelector(".header nav ul");a.isVisible(b)?b.style.display="none":b.style.display="block";let c=document.querySelector(".header .search");a.isVisible(c)?c.style.display="none":c.style.display="block"}function G(b){let a=b.target;a.tagName==="A"?window.location.href=a.getAttribute("href"):window.location.href=a.querySelector("a").getAttribute("href")}function C(){let a=document.querySelectorAll("form");a.forEach(a=>{a.onsubmit=()=>{let b=a.querySelector("button");b&&(b.innerHTML=b.dataset.labelLoading,b.disabled=!0)}})}function u(b){b.preventDefault(),b.stopPropagation();let c=document.querySelector(".search-toggle-switch");c&&(c.style.display="none");let d=document.querySelector(".search-form");d&&(d.style.display="block");let a=document.getElementById("search-input");a&&(a.focus(),a.value="")}function A(){let a=document.getElementById("keyboard-shortcuts");a!==null&&g.open(a.content)}function q(){let d=a.getVisibleElements(".items .item"),c=[];d.forEach(a=>{a.classList.add("item-status-read"),c.push(parseInt(a.dataset.id,10))}),c.length>0&&l(c,"read",()=>{let a=document.querySelector("a[data-action=markPageAsRead]"),c=!1;a&&(c=a.dataset.showOnlyUnread||!1),c?window.location.reload():b("next",!0)})}function x(b){let c=!b,a=h(b);a&&(n(a,c),e()&&a.classList.contains('current-item')&&i())}function n(b,h){let i=parseInt(b.dataset.id,10),a=b.querySelector("a[data-toggle-status]"),c=a.dataset.value,e=c==="read"?"unread":"read";l([i],e);let g,d;c==="read"?(g=document.querySelector("template#icon_read"),d=a.dataset.labelRead,h&&f(a.dataset.toastUnread)):(g=document.querySelector("template#icon_unread"),d=a.dataset.labelUnread,h&&f(a.dataset.toastRead)),a.innerHTML=g.innerHTML+'<span class="icon-label">'+d+'</span>',a.dataset.value=e,b.classList.contains("item-status-"+c)&&(b.classList.remove("item-status-"+c),b.classList.add("item-status-"+e))}function O(a){if(a.classList.contains("item-status-unread")){a.classList.remove("item-status-unread"),a.classList.add("item-status-read");let b=parseInt(a.dataset.id,10);l([b],"read")}}function L(){let b=document.body.dataset.refreshAllFeedsUrl,a=new d(b);a.withCallback(()=>{window.location.reload()}),a.withHttpMethod("GET"),a.execute()}function l(c,b,e){let f=document.body.dataset.entriesStatusUrl,a=new d(f);a.withBody({entry_ids:c,status:b}),a.withCallback(e),a.execute(),b==="read"?I(1):J(1)}function r(a){let c=!a,b=h(a);b&&E(b.querySelector("a[data-save-entry]"),c)}function E(a,c){if(!a)return;if(a.dataset.completed)return;let e=a.innerHTML;a.innerHTML='<span class="icon-label">'+a.dataset.labelLoading+'</span>';let b=new d(a.dataset.saveUrl);b.withCallback(()=>{a.innerHTML=e,a.dataset.completed=!0,c&&f(a.dataset.toastDone)}),b.execute()}function t(a){let c=!a,b=h(a);b&&B(b,c)}function B(e,b){let a=e.querySelector("a[data-toggle-bookmark]");if(!a)return;a.innerHTML='<span class="icon-label">'+a.dataset.labelLoading+'</span>';let c=new d(a.dataset.bookmarkUrl);c.withCallback(()=>{let e=a.dataset.value,g=e==="star"?"unstar":"star",c,d;e==="star"?(c=document.querySelector("template#icon_star"),d=a.dataset.labelStar,b&&f(a.dataset.toastUnstar)):(c=document.querySelector("template#icon_unstar"),d=a.dataset.labelUnstar,b&&f(a.dataset.toastStar)),a.innerHTML=c.innerHTML+'<span class="icon-label">'+d+'</span>',a.dataset.value=g}),c.execute()}function v(){if(e())return;let a=document.querySelector("a[data-fetch-content-entry]");if(!a)return;let c=a.innerHTML;a.innerHTML='<span class="icon-label">'+a.dataset.labelLoading+'</span>';let b=new d(a.dataset.fetchContentUrl);b.withCallback(b=>{a.innerHTML=c,b.json().then(a=>{a.hasOwnProperty("content")&&(document.querySelector(".entry-content").innerHTML=a.content)})}),b.execute()}function w(d){let b=document.querySelector(".entry h1 a");if(b!==null){d?window.location.href=b.getAttribute("href"):a.openNewTab(b.getAttribute("href"));return}let c=document.querySelector(".current-item a[data-original-link]");if(c!==null){a.openNewTab(c.getAttribute("href"));let b=document.querySelector(".current-item");document.location.href!=document.querySelector('a[data-page=starred]').href&&i(),O(b)}}function m(b){if(e()){let b=document.querySelector(".current-item a[data-comments-link]");b!==null&&a.openNewTab(b.getAttribute("href"))}else{let c=document.querySelector("a[data-comments-link]");if(c!==null){b?window.location.href=c.getAttribute("href"):a.openNewTab(c.getAttribute("href"));return}}}function P(){let a=document.querySelector(".current-item .item-title a");a!==null&&(window.location.href=a.getAttribute("href"))}function H(){let a=document.querySelectorAll("[data-action=remove-feed]");if(a.length===1){let b=a[0],c=new d(b.dataset.url);c.withCallback(()=>{b.dataset.redirectUrl?window.location.href=b.dataset.redirectUrl:window.location.reload()}),c.execute()}}function b(b,c){let a=document.querySelector("a[data-page="+b+"]");a?document.location.href=a.href:c&&window.location.reload()}function k(){e()?F():b("previous")}function j(){e()?i():b("next")}function D(){p()?s():b('feeds')}function s(){if(p()){let a=document.querySelector("span.entry-website a");a!==null&&(window.location.href=a.href)}else{let a=document.querySelector(".current-item a[data-feed-link]");a!==null&&(window.location.href=a.getAttribute("href"))}}function F(){let b=a.getVisibleElements(".items .item");if(b.length===0)return;if(document.querySelector(".current-item")===null){b[0].classList.add("current-item"),b[0].querySelector('.item-header a').focus();return}for(let c=0;c<b.length;c++)if(b[c].classList.contains("current-item")){b[c].classList.remove("current-item");let d;c-1>=0?d=b[c-1]:d=b[b.length-1],d.classList.add("current-item"),a.scrollPageTo(d),d.querySelector('.item-header a').focus();break}}function i(){let b=a.getVisibleElements(".items .item");if(b.length===0)return;if(document.querySelector(".current-item")===null){b[0].classList.add("current-item"),b[0].querySelector('.item-header a').focus();return}for(let c=0;c<b.length;c++)if(b[c].classList.contains("current-item")){b[c].classList.remove("current-item");let d;c+1<b.length?d=b[c+1]:d=b[0],d.classList.add("current-item"),a.scrollPageTo(d),d.querySelector('.item-header a').focus();break}}function z(){let b=document.querySelector(".current-item");b!==null&&a.scrollPageTo(b,!0)}function I(a){y(b=>b-a)}function J(a){y(b=>b+a)}function y(a){let b=document.querySelectorAll("span.unread-counter");if(b.forEach(b=>{let c=parseInt(b.textContent,10);b.innerHTML=a(c)}),window.location.href.endsWith('/unread')){let b=parseInt(document.title.split('(')[1],10),c=a(b);document.title=document.title.replace(/(.*?)\(\d+\)(.*?)/,function(d,a,b,e,f){return a+'('+c+')'+b})}}function p(){return document.querySelector("section.entry")!==null}function e(){return document.querySelector(".items")!==null}function h(b){return e()?b?a.findParent(b,"item"):document.querySelector(".current-item"):document.querySelector(".entry")}function o(a,f){a.tagName!='A'&&(a=a.parentNode),a.style.display="none";let e=a.parentNode,b=document.createElement("span"),c=document.createElement("a");c.href="#",c.appendChild(document.createTextNode(a.dataset.labelYes)),c.onclick=d=>{d.preventDefault();let c=document.createElement("span");c.className="loading",c.appendChild(document.createTextNode(a.dataset.labelLoading)),b.remove(),e.appendChild(c),f(a.dataset.url,a.dataset.redirectUrl)};let d=document.createElement("a");d.href="#",d.appendChild(document.createTextNode(a.dataset.labelNo)),d.onclick=c=>{c.preventDefault(),a.style.display="inline",b.remove()},b.className="confirm",b.appendChild(document.createTextNode(a.dataset.labelQuestion+" ")),b.appendChild(c),b.appendChild(document.createTextNode(", ")),b.appendChild(d),e.appendChild(b)}function f(a){if(!a)return;document.querySelector('.toast-wrap .toast-msg').innerHTML=a;let b=document.querySelector('.toast-wrap');b.classList.remove('toastAnimate'),setTimeout(function(){b.classList.add('toastAnimate')},100)}document.addEventListener("DOMContentLoaded",function(){if(C(),!document.querySelector("body[data-disable-keyboard-shortcuts=true]")){let a=new M;a.on("g u",()=>b("unread")),a.on("g b",()=>b("starred")),a.on("g h",()=>b("history")),a.on("g f",()=>D()),a.on("g c",()=>b("categories")),a.on("g s",()=>b("settings")),a.on("ArrowLeft",()=>k()),a.on("ArrowRight",()=>j()),a.on("k",()=>k()),a.on("p",()=>k()),a.on("j",()=>j()),a.on("n",()=>j()),a.on("h",()=>b("previous")),a.on("l",()=>b("next")),a.on("z t",()=>z()),a.on("o",()=>P()),a.on("v",()=>w()),a.on("V",()=>w(!0)),a.on("c",()=>m()),a.on("C",()=>m(!0)),a.on("m",()=>x()),a.on("A",()=>q()),a.on("s",()=>r()),a.on("d",()=>v()),a.on("f",()=>t()),a.on("F",()=>s()),a.on("R",()=>L()),a.on("?",()=>A()),a.on("#",()=>H()),a.on("/",a=>u(a)),a.on("Escape",()=>g.close()),a.listen()}let a=new N;if(a.listen(),c("a[data-save-entry]",a=>r(a.target)),c("a[data-toggle-bookmark]",a=>t(a.target)),c("a[data-fetch-content-entry]",()=>v()),c("a[data-action=search]",a=>u(a)),c("a[data-action=markPageAsRead]",()=>o(event.target,()=>q())),c("a[data-toggle-status]",a=>x(a.target)),c("a[data-confirm]",a=>o(a.target,(c,a)=>{let b=new d(c);b.withCallback(()=>{a?window.location.href=a:window.location.reload()}),b.execute()})),document.documentElement.clientWidth<600&&(c(".logo",()=>K()),c(".header nav li",a=>G(a))),"serviceWorker"in navigator){let a=document.getElementById("service-worker-script");a&&navigator.serviceWorker.register(a.src)}window.addEventListener('beforeinstallprompt',c=>{c.preventDefault();let a=c;const b=document.getElementById('prompt-home-screen');if(b){b.style.display="block";const c=document.getElementById('btn-add-to-home-screen');c&&c.addEventListener('click',c=>{c.preventDefault(),a.prompt(),a.userChoice.then(()=>{a=null,b.style.display="none"})})}})})})()`,
-	"service-worker": `self.addEventListener("fetch",a=>{a.request.url.includes("/feed/icon/")&&a.respondWith(caches.open("feed_icons").then(b=>b.match(a.request).then(c=>c||fetch(a.request).then(c=>(b.put(a.request,c.clone()),c)))))})`,
+	"app":            `(function(){"use strict";class e{static isVisible(e){return e.offsetParent!==null}static openNewTab(e){let t=window.open("");t.opener=null,t.location=e,t.focus()}static scrollPageTo(e,t){let o=window.pageYOffset,n=document.documentElement.clientHeight,s=o+n,i=e.offsetTop+e.offsetHeight;(t||s-i<0||s-e.offsetTop>n)&&window.scrollTo(0,e.offsetTop-10)}static getVisibleElements(e){let t=document.querySelectorAll(e),n=[];for(let e=0;e<t.length;e++)this.isVisible(t[e])&&n.push(t[e]);return n}static findParent(e,t){for(;e&&e!==document;e=e.parentNode)if(e.classList.contains(t))return e;return null}static hasPassiveEventListenerOption(){var e,t=!1;try{e=Object.defineProperty({},"passive",{get:function(){t=!0}}),window.addEventListener("test",e,e),window.removeEventListener("test",e,e)}catch{t=!1}return t}}class B{constructor(){this.reset()}reset(){this.touch={start:{x:-1,y:-1},move:{x:-1,y:-1},element:null}}calculateDistance(){if(this.touch.start.x>=-1&&this.touch.move.x>=-1){let e=Math.abs(this.touch.move.x-this.touch.start.x),t=Math.abs(this.touch.move.y-this.touch.start.y);if(e>30&&t<70)return this.touch.move.x-this.touch.start.x}return 0}findElement(t){return t.classList.contains("touch-item")?t:e.findParent(t,"touch-item")}onTouchStart(e){if(e.touches===0[0]||e.touches.length!==1)return;this.reset(),this.touch.start.x=e.touches[0].clientX,this.touch.start.y=e.touches[0].clientY,this.touch.element=this.findElement(e.touches[0].target)}onTouchMove(e){if(e.touches===0[0]||e.touches.length!==1||this.element===null)return;this.touch.move.x=e.touches[0].clientX,this.touch.move.y=e.touches[0].clientY;let t=this.calculateDistance(),n=t<0?-t:t;if(n>0){let s=1-(n>75?.9:n/75*.9),o=t>75?75:t<-75?-75:t;this.touch.element.style.opacity=s,this.touch.element.style.transform="translateX("+o+"px)",e.preventDefault()}}onTouchEnd(e){if(e.touches===0[0])return;if(this.touch.element!==null){let e=Math.abs(this.calculateDistance());e>75&&m(this.touch.element),this.touch.element.style.opacity=1,this.touch.element.style.transform="none"}this.reset()}listen(){let o=document.querySelectorAll(".touch-item"),n=e.hasPassiveEventListenerOption();o.forEach(e=>{e.addEventListener("touchstart",e=>this.onTouchStart(e),!!n&&{passive:!0}),e.addEventListener("touchmove",e=>this.onTouchMove(e),!!n&&{passive:!1}),e.addEventListener("touchend",e=>this.onTouchEnd(e),!!n&&{passive:!0}),e.addEventListener("touchcancel",()=>this.reset(),!!n&&{passive:!0})});let s=document.querySelector(".entry-content");if(s){let e={previous:null,next:null};const o=(n,s)=>{const o=e[n];o===null?e[n]=setTimeout(()=>{e[n]=null},200):(s.preventDefault(),t(n))};s.addEventListener("touchend",e=>{e.changedTouches[0].clientX>=s.offsetWidth/2?o("next",e):o("previous",e)},!!n&&{passive:!1}),s.addEventListener("touchmove",t=>{Object.keys(e).forEach(t=>e[t]=null)})}}}class I{constructor(){this.queue=[],this.shortcuts={},this.triggers=[]}on(e,t){this.shortcuts[e]=t,this.triggers.push(e.split(" ")[0])}listen(){document.onkeydown=e=>{let t=this.getKey(e);if(this.isEventIgnored(e,t)||this.isModifierKeyDown(e))return;e.preventDefault(),this.queue.push(t);for(let n in this.shortcuts){let s=n.split(" ");if(s.every((e,t)=>e===this.queue[t])){this.queue=[],this.shortcuts[n](e);return}if(s.length===1&&t===s[0]){this.queue=[],this.shortcuts[n](e);return}}this.queue.length>=2&&(this.queue=[])}}isEventIgnored(e,t){return e.target.tagName==="INPUT"||e.target.tagName==="TEXTAREA"||this.queue.length<1&&!this.triggers.includes(t)}isModifierKeyDown(e){return e.getModifierState("Control")||e.getModifierState("Alt")||e.getModifierState("Meta")}getKey(e){const t={Esc:"Escape",Up:"ArrowUp",Down:"ArrowDown",Left:"ArrowLeft",Right:"ArrowRight"};for(let n in t)if(t.hasOwnProperty(n)&&n===e.key)return t[n];return e.key}}class s{constructor(e){this.callback=null,this.url=e,this.options={method:"POST",cache:"no-cache",credentials:"include",body:null,headers:new Headers({"Content-Type":"application/json","X-Csrf-Token":this.getCsrfToken()})}}withHttpMethod(e){return this.options.method=e,this}withBody(e){return this.options.body=JSON.stringify(e),this}withCallback(e){return this.callback=e,this}getCsrfToken(){let e=document.querySelector("meta[name=X-CSRF-Token]");return e!==null?e.getAttribute("value"):""}execute(){fetch(new Request(this.url,this.options)).then(e=>{this.callback&&this.callback(e)})}}class a{static exists(){return document.getElementById("modal-container")!==null}static open(e){if(a.exists())return;let t=document.createElement("div");t.id="modal-container",t.appendChild(document.importNode(e,!0)),document.body.appendChild(t);let n=document.querySelector("a.btn-close-modal");n!==null&&(n.onclick=e=>{e.preventDefault(),a.close()})}static close(){let e=document.getElementById("modal-container");e!==null&&e.parentNode.removeChild(e)}}function n(e,t,n){let s=document.querySelectorAll(e);s.forEach(e=>{e.onclick=e=>{n||e.preventDefault(),t(e)}})}function H(){let t=document.querySelector(".header nav ul");e.isVisible(t)?t.style.display="none":t.style.display="block";let n=document.querySelector(".header .search");e.isVisible(n)?n.style.display="none":n.style.display="block"}function P(e){let t=e.target;t.tagName==="A"?window.location.href=t.getAttribute("href"):window.location.href=t.querySelector("a").getAttribute("href")}function R(){let e=document.querySelectorAll("form");e.forEach(e=>{e.onsubmit=()=>{let t=e.querySelector("button");t&&(t.innerHTML=t.dataset.labelLoading,t.disabled=!0)}})}function g(e){e.preventDefault(),e.stopPropagation();let n=document.querySelector(".search-toggle-switch");n&&(n.style.display="none");let s=document.querySelector(".search-form");s&&(s.style.display="block");let t=document.getElementById("search-input");t&&(t.focus(),t.value="")}function C(){let e=document.getElementById("keyboard-shortcuts");e!==null&&a.open(e.content)}function p(){let s=e.getVisibleElements(".items .item"),n=[];s.forEach(e=>{e.classList.add("item-status-read"),n.push(parseInt(e.dataset.id,10))}),n.length>0&&d(n,"read",()=>{let e=document.querySelector("a[data-action=markPageAsRead]"),n=!1;e&&(n=e.dataset.showOnlyUnread),n?window.location.reload():t("next",!0)})}function h(e){let n=!e,t=c(e);t&&(m(t,n),o()&&t.classList.contains("current-item")&&u())}function m(e,t){let c=parseInt(e.dataset.id,10),n=e.querySelector("a[data-toggle-status]"),s=n.dataset.value,o=s==="read"?"unread":"read";d([c],o);let a,r;s==="read"?(a=document.querySelector("template#icon_read"),r=n.dataset.labelRead,t&&i(n.dataset.toastUnread)):(a=document.querySelector("template#icon_unread"),r=n.dataset.labelUnread,t&&i(n.dataset.toastRead)),n.innerHTML=a.innerHTML+'<span class="icon-label">'+r+"</span>",n.dataset.value=o,e.classList.contains("item-status-"+s)&&(e.classList.remove("item-status-"+s),e.classList.add("item-status-"+o))}function L(e){if(e.classList.contains("item-status-unread")){e.classList.remove("item-status-unread"),e.classList.add("item-status-read");let t=parseInt(e.dataset.id,10);d([t],"read")}}function T(){let t=document.body.dataset.refreshAllFeedsUrl,e=new s(t);e.withCallback(()=>{window.location.reload()}),e.withHttpMethod("GET"),e.execute()}function d(e,t,n){let i=document.body.dataset.entriesStatusUrl,o=new s(i);o.withBody({entry_ids:e,status:t}),o.withCallback(n),o.execute(),t==="read"?D(1):N(1)}function v(e){let n=!e,t=c(e);t&&M(t.querySelector("a[data-save-entry]"),n)}function M(e,t){if(!e)return;if(e.dataset.completed)return;let o=e.innerHTML;e.innerHTML='<span class="icon-label">'+e.dataset.labelLoading+"</span>";let n=new s(e.dataset.saveUrl);n.withCallback(()=>{e.innerHTML=o,e.dataset.completed=!0,t&&i(e.dataset.toastDone)}),n.execute()}function j(e){let n=!e,t=c(e);t&&A(t,n)}function A(e,t){let n=e.querySelector("a[data-toggle-bookmark]");if(!n)return;n.innerHTML='<span class="icon-label">'+n.dataset.labelLoading+"</span>";let o=new s(n.dataset.bookmarkUrl);o.withCallback(()=>{let o=n.dataset.value,a=o==="star"?"unstar":"star",e,s;o==="star"?(e=document.querySelector("template#icon_star"),s=n.dataset.labelStar,t&&i(n.dataset.toastUnstar)):(e=document.querySelector("template#icon_unstar"),s=n.dataset.labelUnstar,t&&i(n.dataset.toastStar)),n.innerHTML=e.innerHTML+'<span class="icon-label">'+s+"</span>",n.dataset.value=a}),o.execute()}function _(){if(o())return;let e=document.querySelector("a[data-fetch-content-entry]");if(!e)return;let n=e.innerHTML;e.innerHTML='<span class="icon-label">'+e.dataset.labelLoading+"</span>";let t=new s(e.dataset.fetchContentUrl);t.withCallback(t=>{e.innerHTML=n,t.json().then(e=>{e.hasOwnProperty("content")&&(document.querySelector(".entry-content").innerHTML=e.content)})}),t.execute()}function w(t){let n=document.querySelector(".entry h1 a");if(n!==null){t?window.location.href=n.getAttribute("href"):e.openNewTab(n.getAttribute("href"));return}let s=document.querySelector(".current-item a[data-original-link]");if(s!==null){e.openNewTab(s.getAttribute("href"));let t=document.querySelector(".current-item");document.location.href!=document.querySelector("a[data-page=starred]").href&&u(),L(t)}}function O(t){if(o()){let t=document.querySelector(".current-item a[data-comments-link]");t!==null&&e.openNewTab(t.getAttribute("href"))}else{let n=document.querySelector("a[data-comments-link]");if(n!==null){t?window.location.href=n.getAttribute("href"):e.openNewTab(n.getAttribute("href"));return}}}function k(){let e=document.querySelector(".current-item .item-title a");e!==null&&(window.location.href=e.getAttribute("href"))}function E(){let e=document.querySelectorAll("[data-action=remove-feed]");if(e.length===1){let t=e[0],n=new s(t.dataset.url);n.withCallback(()=>{t.dataset.redirectUrl?window.location.href=t.dataset.redirectUrl:window.location.reload()}),n.execute()}}function t(e,t){let n=document.querySelector("a[data-page="+e+"]");n?document.location.href=n.href:t&&window.location.reload()}function r(){o()?F():t("previous")}function l(){o()?u():t("next")}function S(){y()?b():t("feeds")}function b(){if(y()){let e=document.querySelector("span.entry-website a");e!==null&&(window.location.href=e.href)}else{let e=document.querySelector(".current-item a[data-feed-link]");e!==null&&(window.location.href=e.getAttribute("href"))}}function F(){let t=e.getVisibleElements(".items .item");if(t.length===0)return;if(document.querySelector(".current-item")===null){t[0].classList.add("current-item"),t[0].querySelector(".item-header a").focus();return}for(let n=0;n<t.length;n++)if(t[n].classList.contains("current-item")){t[n].classList.remove("current-item");let s;n-1>=0?s=t[n-1]:s=t[t.length-1],s.classList.add("current-item"),e.scrollPageTo(s),s.querySelector(".item-header a").focus();break}}function u(){let t=e.getVisibleElements(".items .item");if(t.length===0)return;if(document.querySelector(".current-item")===null){t[0].classList.add("current-item"),t[0].querySelector(".item-header a").focus();return}for(let n=0;n<t.length;n++)if(t[n].classList.contains("current-item")){t[n].classList.remove("current-item");let s;n+1<t.length?s=t[n+1]:s=t[0],s.classList.add("current-item"),e.scrollPageTo(s),s.querySelector(".item-header a").focus();break}}function z(){let t=document.querySelector(".current-item");t!==null&&e.scrollPageTo(t,!0)}function D(e){f(t=>t-e)}function N(e){f(t=>t+e)}function f(e){let t=document.querySelectorAll("span.unread-counter");if(t.forEach(t=>{let n=parseInt(t.textContent,10);t.innerHTML=e(n)}),window.location.href.endsWith("/unread")){let t=parseInt(document.title.split("(")[1],10),n=e(t);document.title=document.title.replace(/(.*?)\(\d+\)(.*?)/,function(e,t,s){return t+"("+n+")"+s})}}function y(){return document.querySelector("section.entry")!==null}function o(){return document.querySelector(".items")!==null}function c(t){return o()?t?e.findParent(t,"item"):document.querySelector(".current-item"):document.querySelector(".entry")}function x(e,t){e.tagName!="A"&&(e=e.parentNode),e.style.display="none";let i=e.parentNode,n=document.createElement("span"),s=document.createElement("a");s.href="#",s.appendChild(document.createTextNode(e.dataset.labelYes)),s.onclick=s=>{s.preventDefault();let o=document.createElement("span");o.className="loading",o.appendChild(document.createTextNode(e.dataset.labelLoading)),n.remove(),i.appendChild(o),t(e.dataset.url,e.dataset.redirectUrl)};let o=document.createElement("a");o.href="#",o.appendChild(document.createTextNode(e.dataset.labelNo)),o.onclick=t=>{t.preventDefault(),e.style.display="inline",n.remove()},n.className="confirm",n.appendChild(document.createTextNode(e.dataset.labelQuestion+" ")),n.appendChild(s),n.appendChild(document.createTextNode(", ")),n.appendChild(o),i.appendChild(n)}function i(e){if(!e)return;document.querySelector(".toast-wrap .toast-msg").innerHTML=e;let t=document.querySelector(".toast-wrap");t.classList.remove("toastAnimate"),setTimeout(function(){t.classList.add("toastAnimate")},100)}document.addEventListener("DOMContentLoaded",()=>{document.querySelectorAll("a[data-seek]").forEach(e=>{e.addEventListener("click",t=>{t.preventDefault();let n=document.querySelector(".entry-enclosure audio, .entry-enclosure video");if(!n)return;let s=n.closest("details");s&&(s.open=!0),n.currentTime=parseFloat(e.dataset.seek),n.play()})})}),document.addEventListener("DOMContentLoaded",()=>{document.querySelectorAll("audio[data-save-url], video[data-save-url]").forEach(e=>{let t=parseInt(e.dataset.mediaProgression,10)||0,o=()=>{t>0&&e.currentTime===0&&(e.currentTime=t)},n=()=>{let n=Math.floor(e.currentTime);if(n===t)return;t=n;let o=new s(e.dataset.saveUrl);o.withBody({media_progression:n,duration:isFinite(e.duration)?Math.floor(e.duration):0}),o.execute()};e.readyState>0?o():e.addEventListener("loadedmetadata",o,{once:!0}),e.addEventListener("timeupdate",()=>{Math.abs(e.currentTime-t)>=10&&n()}),e.addEventListener("pause",n),e.addEventListener("ended",n)})}),document.addEventListener("DOMContentLoaded",function(){if(R(),!document.querySelector("body[data-disable-keyboard-shortcuts=true]")){let e=new I;e.on("g u",()=>t("unread")),e.on("g b",()=>t("starred")),e.on("g h",()=>t("history")),e.on("g f",()=>S()),e.on("g c",()=>t("categories")),e.on("g s",()=>t("settings")),e.on("ArrowLeft",()=>r()),e.on("ArrowRight",()=>l()),e.on("k",()=>r()),e.on("p",()=>r()),e.on("j",()=>l()),e.on("n",()=>l()),e.on("h",()=>t("previous")),e.on("l",()=>t("next")),e.on("z t",()=>z()),e.on("o",()=>k()),e.on("v",()=>w()),e.on("V",()=>w(!0)),e.on("c",()=>O()),e.on("C",()=>O(!0)),e.on("m",()=>h()),e.on("A",()=>p()),e.on("s",()=>v()),e.on("d",()=>_()),e.on("f",()=>j()),e.on("F",()=>b()),e.on("R",()=>T()),e.on("?",()=>C()),e.on("#",()=>E()),e.on("/",e=>g(e)),e.on("Escape",()=>a.close()),e.listen()}let e=new B;if(e.listen(),n("a[data-save-entry]",e=>v(e.target)),n("a[data-toggle-bookmark]",e=>j(e.target)),n("a[data-fetch-content-entry]",()=>_()),n("a[data-action=search]",e=>g(e)),n("a[data-action=markPageAsRead]",()=>x(event.target,()=>p())),n("a[data-toggle-status]",e=>h(e.target)),n("a[data-confirm]",e=>x(e.target,(e,t)=>{let n=new s(e);n.withCallback(()=>{t?window.location.href=t:window.location.reload()}),n.execute()})),document.documentElement.clientWidth<600&&(n(".logo",()=>H()),n(".header nav li",e=>P(e))),"serviceWorker"in navigator){let e=document.getElementById("service-worker-script");e&&navigator.serviceWorker.register(e.src)}window.addEventListener("beforeinstallprompt",e=>{e.preventDefault();let t=e;const n=document.getElementById("prompt-home-screen");if(n){n.style.display="block";const e=document.getElementById("btn-add-to-home-screen");e&&e.addEventListener("click",e=>{e.preventDefault(),t.prompt(),t.userChoice.then(()=>{t=null,n.style.display="none"})})}})})})()`,
+	"service-worker": `self.addEventListener("fetch",e=>{e.request.url.includes("/feed/icon/")&&e.respondWith(caches.open("feed_icons").then(t=>t.match(e.request).then(n=>n||fetch(e.request).then(n=>(t.put(e.request,n.clone()),n)))))})`,
+	"webauthn":       `(function(){"use strict";function t(e){let n=e.replace(/-/g,"+").replace(/_/g,"/"),t=atob(n+"===".slice((n.length+3)%4)),s=new Uint8Array(t.length);for(let e=0;e<t.length;e++)s[e]=t.charCodeAt(e);return s.buffer}function e(e){let t="";return new Uint8Array(e).forEach(e=>{t+=String.fromCharCode(e)}),btoa(t).replace(/\+/g,"-").replace(/\//g,"_").replace(/=+$/,"")}function n(e,t){let n=document.querySelector("meta[name=X-CSRF-Token]"),s=new Request(e,{method:"POST",cache:"no-cache",credentials:"include",body:JSON.stringify(t),headers:new Headers({"Content-Type":"application/json","X-Csrf-Token":n!==null?n.getAttribute("value"):""})});return fetch(s).then(e=>{if(!e.ok)throw new Error(e.statusText);return e.json()})}function s(e){let t=document.getElementById("webauthn-error");t.textContent=e.dataset.labelError,t.hidden=!1}function o(o){let i=document.getElementById("form-webauthn-name");if(!i.reportValidity())return;n(o.dataset.beginUrl,{}).then(e=>(e.challenge=t(e.challenge),e.user.id=t(e.user.id),e.excludeCredentials.forEach(e=>{e.id=t(e.id)}),navigator.credentials.create({publicKey:e}))).then(t=>n(o.dataset.finishUrl,{name:i.value,client_data_json:e(t.response.clientDataJSON),attestation_object:e(t.response.attestationObject)})).then(()=>{window.location.reload()}).catch(()=>{s(o)})}function i(o){n(o.dataset.beginUrl,{}).then(e=>(e.challenge=t(e.challenge),navigator.credentials.get({publicKey:e}))).then(t=>{let s=t.response;return n(o.dataset.finishUrl,{credential_id:e(t.rawId),client_data_json:e(s.clientDataJSON),authenticator_data:e(s.authenticatorData),signature:e(s.signature),user_handle:s.userHandle?e(s.userHandle):""})}).then(e=>{window.location.href=e.redirect_url}).catch(()=>{s(o)})}document.addEventListener("DOMContentLoaded",()=>{if(!window.PublicKeyCredential)return;document.querySelectorAll("[data-webauthn-unsupported]").forEach(e=>{e.hidden=!0});let e={register:o,login:i};document.querySelectorAll("[data-webauthn-action]").forEach(t=>{let n=t.querySelector("button");t.hidden=!1,n.onclick=s=>{s.preventDefault(),e[t.dataset.webauthnAction](n)}})})})()`,
 }
 
 var JavascriptsChecksums = map[string]string{
-	"app":            "5b6e8e27bbde44b774c4575795ba9ebe514b06e8b3a3496adf4d9f5cf718a97a",
-	"service-worker": "730f10dc6a52e0bd9271da0c3b0103368893f3feb0a092fd585ac5b7abedb4ac",
+	"app":            "738186760dcd988b722b7f11161e295394875cb5c7d39963edb0580908c2c5dd",
+	"service-worker": "3c9988d9d051b1d41e9a8eb36c842ea036b43b5afb365651ddfe1c0d699c2318",
+	"webauthn":       "303396b8e413b1649d9db129c4743457355885c96b74369f2387f1aea37eef74",
 }
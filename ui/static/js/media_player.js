@@ -0,0 +1,64 @@
+// Chapters of a podcast episode move the media player of the entry to the beginning of the chapter.
+document.addEventListener("DOMContentLoaded", () => {
+    document.querySelectorAll("a[data-seek]").forEach((element) => {
+        element.addEventListener("click", (event) => {
+            event.preventDefault();
+
+            let player = document.querySelector(".entry-enclosure audio, .entry-enclosure video");
+            if (!player) {
+                return;
+            }
+
+            let enclosures = player.closest("details");
+            if (enclosures) {
+                enclosures.open = true;
+            }
+
+            player.currentTime = parseFloat(element.dataset.seek);
+            player.play();
+        });
+    });
+});
+
+// The playback position of the media players is restored when the page is loaded and saved periodically.
+document.addEventListener("DOMContentLoaded", () => {
+    document.querySelectorAll("audio[data-save-url], video[data-save-url]").forEach((player) => {
+        let lastSavedProgression = parseInt(player.dataset.mediaProgression, 10) || 0;
+
+        let restoreProgression = () => {
+            if (lastSavedProgression > 0 && player.currentTime === 0) {
+                player.currentTime = lastSavedProgression;
+            }
+        };
+
+        let saveProgression = () => {
+            let progression = Math.floor(player.currentTime);
+            if (progression === lastSavedProgression) {
+                return;
+            }
+
+            lastSavedProgression = progression;
+
+            let request = new RequestBuilder(player.dataset.saveUrl);
+            request.withBody({
+                media_progression: progression,
+                duration: isFinite(player.duration) ? Math.floor(player.duration) : 0
+            });
+            request.execute();
+        };
+
+        if (player.readyState > 0) {
+            restoreProgression();
+        } else {
+            player.addEventListener("loadedmetadata", restoreProgression, {once: true});
+        }
+
+        player.addEventListener("timeupdate", () => {
+            if (Math.abs(player.currentTime - lastSavedProgression) >= 10) {
+                saveProgression();
+            }
+        });
+        player.addEventListener("pause", saveProgression);
+        player.addEventListener("ended", saveProgression);
+    });
+});
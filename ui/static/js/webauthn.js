@@ -0,0 +1,123 @@
+// Convert a base64url string to an ArrayBuffer.
+function decodeBase64URL(value) {
+    let base64 = value.replace(/-/g, "+").replace(/_/g, "/");
+    let binary = atob(base64 + "===".slice((base64.length + 3) % 4));
+    let bytes = new Uint8Array(binary.length);
+    for (let i = 0; i < binary.length; i++) {
+        bytes[i] = binary.charCodeAt(i);
+    }
+
+    return bytes.buffer;
+}
+
+// Convert an ArrayBuffer to a base64url string.
+function encodeBase64URL(buffer) {
+    let binary = "";
+    new Uint8Array(buffer).forEach((byte) => {
+        binary += String.fromCharCode(byte);
+    });
+
+    return btoa(binary).replace(/\+/g, "-").replace(/\//g, "_").replace(/=+$/, "");
+}
+
+// Send a JSON request with the CSRF token and decode the JSON response.
+function postJSON(url, body) {
+    let element = document.querySelector("meta[name=X-CSRF-Token]");
+    let request = new Request(url, {
+        method: "POST",
+        cache: "no-cache",
+        credentials: "include",
+        body: JSON.stringify(body),
+        headers: new Headers({
+            "Content-Type": "application/json",
+            "X-Csrf-Token": element !== null ? element.getAttribute("value") : ""
+        })
+    });
+
+    return fetch(request).then((response) => {
+        if (!response.ok) {
+            throw new Error(response.statusText);
+        }
+
+        return response.json();
+    });
+}
+
+function showWebAuthnError(element) {
+    let alert = document.getElementById("webauthn-error");
+    alert.textContent = element.dataset.labelError;
+    alert.hidden = false;
+}
+
+// Register a new security key or passkey for the current user.
+function registerWebAuthnCredential(element) {
+    let input = document.getElementById("form-webauthn-name");
+    if (!input.reportValidity()) {
+        return;
+    }
+
+    postJSON(element.dataset.beginUrl, {}).then((options) => {
+        options.challenge = decodeBase64URL(options.challenge);
+        options.user.id = decodeBase64URL(options.user.id);
+        options.excludeCredentials.forEach((credential) => {
+            credential.id = decodeBase64URL(credential.id);
+        });
+
+        return navigator.credentials.create({publicKey: options});
+    }).then((credential) => {
+        return postJSON(element.dataset.finishUrl, {
+            name: input.value,
+            client_data_json: encodeBase64URL(credential.response.clientDataJSON),
+            attestation_object: encodeBase64URL(credential.response.attestationObject)
+        });
+    }).then(() => {
+        window.location.reload();
+    }).catch(() => {
+        showWebAuthnError(element);
+    });
+}
+
+// Sign in with a passkey, the user is identified by the authenticator.
+function loginWithWebAuthn(element) {
+    postJSON(element.dataset.beginUrl, {}).then((options) => {
+        options.challenge = decodeBase64URL(options.challenge);
+        return navigator.credentials.get({publicKey: options});
+    }).then((credential) => {
+        let response = credential.response;
+        return postJSON(element.dataset.finishUrl, {
+            credential_id: encodeBase64URL(credential.rawId),
+            client_data_json: encodeBase64URL(response.clientDataJSON),
+            authenticator_data: encodeBase64URL(response.authenticatorData),
+            signature: encodeBase64URL(response.signature),
+            user_handle: response.userHandle ? encodeBase64URL(response.userHandle) : ""
+        });
+    }).then((result) => {
+        window.location.href = result.redirect_url;
+    }).catch(() => {
+        showWebAuthnError(element);
+    });
+}
+
+document.addEventListener("DOMContentLoaded", () => {
+    if (!window.PublicKeyCredential) {
+        return;
+    }
+
+    document.querySelectorAll("[data-webauthn-unsupported]").forEach((element) => {
+        element.hidden = true;
+    });
+
+    let actions = {
+        "register": registerWebAuthnCredential,
+        "login": loginWithWebAuthn
+    };
+
+    document.querySelectorAll("[data-webauthn-action]").forEach((container) => {
+        let button = container.querySelector("button");
+        container.hidden = false;
+        button.onclick = (event) => {
+            event.preventDefault();
+            actions[container.dataset.webauthnAction](button);
+        };
+    });
+});
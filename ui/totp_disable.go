@@ -0,0 +1,55 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/audit"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/locale"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+)
+
+func (h *handler) disableTOTP(w http.ResponseWriter, r *http.Request) {
+	printer := locale.NewPrinter(request.UserLanguage(r))
+	sess := session.New(h.store, request.SessionID(r))
+
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	totpForm := form.NewTOTPForm(r)
+	valid := false
+	if totpForm.Validate() == nil {
+		if valid, err = verifyTOTPCode(h.store, user.ID, totpForm.Code); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+	}
+
+	if !valid {
+		sess.NewFlashErrorMessage(printer.Printf("error.invalid_totp_code"))
+		html.Redirect(w, r, route.Path(h.router, "totp"))
+		return
+	}
+
+	if err := h.store.DisableTOTP(user.ID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	logger.Info("[UI:DisableTOTP] Two-factor authentication disabled for username=%s", user.Username)
+	audit.Log(h.store, r, &model.AuditEvent{UserID: user.ID, Action: model.AuditActionTOTPDisabled})
+	sess.NewFlashMessage(printer.Printf("alert.totp_disabled"))
+	html.Redirect(w, r, route.Path(h.router, "totp"))
+}
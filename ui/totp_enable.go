@@ -0,0 +1,84 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+	"time"
+
+	"miniflux.app/audit"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/totp"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+const totpRecoveryCodesCount = 10
+
+func (h *handler) enableTOTP(w http.ResponseWriter, r *http.Request) {
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if h.store.HasTOTP(user.ID) {
+		html.Redirect(w, r, route.Path(h.router, "totp"))
+		return
+	}
+
+	appSession, err := h.store.AppSession(request.SessionID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	secret := appSession.Data.TOTPSecret
+	if secret == "" {
+		html.Redirect(w, r, route.Path(h.router, "totp"))
+		return
+	}
+
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	totpForm := form.NewTOTPForm(r)
+	counter, valid := totp.Validate(secret, totpForm.Code, time.Now(), 0)
+	if !valid {
+		if err := h.prepareTOTPView(view, sess, r, user); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		view.Set("errorMessage", "error.invalid_totp_code")
+		html.OK(w, r, view.Render("totp"))
+		return
+	}
+
+	recoveryCodes := totp.GenerateRecoveryCodes(totpRecoveryCodesCount)
+	if err := h.store.EnableTOTP(user.ID, secret, counter, recoveryCodes); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	logger.Info("[UI:EnableTOTP] Two-factor authentication enabled for username=%s", user.Username)
+	audit.Log(h.store, r, &model.AuditEvent{UserID: user.ID, Action: model.AuditActionTOTPEnabled})
+	sess.SetTOTPSecret("")
+
+	view.Set("totpEnabled", true)
+	view.Set("countRecoveryCodes", len(recoveryCodes))
+	view.Set("recoveryCodes", recoveryCodes)
+	html.OK(w, r, view.Render("totp"))
+}
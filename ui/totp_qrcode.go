@@ -0,0 +1,50 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response"
+	"miniflux.app/http/response/html"
+	"miniflux.app/totp"
+
+	"github.com/skip2/go-qrcode"
+)
+
+const totpIssuer = "Miniflux"
+
+func (h *handler) showTOTPQRCode(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	appSession, err := h.store.AppSession(request.SessionID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	secret := appSession.Data.TOTPSecret
+	if secret == "" || h.store.HasTOTP(user.ID) {
+		html.NotFound(w, r)
+		return
+	}
+
+	png, err := qrcode.Encode(totp.ProvisioningURI(totpIssuer, user.Username, secret), qrcode.Medium, 256)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	response.New(w, r).
+		WithHeader("Content-Type", "image/png").
+		WithHeader("Cache-Control", "no-store").
+		WithBody(png).
+		Write()
+}
@@ -0,0 +1,64 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/model"
+	"miniflux.app/totp"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+)
+
+func (h *handler) showTOTPPage(w http.ResponseWriter, r *http.Request) {
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if err := h.prepareTOTPView(view, sess, r, user); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	html.OK(w, r, view.Render("totp"))
+}
+
+// prepareTOTPView sets the enrollment details, or the number of recovery codes left once enabled.
+// The secret being enrolled is kept in the session until it is confirmed with a valid code.
+func (h *handler) prepareTOTPView(v *view.View, sess *session.Session, r *http.Request, user *model.User) error {
+	if h.store.HasTOTP(user.ID) {
+		v.Set("totpEnabled", true)
+		v.Set("countRecoveryCodes", h.store.CountTOTPRecoveryCodes(user.ID))
+		return nil
+	}
+
+	appSession, err := h.store.AppSession(request.SessionID(r))
+	if err != nil {
+		return err
+	}
+
+	secret := appSession.Data.TOTPSecret
+	if secret == "" {
+		secret = totp.GenerateSecret()
+		sess.SetTOTPSecret(secret)
+	}
+
+	v.Set("totpEnabled", false)
+	v.Set("totpSecret", secret)
+	return nil
+}
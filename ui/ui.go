@@ -21,6 +21,12 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	middleware := newMiddleware(router, store)
 	handler := &handler{router, store, template.NewEngine(router), pool}
 
+	// The proxified URLs are signed, API clients can fetch them without a session when the proxy is public.
+	mediaProxyRoute := "/proxy/{encodedDigest}/{encodedURL}"
+	if config.Opts.IsProxyPublic() {
+		router.HandleFunc(mediaProxyRoute, handler.mediaProxy).Name("proxy").Methods(http.MethodGet)
+	}
+
 	uiRouter := router.NewRoute().Subrouter()
 	uiRouter.Use(middleware.handleUserSession)
 	uiRouter.Use(middleware.handleAppSession)
@@ -41,6 +47,12 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	uiRouter.HandleFunc("/subscribe", handler.submitSubscription).Name("submitSubscription").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/subscriptions", handler.showChooseSubscriptionPage).Name("chooseSubscription").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/bookmarklet", handler.bookmarklet).Name("bookmarklet").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/newsletter/create", handler.showCreateNewsletterPage).Name("createNewsletter").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/newsletter/save", handler.saveNewsletter).Name("saveNewsletter").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/scraped-feed/create", handler.showCreateScrapedFeedPage).Name("createScrapedFeed").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/scraped-feed/save", handler.saveScrapedFeed).Name("saveScrapedFeed").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/watch-page/create", handler.showCreateWatchPage).Name("createWatchPage").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/watch-page/save", handler.saveWatchPage).Name("saveWatchPage").Methods(http.MethodPost)
 
 	// Unread page.
 	uiRouter.HandleFunc("/mark-all-as-read", handler.markAllAsRead).Name("markAllAsRead").Methods(http.MethodPost)
@@ -55,10 +67,16 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	// Bookmark pages.
 	uiRouter.HandleFunc("/starred", handler.showStarredPage).Name("starred").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/starred/entry/{entryID}", handler.showStarredEntryPage).Name("starredEntry").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/starred/export", handler.exportStarredEntries).Name("exportStarredEntries").Methods(http.MethodGet)
 
 	// Search pages.
 	uiRouter.HandleFunc("/search", handler.showSearchEntriesPage).Name("searchEntries").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/search/entry/{entryID}", handler.showSearchEntryPage).Name("searchEntry").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/search/export", handler.exportSearchEntries).Name("exportSearchEntries").Methods(http.MethodGet)
+
+	// Tag pages.
+	uiRouter.HandleFunc("/tags/entries", handler.showTagEntriesPage).Name("tagEntries").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/tags/export", handler.exportTagEntries).Name("exportTagEntries").Methods(http.MethodGet)
 
 	// Feed listing pages.
 	uiRouter.HandleFunc("/feeds", handler.showFeedsPage).Name("feeds").Methods(http.MethodGet)
@@ -83,6 +101,7 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	uiRouter.HandleFunc("/category/{categoryID}/feeds", handler.showCategoryFeedsPage).Name("categoryFeeds").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/category/{categoryID}/entries", handler.showCategoryEntriesPage).Name("categoryEntries").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/category/{categoryID}/entries/all", handler.showCategoryEntriesAllPage).Name("categoryEntriesAll").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/category/{categoryID}/entries/export", handler.exportCategoryEntries).Name("exportCategoryEntries").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/category/{categoryID}/edit", handler.showEditCategoryPage).Name("editCategory").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/category/{categoryID}/update", handler.updateCategory).Name("updateCategory").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/category/{categoryID}/remove", handler.removeCategory).Name("removeCategory").Methods(http.MethodPost)
@@ -91,8 +110,15 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	uiRouter.HandleFunc("/entry/status", handler.updateEntriesStatus).Name("updateEntriesStatus").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/entry/save/{entryID}", handler.saveEntry).Name("saveEntry").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/entry/download/{entryID}", handler.fetchContent).Name("fetchContent").Methods(http.MethodPost)
-	uiRouter.HandleFunc("/proxy/{encodedURL}", handler.imageProxy).Name("proxy").Methods(http.MethodGet)
+	if !config.Opts.IsProxyPublic() {
+		uiRouter.HandleFunc(mediaProxyRoute, handler.mediaProxy).Name("proxy").Methods(http.MethodGet)
+	}
 	uiRouter.HandleFunc("/entry/bookmark/{entryID}", handler.toggleBookmark).Name("toggleBookmark").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/enclosure/{enclosureID}/progression", handler.saveEnclosureProgression).Name("saveEnclosureProgression").Methods(http.MethodPost)
+
+	// Archive pages.
+	uiRouter.HandleFunc("/entry/archive/{entryID}", handler.showEntryArchive).Name("entryArchive").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/entry/archive/{entryID}", handler.archiveEntry).Name("archiveEntry").Methods(http.MethodPost)
 
 	// Share pages.
 	uiRouter.HandleFunc("/entry/share/{entryID}", handler.createSharedEntry).Name("shareEntry").Methods(http.MethodGet)
@@ -107,6 +133,7 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	uiRouter.HandleFunc("/users/{userID}/edit", handler.showEditUserPage).Name("editUser").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/users/{userID}/update", handler.updateUser).Name("updateUser").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/users/{userID}/remove", handler.removeUser).Name("removeUser").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/users/{userID}/unlock", handler.unlockUser).Name("unlockUser").Methods(http.MethodPost)
 
 	// Settings pages.
 	uiRouter.HandleFunc("/settings", handler.showSettingsPage).Name("settings").Methods(http.MethodGet)
@@ -121,6 +148,22 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	uiRouter.HandleFunc("/sessions", handler.showSessionsPage).Name("sessions").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/sessions/{sessionID}/remove", handler.removeSession).Name("removeSession").Methods(http.MethodPost)
 
+	// Audit log pages.
+	uiRouter.HandleFunc("/security-activity", handler.showSecurityActivityPage).Name("securityActivity").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/audit", handler.showAuditEventsPage).Name("auditEvents").Methods(http.MethodGet)
+
+	// Two-factor authentication pages.
+	uiRouter.HandleFunc("/totp", handler.showTOTPPage).Name("totp").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/totp/qrcode.png", handler.showTOTPQRCode).Name("totpQRCode").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/totp/enable", handler.enableTOTP).Name("enableTOTP").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/totp/disable", handler.disableTOTP).Name("disableTOTP").Methods(http.MethodPost)
+
+	// WebAuthn credentials pages.
+	uiRouter.HandleFunc("/webauthn", handler.showWebAuthnCredentialsPage).Name("webAuthnCredentials").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/webauthn/register/begin", handler.beginWebAuthnRegistration).Name("beginWebAuthnRegistration").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/webauthn/register/finish", handler.finishWebAuthnRegistration).Name("finishWebAuthnRegistration").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/webauthn/{credentialID}/remove", handler.removeWebAuthnCredential).Name("removeWebAuthnCredential").Methods(http.MethodPost)
+
 	// API Keys pages.
 	uiRouter.HandleFunc("/keys", handler.showAPIKeysPage).Name("apiKeys").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/keys/{keyID}/remove", handler.removeAPIKey).Name("removeAPIKey").Methods(http.MethodPost)
@@ -132,6 +175,12 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	uiRouter.HandleFunc("/import", handler.showImportPage).Name("import").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/upload", handler.uploadOPML).Name("uploadOPML").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/fetch", handler.fetchOPML).Name("fetchOPML").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/import/{jobID}", handler.showImportJobPage).Name("importJob").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/opml-sources", handler.showOPMLSourcesPage).Name("opmlSources").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/opml-sources/save", handler.saveOPMLSource).Name("saveOPMLSource").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/opml-source/{sourceID}", handler.showOPMLSourcePage).Name("opmlSource").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/opml-source/{sourceID}/refresh", handler.refreshOPMLSource).Name("refreshOPMLSource").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/opml-source/{sourceID}/remove", handler.removeOPMLSource).Name("removeOPMLSource").Methods(http.MethodPost)
 
 	// OAuth2 flow.
 	uiRouter.HandleFunc("/oauth2/{provider}/unlink", handler.oauth2Unlink).Name("oauth2Unlink").Methods(http.MethodGet)
@@ -140,6 +189,10 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 
 	// Authentication pages.
 	uiRouter.HandleFunc("/login", handler.checkLogin).Name("checkLogin").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/login/totp", handler.showLoginTOTPPage).Name("loginTOTP").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/login/totp", handler.checkLoginTOTP).Name("checkLoginTOTP").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/login/webauthn/begin", handler.beginWebAuthnLogin).Name("beginWebAuthnLogin").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/login/webauthn/finish", handler.finishWebAuthnLogin).Name("finishWebAuthnLogin").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/logout", handler.logout).Name("logout").Methods(http.MethodGet)
 	uiRouter.Handle("/", middleware.handleAuthProxy(http.HandlerFunc(handler.showLoginPage))).Name("login").Methods(http.MethodGet)
 
@@ -9,6 +9,7 @@ import (
 
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
+	"miniflux.app/model"
 	"miniflux.app/ui/session"
 	"miniflux.app/ui/view"
 )
@@ -36,7 +37,14 @@ func (h *handler) showUsersPage(w http.ResponseWriter, r *http.Request) {
 
 	users.UseTimezone(user.Timezone)
 
+	lockedUsers, err := h.store.LockedLogins(model.LoginFailureUsername)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
 	view.Set("users", users)
+	view.Set("lockedUsers", lockedUsers)
 	view.Set("menu", "settings")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
@@ -8,9 +8,11 @@ import (
 	"errors"
 	"net/http"
 
+	"miniflux.app/audit"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
+	"miniflux.app/model"
 )
 
 func (h *handler) removeUser(w http.ResponseWriter, r *http.Request) {
@@ -47,5 +49,8 @@ func (h *handler) removeUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// The account does not exist anymore, only its username is kept.
+	audit.Log(h.store, r, &model.AuditEvent{Action: model.AuditActionUserRemoved, Target: selectedUser.Username})
+
 	html.Redirect(w, r, route.Path(h.router, "users"))
 }
@@ -7,6 +7,7 @@ package ui // import "miniflux.app/ui"
 import (
 	"net/http"
 
+	"miniflux.app/audit"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
@@ -64,12 +65,15 @@ func (h *handler) saveUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if _, err := h.store.CreateUser(userCreationRequest); err != nil {
+	newUser, err := h.store.CreateUser(userCreationRequest)
+	if err != nil {
 		logger.Error("[UI:SaveUser] %v", err)
 		view.Set("errorMessage", "error.unable_to_create_user")
 		html.OK(w, r, view.Render("create_user"))
 		return
 	}
 
+	audit.Log(h.store, r, &model.AuditEvent{UserID: newUser.ID, Action: model.AuditActionUserCreated, Target: newUser.Username})
+
 	html.Redirect(w, r, route.Path(h.router, "users"))
 }
@@ -0,0 +1,50 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/audit"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/lockout"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+func (h *handler) unlockUser(w http.ResponseWriter, r *http.Request) {
+	loggedUser, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if !loggedUser.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	selectedUser, err := h.store.UserByID(request.RouteInt64Param(r, "userID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if selectedUser == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	if err := h.store.RemoveLoginFailures(model.LoginFailureUsername, lockout.NormalizeUsername(selectedUser.Username)); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	audit.Log(h.store, r, &model.AuditEvent{UserID: selectedUser.ID, Action: model.AuditActionUserUnlocked, Target: selectedUser.Username})
+	logger.Info("[UI:UnlockUser] username=%s has been unlocked by username=%s", selectedUser.Username, loggedUser.Username)
+	html.Redirect(w, r, route.Path(h.router, "users"))
+}
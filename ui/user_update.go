@@ -7,6 +7,7 @@ package ui // import "miniflux.app/ui"
 import (
 	"net/http"
 
+	"miniflux.app/audit"
 	"miniflux.app/http/request"
 	"miniflux.app/http/response/html"
 	"miniflux.app/http/route"
@@ -63,6 +64,7 @@ func (h *handler) updateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	wasAdmin := selectedUser.IsAdmin
 	userForm.Merge(selectedUser)
 	if err := h.store.UpdateUser(selectedUser); err != nil {
 		logger.Error("[UI:UpdateUser] %v", err)
@@ -71,5 +73,7 @@ func (h *handler) updateUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	audit.LogUserModification(h.store, r, selectedUser, wasAdmin, userForm.Password != "")
+
 	html.Redirect(w, r, route.Path(h.router, "users"))
 }
@@ -0,0 +1,70 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/config"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	feedHandler "miniflux.app/reader/handler"
+	"miniflux.app/ui/form"
+	"miniflux.app/ui/session"
+	"miniflux.app/ui/view"
+	"miniflux.app/validator"
+)
+
+func (h *handler) saveWatchPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	categories, err := h.store.Categories(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	watchPageForm := form.NewWatchPageForm(r)
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", watchPageForm)
+	view.Set("categories", categories)
+	view.Set("menu", "feeds")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+	view.Set("defaultUserAgent", config.Opts.HTTPClientUserAgent())
+	view.Set("hasProxyConfigured", config.Opts.HasHTTPClientProxyConfigured())
+
+	if err := watchPageForm.Validate(); err != nil {
+		view.Set("errorMessage", err.Error())
+		html.OK(w, r, view.Render("create_watch_page"))
+		return
+	}
+
+	watchPageRequest := watchPageForm.Request()
+	if validationErr := validator.ValidateWatchPageCreation(h.store, user.ID, watchPageRequest); validationErr != nil {
+		view.Set("errorMessage", validationErr.TranslationKey)
+		html.OK(w, r, view.Render("create_watch_page"))
+		return
+	}
+
+	feed, err := feedHandler.CreateWatchPageFeed(h.store, user.ID, watchPageRequest)
+	if err != nil {
+		logger.Error("[UI:SaveWatchPage] %v", err)
+		view.Set("errorMessage", err)
+		html.OK(w, r, view.Render("create_watch_page"))
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "feedEntries", "feedID", feed.ID))
+}
@@ -0,0 +1,146 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	json_parser "encoding/json"
+	"net/http"
+
+	"miniflux.app/audit"
+	"miniflux.app/config"
+	"miniflux.app/http/cookie"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/http/route"
+	"miniflux.app/lockout"
+	"miniflux.app/logger"
+	"miniflux.app/ui/session"
+	"miniflux.app/webauthn"
+)
+
+func (h *handler) beginWebAuthnLogin(w http.ResponseWriter, r *http.Request) {
+	rp, err := newRelyingParty()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	challenge := webauthn.NewChallenge()
+	session.New(h.store, request.SessionID(r)).SetWebAuthnChallenge(challenge)
+
+	json.OK(w, r, rp.RequestOptions(challenge))
+}
+
+func (h *handler) finishWebAuthnLogin(w http.ResponseWriter, r *http.Request) {
+	clientIP := request.ClientIP(r)
+	sess := session.New(h.store, request.SessionID(r))
+
+	var assertion webauthn.AssertionResponse
+	if err := json_parser.NewDecoder(r.Body).Decode(&assertion); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	// The username is not known before the credential is found, the client IP address is checked first.
+	lockoutIP := lockout.ClientIP(r)
+	guard := lockout.NewGuard(h.store)
+	if retryAfter := guard.Check("webauthn", "", lockoutIP); retryAfter > 0 {
+		json.TooManyRequests(w, r, retryAfter)
+		return
+	}
+
+	appSession, err := h.store.AppSession(request.SessionID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	// A challenge can only be used once.
+	sess.SetWebAuthnChallenge("")
+
+	credential, err := h.store.WebAuthnCredentialByCredentialID(assertion.CredentialID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if credential == nil || (assertion.UserHandle != "" && assertion.UserHandle != webauthn.UserHandle(credential.UserID)) {
+		logger.Error("[UI:FinishWebAuthnLogin] [ClientIP=%s] Unknown WebAuthn credential", clientIP)
+		guard.Fail("webauthn", "", lockoutIP)
+		json.Unauthorized(w, r)
+		return
+	}
+
+	user, err := h.store.UserByID(credential.UserID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if user == nil {
+		json.Unauthorized(w, r)
+		return
+	}
+
+	if retryAfter := guard.Check("webauthn", user.Username, lockoutIP); retryAfter > 0 {
+		json.TooManyRequests(w, r, retryAfter)
+		return
+	}
+
+	rp, err := newRelyingParty()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	// The authenticator must verify the user since the password is not asked.
+	signCount, err := rp.VerifyAssertion(appSession.Data.WebAuthnChallenge, &assertion, credential.PublicKey, credential.SignCount, true)
+	if err != nil {
+		logger.Error("[UI:FinishWebAuthnLogin] [ClientIP=%s] %v", clientIP, err)
+		guard.Fail("webauthn", user.Username, lockoutIP)
+		audit.LogFailedLogin(h.store, r, user.Username, "webauthn")
+		json.Unauthorized(w, r)
+		return
+	}
+
+	// The counter is updated only if no concurrent login used the same or a later assertion.
+	used, err := h.store.UseWebAuthnCredential(credential.ID, signCount)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if !used {
+		logger.Error("[UI:FinishWebAuthnLogin] [ClientIP=%s] The signature counter of the credential #%d has already been used", clientIP, credential.ID)
+		guard.Fail("webauthn", user.Username, lockoutIP)
+		audit.LogFailedLogin(h.store, r, user.Username, "webauthn")
+		json.Unauthorized(w, r)
+		return
+	}
+
+	guard.Succeed(user.Username)
+
+	sessionToken, _, err := h.store.CreateUserSessionFromUsername(user.Username, r.UserAgent(), clientIP)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	logger.Info("[UI:FinishWebAuthnLogin] username=%s just logged in with a WebAuthn credential", user.Username)
+	h.store.SetLastLogin(user.ID)
+	audit.LogLogin(h.store, r, user, "webauthn")
+
+	sess.SetLanguage(user.Language)
+	sess.SetTheme(user.Theme)
+
+	http.SetCookie(w, cookie.New(
+		cookie.CookieUserSessionID,
+		sessionToken,
+		config.Opts.HTTPS,
+		config.Opts.BasePath(),
+	))
+
+	json.OK(w, r, map[string]string{"redirect_url": route.Path(h.router, "unread")})
+}
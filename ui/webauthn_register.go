@@ -0,0 +1,117 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	json_parser "encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"miniflux.app/audit"
+	"miniflux.app/config"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/json"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+	"miniflux.app/ui/session"
+	"miniflux.app/webauthn"
+)
+
+type webAuthnRegistrationRequest struct {
+	Name string `json:"name"`
+	webauthn.AttestationResponse
+}
+
+// newRelyingParty returns the WebAuthn relying party, its ID is the hostname of BASE_URL.
+func newRelyingParty() (*webauthn.RelyingParty, error) {
+	return webauthn.NewRelyingParty(config.Opts.BaseURL(), "Miniflux")
+}
+
+func (h *handler) beginWebAuthnRegistration(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	rp, err := newRelyingParty()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	credentials, err := h.store.WebAuthnCredentials(user.ID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	var existingCredentialIDs []string
+	for _, credential := range credentials {
+		existingCredentialIDs = append(existingCredentialIDs, credential.CredentialID)
+	}
+
+	challenge := webauthn.NewChallenge()
+	session.New(h.store, request.SessionID(r)).SetWebAuthnChallenge(challenge)
+
+	json.OK(w, r, rp.CreationOptions(challenge, user.ID, user.Username, existingCredentialIDs))
+}
+
+func (h *handler) finishWebAuthnRegistration(w http.ResponseWriter, r *http.Request) {
+	sess := session.New(h.store, request.SessionID(r))
+	userID := request.UserID(r)
+
+	var registrationRequest webAuthnRegistrationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&registrationRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	name := strings.TrimSpace(registrationRequest.Name)
+	if name == "" {
+		json.BadRequest(w, r, errors.New("the credential name is mandatory"))
+		return
+	}
+
+	appSession, err := h.store.AppSession(request.SessionID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	// A challenge can only be used once.
+	sess.SetWebAuthnChallenge("")
+
+	rp, err := newRelyingParty()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	credential, err := rp.VerifyRegistration(appSession.Data.WebAuthnChallenge, &registrationRequest.AttestationResponse)
+	if err != nil {
+		logger.Error("[UI:FinishWebAuthnRegistration] %v", err)
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	webAuthnCredential := &model.WebAuthnCredential{
+		UserID:       userID,
+		CredentialID: credential.ID,
+		PublicKey:    credential.PublicKey,
+		SignCount:    credential.SignCount,
+		Name:         name,
+	}
+
+	if err := h.store.CreateWebAuthnCredential(webAuthnCredential); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	logger.Info("[UI:FinishWebAuthnRegistration] WebAuthn credential %q registered for userID=%d", name, userID)
+	audit.Log(h.store, r, &model.AuditEvent{UserID: userID, Action: model.AuditActionWebAuthnRegistered, Target: name})
+	json.Created(w, r, map[string]int64{"id": webAuthnCredential.ID})
+}
@@ -0,0 +1,32 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package ui // import "miniflux.app/ui"
+
+import (
+	"fmt"
+	"net/http"
+
+	"miniflux.app/audit"
+	"miniflux.app/http/request"
+	"miniflux.app/http/response/html"
+	"miniflux.app/http/route"
+	"miniflux.app/logger"
+	"miniflux.app/model"
+)
+
+func (h *handler) removeWebAuthnCredential(w http.ResponseWriter, r *http.Request) {
+	credentialID := request.RouteInt64Param(r, "credentialID")
+	if err := h.store.RemoveWebAuthnCredential(request.UserID(r), credentialID); err != nil {
+		logger.Error("[UI:RemoveWebAuthnCredential] %v", err)
+	} else {
+		audit.Log(h.store, r, &model.AuditEvent{
+			UserID: request.UserID(r),
+			Action: model.AuditActionWebAuthnRemoved,
+			Target: fmt.Sprintf("#%d", credentialID),
+		})
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "webAuthnCredentials"))
+}
@@ -38,3 +38,16 @@ func ValidateEntryOrder(order string) error {
 
 	return fmt.Errorf(`Invalid entry order, valid order values are: "id", "status", "changed_at", "published_at", "created_at", "category_title", "category_id"`)
 }
+
+// ValidateEnclosureUpdateRequest makes sure the playback position of an attachment is valid.
+func ValidateEnclosureUpdateRequest(request *model.EnclosureUpdateRequest) error {
+	if request.MediaProgression < 0 {
+		return fmt.Errorf(`The media progression must be a positive number of seconds`)
+	}
+
+	if request.Duration < 0 {
+		return fmt.Errorf(`The media duration must be a positive number of seconds`)
+	}
+
+	return nil
+}
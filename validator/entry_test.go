@@ -58,3 +58,17 @@ func TestValidateEntryOrder(t *testing.T) {
 		t.Error(`An invalid order should generate a error`)
 	}
 }
+
+func TestValidateEnclosureUpdateRequest(t *testing.T) {
+	if err := ValidateEnclosureUpdateRequest(&model.EnclosureUpdateRequest{MediaProgression: 120, Duration: 3600}); err != nil {
+		t.Error(`A valid request should not be rejected`)
+	}
+
+	if err := ValidateEnclosureUpdateRequest(&model.EnclosureUpdateRequest{MediaProgression: -1}); err == nil {
+		t.Error(`A negative media progression is not valid`)
+	}
+
+	if err := ValidateEnclosureUpdateRequest(&model.EnclosureUpdateRequest{Duration: -1}); err == nil {
+		t.Error(`A negative duration is not valid`)
+	}
+}
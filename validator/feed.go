@@ -30,6 +30,74 @@ func ValidateFeedCreation(store *storage.Storage, userID int64, request *model.F
 	return nil
 }
 
+// ValidateNewsletterCreation validates newsletter creation.
+func ValidateNewsletterCreation(store *storage.Storage, userID int64, request *model.NewsletterCreationRequest) *ValidationError {
+	if request.Title == "" || request.CategoryID <= 0 {
+		return NewValidationError("error.newsletter_mandatory_fields")
+	}
+
+	if !store.CategoryIDExists(userID, request.CategoryID) {
+		return NewValidationError("error.feed_category_not_found")
+	}
+
+	return nil
+}
+
+// ValidateScrapedFeedCreation validates the creation of a feed scraped from a web page.
+func ValidateScrapedFeedCreation(store *storage.Storage, userID int64, request *model.ScrapedFeedCreationRequest) *ValidationError {
+	if request.CategoryID <= 0 {
+		return NewValidationError("error.scraped_feed_mandatory_fields")
+	}
+
+	if err := ValidateScrapedFeedPreview(request); err != nil {
+		return err
+	}
+
+	if store.FeedURLExists(userID, request.PageURL) {
+		return NewValidationError("error.feed_already_exists")
+	}
+
+	if !store.CategoryIDExists(userID, request.CategoryID) {
+		return NewValidationError("error.feed_category_not_found")
+	}
+
+	return nil
+}
+
+// ValidateWatchPageCreation validates the creation of a feed reporting the changes of a web page.
+func ValidateWatchPageCreation(store *storage.Storage, userID int64, request *model.WatchPageCreationRequest) *ValidationError {
+	if request.PageURL == "" || request.CategoryID <= 0 {
+		return NewValidationError("error.watch_page_mandatory_fields")
+	}
+
+	if !isValidURL(request.PageURL) {
+		return NewValidationError("error.invalid_feed_url")
+	}
+
+	if store.FeedURLExists(userID, request.PageURL) {
+		return NewValidationError("error.feed_already_exists")
+	}
+
+	if !store.CategoryIDExists(userID, request.CategoryID) {
+		return NewValidationError("error.feed_category_not_found")
+	}
+
+	return nil
+}
+
+// ValidateScrapedFeedPreview validates the preview of a feed scraped from a web page.
+func ValidateScrapedFeedPreview(request *model.ScrapedFeedCreationRequest) *ValidationError {
+	if request.PageURL == "" || request.Selectors.Item == "" {
+		return NewValidationError("error.scraped_feed_mandatory_fields")
+	}
+
+	if !isValidURL(request.PageURL) {
+		return NewValidationError("error.invalid_feed_url")
+	}
+
+	return nil
+}
+
 // ValidateFeedModification validates feed modification.
 func ValidateFeedModification(store *storage.Storage, userID int64, request *model.FeedModificationRequest) *ValidationError {
 	if request.FeedURL != nil {
@@ -64,5 +132,11 @@ func ValidateFeedModification(store *storage.Storage, userID int64, request *mod
 		}
 	}
 
+	if request.Selectors != nil {
+		if request.Selectors.Item == "" {
+			return NewValidationError("error.scraped_feed_item_selector_not_empty")
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,36 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package validator // import "miniflux.app/validator"
+
+import (
+	"testing"
+
+	"miniflux.app/model"
+)
+
+func TestValidateScrapedFeedPreview(t *testing.T) {
+	scenarios := map[string]*model.ScrapedFeedCreationRequest{
+		"error.scraped_feed_mandatory_fields": {Selectors: model.FeedSelectors{Item: "article"}},
+		"error.invalid_feed_url":              {PageURL: "example.org", Selectors: model.FeedSelectors{Item: "article"}},
+		"":                                    {PageURL: "https://example.org/news", Selectors: model.FeedSelectors{Item: "article"}},
+	}
+
+	for expected, request := range scenarios {
+		err := ValidateScrapedFeedPreview(request)
+		switch {
+		case expected == "" && err != nil:
+			t.Errorf(`Unexpected error for %q: %v`, request.PageURL, err)
+		case expected != "" && (err == nil || err.TranslationKey != expected):
+			t.Errorf(`Expected %q for %q, got %v`, expected, request.PageURL, err)
+		}
+	}
+}
+
+func TestValidateScrapedFeedPreviewWithoutItemSelector(t *testing.T) {
+	request := &model.ScrapedFeedCreationRequest{PageURL: "https://example.org/news"}
+	if err := ValidateScrapedFeedPreview(request); err == nil {
+		t.Error(`A preview without item selector should generate an error`)
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package validator // import "miniflux.app/validator"
+
+import (
+	"miniflux.app/model"
+	"miniflux.app/storage"
+)
+
+// ValidateOPMLSourceCreation validates the subscription to a remote OPML list.
+func ValidateOPMLSourceCreation(store *storage.Storage, userID int64, request *model.OPMLSourceCreationRequest) *ValidationError {
+	if request.URL == "" || request.CategoryID <= 0 {
+		return NewValidationError("error.feed_mandatory_fields")
+	}
+
+	if !isValidURL(request.URL) {
+		return NewValidationError("error.invalid_opml_source_url")
+	}
+
+	if store.OPMLSourceURLExists(userID, request.URL) {
+		return NewValidationError("error.opml_source_already_exists")
+	}
+
+	if !store.CategoryIDExists(userID, request.CategoryID) {
+		return NewValidationError("error.feed_category_not_found")
+	}
+
+	return nil
+}
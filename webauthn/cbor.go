@@ -0,0 +1,143 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package webauthn // import "miniflux.app/webauthn"
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// maxCBORDepth limits the nesting of decoded CBOR items.
+const maxCBORDepth = 16
+
+var errCBORTruncated = errors.New("webauthn: truncated CBOR data")
+
+// decodeCBOR decodes the first CBOR item of data and returns the remaining bytes.
+// Only the subset used by WebAuthn is supported: integers, byte and text strings,
+// arrays, maps, booleans and null. Indefinite lengths and floats are rejected.
+func decodeCBOR(data []byte) (interface{}, []byte, error) {
+	return decodeCBORItem(data, 0)
+}
+
+func decodeCBORItem(data []byte, depth int) (interface{}, []byte, error) {
+	if depth > maxCBORDepth {
+		return nil, nil, errors.New("webauthn: CBOR data is nested too deeply")
+	}
+
+	if len(data) == 0 {
+		return nil, nil, errCBORTruncated
+	}
+
+	major := data[0] >> 5
+	info := data[0] & 0x1f
+	data = data[1:]
+
+	if major == 7 {
+		switch info {
+		case 20:
+			return false, data, nil
+		case 21:
+			return true, data, nil
+		case 22, 23:
+			return nil, data, nil
+		default:
+			return nil, nil, fmt.Errorf("webauthn: unsupported CBOR simple value %d", info)
+		}
+	}
+
+	length, data, err := decodeCBORLength(info, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch major {
+	case 0:
+		if length > 1<<63-1 {
+			return nil, nil, errors.New("webauthn: CBOR integer overflow")
+		}
+		return int64(length), data, nil
+	case 1:
+		if length > 1<<63-1 {
+			return nil, nil, errors.New("webauthn: CBOR integer overflow")
+		}
+		return -1 - int64(length), data, nil
+	case 2, 3:
+		if uint64(len(data)) < length {
+			return nil, nil, errCBORTruncated
+		}
+		value := make([]byte, length)
+		copy(value, data[:length])
+		if major == 3 {
+			return string(value), data[length:], nil
+		}
+		return value, data[length:], nil
+	case 4:
+		if length > uint64(len(data)) {
+			return nil, nil, errCBORTruncated
+		}
+		items := make([]interface{}, 0, length)
+		for i := uint64(0); i < length; i++ {
+			var item interface{}
+			if item, data, err = decodeCBORItem(data, depth+1); err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+		}
+		return items, data, nil
+	case 5:
+		if length > uint64(len(data)) {
+			return nil, nil, errCBORTruncated
+		}
+		items := make(map[interface{}]interface{}, length)
+		for i := uint64(0); i < length; i++ {
+			var key, value interface{}
+			if key, data, err = decodeCBORItem(data, depth+1); err != nil {
+				return nil, nil, err
+			}
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, nil, errors.New("webauthn: unsupported CBOR map key")
+			}
+			if value, data, err = decodeCBORItem(data, depth+1); err != nil {
+				return nil, nil, err
+			}
+			items[key] = value
+		}
+		return items, data, nil
+	default:
+		return nil, nil, fmt.Errorf("webauthn: unsupported CBOR major type %d", major)
+	}
+}
+
+func decodeCBORLength(info byte, data []byte) (uint64, []byte, error) {
+	switch {
+	case info < 24:
+		return uint64(info), data, nil
+	case info == 24:
+		if len(data) < 1 {
+			return 0, nil, errCBORTruncated
+		}
+		return uint64(data[0]), data[1:], nil
+	case info == 25:
+		if len(data) < 2 {
+			return 0, nil, errCBORTruncated
+		}
+		return uint64(binary.BigEndian.Uint16(data)), data[2:], nil
+	case info == 26:
+		if len(data) < 4 {
+			return 0, nil, errCBORTruncated
+		}
+		return uint64(binary.BigEndian.Uint32(data)), data[4:], nil
+	case info == 27:
+		if len(data) < 8 {
+			return 0, nil, errCBORTruncated
+		}
+		return binary.BigEndian.Uint64(data), data[8:], nil
+	default:
+		return 0, nil, errors.New("webauthn: indefinite CBOR lengths are not supported")
+	}
+}
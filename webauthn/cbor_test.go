@@ -0,0 +1,89 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package webauthn // import "miniflux.app/webauthn"
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestDecodeCBORMap(t *testing.T) {
+	// {"fmt": "none", 1: -7, "data": h'0102', "list": [true, null]}
+	input := []byte{
+		0xa4,
+		0x63, 'f', 'm', 't', 0x64, 'n', 'o', 'n', 'e',
+		0x01, 0x26,
+		0x64, 'd', 'a', 't', 'a', 0x42, 0x01, 0x02,
+		0x64, 'l', 'i', 's', 't', 0x82, 0xf5, 0xf6,
+	}
+
+	value, rest, err := decodeCBOR(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rest) != 0 {
+		t.Errorf(`Unexpected remaining bytes: %v`, rest)
+	}
+
+	items := value.(map[interface{}]interface{})
+	if items["fmt"] != "none" {
+		t.Errorf(`Unexpected "fmt" value: %v`, items["fmt"])
+	}
+
+	if items[int64(1)] != int64(-7) {
+		t.Errorf(`Unexpected integer value: %v`, items[int64(1)])
+	}
+
+	if !bytes.Equal(items["data"].([]byte), []byte{1, 2}) {
+		t.Errorf(`Unexpected byte string value: %v`, items["data"])
+	}
+
+	list := items["list"].([]interface{})
+	if len(list) != 2 || list[0] != true || list[1] != nil {
+		t.Errorf(`Unexpected array value: %v`, list)
+	}
+}
+
+func TestDecodeCBORLengths(t *testing.T) {
+	scenarios := map[string]int64{
+		"\x17":                                 23,
+		"\x18\x18":                             24,
+		"\x19\x01\x00":                         256,
+		"\x1a\x00\x01\x00\x00":                 65536,
+		"\x1b\x00\x00\x00\x01\x00\x00\x00\x00": 4294967296,
+		"\x38\x63":                             -100,
+	}
+
+	for input, expected := range scenarios {
+		value, _, err := decodeCBOR([]byte(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if value != expected {
+			t.Errorf(`Unexpected value for %x: got %v instead of %d`, input, value, expected)
+		}
+	}
+}
+
+func TestDecodeInvalidCBOR(t *testing.T) {
+	scenarios := map[string][]byte{
+		"empty":             {},
+		"truncated string":  {0x45, 0x01},
+		"truncated map":     {0xa2, 0x01},
+		"indefinite length": {0x5f},
+		"float":             {0xfa, 0x00, 0x00, 0x00, 0x00},
+		"array map key":     {0xa1, 0x80, 0x01},
+		"huge array":        {0x9b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		"too deep":          bytes.Repeat([]byte{0x81}, maxCBORDepth+2),
+	}
+
+	for name, input := range scenarios {
+		if _, _, err := decodeCBOR(input); err == nil {
+			t.Errorf(`Decoding %s data should fail`, name)
+		}
+	}
+}
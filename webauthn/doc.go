@@ -0,0 +1,12 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+/*
+Package webauthn implements the relying party side of the Web Authentication API.
+
+Only what is needed to register security keys and passkeys and to verify
+assertions is implemented: attestation statements are not verified, which
+is equivalent to requesting the "none" attestation conveyance.
+*/
+package webauthn // import "miniflux.app/webauthn"
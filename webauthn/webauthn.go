@@ -0,0 +1,422 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package webauthn // import "miniflux.app/webauthn"
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+
+	mfcrypto "miniflux.app/crypto"
+)
+
+// Timeout is the time given to the user to complete a ceremony, in milliseconds.
+const Timeout = 120000
+
+const (
+	challengeSize = 32
+
+	flagUserPresent            = 0x01
+	flagUserVerified           = 0x04
+	flagAttestedCredentialData = 0x40
+
+	algES256 = -7
+	algEdDSA = -8
+	algRS256 = -257
+)
+
+var encoding = base64.RawURLEncoding
+
+// Credential is a public key credential created during registration.
+type Credential struct {
+	ID        string
+	PublicKey []byte
+	SignCount uint32
+}
+
+// AttestationResponse is the result of navigator.credentials.create() sent back by the browser.
+type AttestationResponse struct {
+	ClientDataJSON    string `json:"client_data_json"`
+	AttestationObject string `json:"attestation_object"`
+}
+
+// AssertionResponse is the result of navigator.credentials.get() sent back by the browser.
+type AssertionResponse struct {
+	CredentialID      string `json:"credential_id"`
+	ClientDataJSON    string `json:"client_data_json"`
+	AuthenticatorData string `json:"authenticator_data"`
+	Signature         string `json:"signature"`
+	UserHandle        string `json:"user_handle"`
+}
+
+// CredentialDescriptor identifies an existing credential.
+type CredentialDescriptor struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// CreationOptions are the options given to navigator.credentials.create(), binary values are base64url encoded.
+type CreationOptions struct {
+	Challenge string `json:"challenge"`
+	RP        struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"rp"`
+	User struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+	} `json:"user"`
+	PubKeyCredParams []struct {
+		Type string `json:"type"`
+		Alg  int    `json:"alg"`
+	} `json:"pubKeyCredParams"`
+	Timeout                int                     `json:"timeout"`
+	ExcludeCredentials     []*CredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection struct {
+		ResidentKey      string `json:"residentKey"`
+		UserVerification string `json:"userVerification"`
+	} `json:"authenticatorSelection"`
+	Attestation string `json:"attestation"`
+}
+
+// RequestOptions are the options given to navigator.credentials.get(), binary values are base64url encoded.
+type RequestOptions struct {
+	Challenge        string                  `json:"challenge"`
+	RPID             string                  `json:"rpId"`
+	Timeout          int                     `json:"timeout"`
+	AllowCredentials []*CredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                  `json:"userVerification"`
+}
+
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+type authenticatorData struct {
+	rpIDHash     []byte
+	flags        byte
+	signCount    uint32
+	credentialID []byte
+	publicKey    []byte
+}
+
+// RelyingParty verifies ceremonies for a given origin.
+type RelyingParty struct {
+	ID     string
+	Name   string
+	Origin string
+}
+
+// NewRelyingParty returns a relying party derived from the application base URL.
+func NewRelyingParty(baseURL, name string) (*RelyingParty, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("webauthn: invalid base URL: %v", err)
+	}
+
+	if u.Hostname() == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return nil, fmt.Errorf("webauthn: invalid base URL: %q", baseURL)
+	}
+
+	return &RelyingParty{ID: u.Hostname(), Name: name, Origin: u.Scheme + "://" + u.Host}, nil
+}
+
+// NewChallenge returns a random base64url encoded challenge.
+func NewChallenge() string {
+	return encoding.EncodeToString(mfcrypto.GenerateRandomBytes(challengeSize))
+}
+
+// UserHandle returns the opaque user handle stored by authenticators.
+func UserHandle(userID int64) string {
+	handle := make([]byte, 8)
+	binary.BigEndian.PutUint64(handle, uint64(userID))
+	return encoding.EncodeToString(handle)
+}
+
+// CreationOptions returns the options to register a new credential for the given user.
+func (rp *RelyingParty) CreationOptions(challenge string, userID int64, username string, existingCredentialIDs []string) *CreationOptions {
+	options := &CreationOptions{Challenge: challenge, Timeout: Timeout, Attestation: "none"}
+	options.RP.ID = rp.ID
+	options.RP.Name = rp.Name
+	options.User.ID = UserHandle(userID)
+	options.User.Name = username
+	options.User.DisplayName = username
+	options.AuthenticatorSelection.ResidentKey = "preferred"
+	options.AuthenticatorSelection.UserVerification = "preferred"
+
+	for _, alg := range []int{algES256, algEdDSA, algRS256} {
+		options.PubKeyCredParams = append(options.PubKeyCredParams, struct {
+			Type string `json:"type"`
+			Alg  int    `json:"alg"`
+		}{"public-key", alg})
+	}
+
+	options.ExcludeCredentials = make([]*CredentialDescriptor, 0, len(existingCredentialIDs))
+	for _, id := range existingCredentialIDs {
+		options.ExcludeCredentials = append(options.ExcludeCredentials, &CredentialDescriptor{Type: "public-key", ID: id})
+	}
+
+	return options
+}
+
+// RequestOptions returns the options to sign in with a discoverable credential.
+func (rp *RelyingParty) RequestOptions(challenge string) *RequestOptions {
+	return &RequestOptions{
+		Challenge:        challenge,
+		RPID:             rp.ID,
+		Timeout:          Timeout,
+		AllowCredentials: make([]*CredentialDescriptor, 0),
+		UserVerification: "required",
+	}
+}
+
+// VerifyRegistration validates the response of a registration ceremony and returns the new credential.
+func (rp *RelyingParty) VerifyRegistration(challenge string, response *AttestationResponse) (*Credential, error) {
+	if err := rp.verifyClientData(response.ClientDataJSON, "webauthn.create", challenge); err != nil {
+		return nil, err
+	}
+
+	rawAttestation, err := encoding.DecodeString(response.AttestationObject)
+	if err != nil {
+		return nil, errors.New("webauthn: invalid attestation object encoding")
+	}
+
+	attestation, _, err := decodeCBOR(rawAttestation)
+	if err != nil {
+		return nil, err
+	}
+
+	attestationMap, ok := attestation.(map[interface{}]interface{})
+	if !ok {
+		return nil, errors.New("webauthn: invalid attestation object")
+	}
+
+	rawAuthData, ok := attestationMap["authData"].([]byte)
+	if !ok {
+		return nil, errors.New("webauthn: missing authenticator data")
+	}
+
+	authData, err := rp.parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+
+	if authData.credentialID == nil {
+		return nil, errors.New("webauthn: missing attested credential data")
+	}
+
+	return &Credential{
+		ID:        encoding.EncodeToString(authData.credentialID),
+		PublicKey: authData.publicKey,
+		SignCount: authData.signCount,
+	}, nil
+}
+
+// VerifyAssertion validates the response of an authentication ceremony against the stored credential
+// and returns the new signature counter.
+func (rp *RelyingParty) VerifyAssertion(challenge string, response *AssertionResponse, publicKey []byte, signCount uint32, requireUserVerification bool) (uint32, error) {
+	if err := rp.verifyClientData(response.ClientDataJSON, "webauthn.get", challenge); err != nil {
+		return 0, err
+	}
+
+	rawAuthData, err := encoding.DecodeString(response.AuthenticatorData)
+	if err != nil {
+		return 0, errors.New("webauthn: invalid authenticator data encoding")
+	}
+
+	authData, err := rp.parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return 0, err
+	}
+
+	if requireUserVerification && authData.flags&flagUserVerified == 0 {
+		return 0, errors.New("webauthn: user verification is required")
+	}
+
+	rawClientData, _ := encoding.DecodeString(response.ClientDataJSON)
+	clientDataHash := sha256.Sum256(rawClientData)
+	signedData := make([]byte, 0, len(rawAuthData)+len(clientDataHash))
+	signedData = append(signedData, rawAuthData...)
+	signedData = append(signedData, clientDataHash[:]...)
+
+	signature, err := encoding.DecodeString(response.Signature)
+	if err != nil {
+		return 0, errors.New("webauthn: invalid signature encoding")
+	}
+
+	if err := verifySignature(publicKey, signedData, signature); err != nil {
+		return 0, err
+	}
+
+	// Authenticators that do not implement a counter always return zero.
+	if (authData.signCount != 0 || signCount != 0) && authData.signCount <= signCount {
+		return 0, errors.New("webauthn: signature counter did not increase, the authenticator may have been cloned")
+	}
+
+	return authData.signCount, nil
+}
+
+func (rp *RelyingParty) verifyClientData(encodedClientData, ceremony, challenge string) error {
+	rawClientData, err := encoding.DecodeString(encodedClientData)
+	if err != nil {
+		return errors.New("webauthn: invalid client data encoding")
+	}
+
+	var data clientData
+	if err := json.Unmarshal(rawClientData, &data); err != nil {
+		return fmt.Errorf("webauthn: invalid client data: %v", err)
+	}
+
+	if data.Type != ceremony {
+		return fmt.Errorf("webauthn: unexpected ceremony type %q", data.Type)
+	}
+
+	if challenge == "" || subtle.ConstantTimeCompare([]byte(data.Challenge), []byte(challenge)) != 1 {
+		return errors.New("webauthn: challenge mismatch")
+	}
+
+	if data.Origin != rp.Origin {
+		return fmt.Errorf("webauthn: unexpected origin %q", data.Origin)
+	}
+
+	return nil
+}
+
+func (rp *RelyingParty) parseAuthenticatorData(data []byte) (*authenticatorData, error) {
+	if len(data) < 37 {
+		return nil, errors.New("webauthn: authenticator data is too short")
+	}
+
+	authData := &authenticatorData{
+		rpIDHash:  data[:32],
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if !bytes.Equal(authData.rpIDHash, rpIDHash[:]) {
+		return nil, errors.New("webauthn: relying party ID mismatch")
+	}
+
+	if authData.flags&flagUserPresent == 0 {
+		return nil, errors.New("webauthn: user presence is required")
+	}
+
+	if authData.flags&flagAttestedCredentialData != 0 {
+		rest := data[37:]
+		if len(rest) < 18 {
+			return nil, errors.New("webauthn: attested credential data is too short")
+		}
+
+		length := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if length == 0 || len(rest) < length {
+			return nil, errors.New("webauthn: invalid credential ID")
+		}
+
+		authData.credentialID = rest[:length]
+
+		coseKey, _, err := decodeCBOR(rest[length:])
+		if err != nil {
+			return nil, err
+		}
+
+		if authData.publicKey, err = parseCOSEKey(coseKey); err != nil {
+			return nil, err
+		}
+	}
+
+	return authData, nil
+}
+
+// parseCOSEKey converts a COSE public key to its PKIX, ASN.1 DER form.
+func parseCOSEKey(value interface{}) ([]byte, error) {
+	key, ok := value.(map[interface{}]interface{})
+	if !ok {
+		return nil, errors.New("webauthn: invalid COSE key")
+	}
+
+	alg, _ := key[int64(3)].(int64)
+
+	var publicKey interface{}
+	switch alg {
+	case algES256:
+		crv, _ := key[int64(-1)].(int64)
+		x, _ := key[int64(-2)].([]byte)
+		y, _ := key[int64(-3)].([]byte)
+		if crv != 1 || len(x) != 32 || len(y) != 32 {
+			return nil, errors.New("webauthn: invalid EC2 key")
+		}
+
+		ecKey := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !ecKey.Curve.IsOnCurve(ecKey.X, ecKey.Y) {
+			return nil, errors.New("webauthn: EC2 key is not on the curve")
+		}
+		publicKey = ecKey
+	case algEdDSA:
+		crv, _ := key[int64(-1)].(int64)
+		x, _ := key[int64(-2)].([]byte)
+		if crv != 6 || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("webauthn: invalid OKP key")
+		}
+		publicKey = ed25519.PublicKey(x)
+	case algRS256:
+		n, _ := key[int64(-1)].([]byte)
+		e, _ := key[int64(-2)].([]byte)
+		if len(n) < 256 || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("webauthn: invalid RSA key")
+		}
+		publicKey = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	default:
+		return nil, fmt.Errorf("webauthn: unsupported algorithm %d", alg)
+	}
+
+	return x509.MarshalPKIXPublicKey(publicKey)
+}
+
+func verifySignature(publicKey, data, signature []byte) error {
+	key, err := x509.ParsePKIXPublicKey(publicKey)
+	if err != nil {
+		return fmt.Errorf("webauthn: invalid public key: %v", err)
+	}
+
+	digest := sha256.Sum256(data)
+	valid := false
+
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		var sig struct{ R, S *big.Int }
+		if rest, err := asn1.Unmarshal(signature, &sig); err == nil && len(rest) == 0 && sig.R != nil && sig.S != nil {
+			valid = ecdsa.Verify(k, digest[:], sig.R, sig.S)
+		}
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(k, data, signature)
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], signature) == nil
+	}
+
+	if !valid {
+		return errors.New("webauthn: invalid signature")
+	}
+
+	return nil
+}
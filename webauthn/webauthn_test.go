@@ -0,0 +1,378 @@
+// Copyright 2020 Frédéric Guillot. All rights reserved.
+// Use of this source code is governed by the Apache 2.0
+// license that can be found in the LICENSE file.
+
+package webauthn // import "miniflux.app/webauthn"
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+// softwareAuthenticator emulates a security key for tests.
+type softwareAuthenticator struct {
+	rpID         string
+	origin       string
+	credentialID []byte
+	ecKey        *ecdsa.PrivateKey
+	edKey        ed25519.PrivateKey
+	signCount    uint32
+	flags        byte
+}
+
+func newSoftwareAuthenticator(t *testing.T, rpID, origin string) *softwareAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &softwareAuthenticator{
+		rpID:         rpID,
+		origin:       origin,
+		credentialID: []byte("software-credential"),
+		ecKey:        key,
+		flags:        flagUserPresent | flagUserVerified,
+	}
+}
+
+func (a *softwareAuthenticator) clientData(ceremony, challenge string) string {
+	data, _ := json.Marshal(map[string]interface{}{
+		"type":        ceremony,
+		"challenge":   challenge,
+		"origin":      a.origin,
+		"crossOrigin": false,
+	})
+	return encoding.EncodeToString(data)
+}
+
+func (a *softwareAuthenticator) authenticatorData(flags byte, attestedData []byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(a.rpID))
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, flags)
+	data = append(data, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(data[33:], a.signCount)
+	return append(data, attestedData...)
+}
+
+func (a *softwareAuthenticator) coseKey() []byte {
+	if a.edKey != nil {
+		return encodeCBOR(map[int64]interface{}{
+			1:  int64(1),
+			3:  int64(algEdDSA),
+			-1: int64(6),
+			-2: []byte(a.edKey.Public().(ed25519.PublicKey)),
+		})
+	}
+
+	x := make([]byte, 32)
+	y := make([]byte, 32)
+	a.ecKey.X.FillBytes(x)
+	a.ecKey.Y.FillBytes(y)
+
+	return encodeCBOR(map[int64]interface{}{
+		1:  int64(2),
+		3:  int64(algES256),
+		-1: int64(1),
+		-2: x,
+		-3: y,
+	})
+}
+
+func (a *softwareAuthenticator) create(challenge string) *AttestationResponse {
+	attestedData := make([]byte, 16)
+	attestedData = append(attestedData, byte(len(a.credentialID)>>8), byte(len(a.credentialID)))
+	attestedData = append(attestedData, a.credentialID...)
+	attestedData = append(attestedData, a.coseKey()...)
+
+	attestationObject := encodeCBOR(map[string]interface{}{
+		"fmt":      "none",
+		"attStmt":  map[string]interface{}{},
+		"authData": a.authenticatorData(a.flags|flagAttestedCredentialData, attestedData),
+	})
+
+	return &AttestationResponse{
+		ClientDataJSON:    a.clientData("webauthn.create", challenge),
+		AttestationObject: encoding.EncodeToString(attestationObject),
+	}
+}
+
+func (a *softwareAuthenticator) get(t *testing.T, challenge string) *AssertionResponse {
+	a.signCount++
+	authData := a.authenticatorData(a.flags, nil)
+	clientData := a.clientData("webauthn.get", challenge)
+
+	rawClientData, _ := encoding.DecodeString(clientData)
+	clientDataHash := sha256.Sum256(rawClientData)
+	signedData := append(append([]byte{}, authData...), clientDataHash[:]...)
+
+	var signature []byte
+	if a.edKey != nil {
+		signature = ed25519.Sign(a.edKey, signedData)
+	} else {
+		digest := sha256.Sum256(signedData)
+		r, s, err := ecdsa.Sign(rand.Reader, a.ecKey, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if signature, err = asn1.Marshal(struct{ R, S *big.Int }{r, s}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return &AssertionResponse{
+		CredentialID:      encoding.EncodeToString(a.credentialID),
+		ClientDataJSON:    clientData,
+		AuthenticatorData: encoding.EncodeToString(authData),
+		Signature:         encoding.EncodeToString(signature),
+	}
+}
+
+// encodeCBOR encodes the few types used by the software authenticator.
+func encodeCBOR(value interface{}) []byte {
+	header := func(major byte, length int) []byte {
+		switch {
+		case length < 24:
+			return []byte{major<<5 | byte(length)}
+		case length < 256:
+			return []byte{major<<5 | 24, byte(length)}
+		default:
+			return []byte{major<<5 | 25, byte(length >> 8), byte(length)}
+		}
+	}
+
+	switch v := value.(type) {
+	case int64:
+		if v < 0 {
+			return header(1, int(-1-v))
+		}
+		return header(0, int(v))
+	case []byte:
+		return append(header(2, len(v)), v...)
+	case string:
+		return append(header(3, len(v)), v...)
+	case map[string]interface{}:
+		out := header(5, len(v))
+		for key, item := range v {
+			out = append(out, encodeCBOR(key)...)
+			out = append(out, encodeCBOR(item)...)
+		}
+		return out
+	case map[int64]interface{}:
+		out := header(5, len(v))
+		for key, item := range v {
+			out = append(out, encodeCBOR(key)...)
+			out = append(out, encodeCBOR(item)...)
+		}
+		return out
+	}
+	return nil
+}
+
+func newTestRelyingParty(t *testing.T) *RelyingParty {
+	rp, err := NewRelyingParty("https://reader.example.org/miniflux/", "Miniflux")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rp
+}
+
+func TestNewRelyingParty(t *testing.T) {
+	rp, err := NewRelyingParty("http://localhost:8080/", "Miniflux")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rp.ID != "localhost" {
+		t.Errorf(`Unexpected relying party ID: %q`, rp.ID)
+	}
+
+	if rp.Origin != "http://localhost:8080" {
+		t.Errorf(`Unexpected origin: %q`, rp.Origin)
+	}
+
+	if _, err := NewRelyingParty("/miniflux", "Miniflux"); err == nil {
+		t.Error(`A base URL without hostname should be rejected`)
+	}
+}
+
+func TestRegistrationAndAssertion(t *testing.T) {
+	rp := newTestRelyingParty(t)
+	authenticator := newSoftwareAuthenticator(t, rp.ID, rp.Origin)
+
+	challenge := NewChallenge()
+	credential, err := rp.VerifyRegistration(challenge, authenticator.create(challenge))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if credential.ID != encoding.EncodeToString(authenticator.credentialID) {
+		t.Errorf(`Unexpected credential ID: %q`, credential.ID)
+	}
+
+	for i := uint32(1); i <= 2; i++ {
+		challenge = NewChallenge()
+		signCount, err := rp.VerifyAssertion(challenge, authenticator.get(t, challenge), credential.PublicKey, credential.SignCount, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if signCount != i {
+			t.Errorf(`Unexpected signature counter: got %d instead of %d`, signCount, i)
+		}
+		credential.SignCount = signCount
+	}
+}
+
+func TestEd25519Assertion(t *testing.T) {
+	rp := newTestRelyingParty(t)
+	authenticator := newSoftwareAuthenticator(t, rp.ID, rp.Origin)
+	_, authenticator.edKey, _ = ed25519.GenerateKey(rand.Reader)
+
+	challenge := NewChallenge()
+	credential, err := rp.VerifyRegistration(challenge, authenticator.create(challenge))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	challenge = NewChallenge()
+	if _, err := rp.VerifyAssertion(challenge, authenticator.get(t, challenge), credential.PublicKey, credential.SignCount, true); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRegistrationWithWrongOrigin(t *testing.T) {
+	rp := newTestRelyingParty(t)
+	authenticator := newSoftwareAuthenticator(t, rp.ID, "https://evil.example.org")
+
+	challenge := NewChallenge()
+	if _, err := rp.VerifyRegistration(challenge, authenticator.create(challenge)); err == nil || !strings.Contains(err.Error(), "origin") {
+		t.Errorf(`A response from another origin should be rejected, got %v`, err)
+	}
+}
+
+func TestRegistrationWithWrongRelyingPartyID(t *testing.T) {
+	rp := newTestRelyingParty(t)
+	authenticator := newSoftwareAuthenticator(t, "example.org", rp.Origin)
+
+	challenge := NewChallenge()
+	if _, err := rp.VerifyRegistration(challenge, authenticator.create(challenge)); err == nil {
+		t.Error(`A credential scoped to another relying party should be rejected`)
+	}
+}
+
+func TestRegistrationWithWrongChallenge(t *testing.T) {
+	rp := newTestRelyingParty(t)
+	authenticator := newSoftwareAuthenticator(t, rp.ID, rp.Origin)
+
+	if _, err := rp.VerifyRegistration(NewChallenge(), authenticator.create(NewChallenge())); err == nil {
+		t.Error(`A response to another challenge should be rejected`)
+	}
+
+	if _, err := rp.VerifyRegistration("", authenticator.create("")); err == nil {
+		t.Error(`An empty challenge should be rejected`)
+	}
+}
+
+func TestAssertionWithWrongCeremony(t *testing.T) {
+	rp := newTestRelyingParty(t)
+	authenticator := newSoftwareAuthenticator(t, rp.ID, rp.Origin)
+
+	challenge := NewChallenge()
+	credential, err := rp.VerifyRegistration(challenge, authenticator.create(challenge))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response := authenticator.get(t, challenge)
+	response.ClientDataJSON = authenticator.clientData("webauthn.create", challenge)
+	if _, err := rp.VerifyAssertion(challenge, response, credential.PublicKey, 0, true); err == nil {
+		t.Error(`A registration client data should not be accepted for an assertion`)
+	}
+}
+
+func TestAssertionWithInvalidSignature(t *testing.T) {
+	rp := newTestRelyingParty(t)
+	authenticator := newSoftwareAuthenticator(t, rp.ID, rp.Origin)
+
+	challenge := NewChallenge()
+	credential, err := rp.VerifyRegistration(challenge, authenticator.create(challenge))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Another key signs with the same credential ID.
+	impostor := newSoftwareAuthenticator(t, rp.ID, rp.Origin)
+	challenge = NewChallenge()
+	if _, err := rp.VerifyAssertion(challenge, impostor.get(t, challenge), credential.PublicKey, 0, true); err == nil {
+		t.Error(`A signature made with another key should be rejected`)
+	}
+}
+
+func TestAssertionWithoutUserVerification(t *testing.T) {
+	rp := newTestRelyingParty(t)
+	authenticator := newSoftwareAuthenticator(t, rp.ID, rp.Origin)
+	authenticator.flags = flagUserPresent
+
+	challenge := NewChallenge()
+	credential, err := rp.VerifyRegistration(challenge, authenticator.create(challenge))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	challenge = NewChallenge()
+	if _, err := rp.VerifyAssertion(challenge, authenticator.get(t, challenge), credential.PublicKey, 0, true); err == nil {
+		t.Error(`Passwordless login should require user verification`)
+	}
+
+	challenge = NewChallenge()
+	if _, err := rp.VerifyAssertion(challenge, authenticator.get(t, challenge), credential.PublicKey, 1, false); err != nil {
+		t.Errorf(`User presence should be enough when verification is not required: %v`, err)
+	}
+}
+
+func TestAssertionWithSignCountRegression(t *testing.T) {
+	rp := newTestRelyingParty(t)
+	authenticator := newSoftwareAuthenticator(t, rp.ID, rp.Origin)
+
+	challenge := NewChallenge()
+	credential, err := rp.VerifyRegistration(challenge, authenticator.create(challenge))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	challenge = NewChallenge()
+	if _, err := rp.VerifyAssertion(challenge, authenticator.get(t, challenge), credential.PublicKey, 5, true); err == nil {
+		t.Error(`A signature counter lower than the stored one should be rejected`)
+	}
+}
+
+func TestCreationOptions(t *testing.T) {
+	rp := newTestRelyingParty(t)
+	options := rp.CreationOptions("challenge", 42, "alice", []string{"existing"})
+
+	if options.RP.ID != "reader.example.org" {
+		t.Errorf(`Unexpected relying party ID: %q`, options.RP.ID)
+	}
+
+	if options.User.ID != UserHandle(42) {
+		t.Errorf(`Unexpected user handle: %q`, options.User.ID)
+	}
+
+	if len(options.ExcludeCredentials) != 1 || options.ExcludeCredentials[0].ID != "existing" {
+		t.Errorf(`Existing credentials should be excluded`)
+	}
+
+	if rp.RequestOptions("challenge").UserVerification != "required" {
+		t.Error(`Passwordless login should require user verification`)
+	}
+}